@@ -0,0 +1,256 @@
+// Command admin manages the service from the command line:
+//
+//	admin apikey create -name <name> -subject <subject> [-tenant <id>] [-ttl <duration>]
+//	admin apikey list
+//	admin apikey revoke -id <id>
+//	admin grant create [-tenant <id>] -subject <subject> -role viewer|manager|admin [-building <id>]
+//	admin grant list [-tenant <id>]
+//	admin grant delete [-tenant <id>] -id <id>
+//
+// It reads the same environment (and .env file) as the service.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/sotskov-do/oms-assignment/internal/config"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/service/apikeys"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/storage/postgres"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const usage = `usage:
+  admin apikey create -name <name> -subject <subject> [-tenant <id>] [-ttl <duration>]
+  admin apikey list
+  admin apikey revoke -id <id>
+  admin grant create [-tenant <id>] -subject <subject> -role viewer|manager|admin [-building <id>]
+  admin grant list [-tenant <id>]
+  admin grant delete [-tenant <id>] -id <id>
+`
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	err := run(ctx, os.Args[1:], os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) < 2 || (args[0] != "apikey" && args[0] != "grant") {
+		return errors.New(usage)
+	}
+
+	if os.Getenv(config.IsLocal) == "" {
+		err := godotenv.Load(config.ConfigPath)
+		if err != nil {
+			return fmt.Errorf("error loading config: %w", err)
+		}
+	}
+
+	var err error
+	opts := postgres.DefaultOptions()
+	opts.MaxOpenConns, opts.MaxIdleConns = 1, 1
+	opts.RowLevelSecurity, err = config.Bool(config.PgRowLevelSecurity, false)
+	if err != nil {
+		return fmt.Errorf("invalid db config: %w", err)
+	}
+	db, err := postgres.New(ctx, os.Getenv(config.PgURL), opts)
+	if err != nil {
+		return fmt.Errorf("can't create db: %w", err)
+	}
+	defer db.Stop(ctx)
+
+	err = db.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("can't ping db: %w", err)
+	}
+	migrate, err := config.Bool(config.PgMigrate, true)
+	if err != nil {
+		return fmt.Errorf("invalid db config: %w", err)
+	}
+	if migrate {
+		err = db.Migrate(ctx)
+		if err != nil {
+			return fmt.Errorf("can't migrate db: %w", err)
+		}
+	}
+
+	// The CLI acts without a principal, so it is not restricted by the grants.
+	keys := apikeys.NewService(db)
+	grants := access.NewService(db)
+
+	switch args[0] + " " + args[1] {
+	case "apikey create":
+		return createAPIKey(ctx, keys, args[2:], out)
+	case "apikey list":
+		return listAPIKeys(ctx, keys, out)
+	case "apikey revoke":
+		return revokeAPIKey(ctx, keys, args[2:], out)
+	case "grant create":
+		return createGrant(ctx, grants, args[2:], out)
+	case "grant list":
+		return listGrants(ctx, grants, args[2:], out)
+	case "grant delete":
+		return deleteGrant(ctx, grants, args[2:], out)
+	default:
+		return errors.New(usage)
+	}
+}
+
+func createAPIKey(ctx context.Context, s *apikeys.Service, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("apikey create", flag.ContinueOnError)
+	name := fs.String("name", "", "name of the key, e.g. the system using it")
+	subject := fs.String("subject", "", "principal the key authenticates as")
+	tenantID := fs.String("tenant", "", "tenant the key is bound to, empty lets the requests choose one")
+	ttl := fs.Duration("ttl", 0, "lifetime of the key, 0 never expires")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	key, apiKey, err := s.CreateAPIKey(ctx, *name, *subject, *tenantID, *ttl)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "created api key %d (%s) for %s\n", apiKey.ID, apiKey.Name, apiKey.Subject)
+	fmt.Fprintf(out, "%s\n", key)
+	fmt.Fprintln(out, "store it now, it can't be shown again")
+
+	return nil
+}
+
+func listAPIKeys(ctx context.Context, s *apikeys.Service, out io.Writer) error {
+	keys, err := s.GetAPIKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSUBJECT\tTENANT\tPREFIX\tCREATED\tEXPIRES\tREVOKED")
+	for _, k := range keys {
+		keyTenant := "-"
+		if k.Tenant != nil {
+			keyTenant = *k.Tenant
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			k.ID, k.Name, k.Subject, keyTenant, k.Prefix, formatTime(&k.CreatedAt), formatTime(k.ExpiresAt), formatTime(k.RevokedAt))
+	}
+
+	return w.Flush()
+}
+
+func revokeAPIKey(ctx context.Context, s *apikeys.Service, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("apikey revoke", flag.ContinueOnError)
+	id := fs.Int("id", 0, "id of the key")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	err = s.RevokeAPIKey(ctx, *id)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "revoked api key %d\n", *id)
+
+	return nil
+}
+
+func createGrant(ctx context.Context, s *access.Service, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("grant create", flag.ContinueOnError)
+	tenantID := fs.String("tenant", tenant.Default, "tenant of the grant")
+	subject := fs.String("subject", "", "principal the role is granted to")
+	role := fs.String("role", "", "viewer, manager or admin")
+	building := fs.Int("building", 0, "id of the building, 0 grants the role on every building")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	grant := &storage.Grant{Subject: *subject, Role: *role}
+	if *building != 0 {
+		grant.BuildingID = building
+	}
+	err = s.CreateGrant(tenant.WithID(ctx, *tenantID), grant)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "granted %s to %s on %s\n", grant.Role, grant.Subject, formatBuilding(grant.BuildingID))
+
+	return nil
+}
+
+func listGrants(ctx context.Context, s *access.Service, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("grant list", flag.ContinueOnError)
+	tenantID := fs.String("tenant", tenant.Default, "tenant of the grants")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	grants, err := s.GetGrants(tenant.WithID(ctx, *tenantID))
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSUBJECT\tROLE\tBUILDING\tCREATED")
+	for _, g := range grants {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			g.ID, g.Subject, g.Role, formatBuilding(g.BuildingID), formatTime(&g.CreatedAt))
+	}
+
+	return w.Flush()
+}
+
+func deleteGrant(ctx context.Context, s *access.Service, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("grant delete", flag.ContinueOnError)
+	tenantID := fs.String("tenant", tenant.Default, "tenant of the grant")
+	id := fs.Int("id", 0, "id of the grant")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	err = s.DeleteGrant(tenant.WithID(ctx, *tenantID), *id)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "deleted grant %d\n", *id)
+
+	return nil
+}
+
+func formatBuilding(id *int) string {
+	if id == nil {
+		return "all buildings"
+	}
+	return fmt.Sprintf("building %d", *id)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -2,77 +2,506 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/joho/godotenv"
+	"github.com/sotskov-do/oms-assignment/internal/auth"
 	"github.com/sotskov-do/oms-assignment/internal/config"
 	"github.com/sotskov-do/oms-assignment/internal/controllers"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/admin"
 	"github.com/sotskov-do/oms-assignment/internal/controllers/bms"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/bmsv2"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/gql"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/probes"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/health"
+	"github.com/sotskov-do/oms-assignment/internal/idempotency"
+	"github.com/sotskov-do/oms-assignment/internal/lifecycle"
 	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/metrics"
+	"github.com/sotskov-do/oms-assignment/internal/pii"
+	"github.com/sotskov-do/oms-assignment/internal/ratelimit"
+	"github.com/sotskov-do/oms-assignment/internal/rpc"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
 	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/apikeys"
 	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+	"github.com/sotskov-do/oms-assignment/internal/service/floors"
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/service/residents"
+	"github.com/sotskov-do/oms-assignment/internal/service/search"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
 	"github.com/sotskov-do/oms-assignment/internal/storage/postgres"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
 )
 
 var (
-	app *fiber.App
-	db  *postgres.PostgresDatabase
+	app            *fiber.App
+	db             *postgres.PostgresDatabase
+	healthRegistry *health.Registry
+	lc             *lifecycle.Lifecycle
 )
 
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
 	defer cancel()
-	go start(ctx)
-	<-ctx.Done()
-	stop(ctx)
+
+	err := run(ctx)
+	if err != nil {
+		slog.Log(ctx, logger.LevelCritical, "app failed", "error", err)
+		os.Exit(1)
+	}
 }
 
-func start(ctx context.Context) {
+func run(ctx context.Context) error {
+	lc = lifecycle.New()
+
+	serveErr, err := start(ctx)
+	if err != nil {
+		// Release whatever was started before the failure.
+		return errors.Join(err, stop())
+	}
+
+	select {
+	case <-ctx.Done():
+	case err = <-serveErr:
+		if err == nil {
+			err = errors.New("server stopped unexpectedly")
+		}
+	}
+
+	return errors.Join(err, stop())
+}
+
+func start(ctx context.Context) (_ <-chan error, err error) {
+	// undo releases what was started, in the reverse order, when the startup fails.
+	var undo []func(ctx context.Context) error
+	defer func() {
+		if err == nil {
+			return
+		}
+		ctx := context.WithoutCancel(ctx)
+		for i := len(undo) - 1; i >= 0; i-- {
+			_ = undo[i](ctx)
+		}
+	}()
+
 	// ENV
-	var err error
 	if os.Getenv(config.IsLocal) == "" {
 		err := godotenv.Load(config.ConfigPath)
 		if err != nil {
-			slog.Log(ctx, logger.LevelCritical, "error loading config", "error", err)
-			os.Exit(1)
+			return nil, fmt.Errorf("error loading config: %w", err)
 		}
 	}
 
 	// Logger
-	l := logger.New(true)
+	logOpts, err := logger.LoadOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid log config: %w", err)
+	}
+	l, logLevels := logger.New(os.Stdout, logOpts)
 	slog.SetDefault(l)
 
+	// Tracing
+	tracingOpts, err := loadTracingOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracing config: %w", err)
+	}
+	stopTracing, err := tracing.Setup(ctx, tracingOpts)
+	if err != nil {
+		return nil, fmt.Errorf("can't setup tracing: %w", err)
+	}
+	undo = append(undo, stopTracing)
+
+	// Health
+	healthRegistry, err = newHealthRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("invalid health config: %w", err)
+	}
+	readinessDelay, err := config.Duration(config.ShutdownReadinessDelay, 0)
+	if err != nil {
+		return nil, err
+	}
+	lc.OnStop("readiness", func(ctx context.Context) error {
+		healthRegistry.SetShuttingDown()
+		// Give the load balancer time to notice that the instance is not ready anymore.
+		select {
+		case <-time.After(readinessDelay):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
 	// DB
-	db, err = postgres.New(ctx, os.Getenv(config.PgURL))
+	dbOpts, err := loadDBOptions()
 	if err != nil {
-		slog.Log(ctx, logger.LevelCritical, "can't create db", "error", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("invalid db config: %w", err)
 	}
-	err = db.Ping(ctx)
+	db, err = postgres.New(ctx, os.Getenv(config.PgURL), dbOpts)
 	if err != nil {
-		slog.Log(ctx, logger.LevelCritical, "can't ping db", "error", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("can't create db: %w", err)
+	}
+	undo = append(undo, db.Stop)
+	err = db.PingWithRetry(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't ping db: %w", err)
+	}
+	migrate, err := config.Bool(config.PgMigrate, true)
+	if err != nil {
+		return nil, fmt.Errorf("invalid db config: %w", err)
+	}
+	if migrate {
+		err = db.Migrate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("can't migrate db: %w", err)
+		}
+	}
+	registerDBChecks(healthRegistry)
+	cipher, err := newCipher()
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption keys: %w", err)
+	}
+	db.SetCipher(cipher)
+
+	// Metrics
+	totalsTTL, err := config.Duration(config.MetricsTotalsTTL, 30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics config: %w", err)
 	}
+	metrics := metrics.New()
+	metrics.RegisterDBStats(db.Stats)
+	metrics.RegisterTotals(db.GetTotals, totalsTTL)
+	db.SetQueryObserver(metrics.ObserveQuery)
 
 	// BMS
-	apartmentsService := apartments.NewService(db)
-	buildingsService := buildings.NewService(db)
-	bms := bms.NewBuildingManagementSystem(apartmentsService, buildingsService)
+	accessService := access.NewService(db)
+	apartmentsService := apartments.NewService(db, db, accessService)
+	geocoder, err := newGeocoder()
+	if err != nil {
+		return nil, fmt.Errorf("can't load gazetteer: %w", err)
+	}
+	buildingsService := buildings.NewService(db, accessService, geocoder)
+	searchService := search.NewService(db, db, accessService)
+	floorsService := floors.NewService(db, db, accessService)
+	residentsService := residents.NewService(db, db, accessService)
+	leasesService := leases.NewService(db, db, accessService)
+	bms := bms.NewBuildingManagementSystem(apartmentsService, buildingsService, searchService, floorsService, residentsService, leasesService)
+	bmsV2 := bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, searchService, floorsService, residentsService, leasesService)
+	graphQL := gql.NewGraphQL(apartmentsService, buildingsService)
+	admin := admin.NewAdmin(db, db, logLevels, accessService, accessService)
+	probes := probes.NewProbes(healthRegistry)
+
+	// Auth
+	authenticator, err := newAuthenticator(apikeys.NewService(db))
+	if err != nil {
+		return nil, fmt.Errorf("can't configure auth: %w", err)
+	}
+
+	// Rate limiting, the sweepers of its buckets and of the idempotency keys are workers
+	undo = append(undo, lc.StopWorkers)
+	rateLimit, ipRateLimit, err := newRateLimit()
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit config: %w", err)
+	}
+
+	// Idempotency keys
+	idempotencyKeys, err := newIdempotency()
+	if err != nil {
+		return nil, fmt.Errorf("invalid idempotency config: %w", err)
+	}
+
+	// Responses that don't match the OpenAPI document are replaced with errors, for development only
+	validateResponses, err := config.Bool(config.OpenAPIValidateResponses, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid openapi config: %w", err)
+	}
+
+	// The unprefixed aliases of the v1 routes are removed at the sunset
+	legacySunset, err := config.Date(config.LegacyRoutesSunset, time.Date(2027, time.April, 19, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("invalid versioning config: %w", err)
+	}
 
 	// App
 	app = fiber.New()
-	controllers.SetupRoutes(app, bms)
-	go app.Listen(":3000")
+	controllers.SetupRoutes(app, metrics, bms, bmsV2, graphQL, admin, probes, authenticator, rateLimit, ipRateLimit, idempotencyKeys, validateResponses, legacySunset)
+
+	addr := os.Getenv(config.HTTPAddr)
+	if addr == "" {
+		addr = ":3000"
+	}
+	serveErr, err := lifecycle.Serve(app, addr)
+	if err != nil {
+		return nil, fmt.Errorf("can't listen on %s: %w", addr, err)
+	}
+	undo = append(undo, app.ShutdownWithContext)
 
-	slog.Info("app started")
+	// gRPC, for the internal services, shares the services of the HTTP API
+	grpcServer := rpc.NewServer(apartmentsService, buildingsService, authenticator)
+	grpcAddr := os.Getenv(config.GRPCAddr)
+	if grpcAddr == "" {
+		grpcAddr = ":3001"
+	}
+	grpcErr, err := lifecycle.Listen(grpcAddr, grpcServer.Serve)
+	if err != nil {
+		return nil, fmt.Errorf("can't listen on %s: %w", grpcAddr, err)
+	}
+
+	// Components are stopped in this order: readiness (above), HTTP, gRPC, workers, DB, tracing.
+	lc.OnStop("http", app.ShutdownWithContext)
+	lc.OnStop("grpc", func(ctx context.Context) error {
+		return rpc.Shutdown(ctx, grpcServer)
+	})
+	lc.OnStop("workers", lc.StopWorkers)
+	lc.OnStop("postgres", db.Stop)
+	lc.OnStop("tracing", stopTracing)
+
+	slog.Info("app started", "addr", addr, "grpc_addr", grpcAddr)
+
+	return lifecycle.First(serveErr, grpcErr), nil
 }
 
-func stop(ctx context.Context) {
+func stop() error {
 	slog.Info("shutting down")
-	_ = app.ShutdownWithContext(ctx)
-	_ = db.Stop(ctx)
+
+	timeout, err := config.Duration(config.ShutdownTimeout, 30*time.Second)
+	if err != nil {
+		slog.Error("invalid shutdown timeout, using default", "error", err)
+		timeout = 30 * time.Second
+	}
+
+	return lc.Shutdown(timeout)
+}
+
+func loadDBOptions() (postgres.Options, error) {
+	opts := postgres.DefaultOptions()
+
+	var err, errs error
+	opts.MaxOpenConns, err = config.Int(config.PgMaxOpenConns, opts.MaxOpenConns)
+	errs = errors.Join(errs, err)
+	opts.MaxIdleConns, err = config.Int(config.PgMaxIdleConns, opts.MaxIdleConns)
+	errs = errors.Join(errs, err)
+	opts.ConnMaxLifetime, err = config.Duration(config.PgConnMaxLifetime, opts.ConnMaxLifetime)
+	errs = errors.Join(errs, err)
+	opts.ConnMaxIdleTime, err = config.Duration(config.PgConnMaxIdleTime, opts.ConnMaxIdleTime)
+	errs = errors.Join(errs, err)
+	opts.StatementTimeout, err = config.Duration(config.PgStatementTimeout, opts.StatementTimeout)
+	errs = errors.Join(errs, err)
+	opts.ConnectRetries, err = config.Int(config.PgConnectRetries, opts.ConnectRetries)
+	errs = errors.Join(errs, err)
+	opts.ConnectBackoff, err = config.Duration(config.PgConnectBackoff, opts.ConnectBackoff)
+	errs = errors.Join(errs, err)
+	opts.RowLevelSecurity, err = config.Bool(config.PgRowLevelSecurity, opts.RowLevelSecurity)
+	errs = errors.Join(errs, err)
+
+	return opts, errs
+}
+
+func loadTracingOptions() (tracing.Options, error) {
+	opts := tracing.Options{
+		Exporter: os.Getenv(config.TracingExporter),
+		File:     os.Getenv(config.TracingFile),
+	}
+
+	var err error
+	opts.SampleRatio, err = config.Float(config.TracingSampleRatio, 1)
+
+	return opts, err
+}
+
+// newAuthenticator returns nil when AUTH_ENABLED is false. The bearer tokens are accepted
+// when a JWT HMAC secret or JWKS file is configured.
+func newAuthenticator(apiKeys auth.APIKeyAuthenticator) (*auth.Authenticator, error) {
+	enabled, err := config.Bool(config.AuthEnabled, true)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		slog.Warn("authentication is disabled")
+		return nil, nil
+	}
+
+	opts := auth.JWTOptions{
+		HMACSecret: []byte(os.Getenv(config.JWTHMACSecret)),
+		Issuer:     os.Getenv(config.JWTIssuer),
+		Audience:   os.Getenv(config.JWTAudience),
+	}
+	opts.Leeway, err = config.Duration(config.JWTLeeway, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if path := os.Getenv(config.JWTJWKSFile); path != "" {
+		opts.Keys, err = auth.LoadJWKS(path)
+		if err != nil {
+			return nil, fmt.Errorf("can't load jwks: %w", err)
+		}
+	}
+
+	var verifier *auth.JWTVerifier
+	if len(opts.HMACSecret) > 0 || len(opts.Keys) > 0 {
+		verifier, err = auth.NewJWTVerifier(opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return auth.NewAuthenticator(apiKeys, verifier), nil
+}
+
+// newRateLimit returns the rate limiting middlewares of the clients and of the IPs, the buckets are
+// kept in memory or, to share them between the replicas, in Postgres. Their idle buckets are swept by a worker.
+func newRateLimit() (fiber.Handler, fiber.Handler, error) {
+	enabled, err := config.Bool(config.RateLimitEnabled, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !enabled {
+		return middleware.RateLimit(nil, ratelimit.Limit{}, ratelimit.Limit{}), middleware.RateLimitIP(nil, ratelimit.Limit{}), nil
+	}
+
+	var store storage.RateLimitStorage
+	switch s := os.Getenv(config.RateLimitStore); s {
+	case "", "memory":
+		store = ratelimit.NewMemoryStore()
+	case "postgres":
+		store = db
+	default:
+		return nil, nil, fmt.Errorf("unknown rate limit store [%v]", s)
+	}
+
+	read, write := ratelimit.Limit{Rate: 20, Burst: 100}, ratelimit.Limit{Rate: 5, Burst: 20}
+	ip := ratelimit.Limit{Rate: 50, Burst: 250}
+	var errs error
+	read.Rate, err = config.Float(config.RateLimitReadRate, read.Rate)
+	errs = errors.Join(errs, err)
+	read.Burst, err = config.Int(config.RateLimitReadBurst, read.Burst)
+	errs = errors.Join(errs, err)
+	write.Rate, err = config.Float(config.RateLimitWriteRate, write.Rate)
+	errs = errors.Join(errs, err)
+	write.Burst, err = config.Int(config.RateLimitWriteBurst, write.Burst)
+	errs = errors.Join(errs, err)
+	ip.Rate, err = config.Float(config.RateLimitIPRate, ip.Rate)
+	errs = errors.Join(errs, err)
+	ip.Burst, err = config.Int(config.RateLimitIPBurst, ip.Burst)
+	errs = errors.Join(errs, err)
+	if errs != nil {
+		return nil, nil, errs
+	}
+	if read.Rate <= 0 || write.Rate <= 0 || ip.Rate <= 0 || read.Burst < 1 || write.Burst < 1 || ip.Burst < 1 {
+		return nil, nil, errors.New("rates must be positive and bursts at least 1")
+	}
+
+	limiter := ratelimit.NewLimiter(store)
+	idle := max(ratelimit.IdleAfter(read, write, ip), time.Minute)
+	lc.Go("rate-limit-sweeper", func(ctx context.Context) {
+		limiter.Sweep(ctx, idle, idle)
+	})
+
+	return middleware.RateLimit(limiter, read, write), middleware.RateLimitIP(limiter, ip), nil
+}
+
+// newIdempotency returns the Idempotency-Key middleware, the responses are kept in Postgres or,
+// for a single replica, in memory. Their expired records are swept by a worker.
+func newIdempotency() (fiber.Handler, error) {
+	var store storage.IdempotencyStorage
+	switch s := os.Getenv(config.IdempotencyStore); s {
+	case "", "postgres":
+		store = db
+	case "memory":
+		store = idempotency.NewMemoryStore()
+	case "none":
+		return middleware.Idempotency(nil, 0, 0), nil
+	default:
+		return nil, fmt.Errorf("unknown idempotency store [%v]", s)
+	}
+
+	ttl, err := config.Duration(config.IdempotencyTTL, 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	lockTimeout, err := config.Duration(config.IdempotencyLockTimeout, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 || lockTimeout <= 0 {
+		return nil, errors.New("ttl and lock timeout must be positive")
+	}
+
+	interval := min(ttl, time.Hour)
+	lc.Go("idempotency-sweeper", func(ctx context.Context) {
+		idempotency.Sweep(ctx, store, interval)
+	})
+
+	return middleware.Idempotency(store, ttl, lockTimeout), nil
+}
+
+// newGeocoder returns the gazetteer of the GAZETTEER_FILE, nil without it: the buildings are then
+// only located by the coordinates they are written with.
+func newGeocoder() (geo.Geocoder, error) {
+	path := os.Getenv(config.GazetteerFile)
+	if path == "" {
+		return nil, nil
+	}
+
+	gazetteer, err := geo.LoadGazetteer(path)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("gazetteer loaded", "path", path, "places", gazetteer.Len())
+
+	return gazetteer, nil
+}
+
+// newCipher returns nil when no key is configured, the personal data is then stored in clear.
+func newCipher() (*pii.Cipher, error) {
+	cipher, err := pii.ParseKeys(os.Getenv(config.PIIEncryptionKeys))
+	if err != nil {
+		return nil, err
+	}
+	if cipher == nil {
+		slog.Warn("personal data encryption is disabled")
+	}
+
+	return cipher, nil
+}
+
+func newHealthRegistry() (*health.Registry, error) {
+	timeout, err := config.Duration(config.HealthCheckTimeout, 2*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return health.NewRegistry(timeout), nil
+}
+
+func registerDBChecks(registry *health.Registry) {
+	poolThreshold, err := config.Float(config.HealthPoolThreshold, 0.9)
+	if err != nil {
+		slog.Error("invalid pool threshold, using default", "error", err)
+		poolThreshold = 0.9
+	}
+
+	registry.Register(health.Check{
+		Name:      "postgres",
+		Check:     health.Ping(db),
+		Readiness: true,
+	})
+	registry.Register(health.Check{
+		Name:      "migrations",
+		Check:     health.PendingMigrations(db.PendingMigrations),
+		Readiness: true,
+	})
+	registry.Register(health.Check{
+		Name:  "postgres_pool",
+		Check: health.PoolSaturation(db.Stats, poolThreshold),
+	})
 }
@@ -0,0 +1,248 @@
+// Package address parses, normalises and renders the postal addresses of the buildings. The
+// address column of a building is the free text as the clients sent it or, when they sent the
+// components, their rendering, kept for the clients of the free-text address.
+package address
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+// Address is a structured postal address, the empty components are unknown.
+type Address struct {
+	Street      string
+	HouseNumber string
+	City        string
+	PostalCode  string
+	// Country is the ISO 3166-1 alpha-2 code once normalised.
+	Country string
+	// Latitude and Longitude are WGS 84 degrees, both or neither are set.
+	Latitude  *float64
+	Longitude *float64
+}
+
+var (
+	houseNumber = regexp.MustCompile(`^\d{1,5}[A-Za-z]?([-/]\d{1,5}[A-Za-z]?)?$`)
+	postalCode  = regexp.MustCompile(`^\d{3,10}(-\d{2,4})?$`)
+)
+
+// Parse splits a free-text address, e.g. "HaMishlatim 4" or "4 Main St, Springfield 62701, USA",
+// into its components. The first comma-separated part is the street, with the house number at its
+// end or at its start. The last part is the country if it names one, e.g. "Israel" or "USA", the
+// others are the city and the postal code. What isn't recognised is kept in the street or the
+// city, nothing is dropped.
+func Parse(text string) Address {
+	var parts []string
+	for _, p := range strings.Split(text, ",") {
+		if p = collapse(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if len(parts) == 0 {
+		return Address{}
+	}
+
+	var a Address
+	street := strings.Fields(parts[0])
+	switch n := len(street); {
+	case n > 1 && houseNumber.MatchString(street[n-1]):
+		a.Street, a.HouseNumber = strings.Join(street[:n-1], " "), street[n-1]
+	case n > 1 && houseNumber.MatchString(street[0]):
+		a.Street, a.HouseNumber = strings.Join(street[1:], " "), street[0]
+	default:
+		a.Street = parts[0]
+	}
+
+	rest := parts[1:]
+	if n := len(rest); n > 0 {
+		// The codes aren't recognised here, they would be mistaken for the states, e.g. "IL" or "CA".
+		if code, ok := countryOfName(rest[n-1]); ok {
+			a.Country = code
+			rest = rest[:n-1]
+		}
+	}
+	for _, p := range rest {
+		words := strings.Fields(p)
+		switch n := len(words); {
+		case a.PostalCode == "" && n == 1 && postalCode.MatchString(words[0]):
+			a.PostalCode = words[0]
+			continue
+		case a.PostalCode == "" && n > 1 && postalCode.MatchString(words[0]):
+			a.PostalCode, p = words[0], strings.Join(words[1:], " ")
+		case a.PostalCode == "" && n > 1 && postalCode.MatchString(words[n-1]):
+			a.PostalCode, p = words[n-1], strings.Join(words[:n-1], " ")
+		}
+		if a.City == "" {
+			a.City = p
+		} else {
+			a.City += ", " + p
+		}
+	}
+
+	return a
+}
+
+// Normalize collapses the whitespace of the components, spells out the street type abbreviations
+// ("Main St." becomes "Main Street"), upper-cases the house number and the postal code and
+// converts the country to its ISO 3166-1 alpha-2 code. It fails for an unknown country, a house
+// number without a street and coordinates that are out of range or not given together.
+func (a Address) Normalize() (Address, error) {
+	a.Street = normalizeStreet(a.Street)
+	a.HouseNumber = strings.ToUpper(collapse(a.HouseNumber))
+	a.City = collapse(a.City)
+	a.PostalCode = strings.ToUpper(collapse(a.PostalCode))
+
+	country, err := normalizeCountry(a.Country)
+	if err != nil {
+		return Address{}, err
+	}
+	a.Country = country
+	if a.HouseNumber != "" && a.Street == "" {
+		return Address{}, errors.New("house number without a street")
+	}
+	if (a.Latitude == nil) != (a.Longitude == nil) {
+		return Address{}, errors.New("latitude and longitude must be given together")
+	}
+	if a.Latitude != nil && (*a.Latitude < -90 || *a.Latitude > 90) {
+		return Address{}, fmt.Errorf("latitude [%v] out of range", *a.Latitude)
+	}
+	if a.Longitude != nil && (*a.Longitude < -180 || *a.Longitude > 180) {
+		return Address{}, fmt.Errorf("longitude [%v] out of range", *a.Longitude)
+	}
+
+	return a, nil
+}
+
+// String renders the address as "Street HouseNumber, PostalCode City, Country", without the
+// missing components.
+func (a Address) String() string {
+	var parts []string
+	for _, p := range []string{join(a.Street, a.HouseNumber), join(a.PostalCode, a.City), a.Country} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// IsZero reports whether the address has no component.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// OfBuilding returns the components of the address of the building.
+func OfBuilding(b *models.Building) Address {
+	return Address{
+		Street:      b.Street.String,
+		HouseNumber: b.HouseNumber.String,
+		City:        b.City.String,
+		PostalCode:  b.PostalCode.String,
+		Country:     b.Country.String,
+		Latitude:    b.Latitude.Ptr(),
+		Longitude:   b.Longitude.Ptr(),
+	}
+}
+
+// Assign sets the components of the address of the building and its rendered address, the
+// missing ones are NULL.
+func (a Address) Assign(b *models.Building) {
+	b.Street = nullString(a.Street)
+	b.HouseNumber = nullString(a.HouseNumber)
+	b.City = nullString(a.City)
+	b.PostalCode = nullString(a.PostalCode)
+	b.Country = nullString(a.Country)
+	b.Latitude = null.Float64FromPtr(a.Latitude)
+	b.Longitude = null.Float64FromPtr(a.Longitude)
+	b.Address = nullString(a.String())
+}
+
+// Resolve normalises the address of the building before it is stored: its components, then
+// rendered in the address, if it has any. Otherwise its free-text address is parsed into the
+// components and kept as is. The coordinates are kept either way. A free-text address sent with
+// the components must match them, it is kept then: an edited address can't be silently replaced.
+func Resolve(b *models.Building) error {
+	a := OfBuilding(b)
+	text, parsed := b.Address, false
+	if located := (Address{Latitude: a.Latitude, Longitude: a.Longitude}); a == located {
+		if !b.Address.Valid && a.IsZero() {
+			return nil
+		}
+		a = Parse(b.Address.String)
+		a.Latitude, a.Longitude = located.Latitude, located.Longitude
+		parsed = true
+	}
+
+	a, err := a.Normalize()
+	if err != nil {
+		return err
+	}
+	kept := parsed
+	if !parsed && text.Valid && collapse(text.String) != "" {
+		if !a.matches(text.String) {
+			return fmt.Errorf("address doesn't match its components [%v], send either of them", a)
+		}
+		kept = true
+	}
+	a.Assign(b)
+	if kept {
+		b.Address = text
+	}
+
+	return nil
+}
+
+// matches reports whether the free text is the normalised address, rendered or parsed, e.g.
+// "4 Main St" for the components "Main Street" and "4". The coordinates aren't compared.
+func (a Address) matches(text string) bool {
+	if strings.EqualFold(collapse(text), a.String()) {
+		return true
+	}
+	parsed, err := Parse(text).Normalize()
+	if err != nil {
+		return false
+	}
+	a.Latitude, a.Longitude = nil, nil
+	return strings.EqualFold(parsed.String(), a.String())
+}
+
+// streetTypes are the abbreviations of the street types spelled out at the end of the streets.
+var streetTypes = map[string]string{
+	"st":   "Street",
+	"ave":  "Avenue",
+	"av":   "Avenue",
+	"rd":   "Road",
+	"blvd": "Boulevard",
+	"ln":   "Lane",
+	"dr":   "Drive",
+	"sq":   "Square",
+	"hwy":  "Highway",
+}
+
+func normalizeStreet(street string) string {
+	words := strings.Fields(street)
+	if n := len(words); n > 1 {
+		if full, ok := streetTypes[strings.ToLower(strings.TrimSuffix(words[n-1], "."))]; ok {
+			words[n-1] = full
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// collapse trims the text and replaces its runs of whitespace with a space.
+func collapse(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func join(a, b string) string {
+	return strings.TrimSpace(a + " " + b)
+}
+
+func nullString(s string) null.String {
+	return null.NewString(s, s != "")
+}
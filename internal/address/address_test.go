@@ -0,0 +1,195 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+func Test_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text string
+		want Address
+	}{
+		{text: "", want: Address{}},
+		{text: " , ", want: Address{}},
+		{text: "HaMishlatim 4", want: Address{Street: "HaMishlatim", HouseNumber: "4"}},
+		{text: "4 Main St, Springfield 62701, USA", want: Address{
+			Street: "Main St", HouseNumber: "4", City: "Springfield", PostalCode: "62701", Country: "US",
+		}},
+		{text: "Unter den Linden 77, 10117 Berlin, Germany", want: Address{
+			Street: "Unter den Linden", HouseNumber: "77", City: "Berlin", PostalCode: "10117", Country: "DE",
+		}},
+		{text: "Herzl 12/3, Haifa, 3303612", want: Address{Street: "Herzl", HouseNumber: "12/3", City: "Haifa", PostalCode: "3303612"}},
+		{text: "1600 Pennsylvania Ave, Washington, DC, 20500-0003", want: Address{
+			Street: "Pennsylvania Ave", HouseNumber: "1600", City: "Washington, DC", PostalCode: "20500-0003",
+		}},
+		{text: "Springfield, IL", want: Address{Street: "Springfield", City: "IL"}},
+		{text: "  The   Old Mill ", want: Address{Street: "The Old Mill"}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Parse(tt.text), tt.text)
+	}
+}
+
+func Test_Normalize(t *testing.T) {
+	t.Parallel()
+
+	lat, lon, farLat, farLon := 32.79, 34.99, 91.0, -181.0
+
+	tests := []struct {
+		name    string
+		address Address
+		want    Address
+		wantErr bool
+	}{
+		{
+			name:    "abbreviations",
+			address: Address{Street: " main  st. ", HouseNumber: "4a", City: " Springfield ", PostalCode: "sw1a 1aa", Country: "usa"},
+			want:    Address{Street: "main Street", HouseNumber: "4A", City: "Springfield", PostalCode: "SW1A 1AA", Country: "US"},
+		},
+		{
+			name:    "singleWordStreet",
+			address: Address{Street: "St", Country: "il"},
+			want:    Address{Street: "St", Country: "IL"},
+		},
+		{
+			name:    "coordinates",
+			address: Address{Street: "HaMishlatim", Latitude: &lat, Longitude: &lon},
+			want:    Address{Street: "HaMishlatim", Latitude: &lat, Longitude: &lon},
+		},
+		{name: "unknownCountry", address: Address{Country: "Atlantis"}, wantErr: true},
+		{name: "houseNumberWithoutStreet", address: Address{HouseNumber: "4"}, wantErr: true},
+		{name: "latitudeOnly", address: Address{Latitude: &lat}, wantErr: true},
+		{name: "latitudeOutOfRange", address: Address{Latitude: &farLat, Longitude: &lon}, wantErr: true},
+		{name: "longitudeOutOfRange", address: Address{Latitude: &lat, Longitude: &farLon}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.address.Normalize()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", Address{}.String())
+	assert.Equal(t, "HaMishlatim 4", Address{Street: "HaMishlatim", HouseNumber: "4"}.String())
+	assert.Equal(t, "Main Street 4, 62701 Springfield, US", Address{
+		Street: "Main Street", HouseNumber: "4", City: "Springfield", PostalCode: "62701", Country: "US",
+	}.String())
+	assert.Equal(t, "Haifa, IL", Address{City: "Haifa", Country: "IL"}.String())
+}
+
+func Test_Resolve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("noAddress", func(t *testing.T) {
+		t.Parallel()
+
+		b := &models.Building{Name: "Tower"}
+		require.NoError(t, Resolve(b))
+		assert.Equal(t, &models.Building{Name: "Tower"}, b)
+	})
+
+	t.Run("parsed", func(t *testing.T) {
+		t.Parallel()
+
+		b := &models.Building{Address: null.StringFrom("HaMishlatim 4, Haifa, Israel")}
+		require.NoError(t, Resolve(b))
+		assert.Equal(t, &models.Building{
+			Address:     null.StringFrom("HaMishlatim 4, Haifa, Israel"),
+			Street:      null.StringFrom("HaMishlatim"),
+			HouseNumber: null.StringFrom("4"),
+			City:        null.StringFrom("Haifa"),
+			Country:     null.StringFrom("IL"),
+		}, b)
+	})
+
+	t.Run("parsedWithCoordinates", func(t *testing.T) {
+		t.Parallel()
+
+		b := &models.Building{
+			Address:   null.StringFrom("Herzl 12, Haifa"),
+			Latitude:  null.Float64From(32.81),
+			Longitude: null.Float64From(34.99),
+		}
+		require.NoError(t, Resolve(b))
+		assert.Equal(t, &models.Building{
+			Address:     null.StringFrom("Herzl 12, Haifa"),
+			Street:      null.StringFrom("Herzl"),
+			HouseNumber: null.StringFrom("12"),
+			City:        null.StringFrom("Haifa"),
+			Latitude:    null.Float64From(32.81),
+			Longitude:   null.Float64From(34.99),
+		}, b)
+
+		b = &models.Building{Latitude: null.Float64From(91), Longitude: null.Float64From(34.99)}
+		assert.EqualError(t, Resolve(b), "latitude [91] out of range")
+	})
+
+	t.Run("components", func(t *testing.T) {
+		t.Parallel()
+
+		b := &models.Building{
+			City:       null.StringFrom("Haifa"),
+			PostalCode: null.StringFrom(""),
+			Latitude:   null.Float64From(32.79),
+			Longitude:  null.Float64From(34.99),
+		}
+		require.NoError(t, Resolve(b))
+		assert.Equal(t, &models.Building{
+			Address:   null.StringFrom("Haifa"),
+			City:      null.StringFrom("Haifa"),
+			Latitude:  null.Float64From(32.79),
+			Longitude: null.Float64From(34.99),
+		}, b)
+	})
+
+	t.Run("componentsWithAddress", func(t *testing.T) {
+		t.Parallel()
+
+		b := &models.Building{
+			Address:     null.StringFrom("4 HaMishlatim St., haifa"),
+			Street:      null.StringFrom("HaMishlatim Street"),
+			HouseNumber: null.StringFrom("4"),
+			City:        null.StringFrom("Haifa"),
+		}
+		require.NoError(t, Resolve(b))
+		assert.Equal(t, null.StringFrom("4 HaMishlatim St., haifa"), b.Address, "the matching free text is kept")
+
+		// An address edited without its components, e.g. by a client of the free text.
+		b = &models.Building{
+			Address:     null.StringFrom("Herzl 12, Haifa"),
+			Street:      null.StringFrom("HaMishlatim Street"),
+			HouseNumber: null.StringFrom("4"),
+			City:        null.StringFrom("Haifa"),
+		}
+		assert.EqualError(t, Resolve(b), "address doesn't match its components [HaMishlatim Street 4, Haifa], send either of them")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		b := &models.Building{Address: null.StringFrom("stale"), Country: null.StringFrom("Atlantis")}
+		assert.Error(t, Resolve(b))
+		assert.Equal(t, null.StringFrom("stale"), b.Address)
+	})
+}
@@ -0,0 +1,99 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+)
+
+// countryCodes are the ISO 3166-1 alpha-2 codes.
+var countryCodes = func() map[string]bool {
+	codes := make(map[string]bool)
+	for _, c := range strings.Fields(`
+		AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS
+		BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE
+		EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM
+		HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC
+		LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA
+		NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW
+		SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO
+		TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW`) {
+		codes[c] = true
+	}
+	return codes
+}()
+
+// countryNames are the English names and the usual abbreviations of the countries recognised in
+// the free-text addresses, lower case.
+var countryNames = map[string]string{
+	"israel":                   "IL",
+	"united states":            "US",
+	"united states of america": "US",
+	"usa":                      "US",
+	"united kingdom":           "GB",
+	"uk":                       "GB",
+	"great britain":            "GB",
+	"england":                  "GB",
+	"germany":                  "DE",
+	"deutschland":              "DE",
+	"france":                   "FR",
+	"netherlands":              "NL",
+	"the netherlands":          "NL",
+	"belgium":                  "BE",
+	"spain":                    "ES",
+	"portugal":                 "PT",
+	"italy":                    "IT",
+	"switzerland":              "CH",
+	"austria":                  "AT",
+	"poland":                   "PL",
+	"czech republic":           "CZ",
+	"czechia":                  "CZ",
+	"sweden":                   "SE",
+	"norway":                   "NO",
+	"denmark":                  "DK",
+	"finland":                  "FI",
+	"ireland":                  "IE",
+	"greece":                   "GR",
+	"cyprus":                   "CY",
+	"turkey":                   "TR",
+	"ukraine":                  "UA",
+	"russia":                   "RU",
+	"georgia":                  "GE",
+	"canada":                   "CA",
+	"mexico":                   "MX",
+	"brazil":                   "BR",
+	"argentina":                "AR",
+	"australia":                "AU",
+	"new zealand":              "NZ",
+	"japan":                    "JP",
+	"china":                    "CN",
+	"india":                    "IN",
+	"south africa":             "ZA",
+	"united arab emirates":     "AE",
+	"uae":                      "AE",
+}
+
+// normalizeCountry returns the code of the country, empty if it is.
+func normalizeCountry(s string) (string, error) {
+	if s = collapse(s); s == "" {
+		return "", nil
+	}
+	code, ok := countryCode(s)
+	if !ok {
+		return "", fmt.Errorf("unknown country [%v]", s)
+	}
+	return code, nil
+}
+
+// countryCode returns the ISO 3166-1 alpha-2 code of a code, in any case, or of a name.
+func countryCode(s string) (string, bool) {
+	if code := strings.ToUpper(s); countryCodes[code] {
+		return code, true
+	}
+	return countryOfName(s)
+}
+
+// countryOfName returns the ISO 3166-1 alpha-2 code of a name.
+func countryOfName(s string) (string, bool) {
+	code, ok := countryNames[strings.ToLower(collapse(s))]
+	return code, ok
+}
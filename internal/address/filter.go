@@ -0,0 +1,132 @@
+package address
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+// Filter selects the buildings by the components of their address, case-insensitively. The
+// empty components match any address.
+type Filter struct {
+	Street      string
+	HouseNumber string
+	City        string
+	PostalCode  string
+	Country     string
+}
+
+// Normalize normalises the components like Address.Normalize, so "Main St" matches the buildings
+// of "Main Street" and "Israel" those of "IL".
+func (f Filter) Normalize() (Filter, error) {
+	country, err := normalizeCountry(f.Country)
+	if err != nil {
+		return Filter{}, err
+	}
+
+	return Filter{
+		Street:      normalizeStreet(f.Street),
+		HouseNumber: strings.ToUpper(collapse(f.HouseNumber)),
+		City:        collapse(f.City),
+		PostalCode:  strings.ToUpper(collapse(f.PostalCode)),
+		Country:     country,
+	}, nil
+}
+
+// IsZero reports whether the filter matches any address.
+func (f Filter) IsZero() bool {
+	return f == Filter{}
+}
+
+// Matches reports whether the address has the components of the filter.
+func (f Filter) Matches(a Address) bool {
+	for _, c := range [][2]string{
+		{f.Street, a.Street},
+		{f.HouseNumber, a.HouseNumber},
+		{f.City, a.City},
+		{f.PostalCode, a.PostalCode},
+		{f.Country, a.Country},
+	} {
+		if c[0] != "" && !strings.EqualFold(c[0], c[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterBuildings returns the buildings whose address matches the filter, without changing the slice.
+func FilterBuildings(buildings models.BuildingSlice, f Filter) models.BuildingSlice {
+	if f.IsZero() {
+		return buildings
+	}
+	return slices.DeleteFunc(slices.Clone(buildings), func(b *models.Building) bool { return !f.Matches(OfBuilding(b)) })
+}
+
+// Order is the order of a list of buildings.
+type Order string
+
+const (
+	// OrderID sorts the buildings by ID.
+	OrderID Order = "id"
+	// OrderStreet sorts the buildings by street then house number, numerically first, and those
+	// without a street last.
+	OrderStreet Order = "street"
+)
+
+// ParseOrder returns the order named s, by ID if s is empty.
+func ParseOrder(s string) (Order, error) {
+	switch o := Order(s); o {
+	case "":
+		return OrderID, nil
+	case OrderID, OrderStreet:
+		return o, nil
+	default:
+		return "", fmt.Errorf("unknown sort [%v], either %v or %v", s, OrderID, OrderStreet)
+	}
+}
+
+// SortBuildings sorts the buildings in the order, in place.
+func SortBuildings(buildings models.BuildingSlice, o Order) {
+	slices.SortFunc(buildings, func(a, b *models.Building) int {
+		if o == OrderStreet {
+			if c := cmp.Or(
+				compareLast(strings.ToLower(a.Street.String), strings.ToLower(b.Street.String), ""),
+				compareLast(leadingNumber(a.HouseNumber.String), leadingNumber(b.HouseNumber.String), -1),
+				compareLast(a.HouseNumber.String, b.HouseNumber.String, ""),
+			); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// leadingNumber returns the number that the house number starts with, e.g. 12 for "12A", or -1.
+func leadingNumber(s string) int {
+	end := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+	if end < 0 {
+		end = len(s)
+	}
+	n, err := strconv.Atoi(s[:end])
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// compareLast compares a and b with none, the missing value, after any other.
+func compareLast[T cmp.Ordered](a, b, none T) int {
+	switch {
+	case a == b:
+		return 0
+	case a == none:
+		return 1
+	case b == none:
+		return -1
+	}
+	return cmp.Compare(a, b)
+}
@@ -0,0 +1,69 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+func Test_FilterBuildings(t *testing.T) {
+	t.Parallel()
+
+	buildings := models.BuildingSlice{
+		{ID: 1, Street: null.StringFrom("HaMishlatim"), HouseNumber: null.StringFrom("4"), City: null.StringFrom("Haifa"), Country: null.StringFrom("IL")},
+		{ID: 2, Street: null.StringFrom("Main Street"), HouseNumber: null.StringFrom("4A"), City: null.StringFrom("Springfield"), Country: null.StringFrom("US")},
+		{ID: 3, Address: null.StringFrom("Main St 4a")},
+	}
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		wantIDs []int
+		wantErr bool
+	}{
+		{name: "all", filter: Filter{}, wantIDs: []int{1, 2, 3}},
+		{name: "city", filter: Filter{City: " HAIFA "}, wantIDs: []int{1}},
+		{name: "street", filter: Filter{Street: "main st.", HouseNumber: "4a"}, wantIDs: []int{2}},
+		{name: "countryName", filter: Filter{Country: "United States"}, wantIDs: []int{2}},
+		{name: "houseNumber", filter: Filter{HouseNumber: "4"}, wantIDs: []int{1}},
+		{name: "none", filter: Filter{City: "Haifa", Country: "us"}, wantIDs: []int{}},
+		{name: "unknownCountry", filter: Filter{Country: "Atlantis"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := tt.filter.Normalize()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := []int{}
+			for _, b := range FilterBuildings(buildings, filter) {
+				ids = append(ids, b.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+	assert.Len(t, buildings, 3)
+}
+
+func Test_ParseOrder(t *testing.T) {
+	t.Parallel()
+
+	for s, want := range map[string]Order{"": OrderID, "id": OrderID, "street": OrderStreet} {
+		got, err := ParseOrder(s)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, s)
+	}
+
+	_, err := ParseOrder("city")
+	assert.EqualError(t, err, "unknown sort [city], either id or street")
+}
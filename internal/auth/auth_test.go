@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// writeJWKS writes the public keys into a temporary JWKS file.
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	t.Helper()
+
+	set := map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa-1",
+				"use": "sig",
+				"n":   b64(rsaKey.N.Bytes()),
+				"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec-1",
+				"crv": "P-256",
+				"x":   b64(ecKey.X.FillBytes(make([]byte, 32))),
+				"y":   b64(ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+			{
+				"kty": "oct",
+				"kid": "skipped",
+			},
+		},
+	}
+	b, err := json.Marshal(set)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+
+	return path
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return s
+}
+
+func Test_JWTVerifier(t *testing.T) {
+	t.Parallel()
+
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys, err := LoadJWKS(writeJWKS(t, rsaKey, ecKey))
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	v, err := NewJWTVerifier(JWTOptions{
+		HMACSecret: secret,
+		Keys:       keys,
+		Issuer:     "https://issuer.test",
+		Audience:   "oms",
+	})
+	require.NoError(t, err)
+
+	claims := func(mutate func(c *jwt.RegisteredClaims)) jwt.RegisteredClaims {
+		c := jwt.RegisteredClaims{
+			Subject:   "manager@example.com",
+			Issuer:    "https://issuer.test",
+			Audience:  jwt.ClaimStrings{"oms"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}
+		if mutate != nil {
+			mutate(&c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "hmac",
+			token: sign(t, jwt.SigningMethodHS256, "", secret, claims(nil)),
+		},
+		{
+			name:  "rsa",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(nil)),
+		},
+		{
+			name:  "ec",
+			token: sign(t, jwt.SigningMethodES256, "ec-1", ecKey, claims(nil)),
+		},
+		{
+			name:    "wrongSecret",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte("another secret"), claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "wrongKey",
+			token:   sign(t, jwt.SigningMethodES256, "ec-1", otherKey, claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "unknownKid",
+			token:   sign(t, jwt.SigningMethodES256, "ec-2", ecKey, claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "none",
+			token:   sign(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType, claims(nil)),
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			token: sign(t, jwt.SigningMethodHS256, "", secret, claims(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			})),
+			wantErr: true,
+		},
+		{
+			name: "noExpiry",
+			token: sign(t, jwt.SigningMethodHS256, "", secret, claims(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = nil
+			})),
+			wantErr: true,
+		},
+		{
+			name: "wrongIssuer",
+			token: sign(t, jwt.SigningMethodHS256, "", secret, claims(func(c *jwt.RegisteredClaims) {
+				c.Issuer = "https://evil.test"
+			})),
+			wantErr: true,
+		},
+		{
+			name: "wrongAudience",
+			token: sign(t, jwt.SigningMethodHS256, "", secret, claims(func(c *jwt.RegisteredClaims) {
+				c.Audience = jwt.ClaimStrings{"billing"}
+			})),
+			wantErr: true,
+		},
+		{
+			name: "noSubject",
+			token: sign(t, jwt.SigningMethodHS256, "", secret, claims(func(c *jwt.RegisteredClaims) {
+				c.Subject = ""
+			})),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := v.Verify(tt.token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnauthenticated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &Principal{Subject: "manager@example.com", Method: MethodJWT}, got)
+		})
+	}
+}
+
+func Test_NewJWTVerifier(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewJWTVerifier(JWTOptions{})
+	assert.Error(t, err)
+
+	_, err = ParseJWKS([]byte(`{"keys":[{"kty":"oct","kid":"k"}]}`))
+	assert.Error(t, err)
+}
+
+type apiKeysFunc func(ctx context.Context, key string) (*Principal, error)
+
+func (f apiKeysFunc) AuthenticateAPIKey(ctx context.Context, key string) (*Principal, error) {
+	return f(ctx, key)
+}
+
+func Test_Authenticator(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("test secret")
+	v, err := NewJWTVerifier(JWTOptions{HMACSecret: secret})
+	require.NoError(t, err)
+	token := sign(t, jwt.SigningMethodHS256, "", secret, jwt.RegisteredClaims{
+		Subject:   "manager@example.com",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+
+	apiKeys := apiKeysFunc(func(_ context.Context, key string) (*Principal, error) {
+		if key != "oms_valid" {
+			return nil, ErrUnauthenticated
+		}
+		return &Principal{Subject: "deploy-bot", Method: MethodAPIKey}, nil
+	})
+
+	tests := []struct {
+		name          string
+		jwt           *JWTVerifier
+		apiKey        string
+		authorization string
+		wantSubject   string
+	}{
+		{name: "apiKeyHeader", jwt: v, apiKey: "oms_valid", wantSubject: "deploy-bot"},
+		{name: "apiKeyBearer", jwt: v, authorization: "Bearer oms_valid", wantSubject: "deploy-bot"},
+		{name: "jwt", jwt: v, authorization: "bearer " + token, wantSubject: "manager@example.com"},
+		{name: "jwtDisabled", authorization: "Bearer " + token},
+		{name: "invalidAPIKey", jwt: v, apiKey: "oms_invalid"},
+		{name: "basic", jwt: v, authorization: "Basic dXNlcjpwYXNz"},
+		{name: "missing", jwt: v},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := NewAuthenticator(apiKeys, tt.jwt)
+			got, err := a.Authenticate(context.Background(), tt.apiKey, tt.authorization)
+			if tt.wantSubject == "" {
+				assert.ErrorIs(t, err, ErrUnauthenticated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, got.Subject)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// APIKeyPrefix starts every API key, it tells them apart from the JWTs in the Authorization header.
+const APIKeyPrefix = "oms_"
+
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+type Authenticator struct {
+	apiKeys APIKeyAuthenticator
+	jwt     *JWTVerifier
+}
+
+// NewAuthenticator accepts the API keys and, if jwt is not nil, the bearer tokens.
+func NewAuthenticator(apiKeys APIKeyAuthenticator, jwt *JWTVerifier) *Authenticator {
+	return &Authenticator{
+		apiKeys: apiKeys,
+		jwt:     jwt,
+	}
+}
+
+// Authenticate returns the principal of the API key or, if it is empty, of the Authorization
+// header value which holds a bearer JWT or API key.
+func (a *Authenticator) Authenticate(ctx context.Context, apiKey, authorization string) (*Principal, error) {
+	if apiKey != "" {
+		return a.apiKeys.AuthenticateAPIKey(ctx, apiKey)
+	}
+
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, fmt.Errorf("%w: missing credentials", ErrUnauthenticated)
+	}
+	token = strings.TrimSpace(token)
+
+	if strings.HasPrefix(token, APIKeyPrefix) {
+		return a.apiKeys.AuthenticateAPIKey(ctx, token)
+	}
+	if a.jwt == nil {
+		return nil, fmt.Errorf("%w: bearer tokens are not accepted", ErrUnauthenticated)
+	}
+
+	return a.jwt.Verify(token)
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type JWTOptions struct {
+	// HMACSecret verifies HS256/HS384/HS512 tokens.
+	HMACSecret []byte
+	// Keys verify the RS*, PS* and ES* tokens by their kid header, see LoadJWKS.
+	Keys map[string]crypto.PublicKey
+	// Issuer and Audience are checked when they are set.
+	Issuer   string
+	Audience string
+	// Leeway tolerates the clock skew between the issuer and the server.
+	Leeway time.Duration
+}
+
+// Claims are the claims of the access tokens.
+type Claims struct {
+	jwt.RegisteredClaims
+	// Tenant binds the token to a management company.
+	Tenant string `json:"tenant,omitempty"`
+}
+
+type JWTVerifier struct {
+	opts   JWTOptions
+	parser *jwt.Parser
+}
+
+func NewJWTVerifier(opts JWTOptions) (*JWTVerifier, error) {
+	if len(opts.HMACSecret) == 0 && len(opts.Keys) == 0 {
+		return nil, errors.New("jwt verifier requires an HMAC secret or a JWKS")
+	}
+
+	var methods []string
+	if len(opts.HMACSecret) > 0 {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if len(opts.Keys) > 0 {
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512")
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(opts.Leeway),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	return &JWTVerifier{
+		opts:   opts,
+		parser: jwt.NewParser(parserOpts...),
+	}, nil
+}
+
+// Verify checks the signature and the claims of the token and returns its principal.
+func (v *JWTVerifier) Verify(token string) (*Principal, error) {
+	claims := &Claims{}
+	_, err := v.parser.ParseWithClaims(token, claims, v.key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: token has no subject", ErrUnauthenticated)
+	}
+
+	return &Principal{
+		Subject: claims.Subject,
+		Method:  MethodJWT,
+		Tenant:  claims.Tenant,
+	}, nil
+}
+
+func (v *JWTVerifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return v.opts.HMACSecret, nil
+	default:
+		kid, _ := token.Header["kid"].(string)
+		key, ok := v.opts.Keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id [%v]", kid)
+		}
+		return key, nil
+	}
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	MethodAPIKey = "api_key"
+	MethodJWT    = "jwt"
+)
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Principal is the authenticated caller of the request.
+type Principal struct {
+	// Subject identifies the caller: the subject of the API key or the sub claim of the token.
+	Subject string
+	// Method is the authentication method, MethodAPIKey or MethodJWT.
+	Method string
+	// Tenant is the management company the caller belongs to, empty if it isn't bound to one.
+	Tenant string
+}
+
+type ctxKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext returns the principal of the request, ok is false for anonymous requests.
+func FromContext(ctx context.Context) (p *Principal, ok bool) {
+	p, ok = ctx.Value(ctxKey{}).(*Principal)
+	return p, ok
+}
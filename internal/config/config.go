@@ -4,6 +4,63 @@ const (
 	ConfigPath = ".env"
 	IsLocal    = "IS_LOCAL"
 	LogLevel   = "LOG_LEVEL"
+	HTTPAddr   = "HTTP_ADDR"
+	GRPCAddr   = "GRPC_ADDR"
+	// Logs
+	LogLevels             = "LOG_LEVELS"
+	LogFormat             = "LOG_FORMAT"
+	LogAddSource          = "LOG_ADD_SOURCE"
+	LogSamplingInitial    = "LOG_SAMPLING_INITIAL"
+	LogSamplingThereafter = "LOG_SAMPLING_THEREAFTER"
+	// Shutdown
+	ShutdownTimeout        = "SHUTDOWN_TIMEOUT"
+	ShutdownReadinessDelay = "SHUTDOWN_READINESS_DELAY"
 	// DB
-	PgURL = "PG_URL"
+	PgURL              = "PG_URL"
+	PgMaxOpenConns     = "PG_MAX_OPEN_CONNS"
+	PgMaxIdleConns     = "PG_MAX_IDLE_CONNS"
+	PgConnMaxLifetime  = "PG_CONN_MAX_LIFETIME"
+	PgConnMaxIdleTime  = "PG_CONN_MAX_IDLE_TIME"
+	PgStatementTimeout = "PG_STATEMENT_TIMEOUT"
+	PgConnectRetries   = "PG_CONNECT_RETRIES"
+	PgConnectBackoff   = "PG_CONNECT_BACKOFF"
+	PgMigrate          = "PG_MIGRATE"
+	PgRowLevelSecurity = "PG_ROW_LEVEL_SECURITY"
+	// Tracing
+	TracingExporter    = "TRACING_EXPORTER"
+	TracingFile        = "TRACING_FILE"
+	TracingSampleRatio = "TRACING_SAMPLE_RATIO"
+	// Metrics
+	MetricsTotalsTTL = "METRICS_TOTALS_TTL"
+	// Health
+	HealthCheckTimeout  = "HEALTH_CHECK_TIMEOUT"
+	HealthPoolThreshold = "HEALTH_POOL_THRESHOLD"
+	// Auth
+	AuthEnabled   = "AUTH_ENABLED"
+	JWTHMACSecret = "JWT_HMAC_SECRET"
+	JWTJWKSFile   = "JWT_JWKS_FILE"
+	JWTIssuer     = "JWT_ISSUER"
+	JWTAudience   = "JWT_AUDIENCE"
+	JWTLeeway     = "JWT_LEEWAY"
+	// Rate limiting
+	RateLimitEnabled    = "RATE_LIMIT_ENABLED"
+	RateLimitStore      = "RATE_LIMIT_STORE"
+	RateLimitReadRate   = "RATE_LIMIT_READ_RATE"
+	RateLimitReadBurst  = "RATE_LIMIT_READ_BURST"
+	RateLimitWriteRate  = "RATE_LIMIT_WRITE_RATE"
+	RateLimitWriteBurst = "RATE_LIMIT_WRITE_BURST"
+	RateLimitIPRate     = "RATE_LIMIT_IP_RATE"
+	RateLimitIPBurst    = "RATE_LIMIT_IP_BURST"
+	// Idempotency keys
+	IdempotencyStore       = "IDEMPOTENCY_STORE"
+	IdempotencyTTL         = "IDEMPOTENCY_TTL"
+	IdempotencyLockTimeout = "IDEMPOTENCY_LOCK_TIMEOUT"
+	// OpenAPI
+	OpenAPIValidateResponses = "OPENAPI_VALIDATE_RESPONSES"
+	// Versioning
+	LegacyRoutesSunset = "LEGACY_ROUTES_SUNSET"
+	// Geocoding
+	GazetteerFile = "GAZETTEER_FILE"
+	// Personal data
+	PIIEncryptionKeys = "PII_ENCRYPTION_KEYS"
 )
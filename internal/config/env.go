@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Int returns the integer value of the environment variable key or def if it is not set.
+func Int(key string, def int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s [%v]: %w", key, value, err)
+	}
+
+	return i, nil
+}
+
+// Bool returns the boolean value (e.g. "true", "0") of the environment variable key or def if it is not set.
+func Bool(key string, def bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s [%v]: %w", key, value, err)
+	}
+
+	return b, nil
+}
+
+// Float returns the float value of the environment variable key or def if it is not set.
+func Float(key string, def float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s [%v]: %w", key, value, err)
+	}
+
+	return f, nil
+}
+
+// Duration returns the duration value (e.g. "30s") of the environment variable key or def if it is not set.
+func Duration(key string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s [%v]: %w", key, value, err)
+	}
+
+	return d, nil
+}
+
+// Date returns the date value (e.g. "2027-04-19", UTC) of the environment variable key or def if it is not set.
+func Date(key string, def time.Time) (time.Time, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s [%v]: %w", key, value, err)
+	}
+
+	return t, nil
+}
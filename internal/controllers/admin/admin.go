@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+const (
+	resultKey   = "result"
+	responseKey = "response"
+
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+type DBStatsProvider interface {
+	Stats() sql.DBStats
+}
+
+// PIIReencrypter re-encrypts the personal data stored in clear or with a rotated key.
+type PIIReencrypter interface {
+	ReencryptPII(ctx context.Context) (storage.Reencrypted, error)
+}
+
+type GrantsService interface {
+	GetGrants(ctx context.Context) ([]*storage.Grant, error)
+	CreateGrant(ctx context.Context, grant *storage.Grant) error
+	DeleteGrant(ctx context.Context, id int) error
+}
+
+type Admin struct {
+	db        DBStatsProvider
+	pii       PIIReencrypter
+	logLevels *logger.Levels
+	grants    GrantsService
+	scopes    access.Resolver
+}
+
+func NewAdmin(db DBStatsProvider, pii PIIReencrypter, logLevels *logger.Levels, grants GrantsService, scopes access.Resolver) *Admin {
+	return &Admin{
+		db:        db,
+		pii:       pii,
+		logLevels: logLevels,
+		grants:    grants,
+		scopes:    scopes,
+	}
+}
+
+// Authorize permits the admin routes to the global admins only, it runs after the authentication.
+func (a *Admin) Authorize(c *fiber.Ctx) error {
+	scope, err := a.scopes.Scope(c.UserContext())
+	if err != nil {
+		return sendError(c, fiber.StatusInternalServerError, err)
+	}
+	if !scope.CanAll(access.ActionManage) {
+		err = fmt.Errorf("%w: the admin routes require the global admin role", service.ErrForbidden)
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.Next()
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		return fiber.StatusForbidden
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+func sendError(c *fiber.Ctx, status int, err error) error {
+	return c.Status(status).
+		JSON(&fiber.Map{
+			resultKey:   resultError,
+			responseKey: err.Error(),
+		})
+}
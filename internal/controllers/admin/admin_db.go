@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// DBStats are the statistics of the database connection pool.
+type DBStats struct {
+	MaxOpenConnections int `json:"max_open_connections"`
+
+	OpenConnections int `json:"open_connections"`
+	InUse           int `json:"in_use"`
+	Idle            int `json:"idle"`
+
+	WaitCount         int64   `json:"wait_count"`
+	WaitDurationSecs  float64 `json:"wait_duration_seconds"`
+	MaxIdleClosed     int64   `json:"max_idle_closed"`
+	MaxIdleTimeClosed int64   `json:"max_idle_time_closed"`
+	MaxLifetimeClosed int64   `json:"max_lifetime_closed"`
+}
+
+func (a *Admin) GetDBStatsHandler(c *fiber.Ctx) error {
+	s := a.db.Stats()
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+		responseKey: DBStats{
+			MaxOpenConnections: s.MaxOpenConnections,
+			OpenConnections:    s.OpenConnections,
+			InUse:              s.InUse,
+			Idle:               s.Idle,
+			WaitCount:          s.WaitCount,
+			WaitDurationSecs:   s.WaitDuration.Seconds(),
+			MaxIdleClosed:      s.MaxIdleClosed,
+			MaxIdleTimeClosed:  s.MaxIdleTimeClosed,
+			MaxLifetimeClosed:  s.MaxLifetimeClosed,
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+func (a *Admin) GetGrantsHandler(c *fiber.Ctx) error {
+	grants, err := a.grants.GetGrants(c.UserContext())
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: grants,
+	})
+}
+
+func (a *Admin) CreateGrantHandler(c *fiber.Ctx) error {
+	var grant storage.Grant
+	err := c.BodyParser(&grant)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = a.grants.CreateGrant(c.UserContext(), &grant)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: grant,
+	})
+}
+
+func (a *Admin) DeleteGrantHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = a.grants.DeleteGrant(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
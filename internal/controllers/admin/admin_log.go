@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+// LogLevels are the current global and component log levels.
+type LogLevels struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
+
+// SetLogLevelRequest is the body of the log level changes.
+type SetLogLevelRequest struct {
+	// Component is optional, the global level is changed when it is empty.
+	Component string `json:"component,omitempty"`
+	// Level is the new level, an empty level removes the override of the component.
+	Level string `json:"level,omitempty"`
+}
+
+func (a *Admin) GetLogLevelHandler(c *fiber.Ctx) error {
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: a.snapshotLogLevels(),
+	})
+}
+
+func (a *Admin) SetLogLevelHandler(c *fiber.Ctx) error {
+	var req SetLogLevelRequest
+	err := c.BodyParser(&req)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	if req.Level == "" {
+		if req.Component == "" {
+			return sendError(c, fiber.StatusBadRequest, errors.New("level is required"))
+		}
+		a.logLevels.ResetComponent(req.Component)
+	} else {
+		level, err := logger.ParseLevel(req.Level)
+		if err != nil {
+			return sendError(c, fiber.StatusBadRequest, err)
+		}
+		if req.Component == "" {
+			a.logLevels.Set(level)
+		} else {
+			a.logLevels.SetComponent(req.Component, level)
+		}
+	}
+
+	slog.InfoContext(c.UserContext(), "log level changed", "component", req.Component, "level", req.Level)
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: a.snapshotLogLevels(),
+	})
+}
+
+func (a *Admin) snapshotLogLevels() LogLevels {
+	global, components := a.logLevels.Snapshot()
+
+	levels := LogLevels{
+		Level:      logger.LevelName(global),
+		Components: make(map[string]string, len(components)),
+	}
+	for component, level := range components {
+		levels.Components[component] = logger.LevelName(level)
+	}
+
+	return levels
+}
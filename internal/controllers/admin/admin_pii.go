@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+func (a *Admin) ReencryptPIIHandler(c *fiber.Ctx) error {
+	reencrypted, err := a.pii.ReencryptPII(c.UserContext())
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: reencrypted,
+	})
+}
@@ -1,8 +1,17 @@
 package bms
 
 import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/service"
 	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
 	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+	"github.com/sotskov-do/oms-assignment/internal/service/floors"
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/service/residents"
+	"github.com/sotskov-do/oms-assignment/internal/service/search"
 )
 
 const (
@@ -16,14 +25,67 @@ const (
 type BuildingManagementSystem struct {
 	apartmentsService apartments.ApartmentsService
 	buildingsService  buildings.BuildingsService
+	searchService     search.SearchService
+	floorsService     floors.FloorsService
+	residentsService  residents.ResidentsService
+	leasesService     leases.LeasesService
 }
 
 func NewBuildingManagementSystem(
 	apartmentsService apartments.ApartmentsService,
 	buildingsService buildings.BuildingsService,
+	searchService search.SearchService,
+	floorsService floors.FloorsService,
+	residentsService residents.ResidentsService,
+	leasesService leases.LeasesService,
 ) *BuildingManagementSystem {
 	return &BuildingManagementSystem{
 		apartmentsService: apartmentsService,
 		buildingsService:  buildingsService,
+		searchService:     searchService,
+		floorsService:     floorsService,
+		residentsService:  residentsService,
+		leasesService:     leasesService,
+	}
+}
+
+// errorStatus maps the service errors to the HTTP status codes, the unknown errors are server errors.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalid):
+		return fiber.StatusBadRequest
+	case errors.Is(err, service.ErrForbidden):
+		return fiber.StatusForbidden
+	default:
+		return fiber.StatusInternalServerError
 	}
 }
+
+// newRouteStatus maps the errors like errorStatus, but the missing resources are 404 and the
+// conflicts 409. The routes added under /v1 use it, the routes with an unprefixed alias keep the
+// 500 their clients know.
+func newRouteStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(err, service.ErrConflict):
+		return fiber.StatusConflict
+	default:
+		return errorStatus(err)
+	}
+}
+
+// sendError responds with the error envelope, the server errors are logged with the request context.
+// The message is the legacy one of the error, if any, as the v1 clients may match it.
+func sendError(c *fiber.Ctx, status int, err error) error {
+	if status >= fiber.StatusInternalServerError {
+		ctx := c.UserContext()
+		logger.FromContext(ctx).ErrorContext(ctx, "request failed", "route", c.Route().Name, "error", err)
+	}
+
+	return c.Status(status).
+		JSON(&fiber.Map{
+			resultKey:   resultError,
+			responseKey: service.LegacyMessage(err),
+		})
+}
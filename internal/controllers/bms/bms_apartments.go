@@ -1,18 +1,29 @@
 package bms
 
 import (
+	"context"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
 	"github.com/sotskov-do/oms-assignment/internal/models"
 )
 
+// GetApartmentsHandler streams the apartments as newline-delimited JSON, without the envelope,
+// when the client accepts application/x-ndjson.
 func (bms *BuildingManagementSystem) GetApartmentsHandler(c *fiber.Ctx) error {
-	apartments, err := bms.apartmentsService.GetApartments(c.Context())
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
+	if middleware.AcceptsNDJSON(c) {
+		return middleware.StreamNDJSON(c, func(ctx context.Context, send func(v any) error) error {
+			return bms.apartmentsService.StreamApartments(ctx, func(a *models.Apartment) error {
+				return send(a)
 			})
+		}, func(err error) error {
+			return sendError(c, errorStatus(err), err)
+		})
+	}
+
+	apartments, err := bms.apartmentsService.GetApartments(c.UserContext())
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -24,20 +35,12 @@ func (bms *BuildingManagementSystem) GetApartmentsHandler(c *fiber.Ctx) error {
 func (bms *BuildingManagementSystem) GetApartmentHandler(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id", 0)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
 	}
 
-	apartment, err := bms.apartmentsService.GetApartment(c.Context(), id)
+	apartment, err := bms.apartmentsService.GetApartment(c.UserContext(), id)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -46,23 +49,26 @@ func (bms *BuildingManagementSystem) GetApartmentHandler(c *fiber.Ctx) error {
 	})
 }
 
+// GetApartmentsInBuildingHandler streams the apartments like GetApartmentsHandler.
 func (bms *BuildingManagementSystem) GetApartmentsInBuildingHandler(c *fiber.Ctx) error {
 	buildingId, err := c.ParamsInt("buildingId", 0)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	if middleware.AcceptsNDJSON(c) {
+		return middleware.StreamNDJSON(c, func(ctx context.Context, send func(v any) error) error {
+			return bms.apartmentsService.StreamApartmentsInBuilding(ctx, buildingId, func(a *models.Apartment) error {
+				return send(a)
 			})
+		}, func(err error) error {
+			return sendError(c, errorStatus(err), err)
+		})
 	}
 
-	apartmentsInBuilding, err := bms.apartmentsService.GetApartmentsInBuilding(c.Context(), buildingId)
+	apartmentsInBuilding, err := bms.apartmentsService.GetApartmentsInBuilding(c.UserContext(), buildingId)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -75,20 +81,12 @@ func (bms *BuildingManagementSystem) CreateApartmentHandler(c *fiber.Ctx) error
 	var apartment *models.Apartment
 	err := c.BodyParser(&apartment)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
 	}
 
-	err = bms.apartmentsService.CreateApartment(c.Context(), apartment)
+	err = bms.apartmentsService.CreateApartment(c.UserContext(), apartment)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -99,20 +97,12 @@ func (bms *BuildingManagementSystem) CreateApartmentHandler(c *fiber.Ctx) error
 func (bms *BuildingManagementSystem) DeleteApartmentHandler(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id", 0)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
 	}
 
-	err = bms.apartmentsService.DeleteApartment(c.Context(), id)
+	err = bms.apartmentsService.DeleteApartment(c.UserContext(), id)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
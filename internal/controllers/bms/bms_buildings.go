@@ -2,17 +2,27 @@ package bms
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/query"
 	"github.com/sotskov-do/oms-assignment/internal/models"
 )
 
+// GetBuildingsHandler lists the buildings, only those whose address has the components of the
+// street, house_number, city, postal_code and country query parameters if any, by ID or by street
+// with sort=street.
 func (bms *BuildingManagementSystem) GetBuildingsHandler(c *fiber.Ctx) error {
-	buildings, err := bms.buildingsService.GetBuildings(c.Context())
+	filter, err := query.Address(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	order, err := query.Order(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	buildings, err := bms.buildingsService.FindBuildings(c.UserContext(), filter, order)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -24,20 +34,12 @@ func (bms *BuildingManagementSystem) GetBuildingsHandler(c *fiber.Ctx) error {
 func (bms *BuildingManagementSystem) GetBuildingHandler(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id", 0)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
 	}
 
-	building, err := bms.buildingsService.GetBuilding(c.Context(), id)
+	building, err := bms.buildingsService.GetBuilding(c.UserContext(), id)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -50,20 +52,12 @@ func (bms *BuildingManagementSystem) CreateBuildingHandler(c *fiber.Ctx) error {
 	var building *models.Building
 	err := c.BodyParser(&building)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
 	}
 
-	err = bms.buildingsService.CreateBuilding(c.Context(), building)
+	err = bms.buildingsService.CreateBuilding(c.UserContext(), building)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
@@ -74,20 +68,12 @@ func (bms *BuildingManagementSystem) CreateBuildingHandler(c *fiber.Ctx) error {
 func (bms *BuildingManagementSystem) DeleteBuildingHandler(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id", 0)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, fiber.StatusBadRequest, err)
 	}
 
-	err = bms.buildingsService.DeleteBuilding(c.Context(), id)
+	err = bms.buildingsService.DeleteBuilding(c.UserContext(), id)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).
-			JSON(&fiber.Map{
-				resultKey:   resultError,
-				responseKey: err.Error(),
-			})
+		return sendError(c, errorStatus(err), err)
 	}
 
 	return c.JSON(&fiber.Map{
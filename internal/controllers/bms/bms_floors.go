@@ -0,0 +1,115 @@
+package bms
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// GetFloorsHandler lists the floors of the building by number.
+func (bms *BuildingManagementSystem) GetFloorsHandler(c *fiber.Ctx) error {
+	buildingId, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	floors, err := bms.floorsService.GetFloors(c.UserContext(), buildingId)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: floors,
+	})
+}
+
+func (bms *BuildingManagementSystem) GetFloorHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	floor, err := bms.floorsService.GetFloor(c.UserContext(), buildingId, number)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: floor,
+	})
+}
+
+// CreateFloorHandler creates a floor of the building (update if already exist), the building is the
+// one of the path.
+func (bms *BuildingManagementSystem) CreateFloorHandler(c *fiber.Ctx) error {
+	buildingId, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var floor *storage.Floor
+	err = c.BodyParser(&floor)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+	if floor != nil {
+		floor.BuildingID = buildingId
+	}
+
+	err = bms.floorsService.CreateFloor(c.UserContext(), floor)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
+
+func (bms *BuildingManagementSystem) DeleteFloorHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.floorsService.DeleteFloor(c.UserContext(), buildingId, number)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
+
+func (bms *BuildingManagementSystem) GetApartmentsOnFloorHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	apartments, err := bms.apartmentsService.GetApartmentsOnFloor(c.UserContext(), buildingId, number)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: apartments,
+	})
+}
+
+// floorParams parses the building ID and the floor number of the path. The floor may be negative.
+func floorParams(c *fiber.Ctx) (buildingId int, number int, err error) {
+	buildingId, err = c.ParamsInt("id", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	number, err = c.ParamsInt("floor", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	return buildingId, number, nil
+}
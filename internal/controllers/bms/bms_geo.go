@@ -0,0 +1,72 @@
+package bms
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/query"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+)
+
+// GetBuildingsNearbyHandler lists the buildings within radius_m meters of lat and lon, the closest
+// first, as a GeoJSON feature collection for the clients that accept it.
+func (bms *BuildingManagementSystem) GetBuildingsNearbyHandler(c *fiber.Ctx) error {
+	var center geo.Point
+	var radius float64
+	err := query.Float(c, "lat", &center.Lat)
+	if err == nil {
+		err = query.Float(c, "lon", &center.Lon)
+	}
+	if err == nil {
+		err = query.Float(c, "radius_m", &radius)
+	}
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	nearby, err := bms.buildingsService.GetBuildingsNearby(c.UserContext(), center, radius, c.QueryInt("limit", buildings.DefaultGeoLimit))
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	if middleware.AcceptsGeoJSON(c) {
+		features := make([]*geo.Feature, 0, len(nearby))
+		for _, n := range nearby {
+			features = append(features, geo.NewFeature(n.Building, &n.DistanceM))
+		}
+		return middleware.SendGeoJSON(c, geo.NewFeatureCollection(features))
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: nearby,
+	})
+}
+
+// GetBuildingsInBoxHandler lists the buildings in the bbox (west,south,east,north), as a GeoJSON
+// feature collection for the clients that accept it.
+func (bms *BuildingManagementSystem) GetBuildingsInBoxHandler(c *fiber.Ctx) error {
+	box, err := geo.ParseBox(c.Query("bbox"))
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	inBox, err := bms.buildingsService.GetBuildingsInBox(c.UserContext(), box, c.QueryInt("limit", buildings.DefaultGeoLimit))
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	if middleware.AcceptsGeoJSON(c) {
+		features := make([]*geo.Feature, 0, len(inBox))
+		for _, b := range inBox {
+			features = append(features, geo.NewFeature(b, nil))
+		}
+		return middleware.SendGeoJSON(c, geo.NewFeatureCollection(features))
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: inBox,
+	})
+}
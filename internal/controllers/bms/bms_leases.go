@@ -0,0 +1,139 @@
+package bms
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// GetApartmentLeasesHandler lists the leases of the apartment by start date.
+func (bms *BuildingManagementSystem) GetApartmentLeasesHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	leases, err := bms.leasesService.GetApartmentLeases(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: leases,
+	})
+}
+
+// GetExpiringLeasesHandler lists the active leases ending within the next days, 30 by default.
+func (bms *BuildingManagementSystem) GetExpiringLeasesHandler(c *fiber.Ctx) error {
+	expiring, err := bms.leasesService.GetExpiringLeases(c.UserContext(), c.QueryInt("days", leases.DefaultExpiringDays))
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: expiring,
+	})
+}
+
+func (bms *BuildingManagementSystem) GetLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease, err := bms.leasesService.GetLease(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: lease,
+	})
+}
+
+// CreateLeaseHandler creates a lease (update if already exist and still a draft), the building is
+// the one of the apartment.
+func (bms *BuildingManagementSystem) CreateLeaseHandler(c *fiber.Ctx) error {
+	var lease *storage.Lease
+	err := c.BodyParser(&lease)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.leasesService.CreateLease(c.UserContext(), lease)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
+
+// RenewLeaseHandler creates the lease renewing an active one and responds with it.
+func (bms *BuildingManagementSystem) RenewLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var renewal *leases.Renewal
+	err = c.BodyParser(&renewal)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease, err := bms.leasesService.RenewLease(c.UserContext(), id, renewal)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: lease,
+	})
+}
+
+// TerminateLeaseHandler ends an active lease on the end date of the body.
+func (bms *BuildingManagementSystem) TerminateLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var termination *leases.Termination
+	err = c.BodyParser(&termination)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease, err := bms.leasesService.TerminateLease(c.UserContext(), id, termination)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: lease,
+	})
+}
+
+func (bms *BuildingManagementSystem) DeleteLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.leasesService.DeleteLease(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
@@ -0,0 +1,97 @@
+package bms
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// GetApartmentResidentsHandler lists the current residents of the apartment, all of them with
+// history=true.
+func (bms *BuildingManagementSystem) GetApartmentResidentsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	residents, err := bms.residentsService.GetApartmentResidents(c.UserContext(), id, c.QueryBool("history"))
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: residents,
+	})
+}
+
+// GetBuildingResidentsHandler lists the current residents of the apartments of the building, all
+// of them with history=true.
+func (bms *BuildingManagementSystem) GetBuildingResidentsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	residents, err := bms.residentsService.GetBuildingResidents(c.UserContext(), id, c.QueryBool("history"))
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: residents,
+	})
+}
+
+func (bms *BuildingManagementSystem) GetResidentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	resident, err := bms.residentsService.GetResident(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: resident,
+	})
+}
+
+// CreateResidentHandler creates a resident (update if already exist), the building is the one of
+// the apartment.
+func (bms *BuildingManagementSystem) CreateResidentHandler(c *fiber.Ctx) error {
+	var resident *storage.Resident
+	err := c.BodyParser(&resident)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.residentsService.CreateResident(c.UserContext(), resident)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
+
+func (bms *BuildingManagementSystem) DeleteResidentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.residentsService.DeleteResident(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey: resultSuccess,
+	})
+}
@@ -0,0 +1,34 @@
+package bms
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+func (bms *BuildingManagementSystem) GetStatsHandler(c *fiber.Ctx) error {
+	stats, err := bms.apartmentsService.GetStats(c.UserContext())
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: stats,
+	})
+}
+
+func (bms *BuildingManagementSystem) GetBuildingStatsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	stats, err := bms.apartmentsService.GetBuildingStats(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, newRouteStatus(err), err)
+	}
+
+	return c.JSON(&fiber.Map{
+		resultKey:   resultSuccess,
+		responseKey: stats,
+	})
+}
@@ -0,0 +1,75 @@
+// Package bmsv2 serves the buildings and the apartments of the v2 API. Unlike v1, the resources
+// are sent without the result/response envelope, their nullable fields are always present, the
+// upserts are PUT requests on the resource and the errors are RFC 9457 problem details.
+package bmsv2
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+	"github.com/sotskov-do/oms-assignment/internal/service/floors"
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/service/residents"
+	"github.com/sotskov-do/oms-assignment/internal/service/search"
+)
+
+type BuildingManagementSystem struct {
+	apartmentsService apartments.ApartmentsService
+	buildingsService  buildings.BuildingsService
+	searchService     search.SearchService
+	floorsService     floors.FloorsService
+	residentsService  residents.ResidentsService
+	leasesService     leases.LeasesService
+}
+
+func NewBuildingManagementSystem(
+	apartmentsService apartments.ApartmentsService,
+	buildingsService buildings.BuildingsService,
+	searchService search.SearchService,
+	floorsService floors.FloorsService,
+	residentsService residents.ResidentsService,
+	leasesService leases.LeasesService,
+) *BuildingManagementSystem {
+	return &BuildingManagementSystem{
+		apartmentsService: apartmentsService,
+		buildingsService:  buildingsService,
+		searchService:     searchService,
+		floorsService:     floorsService,
+		residentsService:  residentsService,
+		leasesService:     leasesService,
+	}
+}
+
+// errorStatus maps the service errors to the HTTP status codes, the unknown errors are server errors.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalid):
+		return fiber.StatusBadRequest
+	case errors.Is(err, service.ErrForbidden):
+		return fiber.StatusForbidden
+	case errors.Is(err, service.ErrNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(err, service.ErrConflict):
+		return fiber.StatusConflict
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+// sendError responds with the problem details of the error, the server errors are logged with
+// the request context. Their details aren't sent.
+func sendError(c *fiber.Ctx, status int, err error) error {
+	if status >= fiber.StatusInternalServerError {
+		ctx := c.UserContext()
+		logger.FromContext(ctx).ErrorContext(ctx, "request failed", "route", c.Route().Name, "error", err)
+		err = errors.New("the request couldn't be processed")
+	}
+
+	return middleware.SendError(c, status, err)
+}
@@ -0,0 +1,81 @@
+package bmsv2
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+// ListApartmentsHandler streams the apartments as newline-delimited JSON, one item a line,
+// when the client accepts application/x-ndjson.
+func (bms *BuildingManagementSystem) ListApartmentsHandler(c *fiber.Ctx) error {
+	if middleware.AcceptsNDJSON(c) {
+		return middleware.StreamNDJSON(c, func(ctx context.Context, send func(v any) error) error {
+			return bms.apartmentsService.StreamApartments(ctx, func(a *models.Apartment) error {
+				return send(newApartment(a))
+			})
+		}, func(err error) error {
+			return sendError(c, errorStatus(err), err)
+		})
+	}
+
+	apartments, err := bms.apartmentsService.GetApartments(c.UserContext())
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newApartmentList(apartments))
+}
+
+func (bms *BuildingManagementSystem) GetApartmentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	apartment, err := bms.apartmentsService.GetApartment(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newApartment(apartment))
+}
+
+// PutApartmentHandler creates the apartment or replaces it if it exists.
+func (bms *BuildingManagementSystem) PutApartmentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var input ApartmentInput
+	err = c.BodyParser(&input)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	apartment := input.model(id)
+	err = bms.apartmentsService.CreateApartment(c.UserContext(), apartment)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newApartment(apartment))
+}
+
+func (bms *BuildingManagementSystem) DeleteApartmentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.apartmentsService.DeleteApartment(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,108 @@
+package bmsv2
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/query"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+// ListBuildingsHandler lists the buildings, only those whose address has the components of the
+// street, house_number, city, postal_code and country query parameters if any, by ID or by street
+// with sort=street.
+func (bms *BuildingManagementSystem) ListBuildingsHandler(c *fiber.Ctx) error {
+	filter, err := query.Address(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	order, err := query.Order(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	buildings, err := bms.buildingsService.FindBuildings(c.UserContext(), filter, order)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newBuildingList(buildings))
+}
+
+func (bms *BuildingManagementSystem) GetBuildingHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	building, err := bms.buildingsService.GetBuilding(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newBuilding(building))
+}
+
+// PutBuildingHandler creates the building or replaces it if it exists.
+func (bms *BuildingManagementSystem) PutBuildingHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var input BuildingInput
+	err = c.BodyParser(&input)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	building := input.model(id)
+	err = bms.buildingsService.CreateBuilding(c.UserContext(), building)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newBuilding(building))
+}
+
+func (bms *BuildingManagementSystem) DeleteBuildingHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.buildingsService.DeleteBuilding(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ListBuildingApartmentsHandler streams the apartments like ListApartmentsHandler.
+func (bms *BuildingManagementSystem) ListBuildingApartmentsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	if middleware.AcceptsNDJSON(c) {
+		return middleware.StreamNDJSON(c, func(ctx context.Context, send func(v any) error) error {
+			return bms.apartmentsService.StreamApartmentsInBuilding(ctx, id, func(a *models.Apartment) error {
+				return send(newApartment(a))
+			})
+		}, func(err error) error {
+			return sendError(c, errorStatus(err), err)
+		})
+	}
+
+	apartments, err := bms.apartmentsService.GetApartmentsInBuilding(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newApartmentList(apartments))
+}
@@ -0,0 +1,96 @@
+package bmsv2
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+func (bms *BuildingManagementSystem) ListFloorsHandler(c *fiber.Ctx) error {
+	buildingId, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	floors, err := bms.floorsService.GetFloors(c.UserContext(), buildingId)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(FloorList{Items: floors})
+}
+
+func (bms *BuildingManagementSystem) GetFloorHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	floor, err := bms.floorsService.GetFloor(c.UserContext(), buildingId, number)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(floor)
+}
+
+// PutFloorHandler creates the floor of the building or replaces it if it exists.
+func (bms *BuildingManagementSystem) PutFloorHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var input FloorInput
+	err = c.BodyParser(&input)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	floor := input.model(buildingId, number)
+	err = bms.floorsService.CreateFloor(c.UserContext(), floor)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(floor)
+}
+
+func (bms *BuildingManagementSystem) DeleteFloorHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.floorsService.DeleteFloor(c.UserContext(), buildingId, number)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (bms *BuildingManagementSystem) ListFloorApartmentsHandler(c *fiber.Ctx) error {
+	buildingId, number, err := floorParams(c)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	apartments, err := bms.apartmentsService.GetApartmentsOnFloor(c.UserContext(), buildingId, number)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(newApartmentList(apartments))
+}
+
+// floorParams parses the building ID and the floor number of the path. The floor may be negative.
+func floorParams(c *fiber.Ctx) (buildingId int, number int, err error) {
+	buildingId, err = c.ParamsInt("id", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	number, err = c.ParamsInt("floor", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	return buildingId, number, nil
+}
@@ -0,0 +1,124 @@
+package bmsv2
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+)
+
+// ListApartmentLeasesHandler lists the leases of the apartment by start date.
+func (bms *BuildingManagementSystem) ListApartmentLeasesHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	list, err := bms.leasesService.GetApartmentLeases(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(LeaseList{Items: list})
+}
+
+// ListExpiringLeasesHandler lists the active leases ending within the next days, 30 by default.
+func (bms *BuildingManagementSystem) ListExpiringLeasesHandler(c *fiber.Ctx) error {
+	list, err := bms.leasesService.GetExpiringLeases(c.UserContext(), c.QueryInt("days", leases.DefaultExpiringDays))
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(LeaseList{Items: list})
+}
+
+func (bms *BuildingManagementSystem) GetLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease, err := bms.leasesService.GetLease(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(lease)
+}
+
+// PutLeaseHandler creates the lease or replaces it while it is a draft.
+func (bms *BuildingManagementSystem) PutLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var input LeaseInput
+	err = c.BodyParser(&input)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease := input.model(id)
+	err = bms.leasesService.CreateLease(c.UserContext(), lease)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(lease)
+}
+
+// RenewLeaseHandler creates the lease renewing an active one, 201 with the new lease.
+func (bms *BuildingManagementSystem) RenewLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var renewal leases.Renewal
+	err = c.BodyParser(&renewal)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease, err := bms.leasesService.RenewLease(c.UserContext(), id, &renewal)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(lease)
+}
+
+// TerminateLeaseHandler ends an active lease on the end date of the body.
+func (bms *BuildingManagementSystem) TerminateLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var termination leases.Termination
+	err = c.BodyParser(&termination)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	lease, err := bms.leasesService.TerminateLease(c.UserContext(), id, &termination)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(lease)
+}
+
+func (bms *BuildingManagementSystem) DeleteLeaseHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.leasesService.DeleteLease(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
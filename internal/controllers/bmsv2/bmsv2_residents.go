@@ -0,0 +1,87 @@
+package bmsv2
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListApartmentResidentsHandler lists the current residents of the apartment, all of them with
+// history=true.
+func (bms *BuildingManagementSystem) ListApartmentResidentsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	residents, err := bms.residentsService.GetApartmentResidents(c.UserContext(), id, c.QueryBool("history"))
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(ResidentList{Items: residents})
+}
+
+// ListBuildingResidentsHandler lists the current residents of the apartments of the building, all
+// of them with history=true.
+func (bms *BuildingManagementSystem) ListBuildingResidentsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	residents, err := bms.residentsService.GetBuildingResidents(c.UserContext(), id, c.QueryBool("history"))
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(ResidentList{Items: residents})
+}
+
+func (bms *BuildingManagementSystem) GetResidentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	resident, err := bms.residentsService.GetResident(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(resident)
+}
+
+// PutResidentHandler creates the resident or replaces it if it exists.
+func (bms *BuildingManagementSystem) PutResidentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	var input ResidentInput
+	err = c.BodyParser(&input)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	resident := input.model(id)
+	err = bms.residentsService.CreateResident(c.UserContext(), resident)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(resident)
+}
+
+func (bms *BuildingManagementSystem) DeleteResidentHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	err = bms.residentsService.DeleteResident(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
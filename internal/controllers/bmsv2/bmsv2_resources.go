@@ -0,0 +1,232 @@
+package bmsv2
+
+import (
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// Building is a building with its structured address, Address is the rendering of the components.
+type Building struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Address     *string  `json:"address"`
+	Street      *string  `json:"street"`
+	HouseNumber *string  `json:"house_number"`
+	City        *string  `json:"city"`
+	PostalCode  *string  `json:"postal_code"`
+	Country     *string  `json:"country"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+}
+
+// BuildingInput is the body of the building upserts, the ID is the one of the path. The free-text
+// Address is parsed into the components when none is given, and must match them otherwise.
+type BuildingInput struct {
+	Name        string   `json:"name"`
+	Address     *string  `json:"address,omitempty"`
+	Street      *string  `json:"street,omitempty"`
+	HouseNumber *string  `json:"house_number,omitempty"`
+	City        *string  `json:"city,omitempty"`
+	PostalCode  *string  `json:"postal_code,omitempty"`
+	Country     *string  `json:"country,omitempty"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+}
+
+type BuildingList struct {
+	Items []Building `json:"items"`
+}
+
+// NearbyBuilding is a building found by its distance to the center of a search, in meters.
+type NearbyBuilding struct {
+	Building  Building `json:"building"`
+	DistanceM float64  `json:"distance_m"`
+}
+
+type NearbyBuildingList struct {
+	Items []NearbyBuilding `json:"items"`
+}
+
+type Apartment struct {
+	ID         int     `json:"id"`
+	BuildingID int     `json:"building_id"`
+	Number     *string `json:"number"`
+	Floor      *int    `json:"floor"`
+	SQMeters   *int    `json:"sq_meters"`
+}
+
+// ApartmentInput is the body of the apartment upserts, the ID is the one of the path.
+type ApartmentInput struct {
+	BuildingID int     `json:"building_id"`
+	Number     *string `json:"number,omitempty"`
+	Floor      *int    `json:"floor,omitempty"`
+	SQMeters   *int    `json:"sq_meters,omitempty"`
+}
+
+type ApartmentList struct {
+	Items []Apartment `json:"items"`
+}
+
+// FloorInput is the body of the floor upserts, the building and the number are the ones of the path.
+type FloorInput struct {
+	Label      string `json:"label,omitempty"`
+	SQMeters   *int   `json:"sq_meters,omitempty"`
+	Accessible bool   `json:"accessible,omitempty"`
+}
+
+type FloorList struct {
+	Items []*storage.Floor `json:"items"`
+}
+
+// ResidentInput is the body of the resident upserts, the ID is the one of the path.
+type ResidentInput struct {
+	ApartmentID    int           `json:"apartment_id"`
+	Name           string        `json:"name"`
+	Email          *string       `json:"email,omitempty"`
+	Phone          *string       `json:"phone,omitempty"`
+	PrimaryContact bool          `json:"primary_contact,omitempty"`
+	MoveIn         storage.Date  `json:"move_in"`
+	MoveOut        *storage.Date `json:"move_out,omitempty"`
+}
+
+type ResidentList struct {
+	Items []*storage.Resident `json:"items"`
+}
+
+// LeaseInput is the body of the lease upserts, the ID is the one of the path. The leases are
+// terminated and renewed with their own requests.
+type LeaseInput struct {
+	ApartmentID    int                 `json:"apartment_id"`
+	Lessee         string              `json:"lessee"`
+	StartDate      storage.Date        `json:"start_date"`
+	EndDate        *storage.Date       `json:"end_date,omitempty"`
+	MonthlyRent    int64               `json:"monthly_rent"`
+	Deposit        int64               `json:"deposit,omitempty"`
+	Currency       string              `json:"currency"`
+	Indexation     storage.Indexation  `json:"indexation,omitempty"`
+	IndexationRate *float64            `json:"indexation_rate,omitempty"`
+	Status         storage.LeaseStatus `json:"status,omitempty"`
+}
+
+type LeaseList struct {
+	Items []*storage.Lease `json:"items"`
+}
+
+type SearchResultList struct {
+	Items []*storage.SearchResult `json:"items"`
+}
+
+func newBuilding(b *models.Building) Building {
+	return Building{
+		ID:          b.ID,
+		Name:        b.Name,
+		Address:     b.Address.Ptr(),
+		Street:      b.Street.Ptr(),
+		HouseNumber: b.HouseNumber.Ptr(),
+		City:        b.City.Ptr(),
+		PostalCode:  b.PostalCode.Ptr(),
+		Country:     b.Country.Ptr(),
+		Latitude:    b.Latitude.Ptr(),
+		Longitude:   b.Longitude.Ptr(),
+	}
+}
+
+func newBuildingList(buildings models.BuildingSlice) BuildingList {
+	list := BuildingList{Items: make([]Building, 0, len(buildings))}
+	for _, b := range buildings {
+		list.Items = append(list.Items, newBuilding(b))
+	}
+	return list
+}
+
+func newNearbyBuildingList(nearby []*storage.NearbyBuilding) NearbyBuildingList {
+	list := NearbyBuildingList{Items: make([]NearbyBuilding, 0, len(nearby))}
+	for _, n := range nearby {
+		list.Items = append(list.Items, NearbyBuilding{Building: newBuilding(n.Building), DistanceM: n.DistanceM})
+	}
+	return list
+}
+
+func (in *BuildingInput) model(id int) *models.Building {
+	return &models.Building{
+		ID:          id,
+		Name:        in.Name,
+		Address:     null.StringFromPtr(in.Address),
+		Street:      null.StringFromPtr(in.Street),
+		HouseNumber: null.StringFromPtr(in.HouseNumber),
+		City:        null.StringFromPtr(in.City),
+		PostalCode:  null.StringFromPtr(in.PostalCode),
+		Country:     null.StringFromPtr(in.Country),
+		Latitude:    null.Float64FromPtr(in.Latitude),
+		Longitude:   null.Float64FromPtr(in.Longitude),
+	}
+}
+
+func newApartment(a *models.Apartment) Apartment {
+	return Apartment{
+		ID:         a.ID,
+		BuildingID: a.BuildingID,
+		Number:     a.Number.Ptr(),
+		Floor:      a.Floor.Ptr(),
+		SQMeters:   a.SQMeters.Ptr(),
+	}
+}
+
+func newApartmentList(apartments models.ApartmentSlice) ApartmentList {
+	list := ApartmentList{Items: make([]Apartment, 0, len(apartments))}
+	for _, a := range apartments {
+		list.Items = append(list.Items, newApartment(a))
+	}
+	return list
+}
+
+func (in *FloorInput) model(buildingId, number int) *storage.Floor {
+	return &storage.Floor{
+		BuildingID: buildingId,
+		Number:     number,
+		Label:      in.Label,
+		SQMeters:   in.SQMeters,
+		Accessible: in.Accessible,
+	}
+}
+
+func (in *ResidentInput) model(id int) *storage.Resident {
+	return &storage.Resident{
+		ID:             id,
+		ApartmentID:    in.ApartmentID,
+		Name:           in.Name,
+		Email:          in.Email,
+		Phone:          in.Phone,
+		PrimaryContact: in.PrimaryContact,
+		MoveIn:         in.MoveIn,
+		MoveOut:        in.MoveOut,
+	}
+}
+
+func (in *LeaseInput) model(id int) *storage.Lease {
+	return &storage.Lease{
+		ID:             id,
+		ApartmentID:    in.ApartmentID,
+		Lessee:         in.Lessee,
+		StartDate:      in.StartDate,
+		EndDate:        in.EndDate,
+		MonthlyRent:    in.MonthlyRent,
+		Deposit:        in.Deposit,
+		Currency:       in.Currency,
+		Indexation:     in.Indexation,
+		IndexationRate: in.IndexationRate,
+		Status:         in.Status,
+	}
+}
+
+func (in *ApartmentInput) model(id int) *models.Apartment {
+	return &models.Apartment{
+		ID:         id,
+		BuildingID: in.BuildingID,
+		Number:     null.StringFromPtr(in.Number),
+		Floor:      null.IntFromPtr(in.Floor),
+		SQMeters:   null.IntFromPtr(in.SQMeters),
+	}
+}
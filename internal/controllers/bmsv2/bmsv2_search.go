@@ -0,0 +1,29 @@
+package bmsv2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/service/search"
+)
+
+func (bms *BuildingManagementSystem) SearchHandler(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		return sendError(c, fiber.StatusBadRequest, errors.New("empty query"))
+	}
+	limit := c.QueryInt("limit", search.DefaultLimit)
+	if limit < 1 || limit > search.MaxLimit {
+		return sendError(c, fiber.StatusBadRequest, fmt.Errorf("limit must be between 1 and %d", search.MaxLimit))
+	}
+
+	results, err := bms.searchService.Search(c.UserContext(), query, limit)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(SearchResultList{Items: results})
+}
@@ -0,0 +1,28 @@
+package bmsv2
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+func (bms *BuildingManagementSystem) GetStatsHandler(c *fiber.Ctx) error {
+	stats, err := bms.apartmentsService.GetStats(c.UserContext())
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(stats)
+}
+
+func (bms *BuildingManagementSystem) GetBuildingStatsHandler(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id", 0)
+	if err != nil {
+		return sendError(c, fiber.StatusBadRequest, err)
+	}
+
+	stats, err := bms.apartmentsService.GetBuildingStats(c.UserContext(), id)
+	if err != nil {
+		return sendError(c, errorStatus(err), err)
+	}
+
+	return c.JSON(stats)
+}
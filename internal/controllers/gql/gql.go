@@ -0,0 +1,112 @@
+// Package gql serves the buildings and the apartments over GraphQL, so a client fetches a
+// building, its apartments and their aggregates in one request with the fields it needs.
+// The resolvers go through the services, the nested fields of the lists are batched.
+package gql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+)
+
+//go:embed schema.graphql
+var schema string
+
+const (
+	// maxDepth stops the queries nesting the relations without end,
+	// e.g. building { apartments { building { apartments ... } } }.
+	maxDepth = 8
+	// maxPageSize is the maximum of the first arguments.
+	maxPageSize = 100
+)
+
+type GraphQL struct {
+	apartmentsService apartments.ApartmentsService
+	buildingsService  buildings.BuildingsService
+	schema            *graphql.Schema
+}
+
+func NewGraphQL(
+	apartmentsService apartments.ApartmentsService,
+	buildingsService buildings.BuildingsService,
+) *GraphQL {
+	g := &GraphQL{
+		apartmentsService: apartmentsService,
+		buildingsService:  buildingsService,
+	}
+	g.schema = graphql.MustParseSchema(schema, &resolver{apartmentsService: apartmentsService, buildingsService: buildingsService},
+		graphql.UseStringDescriptions(),
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(maxDepth),
+	)
+
+	return g
+}
+
+// Request is the body of the GraphQL requests.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Handler executes the query of the request. Like the GraphQL over HTTP spec says for the
+// application/json responses, the errors of the query are sent with a 200 status.
+func (g *GraphQL) Handler(c *fiber.Ctx) error {
+	var req Request
+	err := c.BodyParser(&req)
+	if err != nil {
+		return middleware.SendError(c, fiber.StatusBadRequest, err)
+	}
+
+	ctx := withLoaders(c.UserContext(), newLoaders(g.buildingsService, g.apartmentsService))
+	resp := g.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	return c.JSON(resp)
+}
+
+// Error is a resolver error, its code is sent in the extensions of the GraphQL error.
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Extensions() map[string]any {
+	return map[string]any{"code": e.Code}
+}
+
+// resolveError maps the service errors to the GraphQL ones, the server errors are logged with
+// the request context. Their details aren't sent.
+func resolveError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalid):
+		return badRequest(err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		return &Error{Code: "FORBIDDEN", Message: err.Error()}
+	case errors.Is(err, service.ErrNotFound):
+		return &Error{Code: "NOT_FOUND", Message: err.Error()}
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	default:
+		logger.FromContext(ctx).ErrorContext(ctx, "graphql resolver failed", "error", err)
+		return &Error{Code: "INTERNAL_SERVER_ERROR", Message: "the request couldn't be processed"}
+	}
+}
+
+// badRequest is the error of the invalid arguments.
+func badRequest(message string) error {
+	return &Error{Code: "BAD_USER_INPUT", Message: message}
+}
@@ -0,0 +1,160 @@
+package gql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+)
+
+// loader batches and caches the loads of a request, like a dataloader. The resolver of a list
+// queues the keys of its items and the first load fetches them all at once, so a field of the
+// items costs one query instead of one per item (N+1). Unlike a dataloader it doesn't wait for
+// the loads to come in, which makes the batches deterministic.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending map[K]struct{}
+	batches map[K]*batch[K, V]
+}
+
+// batch is a fetch of several keys, done is closed once values and err are set.
+type batch[K comparable, V any] struct {
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		pending: make(map[K]struct{}),
+		batches: make(map[K]*batch[K, V]),
+	}
+}
+
+// Queue adds the keys to the next batch, unless they are loaded already.
+func (l *loader[K, V]) Queue(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		if _, ok := l.batches[key]; !ok {
+			l.pending[key] = struct{}{}
+		}
+	}
+}
+
+// Prime caches the value of the key, so it isn't fetched.
+func (l *loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.batches[key]; ok {
+		return
+	}
+	done := make(chan struct{})
+	close(done)
+	l.batches[key] = &batch[K, V]{done: done, values: map[K]V{key: value}}
+	delete(l.pending, key)
+}
+
+// Load returns the value of the key, the zero value if the fetch didn't return it. The first
+// load of a key fetches it with the queued keys, the others wait for that fetch.
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if ok {
+		l.mu.Unlock()
+
+		select {
+		case <-b.done:
+			return b.values[key], b.err
+		case <-ctx.Done():
+			var zero V
+			return zero, ctx.Err()
+		}
+	}
+
+	b = &batch[K, V]{done: make(chan struct{})}
+	keys := []K{key}
+	l.batches[key] = b
+	for k := range l.pending {
+		if k != key {
+			keys = append(keys, k)
+			l.batches[k] = b
+		}
+	}
+	clear(l.pending)
+	l.mu.Unlock()
+
+	func() {
+		// Closed even if the fetch panics, so the other loads don't wait forever.
+		defer close(b.done)
+		b.values, b.err = l.fetch(ctx, keys)
+	}()
+
+	return b.values[key], b.err
+}
+
+// Reset drops the cached values, e.g. after a mutation.
+func (l *loader[K, V]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	clear(l.pending)
+	clear(l.batches)
+}
+
+// loaders are the loaders of a request.
+type loaders struct {
+	buildings            *loader[int, *models.Building]
+	apartmentsByBuilding *loader[int, models.ApartmentSlice]
+}
+
+func newLoaders(buildingsService buildings.BuildingsService, apartmentsService apartments.ApartmentsService) *loaders {
+	return &loaders{
+		buildings: newLoader(func(ctx context.Context, ids []int) (map[int]*models.Building, error) {
+			buildings, err := buildingsService.GetBuildingsByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			byID := make(map[int]*models.Building, len(buildings))
+			for _, b := range buildings {
+				byID[b.ID] = b
+			}
+			return byID, nil
+		}),
+		apartmentsByBuilding: newLoader(func(ctx context.Context, buildingIDs []int) (map[int]models.ApartmentSlice, error) {
+			apartments, err := apartmentsService.GetApartmentsInBuildings(ctx, buildingIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			byBuilding := make(map[int]models.ApartmentSlice, len(buildingIDs))
+			for _, a := range apartments {
+				byBuilding[a.BuildingID] = append(byBuilding[a.BuildingID], a)
+			}
+			return byBuilding, nil
+		}),
+	}
+}
+
+func (l *loaders) reset() {
+	l.buildings.Reset()
+	l.apartmentsByBuilding.Reset()
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
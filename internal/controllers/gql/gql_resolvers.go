@@ -0,0 +1,518 @@
+package gql
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+)
+
+// resolver resolves the Query and Mutation fields of schema.graphql.
+type resolver struct {
+	apartmentsService apartments.ApartmentsService
+	buildingsService  buildings.BuildingsService
+}
+
+type idArgs struct {
+	ID int32
+}
+
+type buildingFilter struct {
+	Name        *string
+	Address     *string
+	Street      *string
+	HouseNumber *string
+	City        *string
+	PostalCode  *string
+	Country     *string
+}
+
+type apartmentFilter struct {
+	BuildingID  *int32
+	Floor       *int32
+	MinSqMeters *int32
+	MaxSqMeters *int32
+}
+
+type buildingsArgs struct {
+	Filter *buildingFilter
+	First  int32
+	After  *int32
+}
+
+type apartmentsArgs struct {
+	Filter *apartmentFilter
+	First  int32
+	After  *int32
+}
+
+type buildingInput struct {
+	ID          *int32
+	Name        string
+	Address     *string
+	Street      *string
+	HouseNumber *string
+	City        *string
+	PostalCode  *string
+	Country     *string
+	Latitude    *float64
+	Longitude   *float64
+}
+
+type apartmentInput struct {
+	ID         *int32
+	BuildingID int32
+	Number     *string
+	Floor      *int32
+	SqMeters   *int32
+}
+
+type pageInfo struct {
+	EndCursor   *int32
+	HasNextPage bool
+}
+
+type buildingPage struct {
+	Items      []*buildingResolver
+	TotalCount int32
+	PageInfo   pageInfo
+}
+
+type apartmentPage struct {
+	Items      []*apartmentResolver
+	TotalCount int32
+	PageInfo   pageInfo
+}
+
+func (r *resolver) Building(ctx context.Context, args idArgs) (*buildingResolver, error) {
+	if args.ID <= 0 {
+		return nil, badRequest("id must be positive")
+	}
+
+	building, err := r.buildingsService.GetBuilding(ctx, int(args.ID))
+	if errors.Is(err, service.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	return &buildingResolver{building}, nil
+}
+
+func (r *resolver) Buildings(ctx context.Context, args buildingsArgs) (*buildingPage, error) {
+	if args.First < 0 || args.First > maxPageSize {
+		return nil, badRequest(fmt.Sprintf("first must be between 0 and %d", maxPageSize))
+	}
+
+	filter, err := args.Filter.address()
+	if err != nil {
+		return nil, badRequest(err.Error())
+	}
+
+	buildings, err := r.buildingsService.FindBuildings(ctx, filter, address.OrderID)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	buildings = slices.DeleteFunc(slices.Clone(buildings), func(b *models.Building) bool { return !args.Filter.matches(b) })
+	items, info := paginate(buildings, func(b *models.Building) int { return b.ID }, args.First, args.After)
+
+	page := &buildingPage{
+		Items:      make([]*buildingResolver, 0, len(items)),
+		TotalCount: int32(len(buildings)),
+		PageInfo:   info,
+	}
+	ids := make([]int, 0, len(items))
+	for _, b := range items {
+		page.Items = append(page.Items, &buildingResolver{b})
+		ids = append(ids, b.ID)
+	}
+	// The apartments of the page are fetched at once if any is selected.
+	loadersFrom(ctx).apartmentsByBuilding.Queue(ids...)
+
+	return page, nil
+}
+
+func (r *resolver) Apartment(ctx context.Context, args idArgs) (*apartmentResolver, error) {
+	if args.ID <= 0 {
+		return nil, badRequest("id must be positive")
+	}
+
+	apartment, err := r.apartmentsService.GetApartment(ctx, int(args.ID))
+	if errors.Is(err, service.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	return &apartmentResolver{apartment}, nil
+}
+
+func (r *resolver) Apartments(ctx context.Context, args apartmentsArgs) (*apartmentPage, error) {
+	if args.First < 0 || args.First > maxPageSize {
+		return nil, badRequest(fmt.Sprintf("first must be between 0 and %d", maxPageSize))
+	}
+
+	var apartments models.ApartmentSlice
+	var err error
+	if args.Filter != nil && args.Filter.BuildingID != nil {
+		if *args.Filter.BuildingID <= 0 {
+			return nil, badRequest("buildingId must be positive")
+		}
+		apartments, err = r.apartmentsService.GetApartmentsInBuilding(ctx, int(*args.Filter.BuildingID))
+	} else {
+		apartments, err = r.apartmentsService.GetApartments(ctx)
+	}
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	apartments = filterApartments(apartments, args.Filter)
+	items, info := paginate(apartments, func(a *models.Apartment) int { return a.ID }, args.First, args.After)
+
+	page := &apartmentPage{
+		Items:      make([]*apartmentResolver, 0, len(items)),
+		TotalCount: int32(len(apartments)),
+		PageInfo:   info,
+	}
+	buildingIDs := make([]int, 0, len(items))
+	for _, a := range items {
+		page.Items = append(page.Items, &apartmentResolver{a})
+		buildingIDs = append(buildingIDs, a.BuildingID)
+	}
+	// The buildings of the page, and their apartments, are fetched at once if any is selected.
+	l := loadersFrom(ctx)
+	l.buildings.Queue(buildingIDs...)
+	l.apartmentsByBuilding.Queue(buildingIDs...)
+
+	return page, nil
+}
+
+func (r *resolver) UpsertBuilding(ctx context.Context, args struct{ Input buildingInput }) (*buildingResolver, error) {
+	// The loaded rows may change.
+	loadersFrom(ctx).reset()
+
+	building := &models.Building{
+		Name:        args.Input.Name,
+		Address:     null.StringFromPtr(args.Input.Address),
+		Street:      null.StringFromPtr(args.Input.Street),
+		HouseNumber: null.StringFromPtr(args.Input.HouseNumber),
+		City:        null.StringFromPtr(args.Input.City),
+		PostalCode:  null.StringFromPtr(args.Input.PostalCode),
+		Country:     null.StringFromPtr(args.Input.Country),
+		Latitude:    null.Float64FromPtr(args.Input.Latitude),
+		Longitude:   null.Float64FromPtr(args.Input.Longitude),
+	}
+	if args.Input.ID != nil {
+		if *args.Input.ID <= 0 {
+			return nil, badRequest("id must be positive")
+		}
+		building.ID = int(*args.Input.ID)
+	}
+
+	err := r.buildingsService.CreateBuilding(ctx, building)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	return &buildingResolver{building}, nil
+}
+
+func (r *resolver) DeleteBuilding(ctx context.Context, args idArgs) (bool, error) {
+	loadersFrom(ctx).reset()
+
+	if args.ID <= 0 {
+		return false, badRequest("id must be positive")
+	}
+
+	err := r.buildingsService.DeleteBuilding(ctx, int(args.ID))
+	if err != nil {
+		return false, resolveError(ctx, err)
+	}
+
+	return true, nil
+}
+
+func (r *resolver) UpsertApartment(ctx context.Context, args struct{ Input apartmentInput }) (*apartmentResolver, error) {
+	loadersFrom(ctx).reset()
+
+	if args.Input.BuildingID <= 0 {
+		return nil, badRequest("buildingId must be positive")
+	}
+	apartment := &models.Apartment{
+		BuildingID: int(args.Input.BuildingID),
+		Number:     null.StringFromPtr(args.Input.Number),
+		Floor:      nullInt(args.Input.Floor),
+		SQMeters:   nullInt(args.Input.SqMeters),
+	}
+	if args.Input.ID != nil {
+		if *args.Input.ID <= 0 {
+			return nil, badRequest("id must be positive")
+		}
+		apartment.ID = int(*args.Input.ID)
+	}
+
+	err := r.apartmentsService.CreateApartment(ctx, apartment)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	return &apartmentResolver{apartment}, nil
+}
+
+func (r *resolver) DeleteApartment(ctx context.Context, args idArgs) (bool, error) {
+	loadersFrom(ctx).reset()
+
+	if args.ID <= 0 {
+		return false, badRequest("id must be positive")
+	}
+
+	err := r.apartmentsService.DeleteApartment(ctx, int(args.ID))
+	if err != nil {
+		return false, resolveError(ctx, err)
+	}
+
+	return true, nil
+}
+
+type buildingResolver struct {
+	b *models.Building
+}
+
+func (r *buildingResolver) ID() int32 {
+	return int32(r.b.ID)
+}
+
+func (r *buildingResolver) Name() string {
+	return r.b.Name
+}
+
+func (r *buildingResolver) Address() *string {
+	return r.b.Address.Ptr()
+}
+
+func (r *buildingResolver) Street() *string {
+	return r.b.Street.Ptr()
+}
+
+func (r *buildingResolver) HouseNumber() *string {
+	return r.b.HouseNumber.Ptr()
+}
+
+func (r *buildingResolver) City() *string {
+	return r.b.City.Ptr()
+}
+
+func (r *buildingResolver) PostalCode() *string {
+	return r.b.PostalCode.Ptr()
+}
+
+func (r *buildingResolver) Country() *string {
+	return r.b.Country.Ptr()
+}
+
+func (r *buildingResolver) Latitude() *float64 {
+	return r.b.Latitude.Ptr()
+}
+
+func (r *buildingResolver) Longitude() *float64 {
+	return r.b.Longitude.Ptr()
+}
+
+func (r *buildingResolver) Apartments(ctx context.Context, args struct{ Filter *apartmentFilter }) ([]*apartmentResolver, error) {
+	apartments, err := r.apartments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apartments = filterApartments(apartments, args.Filter)
+	resolvers := make([]*apartmentResolver, 0, len(apartments))
+	for _, a := range apartments {
+		resolvers = append(resolvers, &apartmentResolver{a})
+	}
+
+	return resolvers, nil
+}
+
+func (r *buildingResolver) ApartmentCount(ctx context.Context) (int32, error) {
+	apartments, err := r.apartments(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(len(apartments)), nil
+}
+
+func (r *buildingResolver) TotalSqMeters(ctx context.Context) (int32, error) {
+	apartments, err := r.apartments(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int32
+	for _, a := range apartments {
+		total += int32(a.SQMeters.Int)
+	}
+
+	return total, nil
+}
+
+// apartments returns the apartments of the building from the loader. Unlike the building of
+// an apartment, the eager loaded relation isn't used: Apartment.L.LoadBuilding fills it with
+// the loaded apartments only.
+func (r *buildingResolver) apartments(ctx context.Context) (models.ApartmentSlice, error) {
+	l := loadersFrom(ctx)
+	apartments, err := l.apartmentsByBuilding.Load(ctx, r.b.ID)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+	// The building of these apartments is this one.
+	l.buildings.Prime(r.b.ID, r.b)
+
+	return apartments, nil
+}
+
+type apartmentResolver struct {
+	a *models.Apartment
+}
+
+func (r *apartmentResolver) ID() int32 {
+	return int32(r.a.ID)
+}
+
+func (r *apartmentResolver) BuildingID() int32 {
+	return int32(r.a.BuildingID)
+}
+
+func (r *apartmentResolver) Number() *string {
+	return r.a.Number.Ptr()
+}
+
+func (r *apartmentResolver) Floor() *int32 {
+	return int32Ptr(r.a.Floor)
+}
+
+func (r *apartmentResolver) SqMeters() *int32 {
+	return int32Ptr(r.a.SQMeters)
+}
+
+// Building returns the building of the apartment: the relation if it was eager loaded,
+// otherwise the one of the loader.
+func (r *apartmentResolver) Building(ctx context.Context) (*buildingResolver, error) {
+	if building := r.a.R.GetBuilding(); building != nil {
+		return &buildingResolver{building}, nil
+	}
+
+	building, err := loadersFrom(ctx).buildings.Load(ctx, r.a.BuildingID)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+	if building == nil {
+		return nil, nil
+	}
+
+	return &buildingResolver{building}, nil
+}
+
+func (f *buildingFilter) matches(b *models.Building) bool {
+	if f == nil {
+		return true
+	}
+	if f.Name != nil && !containsFold(b.Name, *f.Name) {
+		return false
+	}
+	if f.Address != nil && !containsFold(b.Address.String, *f.Address) {
+		return false
+	}
+	return true
+}
+
+// address returns the normalised filter of the components of the addresses.
+func (f *buildingFilter) address() (address.Filter, error) {
+	if f == nil {
+		return address.Filter{}, nil
+	}
+	return address.Filter{
+		Street:      null.StringFromPtr(f.Street).String,
+		HouseNumber: null.StringFromPtr(f.HouseNumber).String,
+		City:        null.StringFromPtr(f.City).String,
+		PostalCode:  null.StringFromPtr(f.PostalCode).String,
+		Country:     null.StringFromPtr(f.Country).String,
+	}.Normalize()
+}
+
+func (f *apartmentFilter) matches(a *models.Apartment) bool {
+	if f == nil {
+		return true
+	}
+	if f.BuildingID != nil && a.BuildingID != int(*f.BuildingID) {
+		return false
+	}
+	if f.Floor != nil && (!a.Floor.Valid || a.Floor.Int != int(*f.Floor)) {
+		return false
+	}
+	if f.MinSqMeters != nil && (!a.SQMeters.Valid || a.SQMeters.Int < int(*f.MinSqMeters)) {
+		return false
+	}
+	if f.MaxSqMeters != nil && (!a.SQMeters.Valid || a.SQMeters.Int > int(*f.MaxSqMeters)) {
+		return false
+	}
+	return true
+}
+
+// filterApartments returns the apartments matching the filter sorted by ID, without changing the slice.
+func filterApartments(apartments models.ApartmentSlice, f *apartmentFilter) models.ApartmentSlice {
+	apartments = slices.DeleteFunc(slices.Clone(apartments), func(a *models.Apartment) bool { return !f.matches(a) })
+	slices.SortFunc(apartments, func(a, b *models.Apartment) int { return cmp.Compare(a.ID, b.ID) })
+	return apartments
+}
+
+// paginate returns at most first of the items, sorted by ID, whose ID is above after.
+func paginate[T any](items []T, id func(T) int, first int32, after *int32) ([]T, pageInfo) {
+	if after != nil {
+		start, _ := slices.BinarySearchFunc(items, int(*after)+1, func(item T, target int) int {
+			return cmp.Compare(id(item), target)
+		})
+		items = items[start:]
+	}
+
+	info := pageInfo{HasNextPage: len(items) > int(first)}
+	items = items[:min(len(items), int(first))]
+	if len(items) > 0 {
+		cursor := int32(id(items[len(items)-1]))
+		info.EndCursor = &cursor
+	}
+
+	return items, info
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func nullInt(i *int32) null.Int {
+	if i == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(*i))
+}
+
+func int32Ptr(i null.Int) *int32 {
+	if !i.Valid {
+		return nil
+	}
+	v := int32(i.Int)
+	return &v
+}
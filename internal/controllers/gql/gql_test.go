@@ -0,0 +1,313 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/mocks"
+)
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, g *GraphQL, query string, variables map[string]any) response {
+	t.Helper()
+
+	app := fiber.New()
+	app.Post("/graphql", g.Handler)
+
+	body, err := json.Marshal(Request{Query: query, Variables: variables})
+	require.NoError(t, err)
+	req := httptest.NewRequest(fiber.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode)
+
+	var r response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+	return r
+}
+
+var (
+	testBuildings = models.BuildingSlice{
+		{
+			ID: 1, Name: "Tower", Address: null.StringFrom("1 Main St"),
+			Street: null.StringFrom("Main Street"), HouseNumber: null.StringFrom("1"), City: null.StringFrom("Springfield"),
+		},
+		{ID: 2, Name: "Annex"},
+		{
+			ID: 3, Name: "Old tower", Address: null.StringFrom("3 Main St"),
+			Street: null.StringFrom("Main Street"), HouseNumber: null.StringFrom("3"), City: null.StringFrom("Shelbyville"),
+		},
+	}
+	testApartments = models.ApartmentSlice{
+		{ID: 1, BuildingID: 1, Number: null.StringFrom("1A"), Floor: null.IntFrom(1), SQMeters: null.IntFrom(40)},
+		{ID: 2, BuildingID: 1, Number: null.StringFrom("2A"), Floor: null.IntFrom(2), SQMeters: null.IntFrom(60)},
+		{ID: 3, BuildingID: 3, Number: null.StringFrom("1"), Floor: null.IntFrom(1)},
+	}
+)
+
+// services returns the services of testBuildings and testApartments, the batch loads are counted.
+func services(mc *minimock.Controller) (*mocks.BuildingsServiceMock, *mocks.ApartmentsServiceMock, *atomic.Int32, *atomic.Int32) {
+	var buildingBatches, apartmentBatches atomic.Int32
+
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	buildingsService.FindBuildingsMock.Optional().Set(func(_ context.Context, filter address.Filter, order address.Order) (models.BuildingSlice, error) {
+		buildings := slices.Clone(address.FilterBuildings(testBuildings, filter))
+		address.SortBuildings(buildings, order)
+		return buildings, nil
+	})
+	buildingsService.GetBuildingMock.Optional().Set(func(_ context.Context, id int) (*models.Building, error) {
+		i := slices.IndexFunc(testBuildings, func(b *models.Building) bool { return b.ID == id })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, id)
+		}
+		return testBuildings[i], nil
+	})
+	buildingsService.GetBuildingsByIDsMock.Optional().Set(func(_ context.Context, ids []int) (models.BuildingSlice, error) {
+		buildingBatches.Add(1)
+		var buildings models.BuildingSlice
+		for _, b := range testBuildings {
+			if slices.Contains(ids, b.ID) {
+				buildings = append(buildings, b)
+			}
+		}
+		return buildings, nil
+	})
+
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	apartmentsService.GetApartmentsMock.Optional().Return(testApartments, nil)
+	apartmentsService.GetApartmentsInBuildingsMock.Optional().Set(func(_ context.Context, buildingIds []int) (models.ApartmentSlice, error) {
+		apartmentBatches.Add(1)
+		var apartments models.ApartmentSlice
+		for _, a := range testApartments {
+			if slices.Contains(buildingIds, a.BuildingID) {
+				apartments = append(apartments, a)
+			}
+		}
+		return apartments, nil
+	})
+
+	return buildingsService, apartmentsService, &buildingBatches, &apartmentBatches
+}
+
+func Test_NestedQueriesAreBatched(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	buildingsService, apartmentsService, buildingBatches, apartmentBatches := services(mc)
+	g := NewGraphQL(apartmentsService, buildingsService)
+
+	resp := execute(t, g, `{
+		buildings {
+			items {
+				id name address apartmentCount totalSqMeters
+				apartments { id number building { id } }
+			}
+		}
+	}`, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"buildings": {"items": [
+		{"id": 1, "name": "Tower", "address": "1 Main St", "apartmentCount": 2, "totalSqMeters": 100,
+			"apartments": [{"id": 1, "number": "1A", "building": {"id": 1}}, {"id": 2, "number": "2A", "building": {"id": 1}}]},
+		{"id": 2, "name": "Annex", "address": null, "apartmentCount": 0, "totalSqMeters": 0, "apartments": []},
+		{"id": 3, "name": "Old tower", "address": "3 Main St", "apartmentCount": 1, "totalSqMeters": 0,
+			"apartments": [{"id": 3, "number": "1", "building": {"id": 3}}]}
+	]}}`, string(resp.Data))
+	// One query for the apartments of the 3 buildings, their building is the parent one.
+	assert.Equal(t, int32(1), apartmentBatches.Load())
+	assert.Equal(t, int32(0), buildingBatches.Load())
+
+	resp = execute(t, g, `{ apartments { items { id building { name apartmentCount } } } }`, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"apartments": {"items": [
+		{"id": 1, "building": {"name": "Tower", "apartmentCount": 2}},
+		{"id": 2, "building": {"name": "Tower", "apartmentCount": 2}},
+		{"id": 3, "building": {"name": "Old tower", "apartmentCount": 1}}
+	]}}`, string(resp.Data))
+	assert.Equal(t, int32(2), apartmentBatches.Load())
+	assert.Equal(t, int32(1), buildingBatches.Load())
+}
+
+func Test_Pagination(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	buildingsService, apartmentsService, _, _ := services(mc)
+	g := NewGraphQL(apartmentsService, buildingsService)
+
+	query := `query($after: Int) {
+		buildings(first: 2, after: $after) { items { id } totalCount pageInfo { endCursor hasNextPage } }
+	}`
+	resp := execute(t, g, query, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"buildings": {"items": [{"id": 1}, {"id": 2}], "totalCount": 3, "pageInfo": {"endCursor": 2, "hasNextPage": true}}}`, string(resp.Data))
+
+	resp = execute(t, g, query, map[string]any{"after": 2})
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"buildings": {"items": [{"id": 3}], "totalCount": 3, "pageInfo": {"endCursor": 3, "hasNextPage": false}}}`, string(resp.Data))
+
+	resp = execute(t, g, `{ buildings(filter: {name: "TOWER"}) { items { id } totalCount } }`, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"buildings": {"items": [{"id": 1}, {"id": 3}], "totalCount": 2}}`, string(resp.Data))
+
+	resp = execute(t, g, `{ buildings(filter: {street: "main st", city: "SPRINGFIELD"}) { items { id street houseNumber city country } } }`, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"buildings": {"items": [{"id": 1, "street": "Main Street", "houseNumber": "1", "city": "Springfield", "country": null}]}}`, string(resp.Data))
+
+	resp = execute(t, g, `{ buildings(filter: {country: "Atlantis"}) { totalCount } }`, nil)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "BAD_USER_INPUT", resp.Errors[0].Extensions["code"])
+
+	resp = execute(t, g, `{ apartments(filter: {floor: 1}) { items { id } } }`, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"apartments": {"items": [{"id": 1}, {"id": 3}]}}`, string(resp.Data))
+
+	resp = execute(t, g, `{ building(id: 1) { apartments(filter: {minSqMeters: 50}) { id } } }`, nil)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"building": {"apartments": [{"id": 2}]}}`, string(resp.Data))
+
+	resp = execute(t, g, `{ buildings(first: 1000) { totalCount } }`, nil)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "BAD_USER_INPUT", resp.Errors[0].Extensions["code"])
+}
+
+func Test_Errors(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	buildingsService := mocks.NewBuildingsServiceMock(mc).
+		GetBuildingMock.Set(func(_ context.Context, id int) (*models.Building, error) {
+		switch id {
+		case 2:
+			return nil, fmt.Errorf("%w: building [2]", service.ErrForbidden)
+		case 3:
+			return nil, fmt.Errorf("%w: no building with id [3]", service.ErrNotFound)
+		default:
+			return nil, fmt.Errorf("connection refused")
+		}
+	})
+	g := NewGraphQL(mocks.NewApartmentsServiceMock(mc), buildingsService)
+
+	resp := execute(t, g, `{ forbidden: building(id: 2) { id } missing: building(id: 3) { id } }`, nil)
+	assert.JSONEq(t, `{"forbidden": null, "missing": null}`, string(resp.Data))
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "FORBIDDEN", resp.Errors[0].Extensions["code"])
+	assert.Equal(t, []any{"forbidden"}, resp.Errors[0].Path)
+
+	resp = execute(t, g, `{ building(id: 4) { id } }`, nil)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "INTERNAL_SERVER_ERROR", resp.Errors[0].Extensions["code"])
+	assert.Equal(t, "the request couldn't be processed", resp.Errors[0].Message)
+
+	resp = execute(t, g, `{ building(id: 1) { floors } }`, nil)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, `Cannot query field "floors"`)
+}
+
+func Test_Mutations(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	buildingsService := mocks.NewBuildingsServiceMock(mc).
+		CreateBuildingMock.Set(func(_ context.Context, building *models.Building) error {
+		building.ID = 7
+		return nil
+	}).
+		GetBuildingsByIDsMock.Return(models.BuildingSlice{{ID: 7, Name: "Tower"}}, nil).
+		DeleteBuildingMock.Expect(minimock.AnyContext, 7).Return(nil)
+	var created atomic.Bool
+	apartmentsService := mocks.NewApartmentsServiceMock(mc).
+		CreateApartmentMock.Set(func(_ context.Context, apartment *models.Apartment) error {
+		assert.Equal(t, &models.Apartment{ID: 5, BuildingID: 7, Floor: null.IntFrom(3)}, apartment)
+		created.Store(true)
+		return nil
+	}).
+		GetApartmentsInBuildingsMock.Set(func(context.Context, []int) (models.ApartmentSlice, error) {
+		if !created.Load() {
+			return models.ApartmentSlice{}, nil
+		}
+		return models.ApartmentSlice{{ID: 5, BuildingID: 7, Floor: null.IntFrom(3)}}, nil
+	})
+	g := NewGraphQL(apartmentsService, buildingsService)
+
+	resp := execute(t, g, `mutation($input: BuildingInput!) {
+		upsertBuilding(input: $input) { id name address apartmentCount }
+		upsertApartment(input: {id: 5, buildingId: 7, floor: 3}) { id floor sqMeters building { name apartmentCount } }
+		deleteBuilding(id: 7)
+	}`, map[string]any{"input": map[string]any{"name": "Tower"}})
+	require.Empty(t, resp.Errors)
+	// The apartments of the building are loaded again after the apartment is added.
+	assert.JSONEq(t, `{
+		"upsertBuilding": {"id": 7, "name": "Tower", "address": null, "apartmentCount": 0},
+		"upsertApartment": {"id": 5, "floor": 3, "sqMeters": null, "building": {"name": "Tower", "apartmentCount": 1}},
+		"deleteBuilding": true
+	}`, string(resp.Data))
+}
+
+func Test_Loader(t *testing.T) {
+	t.Parallel()
+
+	var fetches atomic.Int32
+	l := newLoader(func(_ context.Context, keys []int) (map[int]string, error) {
+		fetches.Add(1)
+		values := make(map[int]string, len(keys))
+		for _, k := range keys {
+			if k != 4 {
+				values[k] = fmt.Sprint("value ", k)
+			}
+		}
+		return values, nil
+	})
+
+	l.Queue(1, 2, 3, 4)
+	l.Prime(5, "primed")
+
+	var wg sync.WaitGroup
+	for k := 1; k <= 5; k++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := l.Load(context.Background(), k)
+			assert.NoError(t, err)
+			switch k {
+			case 4:
+				assert.Empty(t, v)
+			case 5:
+				assert.Equal(t, "primed", v)
+			default:
+				assert.Equal(t, fmt.Sprint("value ", k), v)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), fetches.Load())
+
+	l.Reset()
+	_, err := l.Load(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+// AccessLog logs every request once it is handled. It must be registered with app.Use
+// after RequestID, the route name is known once the request went through the router.
+// The successful requests to quietRoutes, e.g. the probes, are logged at the debug level.
+func AccessLog(quietRoutes ...string) fiber.Handler {
+	quiet := make(map[string]bool, len(quietRoutes))
+	for _, route := range quietRoutes {
+		quiet[route] = true
+	}
+
+	return func(c *fiber.Ctx) error {
+		return accessLog(c, quiet)
+	}
+}
+
+func accessLog(c *fiber.Ctx, quiet map[string]bool) error {
+	start := time.Now()
+	err := c.Next()
+	latency := time.Since(start)
+
+	status := c.Response().StatusCode()
+	if err != nil {
+		// The error handler runs after the middleware, resolve the status it is going to send.
+		status = fiber.StatusInternalServerError
+		if e, ok := err.(*fiber.Error); ok {
+			status = e.Code
+		}
+	}
+
+	route := c.Route().Name
+	level := slog.LevelInfo
+	switch {
+	case status >= fiber.StatusInternalServerError:
+		level = slog.LevelError
+	case status >= fiber.StatusBadRequest:
+		level = slog.LevelWarn
+	case quiet[route]:
+		level = slog.LevelDebug
+	}
+
+	ctx := c.UserContext()
+	attrs := []slog.Attr{
+		slog.String("route", route),
+		slog.Int("status", status),
+		slog.Float64("latency_ms", float64(latency.Microseconds())/1000),
+	}
+	// The size of a stream isn't known yet, reading its body would buffer it.
+	if !c.Response().IsBodyStream() {
+		attrs = append(attrs, slog.Int("bytes", len(c.Response().Body())))
+	}
+	attrs = append(attrs, slog.String("ip", c.IP()))
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logger.FromContext(ctx).LogAttrs(ctx, level, "request", attrs...)
+
+	return err
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+const (
+	HeaderAPIKey = "X-API-Key"
+
+	resultKey   = "result"
+	responseKey = "response"
+
+	resultError = "error"
+)
+
+// Authenticate rejects the requests without valid credentials with 401 and stores the principal
+// of the others in the user context. A nil authenticator disables the authentication.
+func Authenticate(authenticator *auth.Authenticator) fiber.Handler {
+	if authenticator == nil {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		principal, err := authenticator.Authenticate(ctx, c.Get(HeaderAPIKey), c.Get(fiber.HeaderAuthorization))
+		if err != nil {
+			status := fiber.StatusUnauthorized
+			if errors.Is(err, auth.ErrUnauthenticated) {
+				logger.FromContext(ctx).DebugContext(ctx, "authentication failed", "error", err)
+				c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+				err = auth.ErrUnauthenticated
+			} else {
+				status = fiber.StatusInternalServerError
+				logger.FromContext(ctx).ErrorContext(ctx, "authentication failed", "error", err)
+				err = errors.New("authentication failed")
+			}
+
+			return SendError(c, status, err)
+		}
+
+		ctx = auth.WithPrincipal(ctx, principal)
+		ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("principal", principal.Subject))
+		c.SetUserContext(ctx)
+
+		return c.Next()
+	}
+}
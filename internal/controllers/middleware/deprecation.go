@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	HeaderDeprecation = "Deprecation"
+	HeaderSunset      = "Sunset"
+)
+
+// Deprecated marks the responses of a legacy route as deprecated since deprecatedAt (RFC 9745)
+// and, unless sunset is zero, to be removed at sunset (RFC 8594). The Link header points to the
+// successor of the route, the same path and query under successorPrefix.
+func Deprecated(deprecatedAt, sunset time.Time, successorPrefix string) fiber.Handler {
+	deprecation := "@" + strconv.FormatInt(deprecatedAt.Unix(), 10)
+	var sunsetDate string
+	if !sunset.IsZero() {
+		sunsetDate = sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *fiber.Ctx) error {
+		c.Set(HeaderDeprecation, deprecation)
+		if sunsetDate != "" {
+			c.Set(HeaderSunset, sunsetDate)
+		}
+		c.Append(fiber.HeaderLink, "<"+successorPrefix+c.OriginalURL()+`>; rel="successor-version"`)
+
+		return c.Next()
+	}
+}
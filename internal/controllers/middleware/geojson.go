@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+const MIMEApplicationGeoJSON = "application/geo+json"
+
+// AcceptsGeoJSON reports whether the client prefers GeoJSON (RFC 7946), e.g. a map, to a JSON document.
+func AcceptsGeoJSON(c *fiber.Ctx) bool {
+	return c.Accepts(fiber.MIMEApplicationJSON, MIMEApplicationGeoJSON) == MIMEApplicationGeoJSON
+}
+
+// SendGeoJSON responds with the GeoJSON object v.
+func SendGeoJSON(c *fiber.Ctx, v any) error {
+	return c.JSON(v, MIMEApplicationGeoJSON)
+}
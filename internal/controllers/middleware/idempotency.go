@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/idempotency"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+)
+
+var (
+	errIdempotencyKeyReused     = errors.New("idempotency key was used for another request")
+	errIdempotencyKeyInProgress = errors.New("request with the same idempotency key is in progress")
+	errIdempotencyUnavailable   = errors.New("idempotency key can't be checked")
+)
+
+// Idempotency stores the responses of the POST, PATCH and DELETE requests sent with an
+// Idempotency-Key for ttl and replays them when the requests are retried with the key.
+// The keys are scoped to the tenant and the principal of the request. A key reused for another
+// request gets 422, and a duplicate sent while the first request is in progress gets 409.
+// Server errors aren't stored, so the request can be retried. A nil store disables the keys.
+func Idempotency(store storage.IdempotencyStorage, ttl, lockTimeout time.Duration) fiber.Handler {
+	if store == nil {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		key := c.Get(HeaderIdempotencyKey)
+		if key == "" {
+			return c.Next()
+		}
+		switch c.Method() {
+		case fiber.MethodPost, fiber.MethodPatch, fiber.MethodDelete:
+		default:
+			return c.Next()
+		}
+
+		err := idempotency.ValidateKey(key)
+		if err != nil {
+			return SendError(c, fiber.StatusBadRequest, err)
+		}
+
+		ctx := c.UserContext()
+		client := "anonymous"
+		if principal, ok := auth.FromContext(ctx); ok {
+			client = principal.Method + ":" + principal.Subject
+		}
+		record := &storage.IdempotencyRecord{
+			Scope:       tenant.FromContext(ctx) + ":" + client,
+			Key:         key,
+			RequestHash: idempotency.RequestHash(c.Method(), c.Path(), c.Body()),
+		}
+
+		existing, err := store.ReserveIdempotencyKey(ctx, record, lockTimeout)
+		if err != nil {
+			logger.FromContext(ctx).ErrorContext(ctx, "can't reserve idempotency key", "error", err)
+			return SendError(c, fiber.StatusServiceUnavailable, errIdempotencyUnavailable)
+		}
+		if existing != nil {
+			switch {
+			case existing.RequestHash != record.RequestHash:
+				return SendError(c, fiber.StatusUnprocessableEntity, errIdempotencyKeyReused)
+			case existing.Status == 0:
+				c.Set(fiber.HeaderRetryAfter, "1")
+				return SendError(c, fiber.StatusConflict, errIdempotencyKeyInProgress)
+			}
+
+			logger.FromContext(ctx).DebugContext(ctx, "idempotent response replayed", "idempotency_key", key)
+			c.Set(HeaderIdempotentReplayed, "true")
+			if existing.ContentType != "" {
+				c.Set(fiber.HeaderContentType, existing.ContentType)
+			}
+			return c.Status(existing.Status).Send(existing.Body)
+		}
+
+		err = c.Next()
+		status := c.Response().StatusCode()
+		if err != nil || status >= fiber.StatusInternalServerError {
+			releaseErr := store.ReleaseIdempotencyKey(ctx, record.Scope, record.Key)
+			if releaseErr != nil {
+				logger.FromContext(ctx).ErrorContext(ctx, "can't release idempotency key", "error", releaseErr)
+			}
+			return err
+		}
+
+		record.Status = status
+		record.ContentType = string(c.Response().Header.ContentType())
+		record.Body = append([]byte(nil), c.Response().Body()...)
+		err = store.CompleteIdempotencyKey(ctx, record, ttl)
+		if err != nil {
+			logger.FromContext(ctx).ErrorContext(ctx, "can't store idempotent response", "error", err)
+		}
+
+		return nil
+	}
+}
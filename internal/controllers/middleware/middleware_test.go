@@ -0,0 +1,616 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/idempotency"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/ratelimit"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_RequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		requestID string
+		wantSame  bool
+	}{
+		{
+			name:      "propagated",
+			requestID: "b3f1c1d2-request",
+			wantSame:  true,
+		},
+		{
+			name:      "missing",
+			requestID: "",
+		},
+		{
+			name:      "invalid",
+			requestID: "id with spaces",
+		},
+		{
+			name:      "tooLong",
+			requestID: strings.Repeat("a", maxRequestIDLength+1),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var seen string
+			app := fiber.New()
+			app.Use(RequestID)
+			app.Get("/", func(c *fiber.Ctx) error {
+				seen = logger.RequestID(c.UserContext())
+				return nil
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.requestID != "" {
+				req.Header.Set(HeaderRequestID, tt.requestID)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			got := resp.Header.Get(HeaderRequestID)
+			assert.Equal(t, seen, got)
+			if tt.wantSame {
+				assert.Equal(t, tt.requestID, got)
+			} else {
+				assert.NotEqual(t, tt.requestID, got)
+				assert.Len(t, got, 32)
+			}
+		})
+	}
+}
+
+func Test_AccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(logger.NewHandler(&buf, slog.LevelDebug)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	app := fiber.New()
+	app.Use(RequestID, AccessLog("livez"))
+	app.Get("/livez", func(c *fiber.Ctx) error { return c.SendString("ok") }).Name("livez")
+	app.Route("/buildings", func(api fiber.Router) {
+		api.Get("/:id", func(c *fiber.Ctx) error { return c.SendString("building") }).Name("getByID")
+		api.Delete("/:id", func(c *fiber.Ctx) error { return fiber.ErrBadRequest }).Name("delete")
+		api.Post("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusInternalServerError) }).Name("create")
+	}, "buildings.")
+
+	tests := []struct {
+		method    string
+		target    string
+		wantRoute string
+		wantLevel string
+		wantCode  int
+	}{
+		{fiber.MethodGet, "/buildings/1", "buildings.getByID", "INFO", 200},
+		{fiber.MethodDelete, "/buildings/1", "buildings.delete", "WARN", 400},
+		{fiber.MethodPost, "/buildings", "buildings.create", "ERROR", 500},
+		{fiber.MethodGet, "/livez", "livez", "DEBUG", 200},
+	}
+
+	for _, tt := range tests {
+		buf.Reset()
+
+		req := httptest.NewRequest(tt.method, tt.target, nil)
+		req.Header.Set(HeaderRequestID, "test-request")
+		_, err := app.Test(req)
+		require.NoError(t, err)
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record), buf.String())
+		assert.Equal(t, "request", record["msg"])
+		assert.Equal(t, tt.wantRoute, record["route"])
+		assert.Equal(t, tt.wantLevel, record["level"])
+		assert.Equal(t, float64(tt.wantCode), record["status"])
+		assert.Equal(t, "test-request", record["request_id"])
+		assert.Equal(t, tt.method, record["method"])
+		assert.Contains(t, record, "latency_ms")
+		assert.Contains(t, record, "bytes")
+		assert.Contains(t, record, "ip")
+	}
+}
+
+type apiKeys map[string]string
+
+func (k apiKeys) AuthenticateAPIKey(_ context.Context, key string) (*auth.Principal, error) {
+	subject, ok := k[key]
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	if subject == "" {
+		return nil, errors.New("storage is down")
+	}
+	return &auth.Principal{Subject: subject, Method: auth.MethodAPIKey}, nil
+}
+
+func Test_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	authenticator := auth.NewAuthenticator(apiKeys{"oms_valid": "deploy-bot", "oms_broken": ""}, nil)
+
+	tests := []struct {
+		name          string
+		authenticator *auth.Authenticator
+		apiKey        string
+		wantCode      int
+		wantSubject   string
+	}{
+		{name: "valid", authenticator: authenticator, apiKey: "oms_valid", wantCode: 200, wantSubject: "deploy-bot"},
+		{name: "invalid", authenticator: authenticator, apiKey: "oms_invalid", wantCode: 401},
+		{name: "missing", authenticator: authenticator, wantCode: 401},
+		{name: "storageError", authenticator: authenticator, apiKey: "oms_broken", wantCode: 500},
+		{name: "disabled", wantCode: 200},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Get("/", Authenticate(tt.authenticator), func(c *fiber.Ctx) error {
+				p, ok := auth.FromContext(c.UserContext())
+				if !ok {
+					return c.SendString("")
+				}
+				return c.SendString(p.Subject)
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.apiKey != "" {
+				req.Header.Set(HeaderAPIKey, tt.apiKey)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			switch tt.wantCode {
+			case 200:
+				assert.Equal(t, tt.wantSubject, string(body))
+			case 401:
+				assert.Equal(t, "Bearer", resp.Header.Get(fiber.HeaderWWWAuthenticate))
+				assert.JSONEq(t, `{"result":"error","response":"unauthenticated"}`, string(body))
+			}
+		})
+	}
+}
+
+func Test_Tenant(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		principal  *auth.Principal
+		header     string
+		wantCode   int
+		wantTenant string
+	}{
+		{name: "default", wantCode: 200, wantTenant: tenant.Default},
+		{name: "header", header: "acme", wantCode: 200, wantTenant: "acme"},
+		{name: "invalidHeader", header: "ACME Corp", wantCode: 400},
+		{name: "unboundPrincipal", principal: &auth.Principal{Subject: "ops"}, header: "acme", wantCode: 200, wantTenant: "acme"},
+		{name: "boundPrincipal", principal: &auth.Principal{Subject: "m", Tenant: "acme"}, wantCode: 200, wantTenant: "acme"},
+		{name: "boundPrincipalSameHeader", principal: &auth.Principal{Subject: "m", Tenant: "acme"}, header: "acme", wantCode: 200, wantTenant: "acme"},
+		{name: "boundPrincipalOtherTenant", principal: &auth.Principal{Subject: "m", Tenant: "acme"}, header: "globex", wantCode: 403},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error {
+				if tt.principal != nil {
+					c.SetUserContext(auth.WithPrincipal(c.UserContext(), tt.principal))
+				}
+				return c.Next()
+			}, Tenant, func(c *fiber.Ctx) error {
+				return c.SendString(tenant.FromContext(c.UserContext()))
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(HeaderTenantID, tt.header)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+
+			if tt.wantCode == 200 {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantTenant, string(body))
+			}
+		})
+	}
+}
+
+func Test_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore())
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		if subject := c.Get("X-Subject"); subject != "" {
+			c.SetUserContext(auth.WithPrincipal(c.UserContext(), &auth.Principal{Subject: subject, Method: auth.MethodAPIKey}))
+		}
+		return c.Next()
+	}, RateLimit(limiter, ratelimit.Limit{Rate: 0.01, Burst: 2}, ratelimit.Limit{Rate: 0.01, Burst: 1}))
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendString("ok") })
+	app.Post("/", func(c *fiber.Ctx) error { return c.SendString("ok") })
+
+	do := func(method, subject string) *http.Response {
+		req := httptest.NewRequest(method, "/", nil)
+		if subject != "" {
+			req.Header.Set("X-Subject", subject)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := do(fiber.MethodGet, "alice")
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get(HeaderRateLimitLimit))
+	assert.Equal(t, "1", resp.Header.Get(HeaderRateLimitRemaining))
+	assert.Equal(t, 200, do(fiber.MethodGet, "alice").StatusCode)
+
+	resp = do(fiber.MethodGet, "alice")
+	assert.Equal(t, 429, resp.StatusCode)
+	assert.Equal(t, "0", resp.Header.Get(HeaderRateLimitRemaining))
+	assert.Equal(t, "100", resp.Header.Get(fiber.HeaderRetryAfter))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"result":"error","response":"rate limit exceeded"}`, string(body))
+
+	// The writes have their own budget, and so have the other clients.
+	assert.Equal(t, 200, do(fiber.MethodPost, "alice").StatusCode)
+	assert.Equal(t, 429, do(fiber.MethodPost, "alice").StatusCode)
+	assert.Equal(t, 200, do(fiber.MethodGet, "bob").StatusCode)
+	assert.Equal(t, 200, do(fiber.MethodGet, "").StatusCode)
+}
+
+func Test_RateLimitIP(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore())
+	app := fiber.New()
+	// The bucket of the IP is taken before the credentials are checked.
+	app.Use(RateLimitIP(limiter, ratelimit.Limit{Rate: 0.01, Burst: 2}), func(c *fiber.Ctx) error {
+		if c.Get(HeaderAPIKey) != "valid" {
+			return SendError(c, fiber.StatusUnauthorized, auth.ErrUnauthenticated)
+		}
+		return c.Next()
+	})
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendString("ok") })
+
+	do := func(apiKey string) *http.Response {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.Header.Set(HeaderAPIKey, apiKey)
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	assert.Equal(t, 401, do("random-1").StatusCode)
+	assert.Equal(t, 401, do("random-2").StatusCode)
+	resp := do("valid")
+	assert.Equal(t, 429, resp.StatusCode)
+	assert.Equal(t, "100", resp.Header.Get(fiber.HeaderRetryAfter))
+}
+
+func Test_RateLimitDisabled(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Get("/", RateLimit(nil, ratelimit.Limit{}, ratelimit.Limit{}), func(c *fiber.Ctx) error { return c.SendString("ok") })
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get(HeaderRateLimitLimit))
+}
+
+func Test_Idempotency(t *testing.T) {
+	t.Parallel()
+
+	store := idempotency.NewMemoryStore()
+	var created, failures int
+	started, release := make(chan struct{}), make(chan struct{})
+	app := fiber.New()
+	app.Use(Idempotency(store, time.Hour, time.Minute))
+	app.Post("/apartments", func(c *fiber.Ctx) error {
+		created++
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{"result": "success", "response": created})
+	})
+	app.Post("/fail", func(c *fiber.Ctx) error {
+		failures++
+		return c.SendStatus(fiber.StatusInternalServerError)
+	})
+	app.Post("/slow", func(c *fiber.Ctx) error {
+		close(started)
+		<-release
+		return c.SendString("done")
+	})
+
+	do := func(target, key, body string) *http.Response {
+		req := httptest.NewRequest(fiber.MethodPost, target, strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(HeaderIdempotencyKey, key)
+		}
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		return resp
+	}
+	read := func(resp *http.Response) string {
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	resp := do("/apartments", "key-1", `{"id":1}`)
+	assert.Equal(t, 200, resp.StatusCode)
+	first := read(resp)
+
+	// The retry gets the same response without creating the apartment again.
+	resp = do("/apartments", "key-1", `{"id":1}`)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "true", resp.Header.Get(HeaderIdempotentReplayed))
+	assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+	assert.Equal(t, first, read(resp))
+	assert.Equal(t, 1, created)
+
+	resp = do("/apartments", "key-1", `{"id":2}`)
+	assert.Equal(t, 422, resp.StatusCode)
+	assert.JSONEq(t, `{"result":"error","response":"idempotency key was used for another request"}`, read(resp))
+
+	// Without a key every request runs.
+	do("/apartments", "", `{"id":1}`)
+	do("/apartments", "", `{"id":1}`)
+	assert.Equal(t, 3, created)
+
+	assert.Equal(t, 400, do("/apartments", "bad\x01key", `{}`).StatusCode)
+
+	// Server errors aren't stored.
+	assert.Equal(t, 500, do("/fail", "key-2", "").StatusCode)
+	assert.Equal(t, 500, do("/fail", "key-2", "").StatusCode)
+	assert.Equal(t, 2, failures)
+
+	// A duplicate of a request in progress is rejected.
+	done := make(chan *http.Response)
+	go func() { done <- do("/slow", "key-3", "") }()
+	<-started
+	resp = do("/slow", "key-3", "")
+	assert.Equal(t, 409, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get(fiber.HeaderRetryAfter))
+	close(release)
+	assert.Equal(t, 200, (<-done).StatusCode)
+}
+
+func Test_Deprecated(t *testing.T) {
+	t.Parallel()
+
+	deprecatedAt := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	app := fiber.New()
+	app.Get("/with-sunset", Deprecated(deprecatedAt, deprecatedAt.AddDate(0, 6, 0), "/v1"), func(c *fiber.Ctx) error { return c.SendString("ok") })
+	app.Get("/without-sunset", Deprecated(deprecatedAt, time.Time{}, "/v1"), func(c *fiber.Ctx) error { return c.SendString("ok") })
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/with-sunset?page=2", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "@1792368000", resp.Header.Get(HeaderDeprecation))
+	assert.Equal(t, "Mon, 19 Apr 2027 00:00:00 GMT", resp.Header.Get(HeaderSunset))
+	assert.Equal(t, `</v1/with-sunset?page=2>; rel="successor-version"`, resp.Header.Get(fiber.HeaderLink))
+
+	resp, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/without-sunset", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "@1792368000", resp.Header.Get(HeaderDeprecation))
+	assert.Empty(t, resp.Header.Get(HeaderSunset))
+}
+
+func Test_SendError(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Get("/envelope", func(c *fiber.Ctx) error { return SendError(c, 404, errors.New("no such thing")) })
+	app.Get("/problem", Problems, func(c *fiber.Ctx) error { return SendError(c, 404, errors.New("no such thing")) })
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/envelope", nil))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+	assert.JSONEq(t, `{"result":"error","response":"no such thing"}`, string(body))
+
+	resp, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/problem", nil))
+	require.NoError(t, err)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+	assert.Equal(t, MIMEApplicationProblemJSON, resp.Header.Get(fiber.HeaderContentType))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"no such thing"}`, string(body))
+}
+
+func Test_AcceptsNDJSON(t *testing.T) {
+	t.Parallel()
+
+	for accept, want := range map[string]bool{
+		"":                     false,
+		"*/*":                  false,
+		"application/json":     false,
+		"application/x-ndjson": true,
+		"application/json;q=0.5, application/x-ndjson": true,
+	} {
+		app := fiber.New()
+		app.Get("/", func(c *fiber.Ctx) error {
+			assert.Equal(t, want, AcceptsNDJSON(c), accept)
+			return nil
+		})
+
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.Header.Set(fiber.HeaderAccept, accept)
+		_, err := app.Test(req)
+		require.NoError(t, err)
+	}
+}
+
+func Test_AcceptsGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	for accept, want := range map[string]bool{
+		"":                     false,
+		"*/*":                  false,
+		"application/json":     false,
+		"application/geo+json": true,
+		"application/json;q=0.5, application/geo+json": true,
+	} {
+		app := fiber.New()
+		app.Get("/", func(c *fiber.Ctx) error {
+			assert.Equal(t, want, AcceptsGeoJSON(c), accept)
+			return SendGeoJSON(c, fiber.Map{"type": "FeatureCollection"})
+		})
+
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.Header.Set(fiber.HeaderAccept, accept)
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, MIMEApplicationGeoJSON, resp.Header.Get(fiber.HeaderContentType))
+	}
+}
+
+func Test_StreamNDJSON(t *testing.T) {
+	t.Parallel()
+
+	// values sends n values, then fails with err.
+	values := func(n int, err error) func(ctx context.Context, send func(v any) error) error {
+		return func(ctx context.Context, send func(v any) error) error {
+			for i := 1; i <= n; i++ {
+				if sendErr := send(fiber.Map{"id": i}); sendErr != nil {
+					return sendErr
+				}
+			}
+			return err
+		}
+	}
+	onError := func(c *fiber.Ctx) func(err error) error {
+		return func(err error) error {
+			return SendError(c, fiber.StatusForbidden, err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		produce    func(ctx context.Context, send func(v any) error) error
+		problems   bool
+		wantStatus int
+		wantType   string
+		wantBody   string
+	}{
+		{name: "stream", produce: values(3, nil), wantStatus: 200, wantType: MIMEApplicationNDJSON, wantBody: `{"id":1}` + "\n" + `{"id":2}` + "\n" + `{"id":3}` + "\n"},
+		{name: "empty", produce: values(0, nil), wantStatus: 200, wantType: MIMEApplicationNDJSON},
+		{name: "errorBeforeFirst", produce: values(0, errors.New("forbidden")), wantStatus: 403, wantType: fiber.MIMEApplicationJSON, wantBody: `{"response":"forbidden","result":"error"}`},
+		{
+			name: "errorMidStream", produce: values(2, errors.New("connection reset")), wantStatus: 200, wantType: MIMEApplicationNDJSON,
+			wantBody: `{"id":1}` + "\n" + `{"id":2}` + "\n" + `{"response":"the request couldn't be processed","result":"error"}` + "\n",
+		},
+		{
+			name: "errorMidStreamProblem", produce: values(1, errors.New("connection reset")), problems: true, wantStatus: 200, wantType: MIMEApplicationNDJSON,
+			wantBody: `{"id":1}` + "\n" + `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"the request couldn't be processed"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			handlers := []fiber.Handler{func(c *fiber.Ctx) error {
+				return StreamNDJSON(c, tt.produce, onError(c))
+			}}
+			if tt.problems {
+				handlers = append([]fiber.Handler{Problems}, handlers...)
+			}
+			app.Get("/", handlers...)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantType, resp.Header.Get(fiber.HeaderContentType))
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBody, string(body))
+		})
+	}
+
+	t.Run("clientGone", func(t *testing.T) {
+		t.Parallel()
+
+		cancelled := make(chan struct{})
+		app := fiber.New(fiber.Config{DisableStartupMessage: true})
+		app.Get("/", func(c *fiber.Ctx) error {
+			return StreamNDJSON(c, func(ctx context.Context, send func(v any) error) error {
+				defer close(cancelled)
+				// Endless, until the client goes away.
+				for i := 0; ; i++ {
+					err := send(fiber.Map{"id": i, "padding": strings.Repeat("x", 1024)})
+					if err != nil {
+						return err
+					}
+				}
+			}, onError(c))
+		})
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		go func() {
+			_ = app.Listener(ln)
+		}()
+		defer func() { _ = app.Shutdown() }()
+
+		resp, err := http.Get("http://" + ln.Addr().String() + "/")
+		require.NoError(t, err)
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		require.NoError(t, err)
+		assert.Contains(t, line, `"id":0`)
+		require.NoError(t, resp.Body.Close())
+
+		select {
+		case <-cancelled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the stream wasn't cancelled")
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+const MIMEApplicationNDJSON = "application/x-ndjson"
+
+// ndjsonBuffer is the number of values produced ahead of the client.
+const ndjsonBuffer = 64
+
+// AcceptsNDJSON reports whether the client prefers newline-delimited JSON to a JSON document.
+func AcceptsNDJSON(c *fiber.Ctx) bool {
+	return c.Accepts(fiber.MIMEApplicationJSON, MIMEApplicationNDJSON) == MIMEApplicationNDJSON
+}
+
+// StreamNDJSON responds with the values produce sends, one JSON document a line, as they are
+// produced. The status is committed with the first value: when produce fails before, onError
+// responds as usual. A later error can't change the status, it is logged and the stream ends with
+// an error line in the format of the route, so that the client can tell it from a complete one.
+// A client that goes away cancels the context of produce.
+func StreamNDJSON(c *fiber.Ctx, produce func(ctx context.Context, send func(v any) error) error, onError func(err error) error) error {
+	ctx, cancel := context.WithCancel(c.UserContext())
+	values := make(chan any, ndjsonBuffer)
+	done := make(chan error, 1)
+	go func() {
+		defer close(values)
+		done <- produce(ctx, func(v any) error {
+			select {
+			case values <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	first, ok := <-values
+	if !ok {
+		cancel()
+		err := <-done
+		if err != nil {
+			return onError(err)
+		}
+		c.Set(fiber.HeaderContentType, MIMEApplicationNDJSON)
+		return nil
+	}
+
+	// The fiber context is released once the handler returns, the writer must not use it.
+	failed, _ := errorBody(c, fiber.StatusInternalServerError, errors.New("the request couldn't be processed"))
+	route := c.Route().Name
+	c.Set(fiber.HeaderContentType, MIMEApplicationNDJSON)
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		enc := json.NewEncoder(w)
+		err := enc.Encode(first)
+		for v := range values {
+			if err != nil {
+				break
+			}
+			err = enc.Encode(v)
+			// Flushed when the client waits for the next value, otherwise when the buffer is full.
+			if err == nil && len(values) == 0 {
+				err = w.Flush()
+			}
+		}
+		if err != nil {
+			// The client went away, its writes fail.
+			logger.FromContext(ctx).DebugContext(ctx, "stream aborted", "route", route, "error", err)
+			return
+		}
+
+		err = <-done
+		if err != nil {
+			logger.FromContext(ctx).ErrorContext(ctx, "stream failed", "route", route, "error", err)
+			_ = enc.Encode(failed)
+		}
+		_ = w.Flush()
+	})
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// localProblems marks the requests whose errors are problem details, see Problems.
+const localProblems = "problems"
+
+// Problem is an RFC 9457 problem details document.
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Problems makes the errors of the route, including the ones of the middleware that follow it,
+// RFC 9457 problem details instead of the result/response envelope.
+func Problems(c *fiber.Ctx) error {
+	c.Locals(localProblems, true)
+	return c.Next()
+}
+
+// SendError responds with the error in the format of the route: the result/response envelope,
+// or a problem details document after Problems.
+func SendError(c *fiber.Ctx, status int, err error) error {
+	body, contentType := errorBody(c, status, err)
+	return c.Status(status).JSON(body, contentType)
+}
+
+// errorBody returns the document of the error in the format of the route and its content type.
+func errorBody(c *fiber.Ctx, status int, err error) (any, string) {
+	if problems, _ := c.Locals(localProblems).(bool); problems {
+		return &Problem{
+			Type:   "about:blank",
+			Title:  utils.StatusMessage(status),
+			Status: status,
+			Detail: err.Error(),
+		}, MIMEApplicationProblemJSON
+	}
+
+	return &fiber.Map{
+		resultKey:   resultError,
+		responseKey: err.Error(),
+	}, fiber.MIMEApplicationJSON
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/ratelimit"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+var errRateLimited = errors.New("rate limit exceeded")
+
+// RateLimit limits the requests of every client, identified by its principal or, for the
+// anonymous requests, by its IP. The reads (GET and HEAD) and the writes have separate budgets.
+// If the limiter fails the request is let through. A nil limiter disables the rate limiting.
+func RateLimit(limiter *ratelimit.Limiter, read, write ratelimit.Limit) fiber.Handler {
+	if limiter == nil {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+
+		class, limit := "write", write
+		if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+			class, limit = "read", read
+		}
+		client := "ip:" + c.IP()
+		if principal, ok := auth.FromContext(ctx); ok {
+			client = principal.Method + ":" + principal.Subject
+		}
+
+		return take(c, limiter, class+":"+client, limit, "client", client, "class", class)
+	}
+}
+
+// RateLimitIP limits all the requests of every IP. It runs before the authentication, so the
+// requests with invalid credentials are limited too and don't reach the stores of the keys.
+// A nil limiter disables the rate limiting.
+func RateLimitIP(limiter *ratelimit.Limiter, limit ratelimit.Limit) fiber.Handler {
+	if limiter == nil {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		client := "ip:" + c.IP()
+		return take(c, limiter, client, limit, "client", client)
+	}
+}
+
+// take takes a token from the bucket of the key and rejects the request when it is empty.
+func take(c *fiber.Ctx, limiter *ratelimit.Limiter, key string, limit ratelimit.Limit, args ...any) error {
+	ctx := c.UserContext()
+
+	res, err := limiter.Take(ctx, key, limit)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "rate limiter failed", "error", err)
+		return c.Next()
+	}
+
+	c.Set(HeaderRateLimitLimit, strconv.Itoa(limit.Burst))
+	c.Set(HeaderRateLimitRemaining, strconv.Itoa(res.Remaining))
+	c.Set(HeaderRateLimitReset, ceilSeconds(res.Reset))
+	if !res.Allowed {
+		c.Set(fiber.HeaderRetryAfter, ceilSeconds(res.RetryAfter))
+		logger.FromContext(ctx).DebugContext(ctx, "request rate limited", args...)
+		return SendError(c, fiber.StatusTooManyRequests, errRateLimited)
+	}
+
+	return c.Next()
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+const (
+	HeaderRequestID = fiber.HeaderXRequestID
+
+	maxRequestIDLength = 128
+)
+
+// RequestID propagates the X-Request-ID header of the request or assigns a new ID,
+// echoes it in the response and stores it with a request-scoped logger in the user context.
+func RequestID(c *fiber.Ctx) error {
+	requestID := c.Get(HeaderRequestID)
+	if !validRequestID(requestID) {
+		requestID = newRequestID()
+	}
+	c.Set(HeaderRequestID, requestID)
+
+	ctx := logger.WithRequestID(c.UserContext(), requestID)
+	// The request ID is added by the handler of the logger, it must not be repeated here.
+	ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("method", c.Method(), "path", c.Path()))
+	c.SetUserContext(ctx)
+
+	return c.Next()
+}
+
+// validRequestID accepts the IDs of a reasonable length made of printable ASCII characters,
+// so that a client can't inject anything into the logs.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const HeaderTenantID = "X-Tenant-ID"
+
+// Tenant resolves the tenant of the request and stores it in the user context. A principal bound
+// to a tenant can't act for another one, the others (and the anonymous requests when the
+// authentication is disabled) choose theirs with the X-Tenant-ID header, tenant.Default if it is missing.
+func Tenant(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var bound string
+	if principal, ok := auth.FromContext(ctx); ok {
+		bound = principal.Tenant
+	}
+
+	tenantID, err := tenant.Resolve(c.Get(HeaderTenantID), bound)
+	if errors.Is(err, tenant.ErrForeign) {
+		return SendError(c, fiber.StatusForbidden, err)
+	}
+	if err != nil {
+		return SendError(c, fiber.StatusBadRequest, err)
+	}
+
+	ctx = tenant.WithID(ctx, tenantID)
+	ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("tenant", tenantID))
+	c.SetUserContext(ctx)
+
+	return c.Next()
+}
@@ -0,0 +1,760 @@
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/admin"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/bmsv2"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/gql"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/health"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/openapi"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/service/search"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// apiDocs documents the routes of SetupRoutes by their name. A route missing here is left out of
+// /openapi.json and fails Test_OpenAPICoversRoutes.
+func apiDocs() *openapi.Generator {
+	const streamed = "With Accept: application/x-ndjson the apartments are streamed one a line, as they are read. " +
+		"A stream that fails once started ends with an error line."
+	schemas := openapi.NewSchemas()
+	schemas.Prefix(bmsv2.Building{}, "V2")
+	// The tenant of the rows is the one of the request. Without id the v1 upserts create a new row.
+	for _, s := range []*openapi.Schema{schemas.Component(models.Building{}), schemas.Component(models.Apartment{})} {
+		s.Properties["tenant_id"].ReadOnly = true
+		s.Required = slices.DeleteFunc(s.Required, func(name string) bool { return name == "id" })
+	}
+	grant := schemas.Component(storage.Grant{})
+	grant.Properties["id"].ReadOnly = true
+	grant.Properties["created_at"].ReadOnly = true
+	grant.Required = []string{"subject", "role"}
+	grant.Properties["role"].Enum = []any{"viewer", "manager", "admin"}
+	// The building of the floors is the one of the path.
+	floor := schemas.Component(storage.Floor{})
+	floor.Properties["id"].ReadOnly = true
+	floor.Properties["building_id"].ReadOnly = true
+	floor.Required = []string{"id", "building_id", "number"}
+	// The building of the residents is the one of their apartment.
+	resident := schemas.Component(storage.Resident{})
+	resident.Properties["id"].ReadOnly = true
+	resident.Properties["building_id"].ReadOnly = true
+	resident.Required = []string{"id", "apartment_id", "building_id", "name", "move_in"}
+	for _, s := range []*openapi.Schema{resident, schemas.Component(bmsv2.ResidentInput{})} {
+		s.Properties["move_in"].Format = "date"
+		s.Properties["move_out"].Format = "date"
+	}
+	// The building of the leases is the one of their apartment, and only the renewals refer to another lease.
+	lease := schemas.Component(storage.Lease{})
+	lease.Properties["id"].ReadOnly = true
+	lease.Properties["building_id"].ReadOnly = true
+	lease.Properties["renewal_of"].ReadOnly = true
+	lease.Required = []string{"id", "apartment_id", "building_id", "lessee", "start_date", "monthly_rent", "currency"}
+	for _, s := range []*openapi.Schema{lease, schemas.Component(bmsv2.LeaseInput{})} {
+		s.Properties["start_date"].Format = "date"
+		s.Properties["end_date"].Format = "date"
+		s.Properties["monthly_rent"].Description = "Rent in the minor unit of the currency, e.g. cents"
+		s.Properties["deposit"].Description = "Deposit in the minor unit of the currency, e.g. cents"
+		s.Properties["currency"].Description = "ISO 4217 code of the currency"
+		s.Properties["indexation"].Enum = []any{string(storage.IndexationNone), string(storage.IndexationFixed), string(storage.IndexationCPI)}
+		s.Properties["indexation_rate"].Description = "Yearly increase of a fixed indexation, cap of a cpi one, in percent"
+		s.Properties["status"].Enum = []any{string(storage.LeaseDraft), string(storage.LeaseActive), string(storage.LeaseTerminated)}
+	}
+	schemas.Component(leases.Renewal{}).Properties["end_date"].Format = "date"
+	schemas.Component(leases.Termination{}).Properties["end_date"].Format = "date"
+	schemas.Component(storage.SearchResult{}).Properties["kind"].Enum = []any{storage.SearchKindBuilding, storage.SearchKindApartment}
+	schemas.Component(gql.Request{}).Properties["variables"].Type = []string{"object", "null"}
+	graphQLResponse := &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"data": {Type: []string{"object", "null"}},
+			"errors": {Type: "array", Items: &openapi.Schema{
+				Type:     "object",
+				Required: []string{"message"},
+				Properties: map[string]*openapi.Schema{
+					"message":    {Type: "string"},
+					"path":       {Type: "array"},
+					"extensions": {Type: "object"},
+				},
+			}},
+		},
+	}
+
+	probe := func(summary string) openapi.Route {
+		report := map[string]openapi.MediaType{"application/json": {Schema: schemas.Of(health.Report{})}}
+		return openapi.Route{
+			Summary: summary,
+			Tag:     "health",
+			Public:  true,
+			Responses: map[string]*openapi.Response{
+				"200": {Description: "Healthy", Content: report},
+				"503": {Description: "Unhealthy", Content: report},
+			},
+		}
+	}
+
+	routes := map[string]openapi.Route{
+		"metrics": {
+			Summary: "Prometheus metrics",
+			Tag:     "health",
+			Responses: map[string]*openapi.Response{
+				"200": {
+					Description: "Metrics in the Prometheus text format",
+					Content:     map[string]openapi.MediaType{"text/plain": {Schema: &openapi.Schema{Type: "string"}}},
+				},
+			},
+		},
+		"livez":   probe("Liveness probe"),
+		"readyz":  probe("Readiness probe, fails as soon as the shutdown begins"),
+		"healthz": probe("Detailed status of every dependency"),
+		"openapi": {
+			Summary: "This document",
+			Public:  true,
+			Responses: map[string]*openapi.Response{
+				"200": {
+					Description: "OpenAPI document",
+					Content:     map[string]openapi.MediaType{"application/json": {Schema: &openapi.Schema{Type: "object"}}},
+				},
+			},
+		},
+		"docs": {Hidden: true},
+		"graphql": {
+			Summary:     "Execute a GraphQL query or mutation",
+			Description: "See internal/controllers/gql/schema.graphql for the schema. The errors of the query are sent with a 200 status.",
+			Tag:         "graphql",
+			Body:        gql.Request{},
+			Responses: map[string]*openapi.Response{
+				"200": {
+					Description: "Result of the query",
+					Content:     map[string]openapi.MediaType{"application/json": {Schema: graphQLResponse}},
+				},
+			},
+		},
+	}
+
+	// The address filters are normalised like the stored addresses and compared case-insensitively.
+	addressQuery := []*openapi.Parameter{
+		{Name: "street", Description: "Street, e.g. Main St or Main Street", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "house_number", Description: "House number", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "city", Description: "City", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "postal_code", Description: "Postal code", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "country", Description: "Country, its ISO 3166-1 alpha-2 code or its English name", Schema: &openapi.Schema{Type: "string"}},
+		{
+			Name:        "sort",
+			Description: "Order of the buildings, by ID by default or by street then house number, those without a street last",
+			Schema:      &openapi.Schema{Type: "string", Enum: []any{string(address.OrderID), string(address.OrderStreet)}},
+		},
+	}
+
+	// The v1 routes are also served without the prefix, deprecated.
+	v1 := map[string]openapi.Route{
+		"buildings.getAll": {
+			Summary: "List the buildings",
+			Tag:     "buildings",
+			Query:   addressQuery,
+			Result:  models.BuildingSlice{},
+		},
+		"buildings.getByID": {
+			Summary: "Get a building",
+			Tag:     "buildings",
+			Params:  map[string]string{"id": "Building ID"},
+			Result:  &models.Building{},
+		},
+		"buildings.create": {
+			Summary: "Create a building, or update it if it exists",
+			Tag:     "buildings",
+			Body:    &models.Building{},
+		},
+		"buildings.delete": {
+			Summary: "Delete a building",
+			Tag:     "buildings",
+			Params:  map[string]string{"id": "Building ID"},
+		},
+
+		"apartments.getAll": {
+			Summary:     "List the apartments",
+			Description: streamed,
+			Tag:         "apartments",
+			Result:      models.ApartmentSlice{},
+			Stream:      &models.Apartment{},
+		},
+		"apartments.getByID": {
+			Summary: "Get an apartment",
+			Tag:     "apartments",
+			Params:  map[string]string{"id": "Apartment ID"},
+			Result:  &models.Apartment{},
+		},
+		"apartments.getAllInBuilding": {
+			Summary:     "List the apartments of a building",
+			Description: streamed,
+			Tag:         "apartments",
+			Params:      map[string]string{"buildingId": "Building ID"},
+			Result:      models.ApartmentSlice{},
+			Stream:      &models.Apartment{},
+		},
+		"apartments.create": {
+			Summary: "Create an apartment, or update it if it exists",
+			Tag:     "apartments",
+			Body:    &models.Apartment{},
+		},
+		"apartments.delete": {
+			Summary: "Delete an apartment",
+			Tag:     "apartments",
+			Params:  map[string]string{"id": "Apartment ID"},
+		},
+
+		"admin.dbStats": {
+			Summary: "Database connection pool statistics",
+			Tag:     "admin",
+			Result:  admin.DBStats{},
+		},
+		"admin.reencryptPII": {
+			Summary: "Re-encrypt the personal data with the current key",
+			Description: "Rewrites the personal data of every tenant stored in clear or with a rotated key of `PII_ENCRYPTION_KEYS`, " +
+				"after which the rotated keys can be removed. It can run again after a failure, without keys it rewrites nothing.",
+			Tag:    "admin",
+			Result: storage.Reencrypted{},
+		},
+		"admin.getLogLevel": {
+			Summary: "Current log levels",
+			Tag:     "admin",
+			Result:  admin.LogLevels{},
+		},
+		"admin.setLogLevel": {
+			Summary:     "Change the global or a component log level at runtime",
+			Description: "Without a component the global level is changed, an empty level removes the override of the component.",
+			Tag:         "admin",
+			Body:        admin.SetLogLevelRequest{},
+			Result:      admin.LogLevels{},
+		},
+		"admin.getGrants": {
+			Summary: "List the roles granted to the principals",
+			Tag:     "admin",
+			Result:  []*storage.Grant{},
+		},
+		"admin.createGrant": {
+			Summary:     "Grant a role on a building",
+			Description: "Without building_id the role applies to every building.",
+			Tag:         "admin",
+			Body:        storage.Grant{},
+			Result:      storage.Grant{},
+		},
+		"admin.deleteGrant": {
+			Summary: "Revoke a grant",
+			Tag:     "admin",
+			Params:  map[string]string{"id": "Grant ID"},
+		},
+	}
+	for name, route := range v1 {
+		routes["v1."+name] = route
+		route.Deprecated = true
+		route.Description = strings.TrimSpace(route.Description + " Deprecated alias of the /v1 route.")
+		routes[name] = route
+	}
+	const statsDescription = "Apartment count, total and average area, floor range, apartments per floor, " +
+		"the apartments missing a number, a floor or an area, and the occupancy: the apartments with a current resident " +
+		"and their share. The portfolio is made of the buildings the principal may read."
+	routes["v1.stats"] = openapi.Route{
+		Summary:     "Statistics of the buildings and the apartments",
+		Description: statsDescription,
+		Tag:         "stats",
+		Result:      storage.Stats{},
+	}
+	routes["v1.buildings.stats"] = openapi.Route{
+		Summary:     "Statistics of the apartments of a building",
+		Description: statsDescription,
+		Tag:         "stats",
+		Params:      map[string]string{"id": "Building ID"},
+		Result:      storage.Stats{},
+	}
+	const floorsDescription = "The apartments of a building with floors must be on one of them, " +
+		"those of a building without floors may be on any floor."
+	floorParams := map[string]string{"id": "Building ID", "floor": "Floor number, 0 for the ground floor and below for the basements"}
+	const firstFloorDescription = "The apartments of a building without floors may be on any floor, " +
+		"so its first floor also creates the floors of its apartments, labelled with their number."
+	routes["v1.floors.getAll"] = openapi.Route{
+		Summary:     "List the floors of a building",
+		Description: floorsDescription,
+		Tag:         "floors",
+		Params:      map[string]string{"id": "Building ID"},
+		Result:      []*storage.Floor{},
+	}
+	routes["v1.floors.getByNumber"] = openapi.Route{
+		Summary: "Get a floor of a building",
+		Tag:     "floors",
+		Params:  floorParams,
+		Result:  &storage.Floor{},
+	}
+	routes["v1.floors.getApartments"] = openapi.Route{
+		Summary: "List the apartments on a floor of a building",
+		Tag:     "floors",
+		Params:  floorParams,
+		Result:  models.ApartmentSlice{},
+	}
+	routes["v1.floors.create"] = openapi.Route{
+		Summary:     "Create a floor of a building, or update it if it exists",
+		Description: "The building is the one of the path. The label is the number when it is empty. " + firstFloorDescription,
+		Tag:         "floors",
+		Params:      map[string]string{"id": "Building ID"},
+		Body:        storage.Floor{},
+	}
+	routes["v1.floors.delete"] = openapi.Route{
+		Summary:     "Delete a floor of a building",
+		Description: "The floors with apartments can't be deleted, they answer 409.",
+		Tag:         "floors",
+		Params:      floorParams,
+		Conflict:    true,
+	}
+	const residentsDescription = "The current residents, living there from move_in until the day before move_out, " +
+		"or all of them with history=true."
+	residentsQuery := []*openapi.Parameter{
+		{Name: "history", Description: "List the past and future residents too", Schema: &openapi.Schema{Type: "boolean"}},
+	}
+	const residentDescription = "The name, email and phone are personal data, encrypted at rest. " +
+		"An apartment has at most one primary contact at a time."
+	routes["v1.apartments.residents"] = openapi.Route{
+		Summary:     "List the residents of an apartment",
+		Description: residentsDescription,
+		Tag:         "residents",
+		Params:      map[string]string{"id": "Apartment ID"},
+		Query:       residentsQuery,
+		Result:      []*storage.Resident{},
+	}
+	routes["v1.buildings.residents"] = openapi.Route{
+		Summary:     "List the residents of a building",
+		Description: residentsDescription + " By apartment and move-in date.",
+		Tag:         "residents",
+		Params:      map[string]string{"id": "Building ID"},
+		Query:       residentsQuery,
+		Result:      []*storage.Resident{},
+	}
+	routes["v1.residents.getByID"] = openapi.Route{
+		Summary: "Get a resident",
+		Tag:     "residents",
+		Params:  map[string]string{"id": "Resident ID"},
+		Result:  &storage.Resident{},
+	}
+	routes["v1.residents.create"] = openapi.Route{
+		Summary:     "Create a resident, or update it if it exists",
+		Description: residentDescription + " Without id a new resident is created.",
+		Tag:         "residents",
+		Body:        storage.Resident{},
+	}
+	routes["v1.residents.delete"] = openapi.Route{
+		Summary: "Delete a resident",
+		Tag:     "residents",
+		Params:  map[string]string{"id": "Resident ID"},
+	}
+	const (
+		leasesDescription = "A lease runs from start_date to end_date included, open-ended without end_date. " +
+			"The leases of an apartment never overlap, whatever their status."
+		leaseDescription = "The lessee is personal data, encrypted at rest. A lease is created as a draft unless it is active, " +
+			"and only a draft is replaced; an active lease is renewed or terminated."
+		renewDescription = "The renewal is an active lease starting the day after the end of the renewed one, " +
+			"with its terms but the end date and the rent of the body."
+		terminateDescription = "The lease ends on the end date of the body, no later than its own, and becomes terminated."
+	)
+	minDays, maxDays := 0.0, float64(leases.MaxExpiringDays)
+	expiringQuery := []*openapi.Parameter{
+		{
+			Name:        "days",
+			Description: fmt.Sprintf("Number of days from today, %d by default", leases.DefaultExpiringDays),
+			Schema:      &openapi.Schema{Type: "integer", Format: "int32", Minimum: &minDays, Maximum: &maxDays},
+		},
+	}
+	const expiringDescription = "The active leases ending from today to days later and not renewed yet, by end date, " +
+		"among the buildings the principal may read."
+	routes["v1.apartments.leases"] = openapi.Route{
+		Summary:     "List the leases of an apartment",
+		Description: leasesDescription + " By start date.",
+		Tag:         "leases",
+		Params:      map[string]string{"id": "Apartment ID"},
+		Result:      []*storage.Lease{},
+	}
+	routes["v1.leases.expiring"] = openapi.Route{
+		Summary:     "List the leases expiring soon",
+		Description: expiringDescription,
+		Tag:         "leases",
+		Query:       expiringQuery,
+		Result:      []*storage.Lease{},
+	}
+	routes["v1.leases.getByID"] = openapi.Route{
+		Summary: "Get a lease",
+		Tag:     "leases",
+		Params:  map[string]string{"id": "Lease ID"},
+		Result:  &storage.Lease{},
+	}
+	routes["v1.leases.create"] = openapi.Route{
+		Summary:     "Create a lease, or update it if it exists and is a draft",
+		Description: leaseDescription + " Without id a new lease is created.",
+		Tag:         "leases",
+		Body:        storage.Lease{},
+	}
+	routes["v1.leases.renew"] = openapi.Route{
+		Summary:     "Renew an active lease",
+		Description: renewDescription,
+		Tag:         "leases",
+		Params:      map[string]string{"id": "Lease ID"},
+		Body:        leases.Renewal{},
+		Result:      &storage.Lease{},
+	}
+	routes["v1.leases.terminate"] = openapi.Route{
+		Summary:     "Terminate an active lease",
+		Description: terminateDescription,
+		Tag:         "leases",
+		Params:      map[string]string{"id": "Lease ID"},
+		Body:        leases.Termination{},
+		Result:      &storage.Lease{},
+	}
+	routes["v1.leases.delete"] = openapi.Route{
+		Summary: "Delete a draft lease",
+		Tag:     "leases",
+		Params:  map[string]string{"id": "Lease ID"},
+	}
+	const searchDescription = "Ranked buildings and apartments. A building matches all the words of the query in its name " +
+		"and address or, with typos, is similar to the query. An apartment also has one of the words as number and ranks above its building. " +
+		"The snippets are HTML-escaped, with the matched words between <mark> and </mark>."
+	minLength, minLimit := 1, 1.0
+	searchQuery := []*openapi.Parameter{
+		{Name: "q", Description: "Search query", Required: true, Schema: &openapi.Schema{Type: "string", MinLength: &minLength}},
+		{
+			Name:        "limit",
+			Description: fmt.Sprintf("Maximum number of results, %d by default and at most %d", search.DefaultLimit, search.MaxLimit),
+			Schema:      &openapi.Schema{Type: "integer", Format: "int32", Minimum: &minLimit},
+		},
+	}
+	routes["v1.search"] = openapi.Route{
+		Summary:     "Search the buildings and the apartments",
+		Description: searchDescription,
+		Tag:         "search",
+		Query:       searchQuery,
+		Result:      []*storage.SearchResult{},
+	}
+	const (
+		nearbyDescription = "The buildings with coordinates within radius_m meters of the point, the closest first. " +
+			"Clients accepting application/geo+json get a GeoJSON FeatureCollection instead."
+		withinDescription = "The buildings with coordinates in the bounding box, by ID. " +
+			"Clients accepting application/geo+json get a GeoJSON FeatureCollection instead."
+	)
+	minLat, maxLat, minLon, maxLon, minRadius, maxRadius := -90.0, 90.0, -180.0, 180.0, 0.0, float64(buildings.MaxRadius)
+	maxGeoLimit := float64(buildings.MaxGeoLimit)
+	geoLimit := &openapi.Parameter{
+		Name:        "limit",
+		Description: fmt.Sprintf("Maximum number of buildings, %d by default", buildings.DefaultGeoLimit),
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32", Minimum: &minLimit, Maximum: &maxGeoLimit},
+	}
+	nearbyQuery := []*openapi.Parameter{
+		{Name: "lat", Description: "Latitude of the point, WGS 84 degrees", Required: true, Schema: &openapi.Schema{Type: "number", Minimum: &minLat, Maximum: &maxLat}},
+		{Name: "lon", Description: "Longitude of the point, WGS 84 degrees", Required: true, Schema: &openapi.Schema{Type: "number", Minimum: &minLon, Maximum: &maxLon}},
+		{Name: "radius_m", Description: "Radius in meters", Required: true, Schema: &openapi.Schema{Type: "number", Minimum: &minRadius, Maximum: &maxRadius}},
+		geoLimit,
+	}
+	withinQuery := []*openapi.Parameter{
+		{
+			Name:        "bbox",
+			Description: "Bounding box as west,south,east,north in WGS 84 degrees, e.g. 34.95,32.78,35.02,32.83. The west above the east crosses the antimeridian.",
+			Required:    true,
+			Schema:      &openapi.Schema{Type: "string"},
+		},
+		geoLimit,
+	}
+	routes["v1.buildings.nearby"] = openapi.Route{
+		Summary:     "List the buildings by their distance to a point",
+		Description: nearbyDescription,
+		Tag:         "geo",
+		Query:       nearbyQuery,
+		Result:      []*storage.NearbyBuilding{},
+		GeoJSON:     geo.FeatureCollection{},
+	}
+	routes["v1.buildings.within"] = openapi.Route{
+		Summary:     "List the buildings in a bounding box",
+		Description: withinDescription,
+		Tag:         "geo",
+		Query:       withinQuery,
+		Result:      models.BuildingSlice{},
+		GeoJSON:     geo.FeatureCollection{},
+	}
+
+	// The routes added under /v1 answer the missing resources with 404, unlike the legacy ones.
+	for name, route := range routes {
+		_, legacy := v1[strings.TrimPrefix(name, "v1.")]
+		if strings.HasPrefix(name, "v1.") && !legacy && (len(route.Params) > 0 || route.Body != nil) {
+			route.NotFound = true
+			routes[name] = route
+		}
+	}
+
+	// The v2 routes send problem details and reject the IDs below 1.
+	minID := 1.0
+	v2 := func(route openapi.Route) openapi.Route {
+		route.Problems = true
+		route.ParamSchemas = map[string]*openapi.Schema{"id": {Type: "integer", Format: "int32", Minimum: &minID}}
+		return route
+	}
+	ok := func(v any) map[string]*openapi.Response {
+		return map[string]*openapi.Response{
+			"200": {Description: "Success", Content: map[string]openapi.MediaType{"application/json": {Schema: schemas.Of(v)}}},
+		}
+	}
+	created := func(v any) map[string]*openapi.Response {
+		return map[string]*openapi.Response{
+			"201": {Description: "Created", Content: map[string]openapi.MediaType{"application/json": {Schema: schemas.Of(v)}}},
+		}
+	}
+	noContent := func() map[string]*openapi.Response {
+		return map[string]*openapi.Response{"204": {Description: "Deleted"}}
+	}
+	notFound := func(responses map[string]*openapi.Response) map[string]*openapi.Response {
+		responses["404"] = &openapi.Response{Ref: "#/components/responses/ProblemNotFound"}
+		return responses
+	}
+	for name, route := range map[string]openapi.Route{
+		"buildings.list": {
+			Summary:   "List the buildings",
+			Tag:       "buildings",
+			Query:     addressQuery,
+			Responses: ok(bmsv2.BuildingList{}),
+		},
+		"buildings.nearby": {
+			Summary:     "List the buildings by their distance to a point",
+			Description: nearbyDescription,
+			Tag:         "geo",
+			Query:       nearbyQuery,
+			Responses:   ok(bmsv2.NearbyBuildingList{}),
+			GeoJSON:     geo.FeatureCollection{},
+		},
+		"buildings.within": {
+			Summary:     "List the buildings in a bounding box",
+			Description: withinDescription,
+			Tag:         "geo",
+			Query:       withinQuery,
+			Responses:   ok(bmsv2.BuildingList{}),
+			GeoJSON:     geo.FeatureCollection{},
+		},
+		"buildings.get": {
+			Summary:   "Get a building",
+			Tag:       "buildings",
+			Params:    map[string]string{"id": "Building ID"},
+			Responses: notFound(ok(bmsv2.Building{})),
+		},
+		"buildings.put": {
+			Summary:   "Create or replace a building",
+			Tag:       "buildings",
+			Params:    map[string]string{"id": "Building ID"},
+			Body:      bmsv2.BuildingInput{},
+			Responses: ok(bmsv2.Building{}),
+		},
+		"buildings.delete": {
+			Summary:   "Delete a building",
+			Tag:       "buildings",
+			Params:    map[string]string{"id": "Building ID"},
+			Responses: notFound(noContent()),
+		},
+		"buildings.listApartments": {
+			Summary:     "List the apartments of a building",
+			Description: streamed,
+			Tag:         "apartments",
+			Params:      map[string]string{"id": "Building ID"},
+			Responses:   ok(bmsv2.ApartmentList{}),
+			Stream:      bmsv2.Apartment{},
+		},
+		"buildings.stats": {
+			Summary:     "Statistics of the apartments of a building",
+			Description: statsDescription,
+			Tag:         "stats",
+			Params:      map[string]string{"id": "Building ID"},
+			Responses:   notFound(ok(storage.Stats{})),
+		},
+		"buildings.listFloors": {
+			Summary:     "List the floors of a building",
+			Description: floorsDescription,
+			Tag:         "floors",
+			Params:      map[string]string{"id": "Building ID"},
+			Responses:   ok(bmsv2.FloorList{}),
+		},
+		"buildings.getFloor": {
+			Summary:   "Get a floor of a building",
+			Tag:       "floors",
+			Params:    floorParams,
+			Responses: notFound(ok(storage.Floor{})),
+		},
+		"buildings.putFloor": {
+			Summary:     "Create or replace a floor of a building",
+			Description: "The label is the number when it is empty. " + firstFloorDescription,
+			Tag:         "floors",
+			Params:      floorParams,
+			Body:        bmsv2.FloorInput{},
+			Responses:   notFound(ok(storage.Floor{})),
+		},
+		"buildings.deleteFloor": {
+			Summary:     "Delete a floor of a building",
+			Description: "The floors with apartments can't be deleted, they answer 409.",
+			Tag:         "floors",
+			Params:      floorParams,
+			Conflict:    true,
+			Responses:   notFound(noContent()),
+		},
+		"buildings.listFloorApartments": {
+			Summary:   "List the apartments on a floor of a building",
+			Tag:       "floors",
+			Params:    floorParams,
+			Responses: notFound(ok(bmsv2.ApartmentList{})),
+		},
+		"buildings.listResidents": {
+			Summary:     "List the residents of a building",
+			Description: residentsDescription + " By apartment and move-in date.",
+			Tag:         "residents",
+			Params:      map[string]string{"id": "Building ID"},
+			Query:       residentsQuery,
+			Responses:   ok(bmsv2.ResidentList{}),
+		},
+		"apartments.listResidents": {
+			Summary:     "List the residents of an apartment",
+			Description: residentsDescription,
+			Tag:         "residents",
+			Params:      map[string]string{"id": "Apartment ID"},
+			Query:       residentsQuery,
+			Responses:   notFound(ok(bmsv2.ResidentList{})),
+		},
+		"residents.get": {
+			Summary:   "Get a resident",
+			Tag:       "residents",
+			Params:    map[string]string{"id": "Resident ID"},
+			Responses: notFound(ok(storage.Resident{})),
+		},
+		"residents.put": {
+			Summary:     "Create or replace a resident",
+			Description: residentDescription,
+			Tag:         "residents",
+			Params:      map[string]string{"id": "Resident ID"},
+			Body:        bmsv2.ResidentInput{},
+			Responses:   notFound(ok(storage.Resident{})),
+		},
+		"residents.delete": {
+			Summary:   "Delete a resident",
+			Tag:       "residents",
+			Params:    map[string]string{"id": "Resident ID"},
+			Responses: notFound(noContent()),
+		},
+		"apartments.listLeases": {
+			Summary:     "List the leases of an apartment",
+			Description: leasesDescription + " By start date.",
+			Tag:         "leases",
+			Params:      map[string]string{"id": "Apartment ID"},
+			Responses:   notFound(ok(bmsv2.LeaseList{})),
+		},
+		"leases.expiring": {
+			Summary:     "List the leases expiring soon",
+			Description: expiringDescription,
+			Tag:         "leases",
+			Query:       expiringQuery,
+			Responses:   ok(bmsv2.LeaseList{}),
+		},
+		"leases.get": {
+			Summary:   "Get a lease",
+			Tag:       "leases",
+			Params:    map[string]string{"id": "Lease ID"},
+			Responses: notFound(ok(storage.Lease{})),
+		},
+		"leases.put": {
+			Summary:     "Create a lease or replace a draft",
+			Description: leaseDescription,
+			Tag:         "leases",
+			Params:      map[string]string{"id": "Lease ID"},
+			Body:        bmsv2.LeaseInput{},
+			Responses:   notFound(ok(storage.Lease{})),
+		},
+		"leases.renew": {
+			Summary:     "Renew an active lease",
+			Description: renewDescription,
+			Tag:         "leases",
+			Params:      map[string]string{"id": "Lease ID"},
+			Body:        leases.Renewal{},
+			Responses:   notFound(created(storage.Lease{})),
+		},
+		"leases.terminate": {
+			Summary:     "Terminate an active lease",
+			Description: terminateDescription,
+			Tag:         "leases",
+			Params:      map[string]string{"id": "Lease ID"},
+			Body:        leases.Termination{},
+			Responses:   notFound(ok(storage.Lease{})),
+		},
+		"leases.delete": {
+			Summary:   "Delete a draft lease",
+			Tag:       "leases",
+			Params:    map[string]string{"id": "Lease ID"},
+			Responses: notFound(noContent()),
+		},
+		"stats": {
+			Summary:     "Statistics of the buildings and the apartments",
+			Description: statsDescription,
+			Tag:         "stats",
+			Responses:   ok(storage.Stats{}),
+		},
+		"search": {
+			Summary:     "Search the buildings and the apartments",
+			Description: searchDescription,
+			Tag:         "search",
+			Query:       searchQuery,
+			Responses:   ok(bmsv2.SearchResultList{}),
+		},
+		"apartments.list": {
+			Summary:     "List the apartments",
+			Description: streamed,
+			Tag:         "apartments",
+			Responses:   ok(bmsv2.ApartmentList{}),
+			Stream:      bmsv2.Apartment{},
+		},
+		"apartments.get": {
+			Summary:   "Get an apartment",
+			Tag:       "apartments",
+			Params:    map[string]string{"id": "Apartment ID"},
+			Responses: notFound(ok(bmsv2.Apartment{})),
+		},
+		"apartments.put": {
+			Summary:   "Create or replace an apartment",
+			Tag:       "apartments",
+			Params:    map[string]string{"id": "Apartment ID"},
+			Body:      bmsv2.ApartmentInput{},
+			Responses: ok(bmsv2.Apartment{}),
+		},
+		"apartments.delete": {
+			Summary:   "Delete an apartment",
+			Tag:       "apartments",
+			Params:    map[string]string{"id": "Apartment ID"},
+			Responses: notFound(noContent()),
+		},
+	} {
+		routes["v2."+name] = v2(route)
+	}
+
+	return &openapi.Generator{
+		Info: openapi.Info{
+			Title:       "Building Management System",
+			Description: "Buildings and apartments of the management companies.",
+			Version:     "1.0.0",
+		},
+		Tags: []openapi.Tag{
+			{Name: "buildings"},
+			{Name: "apartments"},
+			{Name: "floors", Description: "Floors of the buildings, against which the floors of the apartments are validated"},
+			{Name: "residents", Description: "Current and past residents of the apartments"},
+			{Name: "leases", Description: "Leases of the apartments, their rent terms, renewals and termination"},
+			{Name: "stats", Description: "Aggregates computed by the database"},
+			{Name: "search", Description: "Full-text search with typo tolerance"},
+			{Name: "geo", Description: "Geospatial searches of the buildings, in JSON or GeoJSON"},
+			{Name: "graphql", Description: "Buildings and apartments over GraphQL"},
+			{Name: "admin", Description: "Operations of the administrators, they require the global admin role"},
+			{Name: "health", Description: "Probes and metrics"},
+		},
+		Schemas: schemas,
+		Routes:  routes,
+	}
+}
@@ -0,0 +1,41 @@
+package probes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sotskov-do/oms-assignment/internal/health"
+)
+
+type Probes struct {
+	registry *health.Registry
+}
+
+func NewProbes(registry *health.Registry) *Probes {
+	return &Probes{
+		registry: registry,
+	}
+}
+
+// LivezHandler reports whether the process is alive and should not be restarted.
+func (p *Probes) LivezHandler(c *fiber.Ctx) error {
+	return send(c, p.registry.Liveness(c.Context()))
+}
+
+// ReadyzHandler reports whether the instance can receive traffic.
+func (p *Probes) ReadyzHandler(c *fiber.Ctx) error {
+	return send(c, p.registry.Readiness(c.Context()))
+}
+
+// HealthzHandler reports the detailed status of every dependency.
+func (p *Probes) HealthzHandler(c *fiber.Ctx) error {
+	return send(c, p.registry.Health(c.Context()))
+}
+
+func send(c *fiber.Ctx, report health.Report) error {
+	status := fiber.StatusOK
+	if report.Status == health.StatusFail {
+		status = fiber.StatusServiceUnavailable
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(status).JSON(report)
+}
@@ -0,0 +1,42 @@
+// Package query parses the query parameters that the v1 and v2 handlers share.
+package query
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+)
+
+// Address returns the normalized address filter of the street, house_number, city, postal_code
+// and country parameters.
+func Address(c *fiber.Ctx) (address.Filter, error) {
+	return address.Filter{
+		Street:      c.Query("street"),
+		HouseNumber: c.Query("house_number"),
+		City:        c.Query("city"),
+		PostalCode:  c.Query("postal_code"),
+		Country:     c.Query("country"),
+	}.Normalize()
+}
+
+// Order returns the order of the sort parameter, by ID if it is missing.
+func Order(c *fiber.Ctx) (address.Order, error) {
+	return address.ParseOrder(c.Query("sort"))
+}
+
+// Float parses the required query parameter name into f.
+func Float(c *fiber.Ctx, name string, f *float64) error {
+	value := c.Query(name)
+	if value == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("%s [%v] isn't a number", name, value)
+	}
+	*f = parsed
+	return nil
+}
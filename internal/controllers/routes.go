@@ -1,35 +1,282 @@
 package controllers
 
 import (
+	"log/slog"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/swaggest/swgui/v5emb"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/admin"
 	"github.com/sotskov-do/oms-assignment/internal/controllers/bms"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/bmsv2"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/gql"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/probes"
+	"github.com/sotskov-do/oms-assignment/internal/metrics"
+	"github.com/sotskov-do/oms-assignment/internal/openapi"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
 )
 
+// legacyDeprecatedAt is when the unprefixed aliases of the v1 routes were deprecated.
+var legacyDeprecatedAt = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
 func SetupRoutes(
 	app *fiber.App,
+	metrics *metrics.Metrics,
+	bms *bms.BuildingManagementSystem,
+	bmsV2 *bmsv2.BuildingManagementSystem,
+	graphQL *gql.GraphQL,
+	admin *admin.Admin,
+	probes *probes.Probes,
+	authenticator *auth.Authenticator,
+	rateLimit fiber.Handler,
+	ipRateLimit fiber.Handler,
+	idempotency fiber.Handler,
+	validateResponses bool,
+	legacySunset time.Time,
+) {
+	authenticate := middleware.Authenticate(authenticator)
+	// The validator gets the OpenAPI document once every route is registered, see below.
+	validator := openapi.NewValidator(nil)
+	validate := validator.Middleware(validateResponses)
+
+	// public prepends the route-level middleware to the handler. Unlike app.Use middleware
+	// they run after the routing, so they know the name of the matched route.
+	public := func(handler fiber.Handler) []fiber.Handler {
+		return []fiber.Handler{tracing.Middleware, metrics.Track, handler}
+	}
+	// h also limits the rate of the IP, requires the caller to be authenticated, limits its rate, resolves
+	// its tenant, validates the request against the OpenAPI document and replays the responses of the retried writes.
+	h := func(handler fiber.Handler) []fiber.Handler {
+		return []fiber.Handler{tracing.Middleware, metrics.Track, ipRateLimit, authenticate, rateLimit, middleware.Tenant, validate, idempotency, handler}
+	}
+
+	app.Use(middleware.RequestID, middleware.AccessLog("metrics", "livez", "readyz", "healthz"))
+
+	// GET /metrics: Prometheus metrics
+	app.Get("/metrics", ipRateLimit, authenticate, metrics.Handler()).Name("metrics")
+	// GET /livez: Liveness probe
+	app.Get("/livez", public(probes.LivezHandler)...).Name("livez")
+	// GET /readyz: Readiness probe, fails as soon as the shutdown begins
+	app.Get("/readyz", public(probes.ReadyzHandler)...).Name("readyz")
+	// GET /healthz: Detailed status of every dependency
+	app.Get("/healthz", public(probes.HealthzHandler)...).Name("healthz")
+
+	// GET /openapi.json: OpenAPI document of the routes, generated once they are all registered
+	var spec *openapi.Document
+	app.Get("/openapi.json", public(func(c *fiber.Ctx) error {
+		return c.JSON(spec)
+	})...).Name("openapi")
+	// GET /docs: Swagger UI of the document
+	app.Get("/docs/*", public(adaptor.HTTPHandler(v5emb.New("Building Management System", "/openapi.json", "/docs/")))...).Name("docs")
+
+	// The current API is served under /v1 and, deprecated, without the prefix.
+	v1 := app.Group("/v1").Name("v1.")
+	// The routes added after the prefix have no unprefixed alias. The static paths under
+	// /v1/buildings are registered before /v1/buildings/{id}.
+	// GET /v1/buildings/nearby?lat=&lon=&radius_m=: List the buildings by their distance to a point
+	v1.Get("/buildings/nearby", h(bms.GetBuildingsNearbyHandler)...).Name("buildings.nearby")
+	// GET /v1/buildings/within?bbox=: List the buildings in a bounding box
+	v1.Get("/buildings/within", h(bms.GetBuildingsInBoxHandler)...).Name("buildings.within")
+	setupV1Routes(v1, h, bms, admin)
+	// GET /v1/stats: Statistics of the buildings and the apartments
+	v1.Get("/stats", h(bms.GetStatsHandler)...).Name("stats")
+	// GET /v1/buildings/{id}/stats: Statistics of the apartments of a building
+	v1.Get("/buildings/:id/stats", h(bms.GetBuildingStatsHandler)...).Name("buildings.stats")
+	// GET /v1/search?q=: Search the buildings and the apartments
+	v1.Get("/search", h(bms.SearchHandler)...).Name("search")
+	v1.Route("/buildings/:id/floors", func(api fiber.Router) {
+		// GET /v1/buildings/{id}/floors: List the floors of a building
+		api.Get("/", h(bms.GetFloorsHandler)...).Name("getAll")
+		// GET /v1/buildings/{id}/floors/{floor}: Get a floor of a building by number
+		api.Get("/:floor", h(bms.GetFloorHandler)...).Name("getByNumber")
+		// GET /v1/buildings/{id}/floors/{floor}/apartments: List the apartments on a floor
+		api.Get("/:floor/apartments", h(bms.GetApartmentsOnFloorHandler)...).Name("getApartments")
+		// POST /v1/buildings/{id}/floors: Create a floor of a building (update if already exist)
+		api.Post("/", h(bms.CreateFloorHandler)...).Name("create")
+		// DELETE /v1/buildings/{id}/floors/{floor}: Delete a floor without apartments
+		api.Delete("/:floor", h(bms.DeleteFloorHandler)...).Name("delete")
+	}, "floors.")
+	// GET /v1/buildings/{id}/residents?history=: List the residents of a building
+	v1.Get("/buildings/:id/residents", h(bms.GetBuildingResidentsHandler)...).Name("buildings.residents")
+	// GET /v1/apartments/{id}/residents?history=: List the residents of an apartment
+	v1.Get("/apartments/:id/residents", h(bms.GetApartmentResidentsHandler)...).Name("apartments.residents")
+	v1.Route("/residents", func(api fiber.Router) {
+		// GET /v1/residents/{id}: Get a resident by ID
+		api.Get("/:id", h(bms.GetResidentHandler)...).Name("getByID")
+		// POST /v1/residents: Create a resident (update if already exist)
+		api.Post("/", h(bms.CreateResidentHandler)...).Name("create")
+		// DELETE /v1/residents/{id}: Delete a resident by ID
+		api.Delete("/:id", h(bms.DeleteResidentHandler)...).Name("delete")
+	}, "residents.")
+	// GET /v1/apartments/{id}/leases: List the leases of an apartment
+	v1.Get("/apartments/:id/leases", h(bms.GetApartmentLeasesHandler)...).Name("apartments.leases")
+	v1.Route("/leases", func(api fiber.Router) {
+		// GET /v1/leases/expiring?days=: List the active leases ending within the next days
+		api.Get("/expiring", h(bms.GetExpiringLeasesHandler)...).Name("expiring")
+		// GET /v1/leases/{id}: Get a lease by ID
+		api.Get("/:id", h(bms.GetLeaseHandler)...).Name("getByID")
+		// POST /v1/leases: Create a lease (update if already exist and still a draft)
+		api.Post("/", h(bms.CreateLeaseHandler)...).Name("create")
+		// POST /v1/leases/{id}/renew: Renew an active lease
+		api.Post("/:id/renew", h(bms.RenewLeaseHandler)...).Name("renew")
+		// POST /v1/leases/{id}/terminate: Terminate an active lease
+		api.Post("/:id/terminate", h(bms.TerminateLeaseHandler)...).Name("terminate")
+		// DELETE /v1/leases/{id}: Delete a draft lease by ID
+		api.Delete("/:id", h(bms.DeleteLeaseHandler)...).Name("delete")
+	}, "leases.")
+	deprecated := middleware.Deprecated(legacyDeprecatedAt, legacySunset, "/v1")
+	setupV1Routes(app, func(handler fiber.Handler) []fiber.Handler {
+		return append([]fiber.Handler{deprecated}, h(handler)...)
+	}, bms, admin)
+
+	// h2 makes the errors problem details, for the v2 API.
+	h2 := func(handler fiber.Handler) []fiber.Handler {
+		return append([]fiber.Handler{middleware.Problems}, h(handler)...)
+	}
+
+	app.Route("/v2", func(v2 fiber.Router) {
+		v2.Route("/buildings", func(api fiber.Router) {
+			// GET /v2/buildings: List the buildings
+			api.Get("/", h2(bmsV2.ListBuildingsHandler)...).Name("list")
+			// GET /v2/buildings/nearby?lat=&lon=&radius_m=: List the buildings by their distance to a point
+			api.Get("/nearby", h2(bmsV2.ListBuildingsNearbyHandler)...).Name("nearby")
+			// GET /v2/buildings/within?bbox=: List the buildings in a bounding box
+			api.Get("/within", h2(bmsV2.ListBuildingsInBoxHandler)...).Name("within")
+			// GET /v2/buildings/{id}: Get a building
+			api.Get("/:id", h2(bmsV2.GetBuildingHandler)...).Name("get")
+			// PUT /v2/buildings/{id}: Create or replace a building
+			api.Put("/:id", h2(bmsV2.PutBuildingHandler)...).Name("put")
+			// DELETE /v2/buildings/{id}: Delete a building
+			api.Delete("/:id", h2(bmsV2.DeleteBuildingHandler)...).Name("delete")
+			// GET /v2/buildings/{id}/apartments: List the apartments of a building
+			api.Get("/:id/apartments", h2(bmsV2.ListBuildingApartmentsHandler)...).Name("listApartments")
+			// GET /v2/buildings/{id}/stats: Statistics of the apartments of a building
+			api.Get("/:id/stats", h2(bmsV2.GetBuildingStatsHandler)...).Name("stats")
+			// GET /v2/buildings/{id}/floors: List the floors of a building
+			api.Get("/:id/floors", h2(bmsV2.ListFloorsHandler)...).Name("listFloors")
+			// GET /v2/buildings/{id}/floors/{floor}: Get a floor of a building
+			api.Get("/:id/floors/:floor", h2(bmsV2.GetFloorHandler)...).Name("getFloor")
+			// PUT /v2/buildings/{id}/floors/{floor}: Create or replace a floor of a building
+			api.Put("/:id/floors/:floor", h2(bmsV2.PutFloorHandler)...).Name("putFloor")
+			// DELETE /v2/buildings/{id}/floors/{floor}: Delete a floor without apartments
+			api.Delete("/:id/floors/:floor", h2(bmsV2.DeleteFloorHandler)...).Name("deleteFloor")
+			// GET /v2/buildings/{id}/floors/{floor}/apartments: List the apartments on a floor
+			api.Get("/:id/floors/:floor/apartments", h2(bmsV2.ListFloorApartmentsHandler)...).Name("listFloorApartments")
+			// GET /v2/buildings/{id}/residents?history=: List the residents of a building
+			api.Get("/:id/residents", h2(bmsV2.ListBuildingResidentsHandler)...).Name("listResidents")
+		}, "buildings.")
+
+		v2.Route("/apartments", func(api fiber.Router) {
+			// GET /v2/apartments: List the apartments
+			api.Get("/", h2(bmsV2.ListApartmentsHandler)...).Name("list")
+			// GET /v2/apartments/{id}: Get an apartment
+			api.Get("/:id", h2(bmsV2.GetApartmentHandler)...).Name("get")
+			// PUT /v2/apartments/{id}: Create or replace an apartment
+			api.Put("/:id", h2(bmsV2.PutApartmentHandler)...).Name("put")
+			// DELETE /v2/apartments/{id}: Delete an apartment
+			api.Delete("/:id", h2(bmsV2.DeleteApartmentHandler)...).Name("delete")
+			// GET /v2/apartments/{id}/residents?history=: List the residents of an apartment
+			api.Get("/:id/residents", h2(bmsV2.ListApartmentResidentsHandler)...).Name("listResidents")
+			// GET /v2/apartments/{id}/leases: List the leases of an apartment
+			api.Get("/:id/leases", h2(bmsV2.ListApartmentLeasesHandler)...).Name("listLeases")
+		}, "apartments.")
+
+		v2.Route("/residents", func(api fiber.Router) {
+			// GET /v2/residents/{id}: Get a resident
+			api.Get("/:id", h2(bmsV2.GetResidentHandler)...).Name("get")
+			// PUT /v2/residents/{id}: Create or replace a resident
+			api.Put("/:id", h2(bmsV2.PutResidentHandler)...).Name("put")
+			// DELETE /v2/residents/{id}: Delete a resident
+			api.Delete("/:id", h2(bmsV2.DeleteResidentHandler)...).Name("delete")
+		}, "residents.")
+
+		v2.Route("/leases", func(api fiber.Router) {
+			// GET /v2/leases/expiring?days=: List the active leases ending within the next days
+			api.Get("/expiring", h2(bmsV2.ListExpiringLeasesHandler)...).Name("expiring")
+			// GET /v2/leases/{id}: Get a lease
+			api.Get("/:id", h2(bmsV2.GetLeaseHandler)...).Name("get")
+			// PUT /v2/leases/{id}: Create a lease or replace a draft
+			api.Put("/:id", h2(bmsV2.PutLeaseHandler)...).Name("put")
+			// POST /v2/leases/{id}/renew: Renew an active lease
+			api.Post("/:id/renew", h2(bmsV2.RenewLeaseHandler)...).Name("renew")
+			// POST /v2/leases/{id}/terminate: Terminate an active lease
+			api.Post("/:id/terminate", h2(bmsV2.TerminateLeaseHandler)...).Name("terminate")
+			// DELETE /v2/leases/{id}: Delete a draft lease
+			api.Delete("/:id", h2(bmsV2.DeleteLeaseHandler)...).Name("delete")
+		}, "leases.")
+
+		// GET /v2/stats: Statistics of the buildings and the apartments
+		v2.Get("/stats", h2(bmsV2.GetStatsHandler)...).Name("stats")
+		// GET /v2/search?q=: Search the buildings and the apartments
+		v2.Get("/search", h2(bmsV2.SearchHandler)...).Name("search")
+	}, "v2.")
+
+	// POST /graphql: Execute a GraphQL query or mutation over the buildings and the apartments
+	app.Post("/graphql", h(graphQL.Handler)...).Name("graphql")
+
+	spec, undocumented := apiDocs().Generate(app.GetRoutes(true))
+	if len(undocumented) > 0 {
+		slog.Warn("routes missing from the OpenAPI document", "routes", undocumented)
+	}
+	validator.Load(spec)
+
+	// Requests that didn't match any route
+	app.Use(metrics.TrackUnmatched)
+}
+
+// setupV1Routes registers the routes of the v1 API on the router, h prepends their middleware.
+func setupV1Routes(
+	router fiber.Router,
+	h func(handler fiber.Handler) []fiber.Handler,
 	bms *bms.BuildingManagementSystem,
+	admin *admin.Admin,
 ) {
-	app.Route("/buildings", func(api fiber.Router) {
-		// GET /buildings: List all buildings (with or without the apartments)
-		api.Get("/", bms.GetBuildingsHandler).Name("getAll")
-		// GET /buildings/{id}: Get a single building by ID
-		api.Get("/:id", bms.GetBuildingHandler).Name("getByID")
-		// POST /buildings: Create a new building (update if already exist)
-		api.Post("/", bms.CreateBuildingHandler).Name("create")
-		// DELETE /buildings/{id}: Delete a building by ID
-		api.Delete("/:id", bms.DeleteBuildingHandler).Name("delete")
+	router.Route("/buildings", func(api fiber.Router) {
+		// GET /v1/buildings: List all buildings (with or without the apartments)
+		api.Get("/", h(bms.GetBuildingsHandler)...).Name("getAll")
+		// GET /v1/buildings/{id}: Get a single building by ID
+		api.Get("/:id", h(bms.GetBuildingHandler)...).Name("getByID")
+		// POST /v1/buildings: Create a new building (update if already exist)
+		api.Post("/", h(bms.CreateBuildingHandler)...).Name("create")
+		// DELETE /v1/buildings/{id}: Delete a building by ID
+		api.Delete("/:id", h(bms.DeleteBuildingHandler)...).Name("delete")
 	}, "buildings.")
 
-	app.Route("/apartments", func(api fiber.Router) {
-		// GET /apartments: List all apartments
-		api.Get("/", bms.GetApartmentsHandler).Name("getAll")
-		// GET /apartments/{id}: Get a single apartment by ID
-		api.Get("/:id", bms.GetApartmentHandler).Name("getByID")
-		// GET /apartments/building/{buildingId}: Get all apartments in a specific building
-		api.Get("/building/:buildingId", bms.GetApartmentsInBuildingHandler).Name("getAllInBuilding")
-		// POST /apartments: Create a new apartment (update if already exist)
-		api.Post("/", bms.CreateApartmentHandler).Name("create")
-		// DELETE /apartments/{id}: Delete an apartment by ID
-		api.Delete("/:id", bms.DeleteApartmentHandler).Name("delete")
+	router.Route("/apartments", func(api fiber.Router) {
+		// GET /v1/apartments: List all apartments
+		api.Get("/", h(bms.GetApartmentsHandler)...).Name("getAll")
+		// GET /v1/apartments/{id}: Get a single apartment by ID
+		api.Get("/:id", h(bms.GetApartmentHandler)...).Name("getByID")
+		// GET /v1/apartments/building/{buildingId}: Get all apartments in a specific building
+		api.Get("/building/:buildingId", h(bms.GetApartmentsInBuildingHandler)...).Name("getAllInBuilding")
+		// POST /v1/apartments: Create a new apartment (update if already exist)
+		api.Post("/", h(bms.CreateApartmentHandler)...).Name("create")
+		// DELETE /v1/apartments/{id}: Delete an apartment by ID
+		api.Delete("/:id", h(bms.DeleteApartmentHandler)...).Name("delete")
 	}, "apartments.")
+
+	// ha also requires the global admin role.
+	ha := func(handler fiber.Handler) []fiber.Handler {
+		return append(h(admin.Authorize), handler)
+	}
+	router.Route("/admin", func(api fiber.Router) {
+		// GET /v1/admin/db/stats: Database connection pool statistics
+		api.Get("/db/stats", ha(admin.GetDBStatsHandler)...).Name("dbStats")
+		// POST /v1/admin/pii/reencrypt: Re-encrypt the personal data with the current key
+		api.Post("/pii/reencrypt", ha(admin.ReencryptPIIHandler)...).Name("reencryptPII")
+		// GET /v1/admin/log-level: Current log levels
+		api.Get("/log-level", ha(admin.GetLogLevelHandler)...).Name("getLogLevel")
+		// PUT /v1/admin/log-level: Change the global or a component log level at runtime
+		api.Put("/log-level", ha(admin.SetLogLevelHandler)...).Name("setLogLevel")
+		// GET /v1/admin/grants: List the roles granted to the principals
+		api.Get("/grants", ha(admin.GetGrantsHandler)...).Name("getGrants")
+		// POST /v1/admin/grants: Grant a role on a building or, without building_id, on every building
+		api.Post("/grants", ha(admin.CreateGrantHandler)...).Name("createGrant")
+		// DELETE /v1/admin/grants/{id}: Revoke a grant
+		api.Delete("/grants/:id", ha(admin.DeleteGrantHandler)...).Name("deleteGrant")
+	}, "admin.")
 }
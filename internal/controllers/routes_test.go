@@ -0,0 +1,1166 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/admin"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/bms"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/bmsv2"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/gql"
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/metrics"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/openapi"
+	"github.com/sotskov-do/oms-assignment/internal/ratelimit"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+	"github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/service/mocks"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+var testSunset = time.Date(2027, time.April, 19, 0, 0, 0, 0, time.UTC)
+
+func newTestApp() *fiber.App {
+	return newTestAppWith(nil, nil, nil, nil)
+}
+
+// newTestAppWith validates the responses against the OpenAPI document.
+func newTestAppWith(bms *bms.BuildingManagementSystem, bmsV2 *bmsv2.BuildingManagementSystem, graphQL *gql.GraphQL, admin *admin.Admin) *fiber.App {
+	app := fiber.New()
+	SetupRoutes(app, metrics.New(), bms, bmsV2, graphQL, admin, nil, nil,
+		middleware.RateLimit(nil, ratelimit.Limit{}, ratelimit.Limit{}), middleware.RateLimitIP(nil, ratelimit.Limit{}),
+		middleware.Idempotency(nil, 0, 0), true, testSunset)
+	return app
+}
+
+func getSpec(t *testing.T, app *fiber.App) map[string]any {
+	t.Helper()
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/openapi.json", nil))
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode)
+
+	var spec map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	return spec
+}
+
+// Test_OpenAPICoversRoutes fails when a route is registered without being documented in apiDocs.
+func Test_OpenAPICoversRoutes(t *testing.T) {
+	t.Parallel()
+
+	app := newTestApp()
+	spec := getSpec(t, app)
+	assert.Equal(t, openapi.Version, spec["openapi"])
+
+	paths := spec["paths"].(map[string]any)
+	docs := apiDocs()
+	for _, r := range app.GetRoutes(true) {
+		if r.Method == fiber.MethodHead || docs.Routes[r.Name].Hidden {
+			continue
+		}
+
+		item, ok := paths[openapi.PathOf(r.Path)].(map[string]any)
+		if assert.True(t, ok, "path of %s %s (%s) is missing from the document", r.Method, r.Path, r.Name) {
+			assert.Contains(t, item, strings.ToLower(r.Method), "%s %s (%s) is missing from the document", r.Method, r.Path, r.Name)
+		}
+	}
+
+	_, undocumented := docs.Generate(app.GetRoutes(true))
+	assert.Empty(t, undocumented)
+}
+
+func Test_OpenAPIDocument(t *testing.T) {
+	t.Parallel()
+
+	app := newTestApp()
+	spec := getSpec(t, app)
+
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+	apartment := schemas["Apartment"].(map[string]any)
+	props := apartment["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "integer", "format": "int32"}, props["id"])
+	assert.Equal(t, []any{"string", "null"}, props["number"].(map[string]any)["type"])
+	assert.Equal(t, []any{"integer", "null"}, props["floor"].(map[string]any)["type"])
+	assert.Equal(t, true, props["tenant_id"].(map[string]any)["readOnly"])
+	// The v1 upserts without id create a new apartment.
+	assert.ElementsMatch(t, []any{"building_id", "tenant_id"}, apartment["required"])
+
+	building := schemas["Building"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, []any{"string", "null"}, building["address"].(map[string]any)["type"])
+	v2Building := schemas["V2Building"].(map[string]any)
+	assert.ElementsMatch(t, []any{
+		"id", "name", "address", "street", "house_number", "city", "postal_code", "country", "latitude", "longitude",
+	}, v2Building["required"])
+	assert.NotContains(t, v2Building["properties"], "tenant_id")
+
+	legacy := spec["paths"].(map[string]any)["/buildings"].(map[string]any)["get"].(map[string]any)
+	assert.Equal(t, true, legacy["deprecated"])
+	v1 := spec["paths"].(map[string]any)["/v1/buildings"].(map[string]any)["get"].(map[string]any)
+	assert.NotContains(t, v1, "deprecated")
+	assert.Equal(t, "v1.buildings.getAll", v1["operationId"])
+	notFound := spec["paths"].(map[string]any)["/v2/buildings/{id}"].(map[string]any)["get"].(map[string]any)["responses"].(map[string]any)["404"]
+	assert.Equal(t, map[string]any{"$ref": "#/components/responses/ProblemNotFound"}, notFound)
+
+	get := spec["paths"].(map[string]any)["/buildings/{id}"].(map[string]any)["get"].(map[string]any)
+	assert.Equal(t, "buildings.getByID", get["operationId"])
+	params := get["parameters"].([]any)
+	assert.Equal(t, map[string]any{
+		"name": "id", "in": "path", "description": "Building ID", "required": true,
+		"schema": map[string]any{"type": "integer", "format": "int32"},
+	}, params[0])
+	responses := get["responses"].(map[string]any)
+	for _, code := range []string{"200", "400", "401", "403", "429", "500"} {
+		assert.Contains(t, responses, code)
+	}
+
+	post := spec["paths"].(map[string]any)["/apartments"].(map[string]any)["post"].(map[string]any)
+	body := post["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)
+	assert.Equal(t, map[string]any{"$ref": "#/components/schemas/Apartment"}, body["schema"])
+	assert.Contains(t, post["responses"], "422")
+
+	livez := spec["paths"].(map[string]any)["/livez"].(map[string]any)["get"].(map[string]any)
+	assert.Equal(t, []any{}, livez["security"])
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/docs/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get(fiber.HeaderContentType), "text/html")
+}
+
+type dbStats struct{}
+
+func (dbStats) Stats() sql.DBStats {
+	return sql.DBStats{MaxOpenConnections: 20, OpenConnections: 2, InUse: 1, Idle: 1}
+}
+
+func (dbStats) ReencryptPII(context.Context) (storage.Reencrypted, error) {
+	return storage.Reencrypted{Residents: 2, Leases: 1}, nil
+}
+
+type grants struct{}
+
+func (grants) GetGrants(context.Context) ([]*storage.Grant, error) {
+	buildingID := 1
+	return []*storage.Grant{
+		{ID: 1, Subject: "admin@example.com", Role: "admin"},
+		{ID: 2, Subject: "manager@example.com", Role: "manager", BuildingID: &buildingID},
+	}, nil
+}
+
+func (grants) CreateGrant(_ context.Context, grant *storage.Grant) error {
+	grant.ID = 3
+	return nil
+}
+
+func (grants) DeleteGrant(context.Context, int) error {
+	return nil
+}
+
+// Test_OpenAPIContract runs the handlers with the response validation, every response
+// must match the document.
+func Test_OpenAPIContract(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	building := &models.Building{ID: 1, Name: "Tower", Address: null.StringFrom("1 Main St"), TenantID: "default"}
+	apartment := &models.Apartment{ID: 1, BuildingID: 1, Number: null.StringFrom("1A"), Floor: null.IntFrom(1), TenantID: "default"}
+	getBuilding := func(_ context.Context, id int) (*models.Building, error) {
+		switch id {
+		case 1:
+			return building, nil
+		case 2:
+			return nil, service.ErrForbidden
+		default:
+			return nil, fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, id)
+		}
+	}
+	buildingsService := mocks.NewBuildingsServiceMock(mc).
+		FindBuildingsMock.Return(models.BuildingSlice{building}, nil).
+		GetBuildingMock.Set(getBuilding).
+		CreateBuildingMock.Return(nil).
+		DeleteBuildingMock.Return(nil)
+	apartmentsService := mocks.NewApartmentsServiceMock(mc).
+		GetApartmentsMock.Return(models.ApartmentSlice{apartment}, nil).
+		GetApartmentMock.Return(apartment, nil).
+		GetApartmentsInBuildingMock.Return(models.ApartmentSlice{apartment}, nil).
+		GetApartmentsInBuildingsMock.Return(models.ApartmentSlice{apartment}, nil).
+		CreateApartmentMock.Return(nil).
+		DeleteApartmentMock.Return(nil)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		gql.NewGraphQL(apartmentsService, buildingsService),
+		admin.NewAdmin(dbStats{}, dbStats{}, logger.NewLevels(slog.LevelInfo), grants{}, access.Fixed(access.Unrestricted())),
+	)
+
+	tests := []struct {
+		method   string
+		target   string
+		body     string
+		wantCode int
+		wantErr  string
+	}{
+		{method: fiber.MethodGet, target: "/buildings", wantCode: 200},
+		{method: fiber.MethodGet, target: "/buildings/1", wantCode: 200},
+		{method: fiber.MethodGet, target: "/buildings/2", wantCode: 403},
+		{method: fiber.MethodPost, target: "/buildings", body: `{"id":1,"name":"Tower","address":null}`, wantCode: 200},
+		{method: fiber.MethodPost, target: "/buildings", body: `{"name":"Tower"}`, wantCode: 200},
+		{method: fiber.MethodDelete, target: "/buildings/1", wantCode: 200},
+		{method: fiber.MethodGet, target: "/apartments", wantCode: 200},
+		{method: fiber.MethodGet, target: "/apartments/1", wantCode: 200},
+		{method: fiber.MethodGet, target: "/apartments/building/1", wantCode: 200},
+		{method: fiber.MethodPost, target: "/apartments", body: `{"id":1,"building_id":1,"number":"1A","floor":1}`, wantCode: 200},
+		{method: fiber.MethodPost, target: "/apartments", body: `{"building_id":1,"number":"1A"}`, wantCode: 200},
+		{method: fiber.MethodDelete, target: "/apartments/1", wantCode: 200},
+		{method: fiber.MethodGet, target: "/admin/db/stats", wantCode: 200},
+		{method: fiber.MethodPost, target: "/admin/pii/reencrypt", wantCode: 200},
+		{method: fiber.MethodGet, target: "/admin/log-level", wantCode: 200},
+		{method: fiber.MethodPut, target: "/admin/log-level", body: `{"component":"postgres","level":"DEBUG"}`, wantCode: 200},
+		{method: fiber.MethodGet, target: "/admin/grants", wantCode: 200},
+		{method: fiber.MethodPost, target: "/admin/grants", body: `{"subject":"viewer@example.com","role":"viewer","building_id":1}`, wantCode: 200},
+		{method: fiber.MethodDelete, target: "/admin/grants/1", wantCode: 200},
+		{method: fiber.MethodGet, target: "/v1/buildings/1", wantCode: 200},
+		{method: fiber.MethodPost, target: "/v1/apartments", body: `{"id":1,"building_id":1,"number":"1A","floor":1}`, wantCode: 200},
+		{method: fiber.MethodGet, target: "/v1/admin/log-level", wantCode: 200},
+		{method: fiber.MethodGet, target: "/v2/buildings", wantCode: 200},
+		{method: fiber.MethodGet, target: "/v2/buildings/1", wantCode: 200},
+		{method: fiber.MethodGet, target: "/v2/buildings/2", wantCode: 403},
+		{method: fiber.MethodGet, target: "/v2/buildings/3", wantCode: 404},
+		{method: fiber.MethodPut, target: "/v2/buildings/1", body: `{"name":"Tower","address":"1 Main St"}`, wantCode: 200},
+		{method: fiber.MethodDelete, target: "/v2/buildings/1", wantCode: 204},
+		{method: fiber.MethodGet, target: "/v2/buildings/1/apartments", wantCode: 200},
+		{method: fiber.MethodGet, target: "/v2/apartments", wantCode: 200},
+		{method: fiber.MethodGet, target: "/v2/apartments/1", wantCode: 200},
+		{method: fiber.MethodPut, target: "/v2/apartments/1", body: `{"building_id":1,"number":"1A"}`, wantCode: 200},
+		{method: fiber.MethodDelete, target: "/v2/apartments/1", wantCode: 204},
+		{method: fiber.MethodPost, target: "/graphql", body: `{"query":"{ buildings { items { id apartmentCount } } }","variables":null}`, wantCode: 200},
+		{method: fiber.MethodPost, target: "/graphql", body: `{"query":"{ building(id: 2) { id } }"}`, wantCode: 200},
+
+		// Invalid requests are rejected before the handlers.
+		{method: fiber.MethodGet, target: "/buildings/abc", wantCode: 400, wantErr: "path.id: must be an integer"},
+		{method: fiber.MethodPost, target: "/buildings", body: `{"id":1,"name":null}`, wantCode: 400, wantErr: "body.name: must be a string"},
+		{method: fiber.MethodPost, target: "/apartments", body: `{"id":1,"building_id":1,"floor":"one"}`, wantCode: 400, wantErr: "body.floor: must be an integer or null"},
+		{method: fiber.MethodPost, target: "/apartments", body: `{"id":1,"building_id":3000000000}`, wantCode: 400, wantErr: "body.building_id: must be a 32-bit integer"},
+		{method: fiber.MethodPost, target: "/apartments", wantCode: 400, wantErr: "body: is required"},
+		{method: fiber.MethodPost, target: "/apartments", body: `{"id":1,`, wantCode: 400},
+		{method: fiber.MethodPost, target: "/admin/grants", body: `{"subject":"s","role":"owner"}`, wantCode: 400, wantErr: "body.role: must be one of [viewer manager admin]"},
+		{method: fiber.MethodGet, target: "/v2/buildings/0", wantCode: 400},
+		{method: fiber.MethodPost, target: "/graphql", body: `{"query":1}`, wantCode: 400, wantErr: "body.query: must be a string"},
+		{method: fiber.MethodPut, target: "/v2/buildings/1", body: `{"address":null}`, wantCode: 400},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+		if tt.body != "" {
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantCode, resp.StatusCode, "%s %s: %s", tt.method, tt.target, body)
+		if tt.wantErr != "" {
+			assert.JSONEq(t, `{"result":"error","response":"`+tt.wantErr+`"}`, string(body))
+		}
+	}
+
+	req := httptest.NewRequest(fiber.MethodPost, "/buildings", strings.NewReader(`id=1&name=Tower`))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationForm)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, 415, resp.StatusCode)
+}
+
+func Test_AdminRequiresGlobalAdmin(t *testing.T) {
+	t.Parallel()
+
+	buildingID := 1
+	viewer := access.NewScope(&storage.Grant{Subject: "viewer@example.com", Role: string(access.RoleViewer), BuildingID: &buildingID})
+	logLevels := logger.NewLevels(slog.LevelInfo)
+	app := newTestAppWith(nil, nil, nil, admin.NewAdmin(dbStats{}, dbStats{}, logLevels, grants{}, access.Fixed(viewer)))
+
+	for _, target := range []string{"/v1/admin/log-level", "/admin/log-level"} {
+		req := httptest.NewRequest(fiber.MethodPut, target, strings.NewReader(`{"level":"DEBUG"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, 403, resp.StatusCode, "%s: %s", target, body)
+		assert.JSONEq(t, `{"result":"error","response":"forbidden: the admin routes require the global admin role"}`, string(body))
+	}
+	assert.Equal(t, slog.LevelInfo, logLevels.Level(""))
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/v1/admin/db/stats", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 403, resp.StatusCode)
+}
+
+type apiKeys map[string]string
+
+func (k apiKeys) AuthenticateAPIKey(_ context.Context, key string) (*auth.Principal, error) {
+	subject, ok := k[key]
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	return &auth.Principal{Subject: subject, Method: auth.MethodAPIKey}, nil
+}
+
+func Test_MetricsRequireCredentials(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	SetupRoutes(app, metrics.New(), nil, nil, nil, nil, nil, auth.NewAuthenticator(apiKeys{"oms_scraper": "prometheus"}, nil),
+		middleware.RateLimit(nil, ratelimit.Limit{}, ratelimit.Limit{}), middleware.RateLimitIP(nil, ratelimit.Limit{}),
+		middleware.Idempotency(nil, 0, 0), false, testSunset)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 401, resp.StatusCode)
+
+	req := httptest.NewRequest(fiber.MethodGet, "/metrics", nil)
+	req.Header.Set(middleware.HeaderAPIKey, "oms_scraper")
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+}
+
+func Test_Versioning(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	building := &models.Building{ID: 1, Name: "Tower", TenantID: "default"}
+	buildingsService := mocks.NewBuildingsServiceMock(mc).
+		FindBuildingsMock.Return(models.BuildingSlice{building}, nil).
+		GetBuildingMock.Return(nil, service.WithLegacyMessage(fmt.Errorf("%w: no building with id [2]", service.ErrNotFound), sql.ErrNoRows.Error()))
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	t.Run("v1", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/v1/buildings", nil))
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(middleware.HeaderDeprecation))
+		assert.Empty(t, resp.Header.Get(middleware.HeaderSunset))
+		assert.Empty(t, resp.Header.Get(fiber.HeaderLink))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"result":"success","response":[{"id":1,"name":"Tower","address":null,"street":null,"house_number":null,"city":null,"postal_code":null,"country":null,"latitude":null,"longitude":null,"tenant_id":"default"}]}`, string(body))
+	})
+
+	t.Run("v1 not found", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/v1/buildings/2", nil))
+		require.NoError(t, err)
+		assert.Equal(t, 500, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"result":"error","response":"sql: no rows in result set"}`, string(body))
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/buildings?with_apartments=false", nil))
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "@1792368000", resp.Header.Get(middleware.HeaderDeprecation))
+		assert.Equal(t, "Mon, 19 Apr 2027 00:00:00 GMT", resp.Header.Get(middleware.HeaderSunset))
+		assert.Equal(t, `</v1/buildings?with_apartments=false>; rel="successor-version"`, resp.Header.Get(fiber.HeaderLink))
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/v2/buildings", nil))
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(middleware.HeaderDeprecation))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"items":[{"id":1,"name":"Tower","address":null,"street":null,"house_number":null,"city":null,"postal_code":null,"country":null,"latitude":null,"longitude":null}]}`, string(body))
+	})
+
+	t.Run("v2 problem", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/v2/buildings/2", nil))
+		require.NoError(t, err)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, middleware.MIMEApplicationProblemJSON, resp.Header.Get(fiber.HeaderContentType))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"not found: no building with id [2]"}`, string(body))
+	})
+
+	t.Run("v2 invalid request", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/v2/buildings/abc", nil))
+		require.NoError(t, err)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"path.id: must be an integer"}`, string(body))
+	})
+}
+
+func Test_NDJSON(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	apartments := models.ApartmentSlice{
+		{ID: 1, BuildingID: 1, Number: null.StringFrom("1A"), TenantID: "default"},
+		{ID: 2, BuildingID: 1, TenantID: "default"},
+	}
+	stream := func(send func(a *models.Apartment) error) error {
+		for _, a := range apartments {
+			err := send(a)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	apartmentsService := mocks.NewApartmentsServiceMock(mc).
+		StreamApartmentsMock.Set(func(_ context.Context, send func(a *models.Apartment) error) error {
+		return stream(send)
+	}).
+		StreamApartmentsInBuildingMock.Set(func(_ context.Context, buildingId int, send func(a *models.Apartment) error) error {
+		if buildingId != 1 {
+			return fmt.Errorf("%w: building [%v]", service.ErrForbidden, buildingId)
+		}
+		return stream(send)
+	})
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantType   string
+		wantBody   string
+	}{
+		{
+			path: "/v1/apartments", wantStatus: 200, wantType: middleware.MIMEApplicationNDJSON,
+			wantBody: `{"id":1,"building_id":1,"number":"1A","floor":null,"sq_meters":null,"tenant_id":"default"}` + "\n" +
+				`{"id":2,"building_id":1,"number":null,"floor":null,"sq_meters":null,"tenant_id":"default"}` + "\n",
+		},
+		{
+			path: "/v2/buildings/1/apartments", wantStatus: 200, wantType: middleware.MIMEApplicationNDJSON,
+			wantBody: `{"id":1,"building_id":1,"number":"1A","floor":null,"sq_meters":null}` + "\n" + `{"id":2,"building_id":1,"number":null,"floor":null,"sq_meters":null}` + "\n",
+		},
+		{
+			path: "/v2/buildings/2/apartments", wantStatus: 403, wantType: middleware.MIMEApplicationProblemJSON,
+			wantBody: `{"type":"about:blank","title":"Forbidden","status":403,"detail":"forbidden: building [2]"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(fiber.MethodGet, tt.path, nil)
+		req.Header.Set(fiber.HeaderAccept, middleware.MIMEApplicationNDJSON)
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, tt.path)
+		assert.Equal(t, tt.wantType, resp.Header.Get(fiber.HeaderContentType), tt.path)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantBody, string(body), tt.path)
+	}
+
+	paths := getSpec(t, app)["paths"].(map[string]any)
+	content := paths["/v1/apartments"].(map[string]any)["get"].(map[string]any)["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+	assert.Contains(t, content, middleware.MIMEApplicationNDJSON)
+	assert.Contains(t, content, fiber.MIMEApplicationJSON)
+}
+
+func Test_Stats(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	avg, minFloor, maxFloor, rate := 45.5, 1, 3, 2.0/3
+	stats := &storage.Stats{
+		Buildings:          1,
+		Apartments:         3,
+		TotalSQMeters:      91,
+		AvgSQMeters:        &avg,
+		MinFloor:           &minFloor,
+		MaxFloor:           &maxFloor,
+		Floors:             []storage.FloorCount{{Floor: 1, Apartments: 2}, {Floor: 3, Apartments: 1}},
+		Missing:            storage.MissingCounts{Number: 1, SQMeters: 1},
+		OccupiedApartments: 2,
+		OccupancyRate:      &rate,
+	}
+	apartmentsService := mocks.NewApartmentsServiceMock(mc).
+		GetStatsMock.Return(&storage.Stats{Floors: []storage.FloorCount{}}, nil).
+		GetBuildingStatsMock.Set(func(_ context.Context, buildingId int) (*storage.Stats, error) {
+		if buildingId != 1 {
+			return nil, fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, buildingId)
+		}
+		return stats, nil
+	})
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	statsJSON := `{"buildings":1,"apartments":3,"total_sq_meters":91,"avg_sq_meters":45.5,"min_floor":1,"max_floor":3,
+		"floors":[{"floor":1,"apartments":2},{"floor":3,"apartments":1}],"missing":{"number":1,"floor":0,"sq_meters":1},
+		"occupied_apartments":2,"occupancy_rate":0.6666666666666666}`
+	emptyJSON := `{"buildings":0,"apartments":0,"total_sq_meters":0,"avg_sq_meters":null,"min_floor":null,"max_floor":null,
+		"floors":[],"missing":{"number":0,"floor":0,"sq_meters":0},"occupied_apartments":0,"occupancy_rate":null}`
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{path: "/v1/buildings/1/stats", wantStatus: 200, wantBody: `{"result":"success","response":` + statsJSON + `}`},
+		{path: "/v1/buildings/2/stats", wantStatus: 404, wantBody: `{"result":"error","response":"not found: no building with id [2]"}`},
+		{path: "/v1/stats", wantStatus: 200, wantBody: `{"result":"success","response":` + emptyJSON + `}`},
+		{path: "/v2/buildings/1/stats", wantStatus: 200, wantBody: statsJSON},
+		{path: "/v2/buildings/2/stats", wantStatus: 404, wantBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"not found: no building with id [2]"}`},
+		{path: "/v2/stats", wantStatus: 200, wantBody: emptyJSON},
+		// The routes added after the /v1 prefix have no unprefixed alias.
+		{path: "/stats", wantStatus: 404},
+	}
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, tt.path)
+
+		if tt.wantBody != "" {
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body), tt.path)
+		}
+	}
+}
+
+func Test_Search(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	searchService := mocks.NewSearchServiceMock(mc).
+		SearchMock.Set(func(_ context.Context, query string, limit int) ([]*storage.SearchResult, error) {
+		if query != "Meridor 79" {
+			return []*storage.SearchResult{}, nil
+		}
+		results := []*storage.SearchResult{
+			{Kind: storage.SearchKindApartment, ID: 7, BuildingID: 1, Title: "79", Snippet: "<mark>79</mark> <mark>Meridor</mark>", Rank: 2.1},
+			{Kind: storage.SearchKindBuilding, ID: 1, BuildingID: 1, Title: "Meridor", Snippet: "<mark>Meridor</mark>", Rank: 0.7},
+		}
+		return results[:min(limit, len(results))], nil
+	})
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, searchService, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, searchService, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	resultsJSON := `[{"kind":"apartment","id":7,"building_id":1,"title":"79","snippet":"<mark>79</mark> <mark>Meridor</mark>","rank":2.1},
+		{"kind":"building","id":1,"building_id":1,"title":"Meridor","snippet":"<mark>Meridor</mark>","rank":0.7}]`
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{path: "/v1/search?q=Meridor+79", wantStatus: 200, wantBody: `{"result":"success","response":` + resultsJSON + `}`},
+		{path: "/v1/search?q=nowhere", wantStatus: 200, wantBody: `{"result":"success","response":[]}`},
+		{path: "/v1/search?q=+", wantStatus: 400, wantBody: `{"result":"error","response":"empty query"}`},
+		{path: "/v1/search", wantStatus: 400},
+		{path: "/v2/search?q=Meridor%2079", wantStatus: 200, wantBody: `{"items":` + resultsJSON + `}`},
+		{path: "/v2/search?q=Meridor%2079&limit=1", wantStatus: 200},
+		{path: "/v2/search?q=Meridor&limit=101", wantStatus: 400,
+			wantBody: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"limit must be between 1 and 100"}`},
+		{path: "/v2/search?q=Meridor&limit=x", wantStatus: 400},
+		{path: "/search?q=Meridor", wantStatus: 404},
+	}
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, tt.path)
+
+		if tt.wantBody != "" {
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body), tt.path)
+		}
+	}
+}
+
+func Test_AddressFilters(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	buildings := models.BuildingSlice{
+		{ID: 1, Name: "Meridor", Street: null.StringFrom("Yafo"), City: null.StringFrom("Haifa"), Country: null.StringFrom("IL")},
+		{ID: 2, Name: "Tower", Street: null.StringFrom("Main Street"), City: null.StringFrom("Springfield"), Country: null.StringFrom("US")},
+		{ID: 3, Name: "Annex"},
+	}
+	buildingsService := mocks.NewBuildingsServiceMock(mc).
+		FindBuildingsMock.Set(func(_ context.Context, filter address.Filter, order address.Order) (models.BuildingSlice, error) {
+		found := slices.Clone(address.FilterBuildings(buildings, filter))
+		address.SortBuildings(found, order)
+		return found, nil
+	})
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantIDs    []int
+	}{
+		{path: "/v2/buildings", wantStatus: 200, wantIDs: []int{1, 2, 3}},
+		{path: "/v2/buildings?city=haifa", wantStatus: 200, wantIDs: []int{1}},
+		{path: "/v2/buildings?street=Main+St.&country=usa", wantStatus: 200, wantIDs: []int{2}},
+		{path: "/v2/buildings?city=Haifa&country=US", wantStatus: 200, wantIDs: []int{}},
+		{path: "/v2/buildings?country=Atlantis", wantStatus: 400},
+		{path: "/v2/buildings?sort=street", wantStatus: 200, wantIDs: []int{2, 1, 3}},
+		{path: "/v2/buildings?sort=name", wantStatus: 400},
+		{path: "/v1/buildings?sort=street&country=us", wantStatus: 200, wantIDs: []int{2}},
+		{path: "/v1/buildings?country=Israel", wantStatus: 200, wantIDs: []int{1}},
+		{path: "/v1/buildings?country=Atlantis", wantStatus: 400},
+	}
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, tt.path)
+		if tt.wantIDs == nil {
+			continue
+		}
+
+		var body struct {
+			Items    []struct{ ID int } `json:"items"`
+			Response []struct{ ID int } `json:"response"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		ids := []int{}
+		for _, b := range append(body.Items, body.Response...) {
+			ids = append(ids, b.ID)
+		}
+		assert.Equal(t, tt.wantIDs, ids, tt.path)
+	}
+}
+
+func Test_Geo(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	building := &models.Building{
+		ID: 1, Name: "Meridor", Address: null.StringFrom("HaMishlatim 4, Haifa, IL"), TenantID: "default",
+		Latitude: null.Float64From(32.8191), Longitude: null.Float64From(34.9983),
+	}
+	buildingsService := mocks.NewBuildingsServiceMock(mc).
+		GetBuildingsNearbyMock.Set(func(_ context.Context, center geo.Point, radius float64, limit int) ([]*storage.NearbyBuilding, error) {
+		assert.Equal(t, geo.Point{Lat: 32.82, Lon: 35}, center)
+		assert.Equal(t, 2000.0, radius)
+		return []*storage.NearbyBuilding{{Building: building, DistanceM: 152.5}}, nil
+	}).
+		GetBuildingsInBoxMock.Set(func(_ context.Context, box geo.Box, limit int) (models.BuildingSlice, error) {
+		assert.Equal(t, geo.Box{MinLat: 32.78, MinLon: 34.95, MaxLat: 32.83, MaxLon: 35.02}, box)
+		assert.Equal(t, buildings.DefaultGeoLimit, limit)
+		return models.BuildingSlice{building}, nil
+	})
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	const (
+		v1Building = `{"id":1,"name":"Meridor","address":"HaMishlatim 4, Haifa, IL","street":null,"house_number":null,"city":null,
+			"postal_code":null,"country":null,"latitude":32.8191,"longitude":34.9983,"tenant_id":"default"}`
+		v2Building = `{"id":1,"name":"Meridor","address":"HaMishlatim 4, Haifa, IL","street":null,"house_number":null,"city":null,
+			"postal_code":null,"country":null,"latitude":32.8191,"longitude":34.9983}`
+		feature = `{"type":"Feature","id":1,"geometry":{"type":"Point","coordinates":[34.9983,32.8191]},
+			"properties":{"name":"Meridor","address":"HaMishlatim 4, Haifa, IL"%s}}`
+	)
+
+	tests := []struct {
+		path            string
+		accept          string
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			path:       "/v1/buildings/nearby?lat=32.82&lon=35&radius_m=2000",
+			wantStatus: 200, wantContentType: fiber.MIMEApplicationJSON,
+			wantBody: `{"result":"success","response":[{"building":` + v1Building + `,"distance_m":152.5}]}`,
+		},
+		{
+			path: "/v1/buildings/nearby?lat=32.82&lon=35&radius_m=2000", accept: middleware.MIMEApplicationGeoJSON,
+			wantStatus: 200, wantContentType: middleware.MIMEApplicationGeoJSON,
+			wantBody: `{"type":"FeatureCollection","features":[` + fmt.Sprintf(feature, `,"distance_m":152.5`) + `]}`,
+		},
+		{path: "/v1/buildings/nearby?lat=91&lon=35&radius_m=2000", wantStatus: 400},
+		{path: "/v1/buildings/nearby?lat=32.82&lon=35", wantStatus: 400},
+		{
+			path:       "/v1/buildings/within?bbox=34.95,32.78,35.02,32.83",
+			wantStatus: 200, wantContentType: fiber.MIMEApplicationJSON,
+			wantBody: `{"result":"success","response":[` + v1Building + `]}`,
+		},
+		{
+			path:       "/v2/buildings/nearby?lat=32.82&lon=35&radius_m=2000&limit=10",
+			wantStatus: 200, wantContentType: fiber.MIMEApplicationJSON,
+			wantBody: `{"items":[{"building":` + v2Building + `,"distance_m":152.5}]}`,
+		},
+		{path: "/v2/buildings/nearby?lat=32.82&lon=35&radius_m=200000", wantStatus: 400, wantContentType: middleware.MIMEApplicationProblemJSON},
+		{
+			path: "/v2/buildings/within?bbox=34.95,32.78,35.02,32.83", accept: middleware.MIMEApplicationGeoJSON,
+			wantStatus: 200, wantContentType: middleware.MIMEApplicationGeoJSON,
+			wantBody: `{"type":"FeatureCollection","features":[` + fmt.Sprintf(feature, "") + `]}`,
+		},
+		{path: "/v2/buildings/within?bbox=34.95,32.78,35.02", wantStatus: 400, wantContentType: middleware.MIMEApplicationProblemJSON},
+		{path: "/v2/buildings/within?bbox=34.95,32.83,35.02,32.78", wantStatus: 400, wantContentType: middleware.MIMEApplicationProblemJSON},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(fiber.MethodGet, tt.path, nil)
+		if tt.accept != "" {
+			req.Header.Set(fiber.HeaderAccept, tt.accept)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, tt.path)
+		if tt.wantContentType != "" {
+			assert.Equal(t, tt.wantContentType, resp.Header.Get(fiber.HeaderContentType), tt.path)
+		}
+
+		if tt.wantBody != "" {
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body), tt.path)
+		}
+	}
+}
+
+func Test_Floors(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	area := 420
+	ground := &storage.Floor{ID: 5, BuildingID: 1, Number: 0, Label: "G", SQMeters: &area, Accessible: true}
+	basement := &storage.Floor{ID: 4, BuildingID: 1, Number: -1, Label: "B1"}
+	floorsService := mocks.NewFloorsServiceMock(mc).
+		GetFloorsMock.Return([]*storage.Floor{basement, ground}, nil).
+		GetFloorMock.Set(func(_ context.Context, buildingId int, number int) (*storage.Floor, error) {
+		if number == -1 {
+			return basement, nil
+		}
+		return nil, fmt.Errorf("%w: no floor [%v] in building [%v]", service.ErrNotFound, number, buildingId)
+	}).
+		CreateFloorMock.Set(func(_ context.Context, floor *storage.Floor) error {
+		assert.Equal(t, 1, floor.BuildingID)
+		floor.ID = 6
+		if floor.Label == "" {
+			floor.Label = strconv.Itoa(floor.Number)
+		}
+		return nil
+	}).
+		DeleteFloorMock.Set(func(_ context.Context, buildingId int, number int) error {
+		if number == 0 {
+			return fmt.Errorf("%w: 2 apartments are on floor [0] of building [1]", service.ErrConflict)
+		}
+		return nil
+	})
+	apartmentsService := mocks.NewApartmentsServiceMock(mc).
+		GetApartmentsOnFloorMock.
+		Expect(minimock.AnyContext, 1, -1).
+		Return(models.ApartmentSlice{{ID: 3, BuildingID: 1, Number: null.StringFrom("B1-1"), Floor: null.IntFrom(-1), TenantID: "default"}}, nil)
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, floorsService, nil, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, floorsService, nil, nil),
+		nil,
+		nil,
+	)
+
+	const (
+		groundJSON   = `{"id":5,"building_id":1,"number":0,"label":"G","sq_meters":420,"accessible":true}`
+		basementJSON = `{"id":4,"building_id":1,"number":-1,"label":"B1","sq_meters":null,"accessible":false}`
+	)
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			method: fiber.MethodGet, path: "/v1/buildings/1/floors",
+			wantStatus: 200, wantBody: `{"result":"success","response":[` + basementJSON + `,` + groundJSON + `]}`,
+		},
+		{
+			method: fiber.MethodGet, path: "/v1/buildings/1/floors/-1",
+			wantStatus: 200, wantBody: `{"result":"success","response":` + basementJSON + `}`,
+		},
+		{
+			method: fiber.MethodGet, path: "/v1/buildings/1/floors/-1/apartments",
+			wantStatus: 200,
+			wantBody:   `{"result":"success","response":[{"id":3,"building_id":1,"number":"B1-1","floor":-1,"sq_meters":null,"tenant_id":"default"}]}`,
+		},
+		{method: fiber.MethodGet, path: "/v1/buildings/1/floors/ground", wantStatus: 400},
+		{
+			method: fiber.MethodPost, path: "/v1/buildings/1/floors", body: `{"number":3,"accessible":true}`,
+			wantStatus: 200, wantBody: `{"result":"success"}`,
+		},
+		{method: fiber.MethodPost, path: "/v1/buildings/1/floors", body: `{"label":"3"}`, wantStatus: 400},
+		{method: fiber.MethodDelete, path: "/v1/buildings/1/floors/0", wantStatus: 409},
+		{
+			method: fiber.MethodGet, path: "/v2/buildings/1/floors",
+			wantStatus: 200, wantBody: `{"items":[` + basementJSON + `,` + groundJSON + `]}`,
+		},
+		{method: fiber.MethodGet, path: "/v2/buildings/1/floors/7", wantStatus: 404},
+		{
+			method: fiber.MethodGet, path: "/v2/buildings/1/floors/-1/apartments",
+			wantStatus: 200, wantBody: `{"items":[{"id":3,"building_id":1,"number":"B1-1","floor":-1,"sq_meters":null}]}`,
+		},
+		{
+			method: fiber.MethodPut, path: "/v2/buildings/1/floors/2", body: `{"sq_meters":380}`,
+			wantStatus: 200, wantBody: `{"id":6,"building_id":1,"number":2,"label":"2","sq_meters":380,"accessible":false}`,
+		},
+		{method: fiber.MethodDelete, path: "/v2/buildings/1/floors/2", wantStatus: 204},
+		{
+			method: fiber.MethodDelete, path: "/v2/buildings/1/floors/0", wantStatus: 409,
+			wantBody: `{"type":"about:blank","title":"Conflict","status":409,"detail":"conflict: 2 apartments are on floor [0] of building [1]"}`,
+		},
+		{method: fiber.MethodGet, path: "/v2/buildings/0/floors", wantStatus: 400},
+	}
+
+	for _, tt := range tests {
+		var body io.Reader
+		if tt.body != "" {
+			body = strings.NewReader(tt.body)
+		}
+		req := httptest.NewRequest(tt.method, tt.path, body)
+		if tt.body != "" {
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, "%s %s", tt.method, tt.path)
+
+		if tt.wantBody != "" {
+			got, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(got), "%s %s", tt.method, tt.path)
+		}
+	}
+}
+
+func Test_Residents(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	email := "ada@example.com"
+	moveOut := storage.NewDate(2025, time.December, 31)
+	ada := &storage.Resident{ID: 3, ApartmentID: 7, BuildingID: 1, Name: "Ada Lovelace", Email: &email, PrimaryContact: true, MoveIn: storage.NewDate(2026, time.January, 1)}
+	charles := &storage.Resident{ID: 2, ApartmentID: 7, BuildingID: 1, Name: "Charles Babbage", MoveIn: storage.NewDate(2020, time.March, 1), MoveOut: &moveOut}
+	list := func(_ context.Context, id int, history bool) ([]*storage.Resident, error) {
+		if id != 1 && id != 7 {
+			return nil, fmt.Errorf("%w: no apartment with id [%v]", service.ErrNotFound, id)
+		}
+		if history {
+			return []*storage.Resident{charles, ada}, nil
+		}
+		return []*storage.Resident{ada}, nil
+	}
+	residentsService := mocks.NewResidentsServiceMock(mc).
+		GetApartmentResidentsMock.Set(list).
+		GetBuildingResidentsMock.Set(list).
+		GetResidentMock.Set(func(_ context.Context, id int) (*storage.Resident, error) {
+		if id == 3 {
+			return ada, nil
+		}
+		return nil, fmt.Errorf("%w: no resident with id [%v]", service.ErrNotFound, id)
+	}).
+		CreateResidentMock.Set(func(_ context.Context, resident *storage.Resident) error {
+		assert.Equal(t, storage.NewDate(2026, time.January, 1), resident.MoveIn)
+		if resident.ID == 0 {
+			resident.ID = 4
+		}
+		resident.BuildingID = 1
+		return nil
+	}).
+		DeleteResidentMock.Return(nil)
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, residentsService, nil),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, residentsService, nil),
+		nil,
+		nil,
+	)
+
+	const (
+		adaJSON = `{"id":3,"apartment_id":7,"building_id":1,"name":"Ada Lovelace","email":"ada@example.com","phone":null,
+			"primary_contact":true,"move_in":"2026-01-01","move_out":null}`
+		charlesJSON = `{"id":2,"apartment_id":7,"building_id":1,"name":"Charles Babbage","email":null,"phone":null,
+			"primary_contact":false,"move_in":"2020-03-01","move_out":"2025-12-31"}`
+	)
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			method: fiber.MethodGet, path: "/v1/apartments/7/residents",
+			wantStatus: 200, wantBody: `{"result":"success","response":[` + adaJSON + `]}`,
+		},
+		{
+			method: fiber.MethodGet, path: "/v1/buildings/1/residents?history=true",
+			wantStatus: 200, wantBody: `{"result":"success","response":[` + charlesJSON + `,` + adaJSON + `]}`,
+		},
+		{
+			method: fiber.MethodGet, path: "/v1/residents/3",
+			wantStatus: 200, wantBody: `{"result":"success","response":` + adaJSON + `}`,
+		},
+		{
+			method: fiber.MethodPost, path: "/v1/residents", body: `{"apartment_id":7,"name":"Ada","move_in":"2026-01-01"}`,
+			wantStatus: 200, wantBody: `{"result":"success"}`,
+		},
+		{method: fiber.MethodPost, path: "/v1/residents", body: `{"apartment_id":7,"name":"Ada","move_in":"01/01/2026"}`, wantStatus: 400},
+		{method: fiber.MethodDelete, path: "/v1/residents/3", wantStatus: 200},
+		{
+			method: fiber.MethodGet, path: "/v2/apartments/7/residents?history=true",
+			wantStatus: 200, wantBody: `{"items":[` + charlesJSON + `,` + adaJSON + `]}`,
+		},
+		{method: fiber.MethodGet, path: "/v2/apartments/8/residents", wantStatus: 404},
+		{
+			method: fiber.MethodGet, path: "/v2/buildings/1/residents",
+			wantStatus: 200, wantBody: `{"items":[` + adaJSON + `]}`,
+		},
+		{method: fiber.MethodGet, path: "/v2/residents/3", wantStatus: 200, wantBody: adaJSON},
+		{method: fiber.MethodGet, path: "/v2/residents/9", wantStatus: 404},
+		{
+			method: fiber.MethodPut, path: "/v2/residents/5", body: `{"apartment_id":7,"name":"Grace Hopper","move_in":"2026-01-01"}`,
+			wantStatus: 200, wantBody: `{"id":5,"apartment_id":7,"building_id":1,"name":"Grace Hopper","email":null,"phone":null,
+				"primary_contact":false,"move_in":"2026-01-01","move_out":null}`,
+		},
+		{method: fiber.MethodPut, path: "/v2/residents/5", body: `{"apartment_id":7,"name":"Grace Hopper","move_in":"2026-13-01"}`, wantStatus: 400},
+		{method: fiber.MethodDelete, path: "/v2/residents/3", wantStatus: 204},
+	}
+
+	for _, tt := range tests {
+		var body io.Reader
+		if tt.body != "" {
+			body = strings.NewReader(tt.body)
+		}
+		req := httptest.NewRequest(tt.method, tt.path, body)
+		if tt.body != "" {
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, "%s %s", tt.method, tt.path)
+
+		if tt.wantBody != "" {
+			got, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(got), "%s %s", tt.method, tt.path)
+		}
+	}
+}
+
+func Test_Leases(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	endDate := storage.NewDate(2026, time.December, 31)
+	lease := func() *storage.Lease {
+		return &storage.Lease{
+			ID: 3, ApartmentID: 7, BuildingID: 1, Lessee: "Ada Lovelace", StartDate: storage.NewDate(2026, time.January, 1), EndDate: &endDate,
+			MonthlyRent: 120000, Deposit: 240000, Currency: "EUR", Indexation: storage.IndexationNone, Status: storage.LeaseActive,
+		}
+	}
+	leasesService := mocks.NewLeasesServiceMock(mc).
+		GetApartmentLeasesMock.Set(func(_ context.Context, apartmentId int) ([]*storage.Lease, error) {
+		if apartmentId != 7 {
+			return nil, fmt.Errorf("%w: no apartment with id [%v]", service.ErrNotFound, apartmentId)
+		}
+		return []*storage.Lease{lease()}, nil
+	}).
+		GetExpiringLeasesMock.Set(func(_ context.Context, days int) ([]*storage.Lease, error) {
+		if days > leases.MaxExpiringDays {
+			return nil, fmt.Errorf("%w: days must be between 0 and %d", service.ErrInvalid, leases.MaxExpiringDays)
+		}
+		return []*storage.Lease{lease()}, nil
+	}).
+		GetLeaseMock.Return(lease(), nil).
+		CreateLeaseMock.Set(func(_ context.Context, l *storage.Lease) error {
+		if l.ID == 0 {
+			l.ID = 4
+		}
+		l.BuildingID, l.Indexation, l.Status = 1, storage.IndexationNone, storage.LeaseDraft
+		return nil
+	}).
+		RenewLeaseMock.Set(func(_ context.Context, id int, renewal *leases.Renewal) (*storage.Lease, error) {
+		renewed := lease()
+		renewed.ID, renewed.StartDate, renewed.EndDate, renewed.MonthlyRent, renewed.RenewalOf = 4, endDate.AddDays(1), renewal.EndDate, *renewal.MonthlyRent, &id
+		return renewed, nil
+	}).
+		TerminateLeaseMock.Set(func(_ context.Context, id int, termination *leases.Termination) (*storage.Lease, error) {
+		terminated := lease()
+		terminated.EndDate, terminated.Status = &termination.EndDate, storage.LeaseTerminated
+		return terminated, nil
+	}).
+		DeleteLeaseMock.Set(func(_ context.Context, id int) error {
+		if id == 3 {
+			return fmt.Errorf("%w: lease [3] is active, only a draft is deleted", service.ErrInvalid)
+		}
+		return nil
+	})
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	app := newTestAppWith(
+		bms.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, leasesService),
+		bmsv2.NewBuildingManagementSystem(apartmentsService, buildingsService, nil, nil, nil, leasesService),
+		nil,
+		nil,
+	)
+
+	const leaseJSON = `{"id":3,"apartment_id":7,"building_id":1,"lessee":"Ada Lovelace","start_date":"2026-01-01","end_date":"2026-12-31",
+		"monthly_rent":120000,"deposit":240000,"currency":"EUR","indexation":"none","indexation_rate":null,"status":"active","renewal_of":null}`
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			method: fiber.MethodGet, path: "/v1/apartments/7/leases",
+			wantStatus: 200, wantBody: `{"result":"success","response":[` + leaseJSON + `]}`,
+		},
+		{
+			method: fiber.MethodGet, path: "/v1/leases/expiring?days=60",
+			wantStatus: 200, wantBody: `{"result":"success","response":[` + leaseJSON + `]}`,
+		},
+		{method: fiber.MethodGet, path: "/v1/leases/expiring?days=4000", wantStatus: 400},
+		{
+			method: fiber.MethodGet, path: "/v1/leases/3",
+			wantStatus: 200, wantBody: `{"result":"success","response":` + leaseJSON + `}`,
+		},
+		{
+			method: fiber.MethodPost, path: "/v1/leases",
+			body:       `{"apartment_id":7,"lessee":"Ada","start_date":"2026-01-01","monthly_rent":120000,"currency":"EUR"}`,
+			wantStatus: 200, wantBody: `{"result":"success"}`,
+		},
+		{
+			method: fiber.MethodPost, path: "/v1/leases",
+			body:       `{"apartment_id":7,"lessee":"Ada","start_date":"2026-01-01","monthly_rent":120000,"currency":"EUR","status":"signed"}`,
+			wantStatus: 400,
+		},
+		{
+			method: fiber.MethodPost, path: "/v1/leases/3/terminate", body: `{"end_date":"2026-06-30"}`,
+			wantStatus: 200,
+			wantBody:   `{"result":"success","response":` + strings.Replace(strings.Replace(leaseJSON, `"2026-12-31"`, `"2026-06-30"`, 1), `"active"`, `"terminated"`, 1) + `}`,
+		},
+		{method: fiber.MethodDelete, path: "/v1/leases/3", wantStatus: 400},
+		{
+			method: fiber.MethodGet, path: "/v2/apartments/7/leases",
+			wantStatus: 200, wantBody: `{"items":[` + leaseJSON + `]}`,
+		},
+		{method: fiber.MethodGet, path: "/v2/apartments/8/leases", wantStatus: 404},
+		{method: fiber.MethodGet, path: "/v2/leases/expiring", wantStatus: 200, wantBody: `{"items":[` + leaseJSON + `]}`},
+		{method: fiber.MethodGet, path: "/v2/leases/3", wantStatus: 200, wantBody: leaseJSON},
+		{
+			method: fiber.MethodPut, path: "/v2/leases/5",
+			body:       `{"apartment_id":7,"lessee":"Grace Hopper","start_date":"2027-01-01","monthly_rent":90000,"currency":"USD"}`,
+			wantStatus: 200,
+			wantBody: `{"id":5,"apartment_id":7,"building_id":1,"lessee":"Grace Hopper","start_date":"2027-01-01","end_date":null,
+				"monthly_rent":90000,"deposit":0,"currency":"USD","indexation":"none","indexation_rate":null,"status":"draft","renewal_of":null}`,
+		},
+		{
+			method: fiber.MethodPut, path: "/v2/leases/5",
+			body:       `{"apartment_id":7,"lessee":"Grace Hopper","start_date":"2027-01-01","monthly_rent":90000,"currency":"USD","indexation":"rpi"}`,
+			wantStatus: 400,
+		},
+		{
+			method: fiber.MethodPost, path: "/v2/leases/3/renew", body: `{"end_date":"2027-12-31","monthly_rent":125000}`,
+			wantStatus: 201,
+			wantBody: `{"id":4,"apartment_id":7,"building_id":1,"lessee":"Ada Lovelace","start_date":"2027-01-01","end_date":"2027-12-31",
+				"monthly_rent":125000,"deposit":240000,"currency":"EUR","indexation":"none","indexation_rate":null,"status":"active","renewal_of":3}`,
+		},
+		{method: fiber.MethodPost, path: "/v2/leases/3/terminate", body: `{}`, wantStatus: 400},
+		{method: fiber.MethodDelete, path: "/v2/leases/4", wantStatus: 204},
+	}
+
+	for _, tt := range tests {
+		var body io.Reader
+		if tt.body != "" {
+			body = strings.NewReader(tt.body)
+		}
+		req := httptest.NewRequest(tt.method, tt.path, body)
+		if tt.body != "" {
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantStatus, resp.StatusCode, "%s %s", tt.method, tt.path)
+
+		if tt.wantBody != "" {
+			got, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(got), "%s %s", tt.method, tt.path)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package geo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+)
+
+// gazetteerColumns are the columns of a gazetteer file, in any order. Every place has a city or a
+// postal code, the other components may be empty, e.g. for the cities as a whole.
+var gazetteerColumns = []string{"country", "postal_code", "city", "street", "house_number", "latitude", "longitude"}
+
+// Gazetteer geocodes the addresses offline, from the places of a file loaded in memory.
+type Gazetteer struct {
+	places map[place]Point
+}
+
+// place is the key of a position, the lower case normalised components of its address.
+type place struct {
+	country     string
+	postalCode  string
+	city        string
+	street      string
+	houseNumber string
+}
+
+// LoadGazetteer reads the gazetteer file at path, see ReadGazetteer.
+func LoadGazetteer(path string) (*Gazetteer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	g, err := ReadGazetteer(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gazetteer %s: %w", path, err)
+	}
+	return g, nil
+}
+
+// ReadGazetteer reads a gazetteer in CSV with a header row naming the columns, e.g.
+//
+//	country,postal_code,city,street,house_number,latitude,longitude
+//	IL,,Haifa,HaMishlatim,4,32.8191,34.9983
+//	IL,,Haifa,,,32.7940,34.9896
+//
+// The addresses are normalised like those of the buildings, the first position of an address wins.
+func ReadGazetteer(r io.Reader) (*Gazetteer, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("no header")
+	}
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range gazetteerColumns {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("no %s column", name)
+		}
+	}
+
+	g := &Gazetteer{places: make(map[place]Point)}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return g, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		column := func(name string) string { return record[index[name]] }
+
+		var p Point
+		p.Lat, err = strconv.ParseFloat(strings.TrimSpace(column("latitude")), 64)
+		if err == nil {
+			p.Lon, err = strconv.ParseFloat(strings.TrimSpace(column("longitude")), 64)
+		}
+		if err == nil {
+			err = p.Validate()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		a, err := address.Address{
+			Street:      column("street"),
+			HouseNumber: column("house_number"),
+			City:        column("city"),
+			PostalCode:  column("postal_code"),
+			Country:     column("country"),
+		}.Normalize()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if a.City == "" && a.PostalCode == "" {
+			return nil, fmt.Errorf("line %d: no city nor postal code", line)
+		}
+		if _, ok := g.places[placeOf(a)]; !ok {
+			g.places[placeOf(a)] = p
+		}
+	}
+}
+
+// Len returns the number of places of the gazetteer.
+func (g *Gazetteer) Len() int {
+	return len(g.places)
+}
+
+// Geocode returns the position of the most precise place of the gazetteer matching the address:
+// the house, the street, the postal code and then the city. The postal code is optional for the
+// houses and the streets, the gazetteers often leave it out. The address should be normalised.
+func (g *Gazetteer) Geocode(a address.Address) (Point, bool) {
+	key := placeOf(a)
+	for _, k := range []place{
+		key,
+		{country: key.country, city: key.city, street: key.street, houseNumber: key.houseNumber},
+		{country: key.country, postalCode: key.postalCode, city: key.city, street: key.street},
+		{country: key.country, city: key.city, street: key.street},
+		{country: key.country, postalCode: key.postalCode},
+		{country: key.country, city: key.city},
+	} {
+		// A street alone or a country alone is ambiguous.
+		if k.city == "" && k.postalCode == "" {
+			continue
+		}
+		if p, ok := g.places[k]; ok {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+func placeOf(a address.Address) place {
+	return place{
+		country:     strings.ToLower(a.Country),
+		postalCode:  strings.ToLower(a.PostalCode),
+		city:        strings.ToLower(a.City),
+		street:      strings.ToLower(a.Street),
+		houseNumber: strings.ToLower(a.HouseNumber),
+	}
+}
@@ -0,0 +1,147 @@
+package geo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+)
+
+const testGazetteer = `longitude,latitude,country,city,postal_code,street,house_number
+34.9983,32.8191,Israel,Haifa,,HaMishlatim,4
+34.9990,32.8180,IL,Haifa,,HaMishlatim,
+34.9896,32.7940,IL,haifa,,,
+35.0000,33.0000,IL,Haifa,,,
+13.3889,52.5170,DE,Berlin,10117,Unter den Linden,77
+13.3900,52.5160,DE,,10117,,
+-89.6501,39.7817,US,Springfield,62701,Main St.,
+`
+
+func Test_ReadGazetteer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr string
+	}{
+		{name: "empty", csv: "", wantErr: "no header"},
+		{name: "missing column", csv: "country,city,latitude,longitude\n", wantErr: "no postal_code column"},
+		{
+			name:    "bad latitude",
+			csv:     "country,postal_code,city,street,house_number,latitude,longitude\nIL,,Haifa,,,north,34.99\n",
+			wantErr: `line 2: strconv.ParseFloat: parsing "north": invalid syntax`,
+		},
+		{
+			name:    "out of range",
+			csv:     "country,postal_code,city,street,house_number,latitude,longitude\nIL,,Haifa,,,32.79,190\n",
+			wantErr: "line 2: longitude [190] out of range",
+		},
+		{
+			name:    "unknown country",
+			csv:     "country,postal_code,city,street,house_number,latitude,longitude\nAtlantis,,Haifa,,,32.79,34.99\n",
+			wantErr: "line 2: unknown country [Atlantis]",
+		},
+		{
+			name:    "no city nor postal code",
+			csv:     "country,postal_code,city,street,house_number,latitude,longitude\nIL,,,HaMishlatim,4,32.79,34.99\n",
+			wantErr: "line 2: no city nor postal code",
+		},
+		{
+			name:    "short row",
+			csv:     "country,postal_code,city,street,house_number,latitude,longitude\nIL,,Haifa\n",
+			wantErr: "record on line 2: wrong number of fields",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := ReadGazetteer(strings.NewReader(tt.csv))
+		assert.EqualError(t, err, tt.wantErr, tt.name)
+	}
+
+	g, err := ReadGazetteer(strings.NewReader(testGazetteer))
+	require.NoError(t, err)
+	assert.Equal(t, 6, g.Len(), "the first position of Haifa wins")
+}
+
+func Test_LoadGazetteer(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "gazetteer.csv")
+	require.NoError(t, os.WriteFile(path, []byte(testGazetteer), 0o600))
+	g, err := LoadGazetteer(path)
+	require.NoError(t, err)
+	assert.Equal(t, 6, g.Len())
+
+	require.NoError(t, os.WriteFile(path, []byte("city\n"), 0o600))
+	_, err = LoadGazetteer(path)
+	assert.EqualError(t, err, "invalid gazetteer "+path+": no country column")
+
+	_, err = LoadGazetteer(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func Test_Geocode(t *testing.T) {
+	t.Parallel()
+
+	g, err := ReadGazetteer(strings.NewReader(testGazetteer))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		address address.Address
+		want    Point
+		wantOk  bool
+	}{
+		{
+			name:    "house",
+			address: address.Address{Street: "HaMishlatim", HouseNumber: "4", City: "Haifa", Country: "IL"},
+			want:    Point{32.8191, 34.9983}, wantOk: true,
+		},
+		{
+			name:    "house without the postal code of the gazetteer",
+			address: address.Address{Street: "HaMishlatim", HouseNumber: "4", City: "Haifa", PostalCode: "3303612", Country: "IL"},
+			want:    Point{32.8191, 34.9983}, wantOk: true,
+		},
+		{
+			name:    "street",
+			address: address.Address{Street: "hamishlatim", HouseNumber: "9", City: "HAIFA", Country: "IL"},
+			want:    Point{32.8180, 34.9990}, wantOk: true,
+		},
+		{
+			name:    "normalised street",
+			address: address.Address{Street: "Main Street", HouseNumber: "4", City: "Springfield", PostalCode: "62701", Country: "US"},
+			want:    Point{39.7817, -89.6501}, wantOk: true,
+		},
+		{
+			name:    "city",
+			address: address.Address{Street: "Herzl", HouseNumber: "12", City: "Haifa", Country: "IL"},
+			want:    Point{32.7940, 34.9896}, wantOk: true,
+		},
+		{
+			name:    "postal code",
+			address: address.Address{Street: "Friedrichstraße", HouseNumber: "1", City: "Berlin-Mitte", PostalCode: "10117", Country: "DE"},
+			want:    Point{52.5160, 13.3900}, wantOk: true,
+		},
+		{
+			name:    "other country",
+			address: address.Address{City: "Haifa", Country: "DE"},
+		},
+		{
+			name:    "street without a city",
+			address: address.Address{Street: "HaMishlatim", HouseNumber: "4", Country: "IL"},
+		},
+		{name: "empty"},
+	}
+
+	for _, tt := range tests {
+		p, ok := g.Geocode(tt.address)
+		assert.Equal(t, tt.wantOk, ok, tt.name)
+		assert.Equal(t, tt.want, p, tt.name)
+	}
+}
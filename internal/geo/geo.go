@@ -0,0 +1,134 @@
+// Package geo locates the buildings: the distances and the bounding boxes of the geospatial
+// searches, their GeoJSON rendering and the offline geocoding of their addresses.
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+)
+
+// EarthRadius is the mean radius of the Earth in meters.
+const EarthRadius = 6371008.8
+
+// Point is a WGS 84 position in degrees.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Validate fails for the coordinates out of range.
+func (p Point) Validate() error {
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+		return fmt.Errorf("latitude [%v] out of range", p.Lat)
+	}
+	if math.IsNaN(p.Lon) || p.Lon < -180 || p.Lon > 180 {
+		return fmt.Errorf("longitude [%v] out of range", p.Lon)
+	}
+	return nil
+}
+
+// Distance returns the great-circle distance between the points in meters, with the haversine
+// formula. The error of the spherical model is below 0.5%.
+func Distance(a, b Point) float64 {
+	dLat := radians(b.Lat - a.Lat)
+	dLon := radians(b.Lon - a.Lon)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(radians(a.Lat))*math.Cos(radians(b.Lat))*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// Box is a bounding box in degrees. A box crossing the antimeridian has MinLon above MaxLon, as
+// in GeoJSON.
+type Box struct {
+	MinLat float64
+	MinLon float64
+	MaxLat float64
+	MaxLon float64
+}
+
+// Validate fails for the coordinates out of range and the boxes whose south is above their north.
+func (b Box) Validate() error {
+	for _, p := range []Point{{b.MinLat, b.MinLon}, {b.MaxLat, b.MaxLon}} {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	if b.MinLat > b.MaxLat {
+		return errors.New("minimum latitude above the maximum latitude")
+	}
+	return nil
+}
+
+// ParseBox parses a box in the order of GeoJSON and of the bbox query parameters of the map
+// clients, "west,south,east,north", e.g. "34.95,32.78,35.02,32.83".
+func ParseBox(s string) (Box, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return Box{}, fmt.Errorf("bbox [%v] isn't west,south,east,north", s)
+	}
+	var coordinates [4]float64
+	for i, p := range parts {
+		c, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return Box{}, fmt.Errorf("bbox [%v] isn't west,south,east,north", s)
+		}
+		coordinates[i] = c
+	}
+
+	box := Box{MinLon: coordinates[0], MinLat: coordinates[1], MaxLon: coordinates[2], MaxLat: coordinates[3]}
+	if err := box.Validate(); err != nil {
+		return Box{}, err
+	}
+	return box, nil
+}
+
+// Contains reports whether the point is in the box, its edges included.
+func (b Box) Contains(p Point) bool {
+	if p.Lat < b.MinLat || p.Lat > b.MaxLat {
+		return false
+	}
+	if b.MinLon <= b.MaxLon {
+		return p.Lon >= b.MinLon && p.Lon <= b.MaxLon
+	}
+	return p.Lon >= b.MinLon || p.Lon <= b.MaxLon
+}
+
+// BoxAround returns a box containing the points within radius meters of the center, to narrow
+// the searches by distance down with the indexes. It spans every longitude near the poles.
+func BoxAround(center Point, radius float64) Box {
+	dLat := degrees(radius / EarthRadius)
+	box := Box{MinLat: center.Lat - dLat, MinLon: -180, MaxLat: center.Lat + dLat, MaxLon: 180}
+	if box.MinLat <= -90 || box.MaxLat >= 90 {
+		box.MinLat, box.MaxLat = math.Max(box.MinLat, -90), math.Min(box.MaxLat, 90)
+		return box
+	}
+
+	// The longitudes are bounded by the meridians tangent to the circle.
+	dLon := degrees(math.Asin(math.Min(math.Sin(radius/EarthRadius)/math.Cos(radians(center.Lat)), 1)))
+	box.MinLon, box.MaxLon = center.Lon-dLon, center.Lon+dLon
+	if box.MinLon < -180 {
+		box.MinLon += 360
+	}
+	if box.MaxLon > 180 {
+		box.MaxLon -= 360
+	}
+	return box
+}
+
+// Geocoder resolves the addresses to their position, the hook of the buildings service.
+type Geocoder interface {
+	// Geocode returns the position of the address and whether it is known.
+	Geocode(a address.Address) (Point, bool)
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
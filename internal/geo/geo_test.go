@@ -0,0 +1,121 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Distance(t *testing.T) {
+	t.Parallel()
+
+	degree := EarthRadius * math.Pi / 180
+	tests := []struct {
+		a, b Point
+		want float64
+	}{
+		{a: Point{32.8191, 34.9983}, b: Point{32.8191, 34.9983}, want: 0},
+		{a: Point{0, 0}, b: Point{1, 0}, want: degree},
+		{a: Point{0, 0}, b: Point{0, 1}, want: degree},
+		{a: Point{0, 179.5}, b: Point{0, -179.5}, want: degree},
+		{a: Point{89.5, 0}, b: Point{89.5, 180}, want: degree},
+		{a: Point{0, 0}, b: Point{0, 180}, want: math.Pi * EarthRadius},
+	}
+
+	for _, tt := range tests {
+		assert.InDelta(t, tt.want, Distance(tt.a, tt.b), 1e-6, "%v %v", tt.a, tt.b)
+		assert.InDelta(t, tt.want, Distance(tt.b, tt.a), 1e-6, "%v %v", tt.b, tt.a)
+	}
+}
+
+func Test_ParseBox(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		want    Box
+		wantErr string
+	}{
+		{s: "34.95,32.78,35.02,32.83", want: Box{MinLat: 32.78, MinLon: 34.95, MaxLat: 32.83, MaxLon: 35.02}},
+		{s: " 179, -1, -179, 1", want: Box{MinLat: -1, MinLon: 179, MaxLat: 1, MaxLon: -179}},
+		{s: "", wantErr: "bbox [] isn't west,south,east,north"},
+		{s: "34.95,32.78,35.02", wantErr: "bbox [34.95,32.78,35.02] isn't west,south,east,north"},
+		{s: "34.95,north,35.02,32.83", wantErr: "bbox [34.95,north,35.02,32.83] isn't west,south,east,north"},
+		{s: "34.95,32.83,35.02,32.78", wantErr: "minimum latitude above the maximum latitude"},
+		{s: "34.95,-91,35.02,32.83", wantErr: "latitude [-91] out of range"},
+		{s: "-181,32.78,35.02,32.83", wantErr: "longitude [-181] out of range"},
+		{s: "34.95,NaN,35.02,32.83", wantErr: "latitude [NaN] out of range"},
+	}
+
+	for _, tt := range tests {
+		box, err := ParseBox(tt.s)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, tt.s)
+			assert.Zero(t, box, tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		assert.Equal(t, tt.want, box, tt.s)
+	}
+}
+
+func Test_BoxContains(t *testing.T) {
+	t.Parallel()
+
+	haifa := Box{MinLat: 32.78, MinLon: 34.95, MaxLat: 32.83, MaxLon: 35.02}
+	fiji := Box{MinLat: -19, MinLon: 177, MaxLat: -16, MaxLon: -179}
+
+	assert.True(t, haifa.Contains(Point{32.8191, 34.9983}))
+	assert.True(t, haifa.Contains(Point{32.78, 35.02}))
+	assert.False(t, haifa.Contains(Point{32.0853, 34.7818}))
+	assert.False(t, haifa.Contains(Point{32.8191, 35.1}))
+	assert.True(t, fiji.Contains(Point{-18.1, 178.4}))
+	assert.True(t, fiji.Contains(Point{-16.8, -179.9}))
+	assert.False(t, fiji.Contains(Point{-18.1, 0}))
+	assert.False(t, fiji.Contains(Point{-20, 178.4}))
+}
+
+func Test_BoxAround(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		center Point
+		radius float64
+	}{
+		{name: "haifa", center: Point{32.8191, 34.9983}, radius: 2000},
+		{name: "equator", center: Point{0, 0}, radius: 100_000},
+		{name: "antimeridian", center: Point{-18.1, 179.99}, radius: 10_000},
+		{name: "antimeridian west", center: Point{-18.1, -179.99}, radius: 10_000},
+		{name: "north pole", center: Point{89.99, 10}, radius: 5000},
+		{name: "south pole", center: Point{-90, 0}, radius: 1000},
+	}
+
+	for _, tt := range tests {
+		box := BoxAround(tt.center, tt.radius)
+		require.NoError(t, box.Validate(), tt.name)
+		assert.True(t, box.Contains(tt.center), tt.name)
+
+		// Every point of the circle is in the box.
+		for bearing := 0.0; bearing < 360; bearing += 5 {
+			p := destination(tt.center, bearing, tt.radius*0.999)
+			assert.True(t, box.Contains(p), "%s: %v at %v", tt.name, p, bearing)
+		}
+	}
+
+	box := BoxAround(Point{-18.1, 179.99}, 10_000)
+	assert.Greater(t, box.MinLon, box.MaxLon, "crosses the antimeridian")
+	box = BoxAround(Point{89.99, 10}, 5000)
+	assert.Equal(t, Box{MinLat: box.MinLat, MinLon: -180, MaxLat: 90, MaxLon: 180}, box)
+}
+
+// destination returns the point at distance meters of p on the bearing in degrees.
+func destination(p Point, bearing, distance float64) Point {
+	d := distance / EarthRadius
+	lat, lon, b := radians(p.Lat), radians(p.Lon), radians(bearing)
+	lat2 := math.Asin(math.Sin(lat)*math.Cos(d) + math.Cos(lat)*math.Sin(d)*math.Cos(b))
+	lon2 := lon + math.Atan2(math.Sin(b)*math.Sin(d)*math.Cos(lat), math.Cos(d)-math.Sin(lat)*math.Sin(lat2))
+	return Point{Lat: degrees(lat2), Lon: math.Remainder(degrees(lon2), 360)}
+}
@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+// The GeoJSON (RFC 7946) types, the buildings are points.
+const (
+	TypeFeatureCollection = "FeatureCollection"
+	TypeFeature           = "Feature"
+	TypePoint             = "Point"
+)
+
+// FeatureCollection is a GeoJSON feature collection of buildings.
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+// Feature is a building as a GeoJSON feature.
+type Feature struct {
+	Type       string             `json:"type"`
+	ID         int                `json:"id"`
+	Geometry   Geometry           `json:"geometry"`
+	Properties BuildingProperties `json:"properties"`
+}
+
+// Geometry is a GeoJSON point, its coordinates are the longitude then the latitude.
+type Geometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// BuildingProperties are the properties of the feature of a building.
+type BuildingProperties struct {
+	Name    string  `json:"name"`
+	Address *string `json:"address"`
+	// DistanceM is the distance to the center of the search in meters, only for the searches by distance.
+	DistanceM *float64 `json:"distance_m,omitempty"`
+}
+
+// NewFeatureCollection returns the collection of the features, empty rather than null without any.
+func NewFeatureCollection(features []*Feature) *FeatureCollection {
+	if features == nil {
+		features = []*Feature{}
+	}
+	return &FeatureCollection{Type: TypeFeatureCollection, Features: features}
+}
+
+// NewFeature returns the feature of a building with coordinates, distance is nil unless the
+// building was searched by distance.
+func NewFeature(b *models.Building, distance *float64) *Feature {
+	return &Feature{
+		Type: TypeFeature,
+		ID:   b.ID,
+		Geometry: Geometry{
+			Type:        TypePoint,
+			Coordinates: []float64{b.Longitude.Float64, b.Latitude.Float64},
+		},
+		Properties: BuildingProperties{
+			Name:      b.Name,
+			Address:   b.Address.Ptr(),
+			DistanceM: distance,
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Ping checks that the dependency answers a ping.
+func Ping(p Pinger) CheckFunc {
+	return p.Ping
+}
+
+// PendingMigrations fails while there are migrations that are not applied yet.
+func PendingMigrations(pending func(ctx context.Context) ([]string, error)) CheckFunc {
+	return func(ctx context.Context) error {
+		versions, err := pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(versions) > 0 {
+			return fmt.Errorf("pending migrations: %s", strings.Join(versions, ", "))
+		}
+		return nil
+	}
+}
+
+// PoolSaturation fails when the share of the connections in use reaches threshold (0..1)
+// of the pool limit, which means that the following queries will wait for a connection.
+func PoolSaturation(stats func() sql.DBStats, threshold float64) CheckFunc {
+	return func(ctx context.Context) error {
+		s := stats()
+		if s.MaxOpenConnections <= 0 {
+			return nil
+		}
+
+		saturation := float64(s.InUse) / float64(s.MaxOpenConnections)
+		if saturation >= threshold {
+			return fmt.Errorf("connection pool is saturated: %d of %d connections in use", s.InUse, s.MaxOpenConnections)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,186 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusWarn = "warn"
+	StatusFail = "fail"
+
+	defaultTimeout = 2 * time.Second
+)
+
+type CheckFunc func(ctx context.Context) error
+
+type Check struct {
+	Name  string
+	Check CheckFunc
+	// Readiness checks must pass for the instance to receive traffic,
+	// other checks only degrade the detailed report.
+	Readiness bool
+	// Liveness checks must pass for the process to be considered alive.
+	Liveness bool
+	// Timeout bounds a single run of the check, the registry default is used when it is 0.
+	Timeout time.Duration
+}
+
+type CheckResult struct {
+	Status   string  `json:"status"`
+	Error    string  `json:"error,omitempty"`
+	Duration float64 `json:"duration_ms"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+type Registry struct {
+	mu     sync.RWMutex
+	checks []Check
+
+	timeout      time.Duration
+	shuttingDown atomic.Bool
+}
+
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Registry{
+		timeout: timeout,
+	}
+}
+
+func (r *Registry) Register(check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks = append(r.checks, check)
+}
+
+// SetShuttingDown makes every following readiness report fail, so that load balancers
+// stop sending traffic before the server starts draining connections.
+func (r *Registry) SetShuttingDown() {
+	r.shuttingDown.Store(true)
+}
+
+func (r *Registry) ShuttingDown() bool {
+	return r.shuttingDown.Load()
+}
+
+// Liveness runs the liveness checks.
+func (r *Registry) Liveness(ctx context.Context) Report {
+	return r.run(ctx, func(c Check) bool { return c.Liveness })
+}
+
+// Readiness runs the readiness checks, it fails without running them once shutdown began.
+func (r *Registry) Readiness(ctx context.Context) Report {
+	if r.ShuttingDown() {
+		return Report{
+			Status: StatusFail,
+			Checks: map[string]CheckResult{
+				"shutdown": {Status: StatusFail, Error: "shutting down"},
+			},
+		}
+	}
+
+	return r.run(ctx, func(c Check) bool { return c.Readiness })
+}
+
+// Health runs all the checks. The report fails if a readiness or liveness check fails
+// and warns if any other check fails.
+func (r *Registry) Health(ctx context.Context) Report {
+	report := r.run(ctx, func(c Check) bool { return true })
+	if r.ShuttingDown() {
+		report.Status = StatusFail
+		report.Checks["shutdown"] = CheckResult{Status: StatusFail, Error: "shutting down"}
+	}
+
+	return report
+}
+
+func (r *Registry) run(ctx context.Context, filter func(c Check) bool) Report {
+	r.mu.RLock()
+	checks := make([]Check, 0, len(r.checks))
+	for _, c := range r.checks {
+		if filter(c) {
+			checks = append(checks, c)
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c Check) {
+			defer wg.Done()
+			results[i] = r.runCheck(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status: StatusOK,
+		Checks: make(map[string]CheckResult, len(checks)),
+	}
+	for i, c := range checks {
+		result := results[i]
+		if result.Status != StatusOK {
+			if c.Readiness || c.Liveness {
+				report.Status = StatusFail
+			} else {
+				result.Status = StatusWarn
+				if report.Status == StatusOK {
+					report.Status = StatusWarn
+				}
+			}
+		}
+		report.Checks[c.Name] = result
+	}
+
+	return report
+}
+
+func (r *Registry) runCheck(ctx context.Context, c Check) (result CheckResult) {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = r.timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	defer func() {
+		result.Duration = float64(time.Since(start).Microseconds()) / 1000
+	}()
+
+	// The check runs in its own goroutine so that a check ignoring ctx can't hang the probe.
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				errCh <- fmt.Errorf("check panicked: %v", p)
+			}
+		}()
+		errCh <- c.Check(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return CheckResult{Status: StatusFail, Error: err.Error()}
+		}
+		return CheckResult{Status: StatusOK}
+	case <-ctx.Done():
+		return CheckResult{Status: StatusFail, Error: fmt.Sprintf("timed out after %v", timeout)}
+	}
+}
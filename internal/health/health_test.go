@@ -0,0 +1,195 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Readiness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		checks       []Check
+		shuttingDown bool
+		want         string
+		wantChecks   []string
+	}{
+		{
+			name: "valid",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { return nil }, Readiness: true},
+				{Name: "pool", Check: func(ctx context.Context) error { return errors.New("saturated") }},
+			},
+			want:       StatusOK,
+			wantChecks: []string{"db"},
+		},
+		{
+			name: "checkError",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { return errors.New("down") }, Readiness: true},
+			},
+			want:       StatusFail,
+			wantChecks: []string{"db"},
+		},
+		{
+			name: "checkTimeout",
+			checks: []Check{
+				{
+					Name: "db",
+					Check: func(ctx context.Context) error {
+						time.Sleep(time.Second)
+						return nil
+					},
+					Readiness: true,
+					Timeout:   10 * time.Millisecond,
+				},
+			},
+			want:       StatusFail,
+			wantChecks: []string{"db"},
+		},
+		{
+			name: "checkPanic",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { panic("boom") }, Readiness: true},
+			},
+			want:       StatusFail,
+			wantChecks: []string{"db"},
+		},
+		{
+			name: "shuttingDown",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { return nil }, Readiness: true},
+			},
+			shuttingDown: true,
+			want:         StatusFail,
+			wantChecks:   []string{"shutdown"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRegistry(time.Second)
+			for _, c := range tt.checks {
+				r.Register(c)
+			}
+			if tt.shuttingDown {
+				r.SetShuttingDown()
+			}
+
+			report := r.Readiness(context.Background())
+			assert.Equal(t, tt.want, report.Status)
+			for _, name := range tt.wantChecks {
+				assert.Contains(t, report.Checks, name)
+			}
+			assert.Len(t, report.Checks, len(tt.wantChecks))
+		})
+	}
+}
+
+func Test_Health(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		checks     []Check
+		want       map[string]string
+		wantStatus string
+	}{
+		{
+			name: "valid",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { return nil }, Readiness: true},
+				{Name: "pool", Check: func(ctx context.Context) error { return nil }},
+			},
+			want:       map[string]string{"db": StatusOK, "pool": StatusOK},
+			wantStatus: StatusOK,
+		},
+		{
+			name: "degraded",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { return nil }, Readiness: true},
+				{Name: "pool", Check: func(ctx context.Context) error { return errors.New("saturated") }},
+			},
+			want:       map[string]string{"db": StatusOK, "pool": StatusWarn},
+			wantStatus: StatusWarn,
+		},
+		{
+			name: "failed",
+			checks: []Check{
+				{Name: "db", Check: func(ctx context.Context) error { return errors.New("down") }, Readiness: true},
+				{Name: "pool", Check: func(ctx context.Context) error { return errors.New("saturated") }},
+			},
+			want:       map[string]string{"db": StatusFail, "pool": StatusWarn},
+			wantStatus: StatusFail,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRegistry(time.Second)
+			for _, c := range tt.checks {
+				r.Register(c)
+			}
+
+			report := r.Health(context.Background())
+			assert.Equal(t, tt.wantStatus, report.Status)
+			for name, status := range tt.want {
+				assert.Equal(t, status, report.Checks[name].Status, name)
+			}
+		})
+	}
+}
+
+func Test_PoolSaturation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		stats   sql.DBStats
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			stats: sql.DBStats{MaxOpenConnections: 10, InUse: 5},
+		},
+		{
+			name:  "unlimited",
+			stats: sql.DBStats{MaxOpenConnections: 0, InUse: 100},
+		},
+		{
+			name:    "saturated",
+			stats:   sql.DBStats{MaxOpenConnections: 10, InUse: 9},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			check := PoolSaturation(func() sql.DBStats { return tt.stats }, 0.9)
+			err := check(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package idempotency makes the retries of the write requests safe: the response of a request
+// sent with an Idempotency-Key is stored and replayed when the request is retried with the key.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// component tags the logs of the package, see logger.Component.
+const component = "idempotency"
+
+// MaxKeyLength bounds the length of the keys, UUIDs and the like fit easily.
+const MaxKeyLength = 255
+
+// ValidateKey checks that the key is made of 1 to MaxKeyLength printable ASCII characters.
+func ValidateKey(key string) error {
+	if key == "" || len(key) > MaxKeyLength {
+		return errors.New("idempotency key must have 1 to 255 characters")
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return errors.New("idempotency key must only have printable ASCII characters")
+		}
+	}
+	return nil
+}
+
+// RequestHash identifies the request a key was sent with, a key reused for another method,
+// path or body gets another hash.
+func RequestHash(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Sweep deletes the expired records every interval until ctx is done, it is meant to run as a worker.
+func Sweep(ctx context.Context, store storage.IdempotencyStorage, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n, err := store.DeleteExpiredIdempotencyKeys(ctx)
+		if err != nil {
+			logger.Component(component).WarnContext(ctx, "can't delete expired idempotency keys", "error", err)
+			continue
+		}
+		logger.Component(component).DebugContext(ctx, "expired idempotency keys deleted", "count", n)
+	}
+}
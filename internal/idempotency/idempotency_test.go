@@ -0,0 +1,76 @@
+package idempotency
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+func Test_ValidateKey(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateKey("0b6f6c1e-3f1d-4c8e-9a57-2d6a1f0e9b11"))
+	assert.Error(t, ValidateKey(""))
+	assert.Error(t, ValidateKey(strings.Repeat("k", MaxKeyLength+1)))
+	assert.Error(t, ValidateKey("key\n"))
+	assert.Error(t, ValidateKey("clé"))
+}
+
+func Test_RequestHash(t *testing.T) {
+	t.Parallel()
+
+	hash := RequestHash("POST", "/apartments", []byte(`{"id":1}`))
+	assert.Equal(t, hash, RequestHash("POST", "/apartments", []byte(`{"id":1}`)))
+	assert.NotEqual(t, hash, RequestHash("POST", "/apartments", []byte(`{"id":2}`)))
+	assert.NotEqual(t, hash, RequestHash("POST", "/buildings", []byte(`{"id":1}`)))
+	assert.NotEqual(t, hash, RequestHash("DELETE", "/apartments", []byte(`{"id":1}`)))
+}
+
+func Test_MemoryStore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	record := &storage.IdempotencyRecord{Scope: "default:anonymous", Key: "k", RequestHash: "h"}
+	existing, err := store.ReserveIdempotencyKey(ctx, record, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// The duplicate sees the request in progress.
+	existing, err = store.ReserveIdempotencyKey(ctx, &storage.IdempotencyRecord{Scope: "default:anonymous", Key: "k", RequestHash: "h"}, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Zero(t, existing.Status)
+
+	// Another scope doesn't.
+	existing, err = store.ReserveIdempotencyKey(ctx, &storage.IdempotencyRecord{Scope: "acme:anonymous", Key: "k", RequestHash: "h"}, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	record.Status, record.Body = 201, []byte("created")
+	require.NoError(t, store.CompleteIdempotencyKey(ctx, record, time.Hour))
+	assert.Error(t, store.CompleteIdempotencyKey(ctx, record, time.Hour))
+
+	// The response outlives the lock timeout, but not the ttl.
+	now = now.Add(30 * time.Minute)
+	existing, err = store.ReserveIdempotencyKey(ctx, &storage.IdempotencyRecord{Scope: "default:anonymous", Key: "k", RequestHash: "h"}, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, 201, existing.Status)
+	assert.Equal(t, []byte("created"), existing.Body)
+
+	now = now.Add(time.Hour)
+	n, err := store.DeleteExpiredIdempotencyKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.Empty(t, store.records)
+}
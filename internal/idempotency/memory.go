@@ -0,0 +1,97 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+type recordKey struct {
+	scope string
+	key   string
+}
+
+// MemoryStore keeps the records in the memory of the process, they are lost on restart and
+// every replica only knows its own ones.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[recordKey]storage.IdempotencyRecord
+	now     func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[recordKey]storage.IdempotencyRecord),
+		now:     time.Now,
+	}
+}
+
+// ReserveIdempotencyKey implements storage.IdempotencyStorage.
+func (s *MemoryStore) ReserveIdempotencyKey(_ context.Context, record *storage.IdempotencyRecord, lockTimeout time.Duration) (*storage.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	k := recordKey{scope: record.Scope, key: record.Key}
+	if existing, ok := s.records[k]; ok && existing.ExpiresAt.After(now) {
+		return &existing, nil
+	}
+
+	record.Status, record.ContentType, record.Body = 0, "", nil
+	record.ExpiresAt = now.Add(lockTimeout)
+	s.records[k] = *record
+
+	return nil, nil
+}
+
+// CompleteIdempotencyKey implements storage.IdempotencyStorage.
+func (s *MemoryStore) CompleteIdempotencyKey(_ context.Context, record *storage.IdempotencyRecord, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := recordKey{scope: record.Scope, key: record.Key}
+	existing, ok := s.records[k]
+	if !ok || existing.Status != 0 || existing.RequestHash != record.RequestHash {
+		return errors.New("idempotency key is no longer reserved")
+	}
+
+	record.ExpiresAt = s.now().Add(ttl)
+	r := *record
+	r.Body = append([]byte(nil), record.Body...)
+	s.records[k] = r
+
+	return nil
+}
+
+// ReleaseIdempotencyKey implements storage.IdempotencyStorage.
+func (s *MemoryStore) ReleaseIdempotencyKey(_ context.Context, scope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := recordKey{scope: scope, key: key}
+	if existing, ok := s.records[k]; ok && existing.Status == 0 {
+		delete(s.records, k)
+	}
+
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys implements storage.IdempotencyStorage.
+func (s *MemoryStore) DeleteExpiredIdempotencyKeys(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	now := s.now()
+	for k, r := range s.records {
+		if !r.ExpiresAt.After(now) {
+			delete(s.records, k)
+			n++
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,135 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type hook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Lifecycle keeps track of the running components of the application and stops them
+// in the order in which their stop hooks were registered.
+type Lifecycle struct {
+	mu    sync.Mutex
+	hooks []hook
+
+	workersCtx    context.Context
+	cancelWorkers context.CancelFunc
+	workers       sync.WaitGroup
+}
+
+func New() *Lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Lifecycle{
+		workersCtx:    ctx,
+		cancelWorkers: cancel,
+	}
+}
+
+// OnStop registers a stop hook. Hooks run one by one in the registration order.
+func (l *Lifecycle) OnStop(name string, stop func(ctx context.Context) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hooks = append(l.hooks, hook{name: name, stop: stop})
+}
+
+// Go runs a background worker until StopWorkers is called.
+func (l *Lifecycle) Go(name string, worker func(ctx context.Context)) {
+	l.workers.Add(1)
+	go func() {
+		defer l.workers.Done()
+		worker(l.workersCtx)
+		slog.Debug("worker stopped", "worker", name)
+	}()
+}
+
+// StopWorkers cancels the context of the background workers and waits for them to return.
+func (l *Lifecycle) StopWorkers(ctx context.Context) error {
+	l.cancelWorkers()
+
+	done := make(chan struct{})
+	go func() {
+		l.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("workers did not stop: %w", ctx.Err())
+	}
+}
+
+// Shutdown runs the stop hooks with a fresh context bounded by timeout, so it must not be
+// called with the context that delivered the shutdown signal. A failing hook doesn't prevent
+// the following ones from running, their errors are joined.
+func (l *Lifecycle) Shutdown(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	l.mu.Lock()
+	hooks := l.hooks
+	l.mu.Unlock()
+
+	var errs error
+	for _, h := range hooks {
+		start := time.Now()
+		err := h.stop(ctx)
+		if err != nil {
+			slog.Error("stop hook failed", "hook", h.name, "error", err)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", h.name, err))
+			continue
+		}
+		slog.Info("stopped", "hook", h.name, "duration", time.Since(start).String())
+	}
+
+	return errs
+}
+
+// Serve binds addr and serves app in the background. Binding errors are returned right away
+// so that the startup can be aborted, later errors of the server are sent to the channel.
+func Serve(app *fiber.App, addr string) (<-chan error, error) {
+	return Listen(addr, app.Listener)
+}
+
+// Listen binds addr and calls serve with the listener in the background, like Serve for the
+// servers other than Fiber, e.g. grpc.Server.Serve.
+func Listen(addr string, serve func(ln net.Listener) error) (<-chan error, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serve(ln)
+	}()
+
+	return errCh, nil
+}
+
+// First returns a channel that receives the first error of the channels, e.g. of the server
+// that stopped first.
+func First(chs ...<-chan error) <-chan error {
+	first := make(chan error, len(chs))
+	for _, ch := range chs {
+		go func() {
+			first <- <-ch
+		}()
+	}
+
+	return first
+}
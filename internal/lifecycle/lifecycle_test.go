@@ -0,0 +1,215 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		hooks     []string
+		failing   string
+		wantOrder []string
+		wantErr   bool
+	}{
+		{
+			name:      "valid",
+			hooks:     []string{"readiness", "http", "workers", "postgres"},
+			wantOrder: []string{"readiness", "http", "workers", "postgres"},
+		},
+		{
+			name:      "hookError",
+			hooks:     []string{"readiness", "http", "workers", "postgres"},
+			failing:   "http",
+			wantOrder: []string{"readiness", "http", "workers", "postgres"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var order []string
+			l := New()
+			for _, name := range tt.hooks {
+				name := name
+				l.OnStop(name, func(ctx context.Context) error {
+					order = append(order, name)
+					if name == tt.failing {
+						return errors.New("hookError")
+					}
+					return nil
+				})
+			}
+
+			err := l.Shutdown(time.Second)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOrder, order)
+		})
+	}
+}
+
+func Test_Shutdown_FreshContext(t *testing.T) {
+	t.Parallel()
+
+	// The signal context is already cancelled when the shutdown begins.
+	signalCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	<-signalCtx.Done()
+
+	l := New()
+	l.OnStop("http", func(ctx context.Context) error {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+		return ctx.Err()
+	})
+
+	assert.NoError(t, l.Shutdown(time.Second))
+}
+
+func Test_Shutdown_Timeout(t *testing.T) {
+	t.Parallel()
+
+	var dbClosed bool
+	l := New()
+	l.OnStop("http", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	l.OnStop("postgres", func(ctx context.Context) error {
+		dbClosed = true
+		return nil
+	})
+
+	start := time.Now()
+	err := l.Shutdown(50 * time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.True(t, dbClosed)
+}
+
+func Test_StopWorkers(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var order []string
+
+	l := New()
+	l.Go("cleanup", func(ctx context.Context) {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		order = append(order, "worker")
+		mu.Unlock()
+	})
+	l.OnStop("workers", l.StopWorkers)
+	l.OnStop("postgres", func(ctx context.Context) error {
+		mu.Lock()
+		order = append(order, "postgres")
+		mu.Unlock()
+		return nil
+	})
+
+	assert.NoError(t, l.Shutdown(time.Second))
+	assert.Equal(t, []string{"worker", "postgres"}, order)
+}
+
+func Test_Serve_ListenError(t *testing.T) {
+	t.Parallel()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	_, err = Serve(fiber.New(fiber.Config{DisableStartupMessage: true}), ln.Addr().String())
+	assert.Error(t, err)
+}
+
+func Test_Shutdown_DrainsInFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	var mu sync.Mutex
+	var order []string
+	record := func(event string) {
+		mu.Lock()
+		order = append(order, event)
+		mu.Unlock()
+	}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/slow", func(c *fiber.Ctx) error {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		record("handler")
+		return c.SendString("done")
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	serveErr, err := Serve(app, addr)
+	require.NoError(t, err)
+
+	l := New()
+	l.OnStop("readiness", func(ctx context.Context) error {
+		record("readiness")
+		return nil
+	})
+	l.OnStop("http", app.ShutdownWithContext)
+	l.OnStop("postgres", func(ctx context.Context) error {
+		record("postgres")
+		return nil
+	})
+
+	respCh := make(chan string, 1)
+	go func() {
+		resp, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			respCh <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		respCh <- string(b)
+	}()
+
+	<-started
+	assert.NoError(t, l.Shutdown(5*time.Second))
+	assert.Equal(t, "done", <-respCh)
+	assert.Equal(t, []string{"readiness", "handler", "postgres"}, order)
+	assert.NoError(t, <-serveErr)
+}
+
+func Test_First(t *testing.T) {
+	t.Parallel()
+
+	httpErr, grpcErr := make(chan error), make(chan error)
+	first := First(httpErr, grpcErr)
+
+	grpcErr <- errors.New("grpc stopped")
+	assert.EqualError(t, <-first, "grpc stopped")
+}
@@ -0,0 +1,112 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// Levels holds the global level and the per-component overrides, they can be changed at runtime.
+type Levels struct {
+	global slog.LevelVar
+
+	mu         sync.RWMutex
+	components map[string]*slog.LevelVar
+}
+
+func NewLevels(level slog.Level) *Levels {
+	l := &Levels{
+		components: make(map[string]*slog.LevelVar),
+	}
+	l.global.Set(level)
+
+	return l
+}
+
+// Level returns the level of the component, the global level is used if it has no override.
+func (l *Levels) Level(component string) slog.Level {
+	if component != "" {
+		l.mu.RLock()
+		v, ok := l.components[component]
+		l.mu.RUnlock()
+		if ok {
+			return v.Level()
+		}
+	}
+
+	return l.global.Level()
+}
+
+func (l *Levels) Set(level slog.Level) {
+	l.global.Set(level)
+}
+
+func (l *Levels) SetComponent(component string, level slog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	v, ok := l.components[component]
+	if !ok {
+		v = new(slog.LevelVar)
+		l.components[component] = v
+	}
+	v.Set(level)
+}
+
+// ResetComponent removes the override of the component.
+func (l *Levels) ResetComponent(component string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.components, component)
+}
+
+// Snapshot returns the global level and the overrides.
+func (l *Levels) Snapshot() (slog.Level, map[string]slog.Level) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	components := make(map[string]slog.Level, len(l.components))
+	for component, v := range l.components {
+		components[component] = v.Level()
+	}
+
+	return l.global.Level(), components
+}
+
+// levelHandler filters the records by the level of the component of the logger,
+// the component is taken from the ComponentKey attribute given to Logger.With.
+type levelHandler struct {
+	next      slog.Handler
+	levels    *Levels
+	component string
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.Level(h.component)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.next.Handle(ctx, r)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	component := h.component
+	for _, a := range attrs {
+		if a.Key == ComponentKey {
+			component = a.Value.String()
+		}
+	}
+
+	return &levelHandler{next: h.next.WithAttrs(attrs), levels: h.levels, component: component}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{next: h.next.WithGroup(name), levels: h.levels, component: h.component}
+}
+
+// Component returns the default logger tagged with the component, so that its level
+// can be overridden. It must be called when logging, not at the package initialization.
+func Component(component string) *slog.Logger {
+	return slog.Default().With(ComponentKey, component)
+}
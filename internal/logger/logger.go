@@ -1,8 +1,13 @@
 package logger
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/sotskov-do/oms-assignment/internal/config"
 )
@@ -11,44 +16,148 @@ const (
 	LevelCritical = slog.Level(12)
 )
 
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+
+	// ComponentKey is the attribute that names the package a logger belongs to,
+	// the per-component level overrides are matched against it.
+	ComponentKey = "component"
+)
+
 var LevelNames = map[slog.Leveler]string{
 	LevelCritical: "CRITICAL",
 }
 
-func New(addSource bool) *slog.Logger {
-	logLevel := getLogLevel()
+type Options struct {
+	Level slog.Level
+	// Components are the per-component level overrides.
+	Components map[string]slog.Level
+	// Format is json (default) or text, the latter is meant for local development.
+	Format    string
+	AddSource bool
+	// Sampling of the records below the INFO level, disabled when SamplingInitial is 0.
+	SamplingInitial    int
+	SamplingThereafter int
+}
+
+// LoadOptions reads the options from the environment, unknown level names are rejected.
+func LoadOptions() (Options, error) {
+	opts := Options{
+		Level:  slog.LevelDebug,
+		Format: FormatJSON,
+	}
+
+	var err, errs error
+	if level := os.Getenv(config.LogLevel); level != "" {
+		opts.Level, err = ParseLevel(level)
+		errs = errors.Join(errs, err)
+	}
+	opts.Components, err = parseComponentLevels(os.Getenv(config.LogLevels))
+	errs = errors.Join(errs, err)
+	if format := os.Getenv(config.LogFormat); format != "" {
+		opts.Format = strings.ToLower(format)
+		if opts.Format != FormatJSON && opts.Format != FormatText {
+			errs = errors.Join(errs, fmt.Errorf("invalid %s [%v]", config.LogFormat, format))
+		}
+	}
+	opts.AddSource, err = config.Bool(config.LogAddSource, true)
+	errs = errors.Join(errs, err)
+	opts.SamplingInitial, err = config.Int(config.LogSamplingInitial, 0)
+	errs = errors.Join(errs, err)
+	opts.SamplingThereafter, err = config.Int(config.LogSamplingThereafter, 0)
+	errs = errors.Join(errs, err)
+
+	return opts, errs
+}
+
+// New returns the logger and the levels that control it at runtime.
+func New(w io.Writer, opts Options) (*slog.Logger, *Levels) {
+	levels := NewLevels(opts.Level)
+	for component, level := range opts.Components {
+		levels.SetComponent(component, level)
+	}
+
+	var h slog.Handler = newFormatHandler(w, opts.Format, opts.AddSource)
+	h = contextHandler{h}
+	if opts.SamplingInitial > 0 {
+		h = newSamplingHandler(h, slog.LevelInfo, time.Second, opts.SamplingInitial, opts.SamplingThereafter)
+	}
+
+	return slog.New(&levelHandler{next: h, levels: levels}), levels
+}
+
+// NewHandler returns the JSON handler of the loggers created by New writing to w.
+func NewHandler(w io.Writer, level slog.Level) slog.Handler {
+	return &levelHandler{next: contextHandler{newFormatHandler(w, FormatJSON, false)}, levels: NewLevels(level)}
+}
 
-	return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+func newFormatHandler(w io.Writer, format string, addSource bool) slog.Handler {
+	opts := &slog.HandlerOptions{
 		AddSource: addSource,
-		Level:     logLevel,
+		// The levels are checked by levelHandler.
+		Level: slog.Level(-1 << 10),
 		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
 			if a.Key == slog.LevelKey {
 				level := a.Value.Any().(slog.Level)
-				levelLabel, exists := LevelNames[level]
-				if !exists {
-					levelLabel = level.String()
-				}
-				a.Value = slog.StringValue(levelLabel)
+				a.Value = slog.StringValue(LevelName(level))
 			}
 			return a
 		},
-	}))
+	}
+
+	if format == FormatText {
+		return slog.NewTextHandler(w, opts)
+	}
+	return slog.NewJSONHandler(w, opts)
+}
+
+// LevelName returns the name of the level as it is printed in the records.
+func LevelName(level slog.Level) string {
+	levelLabel, exists := LevelNames[level]
+	if !exists {
+		levelLabel = level.String()
+	}
+	return levelLabel
 }
 
-func getLogLevel() slog.Level {
-	level := os.Getenv(config.LogLevel)
-	switch level {
+// ParseLevel parses the level names, they are case-insensitive.
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
 	case "DEBUG":
-		return slog.LevelDebug
+		return slog.LevelDebug, nil
 	case "INFO":
-		return slog.LevelInfo
+		return slog.LevelInfo, nil
 	case "WARN":
-		return slog.LevelWarn
+		return slog.LevelWarn, nil
 	case "ERROR":
-		return slog.LevelError
+		return slog.LevelError, nil
 	case "CRITICAL":
-		return LevelCritical
+		return LevelCritical, nil
 	default:
-		return slog.LevelDebug
+		return 0, fmt.Errorf("unknown log level [%v]", name)
+	}
+}
+
+// parseComponentLevels parses the overrides in the "postgres=DEBUG,ratelimit=WARN" format.
+func parseComponentLevels(value string) (map[string]slog.Level, error) {
+	components := make(map[string]slog.Level)
+	if strings.TrimSpace(value) == "" {
+		return components, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		component, name, ok := strings.Cut(pair, "=")
+		component = strings.TrimSpace(component)
+		if !ok || component == "" {
+			return nil, fmt.Errorf("invalid %s entry [%v]", config.LogLevels, pair)
+		}
+		level, err := ParseLevel(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry [%v]: %w", config.LogLevels, pair, err)
+		}
+		components[component] = level
 	}
+
+	return components, nil
 }
@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		level   string
+		want    slog.Level
+		wantErr bool
+	}{
+		{name: "debug", level: "DEBUG", want: slog.LevelDebug},
+		{name: "lowercase", level: "warn", want: slog.LevelWarn},
+		{name: "critical", level: "CRITICAL", want: LevelCritical},
+		{name: "typo", level: "DEBGU", wantErr: true},
+		{name: "empty", level: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseLevel(tt.level)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseComponentLevels(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseComponentLevels("postgres=DEBUG, bms=warn")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]slog.Level{"postgres": slog.LevelDebug, "bms": slog.LevelWarn}, got)
+
+	_, err = parseComponentLevels("postgres=LOUD")
+	assert.Error(t, err)
+	_, err = parseComponentLevels("postgres")
+	assert.Error(t, err)
+}
+
+func Test_Levels(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	l, levels := New(&buf, Options{
+		Level:      slog.LevelInfo,
+		Components: map[string]slog.Level{"postgres": slog.LevelDebug},
+		Format:     FormatText,
+	})
+	pg := l.With(ComponentKey, "postgres")
+
+	l.Debug("root debug")
+	pg.Debug("postgres debug")
+	assert.NotContains(t, buf.String(), "root debug")
+	assert.Contains(t, buf.String(), "postgres debug")
+
+	// Runtime changes apply to the existing loggers.
+	levels.Set(slog.LevelDebug)
+	levels.SetComponent("postgres", slog.LevelError)
+	buf.Reset()
+	l.Debug("root debug")
+	pg.Warn("postgres warn")
+	assert.Contains(t, buf.String(), "root debug")
+	assert.NotContains(t, buf.String(), "postgres warn")
+
+	levels.ResetComponent("postgres")
+	buf.Reset()
+	pg.Debug("postgres debug")
+	assert.Contains(t, buf.String(), "postgres debug")
+	assert.Contains(t, buf.String(), "level=DEBUG")
+}
+
+func Test_Sampling(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	l, _ := New(&buf, Options{
+		Level:              slog.LevelDebug,
+		SamplingInitial:    2,
+		SamplingThereafter: 3,
+	})
+
+	for i := 0; i < 8; i++ {
+		l.DebugContext(context.Background(), "noisy")
+		l.Info("important")
+	}
+
+	// 2 initial records, then every 3rd: the 5th and the 8th.
+	assert.Equal(t, 4, strings.Count(buf.String(), `"msg":"noisy"`))
+	assert.Equal(t, 8, strings.Count(buf.String(), `"msg":"important"`))
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// samplingHandler limits the records below the level: in every tick the first initial
+// records with the same message are logged, then only every thereafter-th one.
+// It keeps noisy debug logs affordable without losing the rare messages.
+type samplingHandler struct {
+	next    slog.Handler
+	sampler *sampler
+}
+
+type sampler struct {
+	below      slog.Level
+	tick       time.Duration
+	initial    int
+	thereafter int
+
+	mu     sync.Mutex
+	resets time.Time
+	counts map[string]int
+}
+
+func newSamplingHandler(next slog.Handler, below slog.Level, tick time.Duration, initial, thereafter int) *samplingHandler {
+	return &samplingHandler{
+		next: next,
+		sampler: &sampler{
+			below:      below,
+			tick:       tick,
+			initial:    initial,
+			thereafter: thereafter,
+			counts:     make(map[string]int),
+		},
+	}
+}
+
+func (s *sampler) keep(r slog.Record) bool {
+	if r.Level >= s.below {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Time.After(s.resets) {
+		s.resets = r.Time.Add(s.tick)
+		clear(s.counts)
+	}
+
+	s.counts[r.Message]++
+	n := s.counts[r.Message]
+	if n <= s.initial {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.initial)%s.thereafter == 0
+}
+
+func (h *samplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *samplingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if !h.sampler.keep(r) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &samplingHandler{next: h.next.WithAttrs(attrs), sampler: h.sampler}
+}
+
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+	return &samplingHandler{next: h.next.WithGroup(name), sampler: h.sampler}
+}
@@ -0,0 +1,242 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+const (
+	namespace = "oms"
+
+	// unmatchedRoute labels the requests that didn't match any named route, e.g. 404.
+	unmatchedRoute = "unmatched"
+
+	totalsTimeout = 5 * time.Second
+)
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests  *prometheus.CounterVec
+	httpDuration  *prometheus.HistogramVec
+	httpInFlight  *prometheus.GaugeVec
+	queryDuration *prometheus.HistogramVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of handled HTTP requests by route and status code.",
+		}, []string{"route", "method", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the HTTP requests by route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		httpInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_in_flight",
+			Help:      "Number of HTTP requests being handled by route.",
+		}, []string{"route"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "Duration of the storage methods.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.httpInFlight,
+		m.queryDuration,
+	)
+
+	return m
+}
+
+// Registry returns the registry that is exposed by Handler.
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		// A failing collector, e.g. the totals while the database is down, must not hide the other metrics.
+		ErrorHandling: promhttp.ContinueOnError,
+	}))
+}
+
+// Track records the HTTP metrics of the route. It must be the first handler of the route
+// (not an app.Use middleware) so that the route name is known while the request is in flight.
+func (m *Metrics) Track(c *fiber.Ctx) error {
+	route := routeName(c)
+	method := c.Method()
+
+	inFlight := m.httpInFlight.WithLabelValues(route)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	err := c.Next()
+
+	status := c.Response().StatusCode()
+	if err != nil {
+		// The error handler runs after the middleware, resolve the status it is going to send.
+		status = fiber.StatusInternalServerError
+		if e, ok := err.(*fiber.Error); ok {
+			status = e.Code
+		}
+	}
+
+	m.httpDuration.WithLabelValues(route, method).Observe(time.Since(start).Seconds())
+	m.httpRequests.WithLabelValues(route, method, strconv.Itoa(status)).Inc()
+
+	return err
+}
+
+// TrackUnmatched records the requests that did not match any route, it must be registered
+// with app.Use after all the routes.
+func (m *Metrics) TrackUnmatched(c *fiber.Ctx) error {
+	m.httpRequests.WithLabelValues(unmatchedRoute, c.Method(), strconv.Itoa(fiber.StatusNotFound)).Inc()
+	return c.Next()
+}
+
+func routeName(c *fiber.Ctx) string {
+	name := c.Route().Name
+	if name == "" {
+		return unmatchedRoute
+	}
+	return name
+}
+
+// ObserveQuery records the duration of the storage method.
+func (m *Metrics) ObserveQuery(method string, duration time.Duration) {
+	m.queryDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// RegisterDBStats exposes the connection pool statistics.
+func (m *Metrics) RegisterDBStats(stats func() sql.DBStats) {
+	gauge := func(name, help string, value func(s sql.DBStats) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "db_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return value(stats()) })
+	}
+	counter := func(name, help string, value func(s sql.DBStats) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "db_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return value(stats()) })
+	}
+
+	m.registry.MustRegister(
+		gauge("max_open_connections", "Maximum number of open connections.",
+			func(s sql.DBStats) float64 { return float64(s.MaxOpenConnections) }),
+		gauge("open_connections", "Number of established connections.",
+			func(s sql.DBStats) float64 { return float64(s.OpenConnections) }),
+		gauge("in_use_connections", "Number of connections currently in use.",
+			func(s sql.DBStats) float64 { return float64(s.InUse) }),
+		gauge("idle_connections", "Number of idle connections.",
+			func(s sql.DBStats) float64 { return float64(s.Idle) }),
+		counter("wait_count_total", "Number of connections waited for.",
+			func(s sql.DBStats) float64 { return float64(s.WaitCount) }),
+		counter("wait_duration_seconds_total", "Time blocked waiting for a new connection.",
+			func(s sql.DBStats) float64 { return s.WaitDuration.Seconds() }),
+		counter("max_idle_closed_total", "Number of connections closed due to the idle limit.",
+			func(s sql.DBStats) float64 { return float64(s.MaxIdleClosed) }),
+		counter("max_idle_time_closed_total", "Number of connections closed due to the idle time limit.",
+			func(s sql.DBStats) float64 { return float64(s.MaxIdleTimeClosed) }),
+		counter("max_lifetime_closed_total", "Number of connections closed due to the lifetime limit.",
+			func(s sql.DBStats) float64 { return float64(s.MaxLifetimeClosed) }),
+	)
+}
+
+// RegisterTotals exposes the business totals. They are queried on the scrapes and kept for ttl,
+// so the frequent scrapes don't run the aggregate query every time.
+func (m *Metrics) RegisterTotals(totals func(ctx context.Context) (storage.Totals, error), ttl time.Duration) {
+	m.registry.MustRegister(&totalsCollector{totals: totals, ttl: ttl})
+}
+
+var (
+	buildingsDesc  = prometheus.NewDesc(namespace+"_buildings", "Number of buildings.", nil, nil)
+	apartmentsDesc = prometheus.NewDesc(namespace+"_apartments", "Number of apartments.", nil, nil)
+	sqMetersDesc   = prometheus.NewDesc(namespace+"_apartments_sq_meters", "Total area of the apartments in square meters.", nil, nil)
+)
+
+type totalsCollector struct {
+	totals func(ctx context.Context) (storage.Totals, error)
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	cached    storage.Totals
+	expiresAt time.Time
+}
+
+func (c *totalsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- buildingsDesc
+	ch <- apartmentsDesc
+	ch <- sqMetersDesc
+}
+
+func (c *totalsCollector) Collect(ch chan<- prometheus.Metric) {
+	t, err := c.get()
+	if err != nil {
+		slog.Error("can't collect totals", "error", err)
+		ch <- prometheus.NewInvalidMetric(buildingsDesc, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(buildingsDesc, prometheus.GaugeValue, float64(t.Buildings))
+	ch <- prometheus.MustNewConstMetric(apartmentsDesc, prometheus.GaugeValue, float64(t.Apartments))
+	ch <- prometheus.MustNewConstMetric(sqMetersDesc, prometheus.GaugeValue, float64(t.SQMeters))
+}
+
+// get returns the cached totals or, once they expired, queries them. The errors aren't cached.
+func (c *totalsCollector) get() (storage.Totals, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Now().Before(c.expiresAt) {
+		return c.cached, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), totalsTimeout)
+	defer cancel()
+
+	t, err := c.totals(ctx)
+	if err != nil {
+		return storage.Totals{}, err
+	}
+	c.cached, c.expiresAt = t, time.Now().Add(c.ttl)
+
+	return t, nil
+}
@@ -0,0 +1,165 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+func newTestApp(m *Metrics) *fiber.App {
+	app := fiber.New()
+	app.Get("/metrics", m.Handler())
+	app.Route("/buildings", func(api fiber.Router) {
+		api.Get("/:id", m.Track, func(c *fiber.Ctx) error {
+			if c.Params("id") == "0" {
+				return c.Status(fiber.StatusBadRequest).SendString("bad id")
+			}
+			return c.SendString("ok")
+		}).Name("getByID")
+		api.Delete("/:id", m.Track, func(c *fiber.Ctx) error {
+			return fiber.ErrForbidden
+		}).Name("delete")
+	}, "buildings.")
+	app.Use(m.TrackUnmatched)
+
+	return app
+}
+
+func Test_Track(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantLabels []string
+	}{
+		{
+			name:       "valid",
+			method:     fiber.MethodGet,
+			target:     "/buildings/1",
+			wantLabels: []string{"buildings.getByID", fiber.MethodGet, "200"},
+		},
+		{
+			name:       "badRequest",
+			method:     fiber.MethodGet,
+			target:     "/buildings/0",
+			wantLabels: []string{"buildings.getByID", fiber.MethodGet, "400"},
+		},
+		{
+			name:       "handlerError",
+			method:     fiber.MethodDelete,
+			target:     "/buildings/1",
+			wantLabels: []string{"buildings.delete", fiber.MethodDelete, "403"},
+		},
+		{
+			name:       "unmatched",
+			method:     fiber.MethodGet,
+			target:     "/unknown",
+			wantLabels: []string{unmatchedRoute, fiber.MethodGet, "404"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := New()
+			app := newTestApp(m)
+
+			_, err := app.Test(httptest.NewRequest(tt.method, tt.target, nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues(tt.wantLabels...)))
+			if tt.wantLabels[0] != unmatchedRoute {
+				assert.Equal(t, 1, testutil.CollectAndCount(m.httpDuration))
+				assert.Equal(t, 0.0, testutil.ToFloat64(m.httpInFlight.WithLabelValues(tt.wantLabels[0])))
+			}
+		})
+	}
+}
+
+func Test_Handler(t *testing.T) {
+	t.Parallel()
+
+	m := New()
+	m.RegisterDBStats(func() sql.DBStats {
+		return sql.DBStats{MaxOpenConnections: 20, OpenConnections: 3, InUse: 1, Idle: 2}
+	})
+	var queries atomic.Int32
+	m.RegisterTotals(func(ctx context.Context) (storage.Totals, error) {
+		queries.Add(1)
+		return storage.Totals{Buildings: 4, Apartments: 4, SQMeters: 195}, nil
+	}, time.Minute)
+	m.ObserveQuery("GetBuildings", 10*time.Millisecond)
+	app := newTestApp(m)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	body := string(b)
+
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	for _, want := range []string{
+		"oms_buildings 4",
+		"oms_apartments 4",
+		"oms_apartments_sq_meters 195",
+		"oms_db_pool_max_open_connections 20",
+		"oms_db_pool_in_use_connections 1",
+		`oms_db_query_duration_seconds_count{method="GetBuildings"} 1`,
+	} {
+		assert.True(t, strings.Contains(body, want), want)
+	}
+
+	// The totals are cached until the TTL.
+	resp, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+	b, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "oms_buildings 4")
+	assert.Equal(t, int32(1), queries.Load())
+}
+
+func Test_Handler_TotalsError(t *testing.T) {
+	t.Parallel()
+
+	m := New()
+	m.RegisterDBStats(func() sql.DBStats {
+		return sql.DBStats{MaxOpenConnections: 20}
+	})
+	var queries atomic.Int32
+	m.RegisterTotals(func(ctx context.Context) (storage.Totals, error) {
+		queries.Add(1)
+		return storage.Totals{}, errors.New("storageError")
+	}, time.Minute)
+	app := newTestApp(m)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(b), "oms_db_pool_max_open_connections 20")
+	assert.NotContains(t, string(b), "oms_buildings ")
+
+	// The errors aren't cached.
+	_, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), queries.Load())
+}
@@ -29,6 +29,7 @@ type Apartment struct {
 	Number     null.String `boil:"number" json:"number,omitempty" toml:"number" yaml:"number,omitempty"`
 	Floor      null.Int    `boil:"floor" json:"floor,omitempty" toml:"floor" yaml:"floor,omitempty"`
 	SQMeters   null.Int    `boil:"sq_meters" json:"sq_meters,omitempty" toml:"sq_meters" yaml:"sq_meters,omitempty"`
+	TenantID   string      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
 
 	R *apartmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L apartmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,12 +41,14 @@ var ApartmentColumns = struct {
 	Number     string
 	Floor      string
 	SQMeters   string
+	TenantID   string
 }{
 	ID:         "id",
 	BuildingID: "building_id",
 	Number:     "number",
 	Floor:      "floor",
 	SQMeters:   "sq_meters",
+	TenantID:   "tenant_id",
 }
 
 var ApartmentTableColumns = struct {
@@ -54,12 +57,14 @@ var ApartmentTableColumns = struct {
 	Number     string
 	Floor      string
 	SQMeters   string
+	TenantID   string
 }{
 	ID:         "apartment.id",
 	BuildingID: "apartment.building_id",
 	Number:     "apartment.number",
 	Floor:      "apartment.floor",
 	SQMeters:   "apartment.sq_meters",
+	TenantID:   "apartment.tenant_id",
 }
 
 // Generated where
@@ -181,12 +186,14 @@ var ApartmentWhere = struct {
 	Number     whereHelpernull_String
 	Floor      whereHelpernull_Int
 	SQMeters   whereHelpernull_Int
+	TenantID   whereHelperstring
 }{
 	ID:         whereHelperint{field: "\"apartment\".\"id\""},
 	BuildingID: whereHelperint{field: "\"apartment\".\"building_id\""},
 	Number:     whereHelpernull_String{field: "\"apartment\".\"number\""},
 	Floor:      whereHelpernull_Int{field: "\"apartment\".\"floor\""},
 	SQMeters:   whereHelpernull_Int{field: "\"apartment\".\"sq_meters\""},
+	TenantID:   whereHelperstring{field: "\"apartment\".\"tenant_id\""},
 }
 
 // ApartmentRels is where relationship names are stored.
@@ -217,8 +224,8 @@ func (r *apartmentR) GetBuilding() *Building {
 type apartmentL struct{}
 
 var (
-	apartmentAllColumns            = []string{"id", "building_id", "number", "floor", "sq_meters"}
-	apartmentColumnsWithoutDefault = []string{"building_id"}
+	apartmentAllColumns            = []string{"id", "building_id", "number", "floor", "sq_meters", "tenant_id"}
+	apartmentColumnsWithoutDefault = []string{"building_id", "tenant_id"}
 	apartmentColumnsWithDefault    = []string{"id", "number", "floor", "sq_meters"}
 	apartmentPrimaryKeyColumns     = []string{"id"}
 	apartmentGeneratedColumns      = []string{}
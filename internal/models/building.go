@@ -24,32 +24,72 @@ import (
 
 // Building is an object representing the database table.
 type Building struct {
-	ID      int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name    string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Address null.String `boil:"address" json:"address,omitempty" toml:"address" yaml:"address,omitempty"`
+	ID          int          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string       `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Address     null.String  `boil:"address" json:"address,omitempty" toml:"address" yaml:"address,omitempty"`
+	TenantID    string       `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	Street      null.String  `boil:"street" json:"street,omitempty" toml:"street" yaml:"street,omitempty"`
+	HouseNumber null.String  `boil:"house_number" json:"house_number,omitempty" toml:"house_number" yaml:"house_number,omitempty"`
+	City        null.String  `boil:"city" json:"city,omitempty" toml:"city" yaml:"city,omitempty"`
+	PostalCode  null.String  `boil:"postal_code" json:"postal_code,omitempty" toml:"postal_code" yaml:"postal_code,omitempty"`
+	Country     null.String  `boil:"country" json:"country,omitempty" toml:"country" yaml:"country,omitempty"`
+	Latitude    null.Float64 `boil:"latitude" json:"latitude,omitempty" toml:"latitude" yaml:"latitude,omitempty"`
+	Longitude   null.Float64 `boil:"longitude" json:"longitude,omitempty" toml:"longitude" yaml:"longitude,omitempty"`
 
 	R *buildingR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L buildingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var BuildingColumns = struct {
-	ID      string
-	Name    string
-	Address string
+	ID          string
+	Name        string
+	Address     string
+	TenantID    string
+	Street      string
+	HouseNumber string
+	City        string
+	PostalCode  string
+	Country     string
+	Latitude    string
+	Longitude   string
 }{
-	ID:      "id",
-	Name:    "name",
-	Address: "address",
+	ID:          "id",
+	Name:        "name",
+	Address:     "address",
+	TenantID:    "tenant_id",
+	Street:      "street",
+	HouseNumber: "house_number",
+	City:        "city",
+	PostalCode:  "postal_code",
+	Country:     "country",
+	Latitude:    "latitude",
+	Longitude:   "longitude",
 }
 
 var BuildingTableColumns = struct {
-	ID      string
-	Name    string
-	Address string
+	ID          string
+	Name        string
+	Address     string
+	TenantID    string
+	Street      string
+	HouseNumber string
+	City        string
+	PostalCode  string
+	Country     string
+	Latitude    string
+	Longitude   string
 }{
-	ID:      "building.id",
-	Name:    "building.name",
-	Address: "building.address",
+	ID:          "building.id",
+	Name:        "building.name",
+	Address:     "building.address",
+	TenantID:    "building.tenant_id",
+	Street:      "building.street",
+	HouseNumber: "building.house_number",
+	City:        "building.city",
+	PostalCode:  "building.postal_code",
+	Country:     "building.country",
+	Latitude:    "building.latitude",
+	Longitude:   "building.longitude",
 }
 
 // Generated where
@@ -81,14 +121,68 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var BuildingWhere = struct {
-	ID      whereHelperint
-	Name    whereHelperstring
-	Address whereHelpernull_String
+	ID          whereHelperint
+	Name        whereHelperstring
+	Address     whereHelpernull_String
+	TenantID    whereHelperstring
+	Street      whereHelpernull_String
+	HouseNumber whereHelpernull_String
+	City        whereHelpernull_String
+	PostalCode  whereHelpernull_String
+	Country     whereHelpernull_String
+	Latitude    whereHelpernull_Float64
+	Longitude   whereHelpernull_Float64
 }{
-	ID:      whereHelperint{field: "\"building\".\"id\""},
-	Name:    whereHelperstring{field: "\"building\".\"name\""},
-	Address: whereHelpernull_String{field: "\"building\".\"address\""},
+	ID:          whereHelperint{field: "\"building\".\"id\""},
+	Name:        whereHelperstring{field: "\"building\".\"name\""},
+	Address:     whereHelpernull_String{field: "\"building\".\"address\""},
+	TenantID:    whereHelperstring{field: "\"building\".\"tenant_id\""},
+	Street:      whereHelpernull_String{field: "\"building\".\"street\""},
+	HouseNumber: whereHelpernull_String{field: "\"building\".\"house_number\""},
+	City:        whereHelpernull_String{field: "\"building\".\"city\""},
+	PostalCode:  whereHelpernull_String{field: "\"building\".\"postal_code\""},
+	Country:     whereHelpernull_String{field: "\"building\".\"country\""},
+	Latitude:    whereHelpernull_Float64{field: "\"building\".\"latitude\""},
+	Longitude:   whereHelpernull_Float64{field: "\"building\".\"longitude\""},
 }
 
 // BuildingRels is where relationship names are stored.
@@ -119,9 +213,9 @@ func (r *buildingR) GetApartments() ApartmentSlice {
 type buildingL struct{}
 
 var (
-	buildingAllColumns            = []string{"id", "name", "address"}
-	buildingColumnsWithoutDefault = []string{"name"}
-	buildingColumnsWithDefault    = []string{"id", "address"}
+	buildingAllColumns            = []string{"id", "name", "address", "tenant_id", "street", "house_number", "city", "postal_code", "country", "latitude", "longitude"}
+	buildingColumnsWithoutDefault = []string{"name", "tenant_id"}
+	buildingColumnsWithDefault    = []string{"id", "address", "street", "house_number", "city", "postal_code", "country", "latitude", "longitude"}
 	buildingPrimaryKeyColumns     = []string{"id"}
 	buildingGeneratedColumns      = []string{}
 )
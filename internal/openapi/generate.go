@@ -0,0 +1,350 @@
+package openapi
+
+import (
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+)
+
+const (
+	Version = "3.1.0"
+
+	mimeJSON = fiber.MIMEApplicationJSON
+)
+
+// Route documents a named Fiber route.
+type Route struct {
+	Summary     string
+	Description string
+	Tag         string
+	// Public routes don't require credentials and aren't rate limited.
+	Public bool
+	// Hidden routes, e.g. the docs UI, are left out of the document.
+	Hidden bool
+	// Deprecated routes are kept for the existing clients, see middleware.Deprecated.
+	Deprecated bool
+	// Problems routes send RFC 9457 problem details instead of the error envelope, see middleware.Problems.
+	Problems bool
+	// NotFound routes answer the missing resources with 404.
+	NotFound bool
+	// Conflict routes answer with 409 when the state of the resource prevents the operation.
+	Conflict bool
+	// Params describe the path parameters, which are integers unless ParamSchemas says otherwise.
+	Params       map[string]string
+	ParamSchemas map[string]*Schema
+	// Query are the query parameters, their In is set to query.
+	Query []*Parameter
+	// Body is a value of the type of the JSON request body, nil if the route takes none.
+	Body any
+	// Result is a value of the type of the response field of the success envelope,
+	// nil if the envelope has none.
+	Result any
+	// Responses replace the success envelope, for the routes that don't use it.
+	Responses map[string]*Response
+	// Stream is a value of the type of the lines of the application/x-ndjson success responses,
+	// nil if the route doesn't stream.
+	Stream any
+	// GeoJSON is a value of the type of the application/geo+json success responses, nil if the
+	// route has none.
+	GeoJSON any
+}
+
+// Generator documents the routes of an app by their name.
+type Generator struct {
+	Info    Info
+	Tags    []Tag
+	Routes  map[string]Route
+	Schemas *Schemas
+}
+
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)\??`)
+
+// Generate returns the document of the routes, the HEAD routes Fiber adds for the GET ones are
+// skipped. The routes without a Route are returned as undocumented and left out of the document.
+func (g *Generator) Generate(routes []fiber.Route) (doc *Document, undocumented []string) {
+	doc = &Document{
+		OpenAPI: Version,
+		Info:    g.Info,
+		Tags:    g.Tags,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Parameters:      parameters(),
+			Responses:       responses(),
+			SecuritySchemes: securitySchemes(),
+		},
+		Security: []SecurityRequirement{{"apiKey": {}}, {"bearer": {}}},
+	}
+
+	for _, r := range routes {
+		if r.Method == fiber.MethodHead {
+			continue
+		}
+		spec, ok := g.Routes[r.Name]
+		if !ok {
+			undocumented = append(undocumented, r.Method+" "+r.Path)
+			continue
+		}
+		if spec.Hidden {
+			continue
+		}
+
+		path := PathOf(r.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(PathItem)
+		}
+		doc.Paths[path][strings.ToLower(r.Method)] = g.operation(r, spec)
+	}
+
+	doc.Components.Schemas = g.Schemas.Components()
+	doc.Components.Schemas["Error"] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"result":   {Const: "error"},
+			"response": {Type: "string", Description: "Error message"},
+		},
+		Required: []string{"result", "response"},
+	}
+	doc.Components.Schemas["Problem"] = &Schema{
+		Type:        "object",
+		Description: "RFC 9457 problem details",
+		Properties: map[string]*Schema{
+			"type":   {Type: "string"},
+			"title":  {Type: "string"},
+			"status": {Type: "integer", Format: "int32"},
+			"detail": {Type: "string"},
+		},
+		Required: []string{"type", "title", "status"},
+	}
+	sort.Strings(undocumented)
+
+	return doc, undocumented
+}
+
+// PathOf converts the parameters of a Fiber path to the OpenAPI syntax, /buildings/:id
+// becomes /buildings/{id}.
+func PathOf(path string) string {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return pathParam.ReplaceAllString(path, "{$1}")
+}
+
+func (g *Generator) operation(r fiber.Route, spec Route) *Operation {
+	op := &Operation{
+		OperationID: r.Name,
+		Summary:     spec.Summary,
+		Description: spec.Description,
+		Deprecated:  spec.Deprecated,
+		Responses:   make(map[string]*Response),
+	}
+	if spec.Tag != "" {
+		op.Tags = []string{spec.Tag}
+	}
+	if spec.Public {
+		op.Security = &[]SecurityRequirement{}
+	}
+
+	for _, name := range r.Params {
+		schema, ok := spec.ParamSchemas[name]
+		if !ok {
+			schema = &Schema{Type: "integer", Format: "int32"}
+		}
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        name,
+			In:          "path",
+			Description: spec.Params[name],
+			Required:    true,
+			Schema:      schema,
+		})
+	}
+
+	for _, p := range spec.Query {
+		param := *p
+		param.In = "query"
+		op.Parameters = append(op.Parameters, &param)
+	}
+
+	write := r.Method == fiber.MethodPost || r.Method == fiber.MethodPatch || r.Method == fiber.MethodDelete
+	if !spec.Public {
+		op.Parameters = append(op.Parameters, &Parameter{Ref: "#/components/parameters/TenantID"})
+		if write {
+			op.Parameters = append(op.Parameters, &Parameter{Ref: "#/components/parameters/IdempotencyKey"})
+		}
+	}
+
+	if spec.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{mimeJSON: {Schema: g.Schemas.Of(spec.Body)}},
+		}
+	}
+
+	if spec.Responses != nil {
+		for code, resp := range spec.Responses {
+			op.Responses[code] = resp
+		}
+	} else {
+		envelope := &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"result": {Const: "success"},
+			},
+			Required: []string{"result"},
+		}
+		if spec.Result != nil {
+			envelope.Properties["response"] = g.Schemas.Of(spec.Result)
+			envelope.Required = append(envelope.Required, "response")
+		}
+		op.Responses["200"] = &Response{
+			Description: "Success",
+			Content:     map[string]MediaType{mimeJSON: {Schema: envelope}},
+		}
+	}
+	if spec.Stream != nil {
+		ok := *op.Responses["200"]
+		ok.Content = maps.Clone(ok.Content)
+		ok.Content[middleware.MIMEApplicationNDJSON] = MediaType{Schema: g.Schemas.Of(spec.Stream)}
+		op.Responses["200"] = &ok
+	}
+	if spec.GeoJSON != nil {
+		ok := *op.Responses["200"]
+		ok.Content = maps.Clone(ok.Content)
+		ok.Content[middleware.MIMEApplicationGeoJSON] = MediaType{Schema: g.Schemas.Of(spec.GeoJSON)}
+		op.Responses["200"] = &ok
+	}
+
+	errorRef := func(name string) *Response {
+		if spec.Problems {
+			name = "Problem" + name
+		}
+		return &Response{Ref: "#/components/responses/" + name}
+	}
+	if len(r.Params) > 0 || len(spec.Query) > 0 || spec.Body != nil {
+		op.Responses["400"] = errorRef("BadRequest")
+	}
+	if spec.Body != nil {
+		op.Responses["415"] = errorRef("UnsupportedMediaType")
+	}
+	if spec.NotFound {
+		op.Responses["404"] = errorRef("NotFound")
+	}
+	if !spec.Public {
+		op.Responses["401"] = errorRef("Unauthorized")
+		op.Responses["403"] = errorRef("Forbidden")
+		op.Responses["429"] = errorRef("TooManyRequests")
+		op.Responses["500"] = errorRef("InternalError")
+		if write {
+			op.Responses["409"] = errorRef("IdempotencyConflict")
+			op.Responses["422"] = errorRef("IdempotencyKeyReused")
+		}
+	}
+	if spec.Conflict {
+		op.Responses["409"] = errorRef("Conflict")
+	}
+
+	return op
+}
+
+func parameters() map[string]*Parameter {
+	maxKeyLength := 255
+	return map[string]*Parameter{
+		"TenantID": {
+			Name: middleware.HeaderTenantID,
+			In:   "header",
+			Description: "Tenant to act for, for the principals that aren't bound to one. " +
+				"Defaults to the principal's tenant or to the default tenant.",
+			Schema: &Schema{Type: "string"},
+		},
+		"IdempotencyKey": {
+			Name: middleware.HeaderIdempotencyKey,
+			In:   "header",
+			Description: "Makes the retries of the request safe, the response of the first request " +
+				"with the key is replayed.",
+			Schema: &Schema{Type: "string", MaxLength: &maxKeyLength},
+		},
+	}
+}
+
+// responses returns the error responses in both formats, the problem details ones are prefixed with Problem.
+func responses() map[string]*Response {
+	base := errorResponses()
+	all := make(map[string]*Response, 2*len(base))
+	for name, resp := range base {
+		all[name] = &Response{
+			Description: resp.Description,
+			Headers:     resp.Headers,
+			Content:     map[string]MediaType{mimeJSON: {Schema: Ref("Error")}},
+		}
+		all["Problem"+name] = &Response{
+			Description: resp.Description,
+			Headers:     resp.Headers,
+			Content:     map[string]MediaType{middleware.MIMEApplicationProblemJSON: {Schema: Ref("Problem")}},
+		}
+	}
+	return all
+}
+
+func errorResponse(description string, headers map[string]*Header) *Response {
+	return &Response{
+		Description: description,
+		Headers:     headers,
+	}
+}
+
+func errorResponses() map[string]*Response {
+	seconds := &Schema{Type: "integer"}
+	return map[string]*Response{
+		"BadRequest": errorResponse("Invalid parameter or body", nil),
+		"Unauthorized": errorResponse("Missing or invalid credentials", map[string]*Header{
+			fiber.HeaderWWWAuthenticate: {Schema: &Schema{Type: "string"}},
+		}),
+		"Forbidden": errorResponse("The principal isn't allowed to act on the resource or the tenant", nil),
+		"NotFound":  errorResponse("The resource doesn't exist", nil),
+		"TooManyRequests": errorResponse("Rate limit exceeded", map[string]*Header{
+			fiber.HeaderRetryAfter: {Description: "Seconds until the next request is allowed", Schema: seconds},
+			middleware.HeaderRateLimitLimit: {
+				Description: "Size of the bucket",
+				Schema:      seconds,
+			},
+			middleware.HeaderRateLimitRemaining: {
+				Description: "Requests that can be made right away",
+				Schema:      seconds,
+			},
+			middleware.HeaderRateLimitReset: {
+				Description: "Seconds until the bucket is full",
+				Schema:      seconds,
+			},
+		}),
+		"IdempotencyConflict": errorResponse("A request with the same idempotency key is in progress", map[string]*Header{
+			fiber.HeaderRetryAfter: {Schema: seconds},
+		}),
+		"IdempotencyKeyReused": errorResponse("The idempotency key was used for another request", nil),
+		"Conflict": errorResponse("The state of the resource prevents the operation, or a request with the same "+
+			"idempotency key is in progress", map[string]*Header{
+			fiber.HeaderRetryAfter: {Description: "Only for the idempotency key in progress", Schema: seconds},
+		}),
+		"UnsupportedMediaType": errorResponse("The body isn't JSON", nil),
+		"InternalError":        errorResponse("Server error", nil),
+	}
+}
+
+func securitySchemes() map[string]*SecurityScheme {
+	return map[string]*SecurityScheme{
+		"apiKey": {
+			Type:        "apiKey",
+			Name:        middleware.HeaderAPIKey,
+			In:          "header",
+			Description: "API key created with the admin CLI, also accepted as a bearer token",
+		},
+		"bearer": {
+			Type:         "http",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package openapi
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+// Middleware validates the requests of the documented routes before their handler runs, the
+// invalid ones get 400, or 415 when their body isn't JSON. It must be a route middleware, so it
+// knows the matched route.
+//
+// With validateResponses the responses are validated too and the ones that don't match the
+// document are replaced with a 500. It is meant for the tests and the development environments.
+func (v *Validator) Middleware(validateResponses bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		query := make(map[string][]string)
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			query[string(key)] = append(query[string(key)], string(value))
+		})
+
+		err := v.ValidateRequest(Request{
+			Method:      c.Method(),
+			Path:        c.Route().Path,
+			PathParams:  c.AllParams(),
+			Query:       query,
+			Header:      func(name string) string { return c.Get(name) },
+			ContentType: c.Get(fiber.HeaderContentType),
+			Body:        c.Body(),
+		})
+		if err != nil {
+			status := fiber.StatusBadRequest
+			if errors.Is(err, ErrUnsupportedMediaType) {
+				status = fiber.StatusUnsupportedMediaType
+			}
+			return middleware.SendError(c, status, err)
+		}
+
+		err = c.Next()
+		// The streams aren't validated, reading their body would buffer them.
+		if err != nil || !validateResponses || c.Response().IsBodyStream() {
+			return err
+		}
+
+		resp := c.Response()
+		err = v.ValidateResponse(c.Method(), c.Route().Path, resp.StatusCode(), string(resp.Header.ContentType()), resp.Body())
+		if err != nil {
+			ctx := c.UserContext()
+			logger.FromContext(ctx).ErrorContext(ctx, "response doesn't match the OpenAPI document",
+				"route", c.Route().Name, "status", resp.StatusCode(), "error", err)
+			resp.ResetBody()
+			return middleware.SendError(c, fiber.StatusInternalServerError, errors.New("response doesn't match the OpenAPI document: "+err.Error()))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,187 @@
+package openapi
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+type item struct {
+	ID    int         `json:"id"`
+	Name  string      `json:"name"`
+	Note  null.String `json:"note,omitempty"`
+	Count *int        `json:"count"`
+	Tags  []string    `json:"tags,omitempty"`
+	Owner string      `json:"owner"`
+	Since *day        `json:"since,omitempty"`
+	skip  string
+}
+
+// day is marshalled as a string.
+type day struct {
+	d int
+}
+
+func (d day) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(d.d)), nil
+}
+
+func newTestDocument(t *testing.T) *Document {
+	t.Helper()
+
+	app := fiber.New()
+	noop := func(c *fiber.Ctx) error { return nil }
+	app.Get("/items/:id", noop).Name("items.get")
+	app.Post("/items", noop).Name("items.create")
+	app.Get("/undocumented", noop).Name("undocumented")
+
+	schemas := NewSchemas()
+	schemas.Component(item{}).Properties["owner"].ReadOnly = true
+	minLimit, maxLimit := 1.0, 100.0
+	g := &Generator{
+		Info:    Info{Title: "test", Version: "1"},
+		Schemas: schemas,
+		Routes: map[string]Route{
+			"items.get": {
+				Summary: "Get",
+				Query:   []*Parameter{{Name: "limit", Schema: &Schema{Type: "integer", Minimum: &minLimit, Maximum: &maxLimit}}},
+				Result:  item{},
+			},
+			"items.create": {Summary: "Create", Body: item{}},
+		},
+	}
+	doc, undocumented := g.Generate(app.GetRoutes(true))
+	assert.Equal(t, []string{"GET /undocumented"}, undocumented)
+
+	return doc
+}
+
+func Test_Generate(t *testing.T) {
+	t.Parallel()
+
+	doc := newTestDocument(t)
+	assert.Contains(t, doc.Paths, "/items/{id}")
+	assert.Contains(t, doc.Paths, "/items")
+	assert.NotContains(t, doc.Paths, "/undocumented")
+
+	s := doc.Components.Schemas["item"]
+	require.NotNil(t, s)
+	assert.Equal(t, []string{"id", "name", "count", "owner"}, s.Required)
+	assert.Equal(t, []string{"string", "null"}, s.Properties["note"].Type)
+	assert.Equal(t, []string{"integer", "null"}, s.Properties["count"].Type)
+	assert.Equal(t, "array", s.Properties["tags"].Type)
+	assert.Equal(t, []string{"string", "null"}, s.Properties["since"].Type)
+	assert.NotContains(t, doc.Components.Schemas, "day")
+	assert.NotContains(t, s.Properties, "skip")
+
+	assert.Equal(t, "/buildings/{id}", PathOf("/buildings/:id"))
+	assert.Equal(t, "/apartments", PathOf("/apartments/"))
+	assert.Equal(t, "/", PathOf("/"))
+}
+
+func Test_ValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(newTestDocument(t))
+	create := func(body string) error {
+		return v.ValidateRequest(Request{Method: "POST", Path: "/items", ContentType: "application/json", Body: []byte(body)})
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr string
+	}{
+		{name: "valid", err: create(`{"id":1,"name":"a","note":null,"count":null,"tags":["x"]}`)},
+		{name: "readOnlyNotRequired", err: create(`{"id":1,"name":"a","count":2}`)},
+		{name: "unknownProperty", err: create(`{"id":1,"name":"a","count":2,"extra":true}`)},
+		{name: "missing", err: create(`{"id":1,"count":2}`), wantErr: "body.name: is required"},
+		{name: "notInteger", err: create(`{"id":1.5,"name":"a","count":2}`), wantErr: "body.id: must be an integer"},
+		{name: "notNullable", err: create(`{"id":null,"name":"a","count":2}`), wantErr: "body.id: must be an integer"},
+		{name: "item", err: create(`{"id":1,"name":"a","count":2,"tags":[1]}`), wantErr: "body.tags[0]: must be a string"},
+		{name: "notObject", err: create(`[]`), wantErr: "body: must be an object"},
+		{name: "trailingData", err: create(`{"id":1,"name":"a","count":2} {}`), wantErr: "body: invalid JSON: unexpected data after the value"},
+		{name: "emptyBody", err: create(``), wantErr: "body: is required"},
+		{
+			name:    "mediaType",
+			err:     v.ValidateRequest(Request{Method: "POST", Path: "/items", ContentType: "text/plain", Body: []byte(`{}`)}),
+			wantErr: ErrUnsupportedMediaType.Error(),
+		},
+		{
+			name: "pathParam",
+			err:  v.ValidateRequest(Request{Method: "GET", Path: "/items/:id", PathParams: map[string]string{"id": "42"}}),
+		},
+		{
+			name:    "invalidPathParam",
+			err:     v.ValidateRequest(Request{Method: "GET", Path: "/items/:id", PathParams: map[string]string{"id": "x"}}),
+			wantErr: "path.id: must be an integer",
+		},
+		{
+			name: "queryParam",
+			err:  v.ValidateRequest(Request{Method: "GET", Path: "/items/:id", PathParams: map[string]string{"id": "42"}, Query: map[string][]string{"limit": {"5"}}}),
+		},
+		{
+			name:    "invalidQueryParam",
+			err:     v.ValidateRequest(Request{Method: "GET", Path: "/items/:id", PathParams: map[string]string{"id": "42"}, Query: map[string][]string{"limit": {"x"}}}),
+			wantErr: "query.limit: must be an integer",
+		},
+		{
+			name:    "belowMinimum",
+			err:     v.ValidateRequest(Request{Method: "GET", Path: "/items/:id", PathParams: map[string]string{"id": "42"}, Query: map[string][]string{"limit": {"0"}}}),
+			wantErr: "query.limit: must be at least 1",
+		},
+		{
+			name:    "aboveMaximum",
+			err:     v.ValidateRequest(Request{Method: "GET", Path: "/items/:id", PathParams: map[string]string{"id": "42"}, Query: map[string][]string{"limit": {"101"}}}),
+			wantErr: "query.limit: must be at most 100",
+		},
+		{
+			name:    "longHeader",
+			err:     v.ValidateRequest(Request{Method: "POST", Path: "/items", Header: func(string) string { return string(make([]byte, 300)) }}),
+			wantErr: "header.Idempotency-Key: must have at most 255 characters",
+		},
+		{
+			name: "undocumented",
+			err:  v.ValidateRequest(Request{Method: "GET", Path: "/undocumented"}),
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.wantErr == "" {
+			assert.NoError(t, tt.err, tt.name)
+		} else if assert.Error(t, tt.err, tt.name) {
+			assert.Contains(t, tt.err.Error(), tt.wantErr, tt.name)
+		}
+	}
+}
+
+func Test_ValidateResponse(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(newTestDocument(t))
+
+	err := v.ValidateResponse("GET", "/items/:id", 200, "application/json",
+		[]byte(`{"result":"success","response":{"id":1,"name":"a","count":null,"owner":"o"}}`))
+	assert.NoError(t, err)
+
+	// The readOnly properties are required in the responses.
+	err = v.ValidateResponse("GET", "/items/:id", 200, "application/json",
+		[]byte(`{"result":"success","response":{"id":1,"name":"a","count":null}}`))
+	assert.EqualError(t, err, "response.response.owner: is required")
+
+	err = v.ValidateResponse("GET", "/items/:id", 200, "application/json", []byte(`{"result":"ok"}`))
+	assert.EqualError(t, err, "response.response: is required")
+
+	err = v.ValidateResponse("GET", "/items/:id", 400, "application/json", []byte(`{"result":"error","response":"bad id"}`))
+	assert.NoError(t, err)
+
+	err = v.ValidateResponse("GET", "/items/:id", 404, "application/json", []byte(`{}`))
+	assert.EqualError(t, err, "status 404 of items.get is not documented")
+
+	err = v.ValidateResponse("GET", "/items/:id", 200, "text/plain", []byte(`ok`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package openapi
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// nullPkgPath is the package of the null.String, null.Int, etc. fields of the generated models.
+const nullPkgPath = "github.com/volatiletech/null/v8"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Schemas derives the JSON schemas of Go types from their JSON tags. The named structs are added
+// to the components and referenced, the nullable values (pointers and the null package types)
+// get a ["<type>", "null"] type.
+type Schemas struct {
+	components map[string]*Schema
+	prefixes   map[string]string
+}
+
+func NewSchemas() *Schemas {
+	return &Schemas{
+		components: make(map[string]*Schema),
+		prefixes:   make(map[string]string),
+	}
+}
+
+// Prefix prepends prefix to the component names of the structs of the package of v, so they
+// don't clash with the ones of the same name in other packages.
+func (s *Schemas) Prefix(v any, prefix string) {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	s.prefixes[t.PkgPath()] = prefix
+}
+
+func (s *Schemas) name(t reflect.Type) string {
+	return s.prefixes[t.PkgPath()] + t.Name()
+}
+
+// Components returns the schemas of the named structs seen so far.
+func (s *Schemas) Components() map[string]*Schema {
+	return s.components
+}
+
+// Of returns the schema of the type of v.
+func (s *Schemas) Of(v any) *Schema {
+	return s.schema(reflect.TypeOf(v))
+}
+
+// Component returns the component schema of the named struct type of v, so the caller can refine it.
+func (s *Schemas) Component(v any) *Schema {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	s.schema(t)
+	return s.components[s.name(t)]
+}
+
+func (s *Schemas) schema(t reflect.Type) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.PkgPath() == nullPkgPath && t.Kind() == reflect.Struct && t.NumField() > 0:
+		// null.X{X x; Valid bool}
+		return nullable(s.schema(t.Field(0).Type))
+	case t.Kind() == reflect.Struct && t.Implements(textMarshalerType):
+		// e.g. storage.Date, marshalled as a string
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem := s.schema(t.Elem())
+		if elem.Ref != "" {
+			return elem
+		}
+		return nullable(elem)
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		name := s.name(t)
+		if _, ok := s.components[name]; !ok {
+			// The placeholder stops the recursion of the self-referencing types.
+			s.components[name] = &Schema{}
+			*s.components[name] = *s.object(t)
+		}
+		return Ref(name)
+	default:
+		return &Schema{}
+	}
+}
+
+func (s *Schemas) object(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		schema.Properties[name] = s.schema(f.Type)
+		if !strings.Contains(opts, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// Ref references a component schema.
+func Ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func nullable(s *Schema) *Schema {
+	if name, ok := s.Type.(string); ok {
+		s.Type = []string{name, "null"}
+	}
+	return s
+}
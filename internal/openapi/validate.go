@@ -0,0 +1,401 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sotskov-do/oms-assignment/internal/controllers/middleware"
+)
+
+// ValidationError is a value that doesn't match its schema.
+type ValidationError struct {
+	// Field is the location of the value, e.g. body.building_id or path.id.
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// ErrUnsupportedMediaType is returned for the bodies that aren't JSON.
+var ErrUnsupportedMediaType = errors.New("request body must be application/json")
+
+// Request is the part of an HTTP request the Validator checks.
+type Request struct {
+	Method string
+	// Path is the Fiber path of the matched route, e.g. /buildings/:id.
+	Path        string
+	PathParams  map[string]string
+	Query       map[string][]string
+	Header      func(name string) string
+	ContentType string
+	Body        []byte
+}
+
+// Validator checks the requests and the responses of the operations against their document.
+type Validator struct {
+	doc *Document
+}
+
+// NewValidator returns a validator of doc. The document can be loaded later, see Load,
+// the validator doesn't check anything until then.
+func NewValidator(doc *Document) *Validator {
+	return &Validator{
+		doc: doc,
+	}
+}
+
+// Load replaces the document, it must be called before the validator is used.
+func (v *Validator) Load(doc *Document) {
+	v.doc = doc
+}
+
+func (v *Validator) operation(method, path string) *Operation {
+	if v.doc == nil {
+		return nil
+	}
+	item, ok := v.doc.Paths[PathOf(path)]
+	if !ok {
+		return nil
+	}
+	return item[strings.ToLower(method)]
+}
+
+// ValidateRequest checks the parameters and the JSON body of a request. The requests of the
+// undocumented operations aren't checked.
+func (v *Validator) ValidateRequest(req Request) error {
+	op := v.operation(req.Method, req.Path)
+	if op == nil {
+		return nil
+	}
+
+	for _, p := range op.Parameters {
+		p = v.parameter(p)
+		if p == nil {
+			continue
+		}
+
+		var value string
+		var present bool
+		switch p.In {
+		case "path":
+			value, present = req.PathParams[p.Name]
+		case "query":
+			values := req.Query[p.Name]
+			if len(values) > 0 {
+				value, present = values[0], true
+			}
+		case "header":
+			if req.Header != nil {
+				value = req.Header(p.Name)
+				present = value != ""
+			}
+		}
+		if !present {
+			if p.Required {
+				return &ValidationError{Field: p.In + "." + p.Name, Reason: "is required"}
+			}
+			continue
+		}
+
+		err := v.validateParam(p.In+"."+p.Name, value, p.Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.RequestBody == nil {
+		return nil
+	}
+	if len(bytes.TrimSpace(req.Body)) == 0 {
+		if op.RequestBody.Required {
+			return &ValidationError{Field: "body", Reason: "is required"}
+		}
+		return nil
+	}
+	media, ok := op.RequestBody.Content[mimeJSON]
+	if !ok {
+		return nil
+	}
+	if !isJSON(req.ContentType) {
+		return ErrUnsupportedMediaType
+	}
+
+	return v.validateJSON("body", req.Body, media.Schema, true)
+}
+
+// ValidateResponse checks that the status of a response is documented and that its JSON body
+// matches the documented schema.
+func (v *Validator) ValidateResponse(method, path string, status int, contentType string, body []byte) error {
+	op := v.operation(method, path)
+	if op == nil {
+		return fmt.Errorf("%s %s is not documented", method, path)
+	}
+
+	resp, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		resp, ok = op.Responses["default"]
+	}
+	if !ok {
+		return fmt.Errorf("status %d of %s is not documented", status, op.OperationID)
+	}
+	resp = v.response(resp)
+
+	if len(resp.Content) == 0 {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	media, ok := resp.Content[mediaType]
+	if !ok {
+		return fmt.Errorf("content type %q of %s %d is not documented", contentType, op.OperationID, status)
+	}
+	if (mediaType != mimeJSON && mediaType != middleware.MIMEApplicationGeoJSON) || media.Schema == nil {
+		return nil
+	}
+
+	return v.validateJSON("response", body, media.Schema, false)
+}
+
+func (v *Validator) validateParam(field, value string, schema *Schema) error {
+	schema = v.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	// The parameters are strings, they are converted to the type of their schema first.
+	var decoded any = value
+	if types := schemaTypes(schema); len(types) == 1 {
+		switch types[0] {
+		case "integer", "number":
+			decoded = json.Number(value)
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return &ValidationError{Field: field, Reason: "must be " + article(types[0])}
+			}
+		case "boolean":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return &ValidationError{Field: field, Reason: "must be a boolean"}
+			}
+			decoded = b
+		}
+	}
+
+	return v.validate(field, decoded, schema, true)
+}
+
+func (v *Validator) validateJSON(field string, body []byte, schema *Schema, request bool) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	err := dec.Decode(&value)
+	if err != nil {
+		return &ValidationError{Field: field, Reason: "invalid JSON: " + err.Error()}
+	}
+	if dec.More() {
+		return &ValidationError{Field: field, Reason: "invalid JSON: unexpected data after the value"}
+	}
+
+	return v.validate(field, value, schema, request)
+}
+
+// validate checks the value against the schema. The readOnly properties aren't required in the requests.
+func (v *Validator) validate(field string, value any, schema *Schema, request bool) error {
+	schema = v.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	if schema.Const != nil && !equal(value, schema.Const) {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("must be %v", schema.Const)}
+	}
+	if len(schema.Enum) > 0 {
+		found := false
+		for _, e := range schema.Enum {
+			if equal(value, e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &ValidationError{Field: field, Reason: fmt.Sprintf("must be one of %v", schema.Enum)}
+		}
+	}
+
+	types := schemaTypes(schema)
+	if len(types) > 0 {
+		matched := ""
+		for _, t := range types {
+			if hasType(value, t) {
+				matched = t
+				break
+			}
+		}
+		if matched == "" {
+			return &ValidationError{Field: field, Reason: "must be " + strings.Join(articles(types), " or ")}
+		}
+	}
+
+	switch value := value.(type) {
+	case string:
+		n := utf8.RuneCountInString(value)
+		if schema.MinLength != nil && n < *schema.MinLength {
+			return &ValidationError{Field: field, Reason: fmt.Sprintf("must have at least %d characters", *schema.MinLength)}
+		}
+		if schema.MaxLength != nil && n > *schema.MaxLength {
+			return &ValidationError{Field: field, Reason: fmt.Sprintf("must have at most %d characters", *schema.MaxLength)}
+		}
+	case json.Number:
+		f, _ := value.Float64()
+		if schema.Minimum != nil && f < *schema.Minimum {
+			return &ValidationError{Field: field, Reason: fmt.Sprintf("must be at least %v", *schema.Minimum)}
+		}
+		if schema.Maximum != nil && f > *schema.Maximum {
+			return &ValidationError{Field: field, Reason: fmt.Sprintf("must be at most %v", *schema.Maximum)}
+		}
+		if schema.Format == "int32" && (f < math.MinInt32 || f > math.MaxInt32) {
+			return &ValidationError{Field: field, Reason: "must be a 32-bit integer"}
+		}
+	case []any:
+		if schema.Items != nil {
+			for i, item := range value {
+				err := v.validate(fmt.Sprintf("%s[%d]", field, i), item, schema.Items, request)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]any:
+		for _, name := range schema.Required {
+			if prop := v.resolve(schema.Properties[name]); request && prop != nil && prop.ReadOnly {
+				continue
+			}
+			if _, ok := value[name]; !ok {
+				return &ValidationError{Field: field + "." + name, Reason: "is required"}
+			}
+		}
+		// Sorted, so the first error of a body is always the same one.
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			propSchema, ok := schema.Properties[name]
+			if !ok {
+				propSchema = schema.AdditionalProperties
+			}
+			if propSchema == nil {
+				continue
+			}
+			err := v.validate(field+"."+name, value[name], propSchema, request)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = v.doc.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+	}
+	return schema
+}
+
+func (v *Validator) parameter(p *Parameter) *Parameter {
+	if p.Ref != "" {
+		return v.doc.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+	}
+	return p
+}
+
+func (v *Validator) response(r *Response) *Response {
+	if r.Ref != "" {
+		return v.doc.Components.Responses[strings.TrimPrefix(r.Ref, "#/components/responses/")]
+	}
+	return r
+}
+
+func schemaTypes(schema *Schema) []string {
+	switch t := schema.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	default:
+		return nil
+	}
+}
+
+func hasType(value any, t string) bool {
+	switch t {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f) && !math.IsInf(f, 0)
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	default:
+		return true
+	}
+}
+
+func equal(value, expected any) bool {
+	if n, ok := value.(json.Number); ok {
+		return n.String() == fmt.Sprint(expected)
+	}
+	return value == expected
+}
+
+func article(t string) string {
+	switch t {
+	case "null":
+		return "null"
+	case "array", "object", "integer":
+		return "an " + t
+	default:
+		return "a " + t
+	}
+}
+
+func articles(types []string) []string {
+	out := make([]string, len(types))
+	for i, t := range types {
+		out[i] = article(t)
+	}
+	return out
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == mimeJSON || strings.HasSuffix(mediaType, "+json"))
+}
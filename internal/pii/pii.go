@@ -0,0 +1,134 @@
+// Package pii encrypts the personal data at rest, e.g. the names and the contact details of the
+// residents, with AES-256-GCM.
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix starts the encrypted values, the other values were stored before the encryption was
+// enabled and are read as they are.
+const prefix = "pii:v1:"
+
+// KeySize is the size of the keys in bytes, AES-256.
+const KeySize = 32
+
+// ErrUnknownKey is returned for the values encrypted with a key that isn't in the cipher.
+var ErrUnknownKey = errors.New("unknown encryption key")
+
+// Cipher encrypts with its first key and decrypts with any of them, so the keys can be rotated:
+// the new key is added first, the old one is kept until the Stale values are re-encrypted, e.g.
+// with POST /admin/pii/reencrypt. A nil Cipher stores the values in clear.
+type Cipher struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// ParseKeys reads the keys of a cipher as "id:base64key,id:base64key", the first key encrypts, e.g.
+//
+//	2026:q3Ah1xZp...=,2025:Vd8rLwM0...=
+//
+// It returns nil for an empty string.
+func ParseKeys(s string) (*Cipher, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	c := &Cipher{keys: make(map[string]cipher.AEAD)}
+	for _, entry := range strings.Split(s, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("key [%v] isn't id:base64key", id)
+		}
+		if _, ok := c.keys[id]; ok {
+			return nil, fmt.Errorf("key [%v] is repeated", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key [%v] isn't base64: %w", id, err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("key [%v]: %w", id, err)
+		}
+		c.keys[id] = aead
+		if c.current == "" {
+			c.current = id
+		}
+	}
+	return c, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key of %d bytes, %d are required", len(key), KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt encrypts the value of the field, e.g. "resident.email". The field is authenticated with
+// the value, so a value copied to another field doesn't decrypt. The empty values aren't encrypted.
+func (c *Cipher) Encrypt(field, value string) (string, error) {
+	if c == nil || value == "" {
+		return value, nil
+	}
+
+	aead := c.keys[c.current]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(value)+aead.Overhead())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(field))
+
+	return prefix + c.current + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value of the field returned by Encrypt. The values that weren't encrypted are
+// returned as they are.
+func (c *Cipher) Decrypt(field, value string) (string, error) {
+	encrypted, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+
+	id, encoded, _ := strings.Cut(encrypted, ":")
+	var aead cipher.AEAD
+	if c != nil {
+		aead = c.keys[id]
+	}
+	if aead == nil {
+		return "", fmt.Errorf("%w [%v] of %s", ErrUnknownKey, id, field)
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("malformed encrypted %s", field)
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(field))
+	if err != nil {
+		return "", fmt.Errorf("can't decrypt %s: %w", field, err)
+	}
+	return string(plain), nil
+}
+
+// Stale reports whether the value isn't stored the way Encrypt stores it: in clear or with a rotated
+// key. Without a Cipher no value is stale, the encrypted values aren't decrypted back to clear.
+func (c *Cipher) Stale(value string) bool {
+	return c != nil && value != "" && !strings.HasPrefix(value, prefix+c.current+":")
+}
+
+// Encrypted reports whether the value was encrypted by a Cipher.
+func Encrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
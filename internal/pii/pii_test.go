@@ -0,0 +1,151 @@
+package pii
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func key(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, KeySize))
+}
+
+func Test_ParseKeys(t *testing.T) {
+	t.Parallel()
+
+	c, err := ParseKeys("")
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = ParseKeys(" new:" + key(1) + ", old:" + key(2))
+	require.NoError(t, err)
+	assert.Equal(t, "new", c.current)
+	assert.Len(t, c.keys, 2)
+
+	for name, keys := range map[string]string{
+		"noID":      key(1),
+		"notBase64": "a:not base64",
+		"short":     "a:" + base64.StdEncoding.EncodeToString([]byte("short")),
+		"repeated":  "a:" + key(1) + ",a:" + key(2),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseKeys(keys)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_Cipher(t *testing.T) {
+	t.Parallel()
+
+	c, err := ParseKeys("new:" + key(1) + ",old:" + key(2))
+	require.NoError(t, err)
+	old, err := ParseKeys("old:" + key(2))
+	require.NoError(t, err)
+
+	t.Run("roundTrip", func(t *testing.T) {
+		t.Parallel()
+
+		encrypted, err := c.Encrypt("resident.email", "ada@example.com")
+		require.NoError(t, err)
+		assert.True(t, Encrypted(encrypted))
+		assert.True(t, strings.HasPrefix(encrypted, "pii:v1:new:"))
+		assert.NotContains(t, encrypted, "ada")
+
+		again, err := c.Encrypt("resident.email", "ada@example.com")
+		require.NoError(t, err)
+		assert.NotEqual(t, encrypted, again, "the nonce is random")
+
+		decrypted, err := c.Decrypt("resident.email", encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "ada@example.com", decrypted)
+	})
+
+	t.Run("rotatedKey", func(t *testing.T) {
+		t.Parallel()
+
+		encrypted, err := old.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+
+		decrypted, err := c.Decrypt("resident.name", encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "Ada", decrypted)
+	})
+
+	t.Run("unknownKey", func(t *testing.T) {
+		t.Parallel()
+
+		encrypted, err := c.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+
+		_, err = old.Decrypt("resident.name", encrypted)
+		assert.ErrorIs(t, err, ErrUnknownKey)
+
+		var none *Cipher
+		_, err = none.Decrypt("resident.name", encrypted)
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("otherField", func(t *testing.T) {
+		t.Parallel()
+
+		encrypted, err := c.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+
+		_, err = c.Decrypt("resident.email", encrypted)
+		assert.Error(t, err)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		t.Parallel()
+
+		encrypted, err := c.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+
+		_, err = c.Decrypt("resident.name", encrypted[:len(encrypted)-2]+"AA")
+		assert.Error(t, err)
+		_, err = c.Decrypt("resident.name", "pii:v1:new:!")
+		assert.Error(t, err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		t.Parallel()
+
+		var none *Cipher
+		encrypted, err := none.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+		assert.Equal(t, "Ada", encrypted)
+
+		decrypted, err := c.Decrypt("resident.name", "Ada")
+		require.NoError(t, err)
+		assert.Equal(t, "Ada", decrypted, "the values stored before the encryption are read in clear")
+
+		empty, err := c.Encrypt("resident.phone", "")
+		require.NoError(t, err)
+		assert.Empty(t, empty)
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		t.Parallel()
+
+		current, err := c.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+		rotated, err := old.Encrypt("resident.name", "Ada")
+		require.NoError(t, err)
+
+		assert.False(t, c.Stale(current))
+		assert.True(t, c.Stale(rotated))
+		assert.True(t, c.Stale("Ada"))
+		assert.False(t, c.Stale(""))
+
+		var none *Cipher
+		assert.False(t, none.Stale("Ada"))
+		assert.False(t, none.Stale(current))
+	})
+}
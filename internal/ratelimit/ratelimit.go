@@ -0,0 +1,97 @@
+// Package ratelimit limits the requests of every client with token buckets.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// component tags the logs of the package, see logger.Component.
+const component = "ratelimit"
+
+// Limit is a token bucket: it holds up to Burst requests and refills at Rate requests per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result is the state of the bucket after a request.
+type Result struct {
+	Allowed bool
+	// Remaining is the number of requests that can be made right away.
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until the next request is allowed, 0 if it is allowed now.
+	RetryAfter time.Duration
+}
+
+type Limiter struct {
+	store storage.RateLimitStorage
+}
+
+func NewLimiter(store storage.RateLimitStorage) *Limiter {
+	return &Limiter{
+		store: store,
+	}
+}
+
+// Take takes a token from the bucket of the key.
+func (l *Limiter) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	tokens, allowed, err := l.store.TakeToken(ctx, key, limit.Rate, limit.Burst)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := Result{
+		Allowed:   allowed,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     seconds((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+	if !allowed {
+		res.RetryAfter = seconds((1 - tokens) / limit.Rate)
+	}
+
+	return res, nil
+}
+
+// Sweep deletes the idle buckets every interval until ctx is done, it is meant to run as a worker.
+func (l *Limiter) Sweep(ctx context.Context, interval, idle time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n, err := l.store.DeleteIdleBuckets(ctx, idle)
+		if err != nil {
+			logger.Component(component).WarnContext(ctx, "can't delete idle rate limit buckets", "error", err)
+			continue
+		}
+		logger.Component(component).DebugContext(ctx, "idle rate limit buckets deleted", "count", n)
+	}
+}
+
+// IdleAfter is the time after which the buckets of the limits are full again.
+func IdleAfter(limits ...Limit) time.Duration {
+	var idle time.Duration
+	for _, l := range limits {
+		idle = max(idle, seconds(float64(l.Burst)/l.Rate))
+	}
+	return idle
+}
+
+func seconds(s float64) time.Duration {
+	if s <= 0 {
+		return 0
+	}
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestStore() (*MemoryStore, *clock) {
+	c := &clock{now: time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore()
+	s.now = c.Now
+	return s, c
+}
+
+func Test_Limiter(t *testing.T) {
+	t.Parallel()
+
+	store, clock := newTestStore()
+	l := NewLimiter(store)
+	limit := Limit{Rate: 2, Burst: 3}
+	ctx := context.Background()
+
+	// The burst is allowed right away.
+	for i := 2; i >= 0; i-- {
+		res, err := l.Take(ctx, "client", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, i, res.Remaining)
+		assert.Zero(t, res.RetryAfter)
+	}
+
+	res, err := l.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, res.Reset)
+
+	// Other keys have their own buckets.
+	res, err = l.Take(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// A token is back after 1/rate.
+	clock.Advance(500 * time.Millisecond)
+	res, err = l.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	res, err = l.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	// The bucket doesn't fill past the burst.
+	clock.Advance(time.Hour)
+	res, err = l.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 2, res.Remaining)
+}
+
+func Test_DeleteIdleBuckets(t *testing.T) {
+	t.Parallel()
+
+	store, clock := newTestStore()
+	ctx := context.Background()
+
+	_, _, err := store.TakeToken(ctx, "idle", 1, 1)
+	require.NoError(t, err)
+	clock.Advance(time.Minute)
+	_, _, err = store.TakeToken(ctx, "active", 1, 1)
+	require.NoError(t, err)
+
+	n, err := store.DeleteIdleBuckets(ctx, 30*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	assert.Contains(t, store.buckets, "active")
+	assert.NotContains(t, store.buckets, "idle")
+}
+
+func Test_IdleAfter(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 50*time.Second, IdleAfter(Limit{Rate: 2, Burst: 100}, Limit{Rate: 0.5, Burst: 20}))
+}
@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: bms/v1/bms.proto
+
+package bmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Building struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 in CreateBuilding creates a new building.
+	Id      int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address *string `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	// Output only, the tenant of the request.
+	TenantId string `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Building) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Building) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Building) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *Building) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type Apartment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 in CreateApartment creates a new apartment.
+	Id         int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuildingId int32   `protobuf:"varint,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	Number     *string `protobuf:"bytes,3,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	Floor      *int32  `protobuf:"varint,4,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	SqMeters   *int32  `protobuf:"varint,5,opt,name=sq_meters,json=sqMeters,proto3,oneof" json:"sq_meters,omitempty"`
+	// Output only, the tenant of the request.
+	TenantId string `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *Apartment) Reset() {
+	*x = Apartment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Apartment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Apartment) ProtoMessage() {}
+
+func (x *Apartment) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Apartment.ProtoReflect.Descriptor instead.
+func (*Apartment) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Apartment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Apartment) GetBuildingId() int32 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *Apartment) GetNumber() string {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return ""
+}
+
+func (x *Apartment) GetFloor() int32 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *Apartment) GetSqMeters() int32 {
+	if x != nil && x.SqMeters != nil {
+		return *x.SqMeters
+	}
+	return 0
+}
+
+func (x *Apartment) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListBuildingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBuildingsRequest) Reset() {
+	*x = ListBuildingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBuildingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsRequest) ProtoMessage() {}
+
+func (x *ListBuildingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBuildingsRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{2}
+}
+
+type GetBuildingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetBuildingRequest) Reset() {
+	*x = GetBuildingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildingRequest) ProtoMessage() {}
+
+func (x *GetBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildingRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBuildingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateBuildingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building *Building `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *CreateBuildingRequest) Reset() {
+	*x = CreateBuildingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingRequest) ProtoMessage() {}
+
+func (x *CreateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateBuildingRequest) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type DeleteBuildingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteBuildingRequest) Reset() {
+	*x = DeleteBuildingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuildingRequest) ProtoMessage() {}
+
+func (x *DeleteBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuildingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteBuildingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListApartmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListApartmentsRequest) Reset() {
+	*x = ListApartmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApartmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApartmentsRequest) ProtoMessage() {}
+
+func (x *ListApartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListApartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{6}
+}
+
+type GetApartmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetApartmentRequest) Reset() {
+	*x = GetApartmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApartmentRequest) ProtoMessage() {}
+
+func (x *GetApartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApartmentRequest.ProtoReflect.Descriptor instead.
+func (*GetApartmentRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetApartmentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListApartmentsInBuildingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildingId int32 `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+}
+
+func (x *ListApartmentsInBuildingRequest) Reset() {
+	*x = ListApartmentsInBuildingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApartmentsInBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApartmentsInBuildingRequest) ProtoMessage() {}
+
+func (x *ListApartmentsInBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApartmentsInBuildingRequest.ProtoReflect.Descriptor instead.
+func (*ListApartmentsInBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListApartmentsInBuildingRequest) GetBuildingId() int32 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+type CreateApartmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Apartment *Apartment `protobuf:"bytes,1,opt,name=apartment,proto3" json:"apartment,omitempty"`
+}
+
+func (x *CreateApartmentRequest) Reset() {
+	*x = CreateApartmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApartmentRequest) ProtoMessage() {}
+
+func (x *CreateApartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApartmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateApartmentRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateApartmentRequest) GetApartment() *Apartment {
+	if x != nil {
+		return x.Apartment
+	}
+	return nil
+}
+
+type DeleteApartmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteApartmentRequest) Reset() {
+	*x = DeleteApartmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bms_v1_bms_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteApartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApartmentRequest) ProtoMessage() {}
+
+func (x *DeleteApartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bms_v1_bms_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApartmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApartmentRequest) Descriptor() ([]byte, []int) {
+	return file_bms_v1_bms_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteApartmentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_bms_v1_bms_proto protoreflect.FileDescriptor
+
+var file_bms_v1_bms_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x62, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xd6, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x71, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x73, 0x71, 0x4d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73,
+	0x71, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x27, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x32,
+	0x9e, 0x02, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x62, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x32, 0x84, 0x03, 0x0a, 0x11, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x62,
+	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x49, 0x6e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x2e, 0x62, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x74, 0x73, 0x6b, 0x6f, 0x76, 0x2d, 0x64, 0x6f,
+	0x2f, 0x6f, 0x6d, 0x73, 0x2d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f,
+	0x62, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6d, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bms_v1_bms_proto_rawDescOnce sync.Once
+	file_bms_v1_bms_proto_rawDescData = file_bms_v1_bms_proto_rawDesc
+)
+
+func file_bms_v1_bms_proto_rawDescGZIP() []byte {
+	file_bms_v1_bms_proto_rawDescOnce.Do(func() {
+		file_bms_v1_bms_proto_rawDescData = protoimpl.X.CompressGZIP(file_bms_v1_bms_proto_rawDescData)
+	})
+	return file_bms_v1_bms_proto_rawDescData
+}
+
+var file_bms_v1_bms_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_bms_v1_bms_proto_goTypes = []any{
+	(*Building)(nil),                        // 0: bms.v1.Building
+	(*Apartment)(nil),                       // 1: bms.v1.Apartment
+	(*ListBuildingsRequest)(nil),            // 2: bms.v1.ListBuildingsRequest
+	(*GetBuildingRequest)(nil),              // 3: bms.v1.GetBuildingRequest
+	(*CreateBuildingRequest)(nil),           // 4: bms.v1.CreateBuildingRequest
+	(*DeleteBuildingRequest)(nil),           // 5: bms.v1.DeleteBuildingRequest
+	(*ListApartmentsRequest)(nil),           // 6: bms.v1.ListApartmentsRequest
+	(*GetApartmentRequest)(nil),             // 7: bms.v1.GetApartmentRequest
+	(*ListApartmentsInBuildingRequest)(nil), // 8: bms.v1.ListApartmentsInBuildingRequest
+	(*CreateApartmentRequest)(nil),          // 9: bms.v1.CreateApartmentRequest
+	(*DeleteApartmentRequest)(nil),          // 10: bms.v1.DeleteApartmentRequest
+	(*emptypb.Empty)(nil),                   // 11: google.protobuf.Empty
+}
+var file_bms_v1_bms_proto_depIdxs = []int32{
+	0,  // 0: bms.v1.CreateBuildingRequest.building:type_name -> bms.v1.Building
+	1,  // 1: bms.v1.CreateApartmentRequest.apartment:type_name -> bms.v1.Apartment
+	2,  // 2: bms.v1.BuildingsService.ListBuildings:input_type -> bms.v1.ListBuildingsRequest
+	3,  // 3: bms.v1.BuildingsService.GetBuilding:input_type -> bms.v1.GetBuildingRequest
+	4,  // 4: bms.v1.BuildingsService.CreateBuilding:input_type -> bms.v1.CreateBuildingRequest
+	5,  // 5: bms.v1.BuildingsService.DeleteBuilding:input_type -> bms.v1.DeleteBuildingRequest
+	6,  // 6: bms.v1.ApartmentsService.ListApartments:input_type -> bms.v1.ListApartmentsRequest
+	7,  // 7: bms.v1.ApartmentsService.GetApartment:input_type -> bms.v1.GetApartmentRequest
+	8,  // 8: bms.v1.ApartmentsService.ListApartmentsInBuilding:input_type -> bms.v1.ListApartmentsInBuildingRequest
+	9,  // 9: bms.v1.ApartmentsService.CreateApartment:input_type -> bms.v1.CreateApartmentRequest
+	10, // 10: bms.v1.ApartmentsService.DeleteApartment:input_type -> bms.v1.DeleteApartmentRequest
+	0,  // 11: bms.v1.BuildingsService.ListBuildings:output_type -> bms.v1.Building
+	0,  // 12: bms.v1.BuildingsService.GetBuilding:output_type -> bms.v1.Building
+	0,  // 13: bms.v1.BuildingsService.CreateBuilding:output_type -> bms.v1.Building
+	11, // 14: bms.v1.BuildingsService.DeleteBuilding:output_type -> google.protobuf.Empty
+	1,  // 15: bms.v1.ApartmentsService.ListApartments:output_type -> bms.v1.Apartment
+	1,  // 16: bms.v1.ApartmentsService.GetApartment:output_type -> bms.v1.Apartment
+	1,  // 17: bms.v1.ApartmentsService.ListApartmentsInBuilding:output_type -> bms.v1.Apartment
+	1,  // 18: bms.v1.ApartmentsService.CreateApartment:output_type -> bms.v1.Apartment
+	11, // 19: bms.v1.ApartmentsService.DeleteApartment:output_type -> google.protobuf.Empty
+	11, // [11:20] is the sub-list for method output_type
+	2,  // [2:11] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_bms_v1_bms_proto_init() }
+func file_bms_v1_bms_proto_init() {
+	if File_bms_v1_bms_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bms_v1_bms_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Building); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Apartment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBuildingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBuildingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateBuildingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteBuildingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ListApartmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetApartmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ListApartmentsInBuildingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateApartmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bms_v1_bms_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteApartmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_bms_v1_bms_proto_msgTypes[0].OneofWrappers = []any{}
+	file_bms_v1_bms_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bms_v1_bms_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_bms_v1_bms_proto_goTypes,
+		DependencyIndexes: file_bms_v1_bms_proto_depIdxs,
+		MessageInfos:      file_bms_v1_bms_proto_msgTypes,
+	}.Build()
+	File_bms_v1_bms_proto = out.File
+	file_bms_v1_bms_proto_rawDesc = nil
+	file_bms_v1_bms_proto_goTypes = nil
+	file_bms_v1_bms_proto_depIdxs = nil
+}
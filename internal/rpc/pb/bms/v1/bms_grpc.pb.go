@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: bms/v1/bms.proto
+
+package bmsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	BuildingsService_ListBuildings_FullMethodName  = "/bms.v1.BuildingsService/ListBuildings"
+	BuildingsService_GetBuilding_FullMethodName    = "/bms.v1.BuildingsService/GetBuilding"
+	BuildingsService_CreateBuilding_FullMethodName = "/bms.v1.BuildingsService/CreateBuilding"
+	BuildingsService_DeleteBuilding_FullMethodName = "/bms.v1.BuildingsService/DeleteBuilding"
+)
+
+// BuildingsServiceClient is the client API for BuildingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BuildingsService mirrors the buildings of the REST API. The calls carry the credentials in the
+// x-api-key or authorization metadata, and the tenant in x-tenant-id like the HTTP headers.
+type BuildingsServiceClient interface {
+	// ListBuildings streams the buildings the caller may read.
+	ListBuildings(ctx context.Context, in *ListBuildingsRequest, opts ...grpc.CallOption) (BuildingsService_ListBuildingsClient, error)
+	GetBuilding(ctx context.Context, in *GetBuildingRequest, opts ...grpc.CallOption) (*Building, error)
+	// CreateBuilding creates the building, or updates it if it has the ID of an existing one.
+	CreateBuilding(ctx context.Context, in *CreateBuildingRequest, opts ...grpc.CallOption) (*Building, error)
+	// DeleteBuilding deletes the building and its apartments.
+	DeleteBuilding(ctx context.Context, in *DeleteBuildingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type buildingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBuildingsServiceClient(cc grpc.ClientConnInterface) BuildingsServiceClient {
+	return &buildingsServiceClient{cc}
+}
+
+func (c *buildingsServiceClient) ListBuildings(ctx context.Context, in *ListBuildingsRequest, opts ...grpc.CallOption) (BuildingsService_ListBuildingsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BuildingsService_ServiceDesc.Streams[0], BuildingsService_ListBuildings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &buildingsServiceListBuildingsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BuildingsService_ListBuildingsClient interface {
+	Recv() (*Building, error)
+	grpc.ClientStream
+}
+
+type buildingsServiceListBuildingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *buildingsServiceListBuildingsClient) Recv() (*Building, error) {
+	m := new(Building)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *buildingsServiceClient) GetBuilding(ctx context.Context, in *GetBuildingRequest, opts ...grpc.CallOption) (*Building, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Building)
+	err := c.cc.Invoke(ctx, BuildingsService_GetBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *buildingsServiceClient) CreateBuilding(ctx context.Context, in *CreateBuildingRequest, opts ...grpc.CallOption) (*Building, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Building)
+	err := c.cc.Invoke(ctx, BuildingsService_CreateBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *buildingsServiceClient) DeleteBuilding(ctx context.Context, in *DeleteBuildingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BuildingsService_DeleteBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuildingsServiceServer is the server API for BuildingsService service.
+// All implementations must embed UnimplementedBuildingsServiceServer
+// for forward compatibility
+//
+// BuildingsService mirrors the buildings of the REST API. The calls carry the credentials in the
+// x-api-key or authorization metadata, and the tenant in x-tenant-id like the HTTP headers.
+type BuildingsServiceServer interface {
+	// ListBuildings streams the buildings the caller may read.
+	ListBuildings(*ListBuildingsRequest, BuildingsService_ListBuildingsServer) error
+	GetBuilding(context.Context, *GetBuildingRequest) (*Building, error)
+	// CreateBuilding creates the building, or updates it if it has the ID of an existing one.
+	CreateBuilding(context.Context, *CreateBuildingRequest) (*Building, error)
+	// DeleteBuilding deletes the building and its apartments.
+	DeleteBuilding(context.Context, *DeleteBuildingRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBuildingsServiceServer()
+}
+
+// UnimplementedBuildingsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBuildingsServiceServer struct {
+}
+
+func (UnimplementedBuildingsServiceServer) ListBuildings(*ListBuildingsRequest, BuildingsService_ListBuildingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBuildings not implemented")
+}
+func (UnimplementedBuildingsServiceServer) GetBuilding(context.Context, *GetBuildingRequest) (*Building, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuilding not implemented")
+}
+func (UnimplementedBuildingsServiceServer) CreateBuilding(context.Context, *CreateBuildingRequest) (*Building, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBuilding not implemented")
+}
+func (UnimplementedBuildingsServiceServer) DeleteBuilding(context.Context, *DeleteBuildingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBuilding not implemented")
+}
+func (UnimplementedBuildingsServiceServer) mustEmbedUnimplementedBuildingsServiceServer() {}
+
+// UnsafeBuildingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BuildingsServiceServer will
+// result in compilation errors.
+type UnsafeBuildingsServiceServer interface {
+	mustEmbedUnimplementedBuildingsServiceServer()
+}
+
+func RegisterBuildingsServiceServer(s grpc.ServiceRegistrar, srv BuildingsServiceServer) {
+	s.RegisterService(&BuildingsService_ServiceDesc, srv)
+}
+
+func _BuildingsService_ListBuildings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBuildingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BuildingsServiceServer).ListBuildings(m, &buildingsServiceListBuildingsServer{ServerStream: stream})
+}
+
+type BuildingsService_ListBuildingsServer interface {
+	Send(*Building) error
+	grpc.ServerStream
+}
+
+type buildingsServiceListBuildingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *buildingsServiceListBuildingsServer) Send(m *Building) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BuildingsService_GetBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildingsServiceServer).GetBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildingsService_GetBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildingsServiceServer).GetBuilding(ctx, req.(*GetBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BuildingsService_CreateBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildingsServiceServer).CreateBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildingsService_CreateBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildingsServiceServer).CreateBuilding(ctx, req.(*CreateBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BuildingsService_DeleteBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildingsServiceServer).DeleteBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildingsService_DeleteBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildingsServiceServer).DeleteBuilding(ctx, req.(*DeleteBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BuildingsService_ServiceDesc is the grpc.ServiceDesc for BuildingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BuildingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bms.v1.BuildingsService",
+	HandlerType: (*BuildingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBuilding",
+			Handler:    _BuildingsService_GetBuilding_Handler,
+		},
+		{
+			MethodName: "CreateBuilding",
+			Handler:    _BuildingsService_CreateBuilding_Handler,
+		},
+		{
+			MethodName: "DeleteBuilding",
+			Handler:    _BuildingsService_DeleteBuilding_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListBuildings",
+			Handler:       _BuildingsService_ListBuildings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bms/v1/bms.proto",
+}
+
+const (
+	ApartmentsService_ListApartments_FullMethodName           = "/bms.v1.ApartmentsService/ListApartments"
+	ApartmentsService_GetApartment_FullMethodName             = "/bms.v1.ApartmentsService/GetApartment"
+	ApartmentsService_ListApartmentsInBuilding_FullMethodName = "/bms.v1.ApartmentsService/ListApartmentsInBuilding"
+	ApartmentsService_CreateApartment_FullMethodName          = "/bms.v1.ApartmentsService/CreateApartment"
+	ApartmentsService_DeleteApartment_FullMethodName          = "/bms.v1.ApartmentsService/DeleteApartment"
+)
+
+// ApartmentsServiceClient is the client API for ApartmentsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ApartmentsService mirrors the apartments of the REST API.
+type ApartmentsServiceClient interface {
+	// ListApartments streams the apartments of the buildings the caller may read.
+	ListApartments(ctx context.Context, in *ListApartmentsRequest, opts ...grpc.CallOption) (ApartmentsService_ListApartmentsClient, error)
+	GetApartment(ctx context.Context, in *GetApartmentRequest, opts ...grpc.CallOption) (*Apartment, error)
+	// ListApartmentsInBuilding streams the apartments of a building.
+	ListApartmentsInBuilding(ctx context.Context, in *ListApartmentsInBuildingRequest, opts ...grpc.CallOption) (ApartmentsService_ListApartmentsInBuildingClient, error)
+	// CreateApartment creates the apartment, or updates it if it has the ID of an existing one.
+	CreateApartment(ctx context.Context, in *CreateApartmentRequest, opts ...grpc.CallOption) (*Apartment, error)
+	DeleteApartment(ctx context.Context, in *DeleteApartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type apartmentsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApartmentsServiceClient(cc grpc.ClientConnInterface) ApartmentsServiceClient {
+	return &apartmentsServiceClient{cc}
+}
+
+func (c *apartmentsServiceClient) ListApartments(ctx context.Context, in *ListApartmentsRequest, opts ...grpc.CallOption) (ApartmentsService_ListApartmentsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApartmentsService_ServiceDesc.Streams[0], ApartmentsService_ListApartments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apartmentsServiceListApartmentsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApartmentsService_ListApartmentsClient interface {
+	Recv() (*Apartment, error)
+	grpc.ClientStream
+}
+
+type apartmentsServiceListApartmentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apartmentsServiceListApartmentsClient) Recv() (*Apartment, error) {
+	m := new(Apartment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apartmentsServiceClient) GetApartment(ctx context.Context, in *GetApartmentRequest, opts ...grpc.CallOption) (*Apartment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Apartment)
+	err := c.cc.Invoke(ctx, ApartmentsService_GetApartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apartmentsServiceClient) ListApartmentsInBuilding(ctx context.Context, in *ListApartmentsInBuildingRequest, opts ...grpc.CallOption) (ApartmentsService_ListApartmentsInBuildingClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApartmentsService_ServiceDesc.Streams[1], ApartmentsService_ListApartmentsInBuilding_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apartmentsServiceListApartmentsInBuildingClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApartmentsService_ListApartmentsInBuildingClient interface {
+	Recv() (*Apartment, error)
+	grpc.ClientStream
+}
+
+type apartmentsServiceListApartmentsInBuildingClient struct {
+	grpc.ClientStream
+}
+
+func (x *apartmentsServiceListApartmentsInBuildingClient) Recv() (*Apartment, error) {
+	m := new(Apartment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apartmentsServiceClient) CreateApartment(ctx context.Context, in *CreateApartmentRequest, opts ...grpc.CallOption) (*Apartment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Apartment)
+	err := c.cc.Invoke(ctx, ApartmentsService_CreateApartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apartmentsServiceClient) DeleteApartment(ctx context.Context, in *DeleteApartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApartmentsService_DeleteApartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApartmentsServiceServer is the server API for ApartmentsService service.
+// All implementations must embed UnimplementedApartmentsServiceServer
+// for forward compatibility
+//
+// ApartmentsService mirrors the apartments of the REST API.
+type ApartmentsServiceServer interface {
+	// ListApartments streams the apartments of the buildings the caller may read.
+	ListApartments(*ListApartmentsRequest, ApartmentsService_ListApartmentsServer) error
+	GetApartment(context.Context, *GetApartmentRequest) (*Apartment, error)
+	// ListApartmentsInBuilding streams the apartments of a building.
+	ListApartmentsInBuilding(*ListApartmentsInBuildingRequest, ApartmentsService_ListApartmentsInBuildingServer) error
+	// CreateApartment creates the apartment, or updates it if it has the ID of an existing one.
+	CreateApartment(context.Context, *CreateApartmentRequest) (*Apartment, error)
+	DeleteApartment(context.Context, *DeleteApartmentRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedApartmentsServiceServer()
+}
+
+// UnimplementedApartmentsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedApartmentsServiceServer struct {
+}
+
+func (UnimplementedApartmentsServiceServer) ListApartments(*ListApartmentsRequest, ApartmentsService_ListApartmentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListApartments not implemented")
+}
+func (UnimplementedApartmentsServiceServer) GetApartment(context.Context, *GetApartmentRequest) (*Apartment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApartment not implemented")
+}
+func (UnimplementedApartmentsServiceServer) ListApartmentsInBuilding(*ListApartmentsInBuildingRequest, ApartmentsService_ListApartmentsInBuildingServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListApartmentsInBuilding not implemented")
+}
+func (UnimplementedApartmentsServiceServer) CreateApartment(context.Context, *CreateApartmentRequest) (*Apartment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApartment not implemented")
+}
+func (UnimplementedApartmentsServiceServer) DeleteApartment(context.Context, *DeleteApartmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApartment not implemented")
+}
+func (UnimplementedApartmentsServiceServer) mustEmbedUnimplementedApartmentsServiceServer() {}
+
+// UnsafeApartmentsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApartmentsServiceServer will
+// result in compilation errors.
+type UnsafeApartmentsServiceServer interface {
+	mustEmbedUnimplementedApartmentsServiceServer()
+}
+
+func RegisterApartmentsServiceServer(s grpc.ServiceRegistrar, srv ApartmentsServiceServer) {
+	s.RegisterService(&ApartmentsService_ServiceDesc, srv)
+}
+
+func _ApartmentsService_ListApartments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListApartmentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApartmentsServiceServer).ListApartments(m, &apartmentsServiceListApartmentsServer{ServerStream: stream})
+}
+
+type ApartmentsService_ListApartmentsServer interface {
+	Send(*Apartment) error
+	grpc.ServerStream
+}
+
+type apartmentsServiceListApartmentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apartmentsServiceListApartmentsServer) Send(m *Apartment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApartmentsService_GetApartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApartmentsServiceServer).GetApartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApartmentsService_GetApartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApartmentsServiceServer).GetApartment(ctx, req.(*GetApartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApartmentsService_ListApartmentsInBuilding_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListApartmentsInBuildingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApartmentsServiceServer).ListApartmentsInBuilding(m, &apartmentsServiceListApartmentsInBuildingServer{ServerStream: stream})
+}
+
+type ApartmentsService_ListApartmentsInBuildingServer interface {
+	Send(*Apartment) error
+	grpc.ServerStream
+}
+
+type apartmentsServiceListApartmentsInBuildingServer struct {
+	grpc.ServerStream
+}
+
+func (x *apartmentsServiceListApartmentsInBuildingServer) Send(m *Apartment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApartmentsService_CreateApartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApartmentsServiceServer).CreateApartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApartmentsService_CreateApartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApartmentsServiceServer).CreateApartment(ctx, req.(*CreateApartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApartmentsService_DeleteApartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApartmentsServiceServer).DeleteApartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApartmentsService_DeleteApartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApartmentsServiceServer).DeleteApartment(ctx, req.(*DeleteApartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApartmentsService_ServiceDesc is the grpc.ServiceDesc for ApartmentsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApartmentsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bms.v1.ApartmentsService",
+	HandlerType: (*ApartmentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetApartment",
+			Handler:    _ApartmentsService_GetApartment_Handler,
+		},
+		{
+			MethodName: "CreateApartment",
+			Handler:    _ApartmentsService_CreateApartment_Handler,
+		},
+		{
+			MethodName: "DeleteApartment",
+			Handler:    _ApartmentsService_DeleteApartment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListApartments",
+			Handler:       _ApartmentsService_ListApartments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListApartmentsInBuilding",
+			Handler:       _ApartmentsService_ListApartmentsInBuilding_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bms/v1/bms.proto",
+}
@@ -0,0 +1,103 @@
+// Package rpc serves the buildings and the apartments over gRPC for the internal services, on a
+// port of its own. The servers go through the same services as the HTTP controllers, the calls
+// are authenticated and resolve their tenant like the HTTP requests.
+package rpc
+
+//go:generate buf generate ../../proto --template ../../proto/buf.gen.yaml --output ../../proto
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	bmsv1 "github.com/sotskov-do/oms-assignment/internal/rpc/pb/bms/v1"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+)
+
+// NewServer returns the gRPC server of the services. A nil authenticator disables the authentication.
+func NewServer(
+	apartmentsService apartments.ApartmentsService,
+	buildingsService buildings.BuildingsService,
+	authenticator *auth.Authenticator,
+) *grpc.Server {
+	interceptors := []contextFunc{
+		authenticate(authenticator),
+		resolveTenant,
+	}
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(logCalls, unaryInterceptor(interceptors...)),
+		grpc.ChainStreamInterceptor(logStreams, streamInterceptor(interceptors...)),
+	)
+	bmsv1.RegisterBuildingsServiceServer(s, &buildingsServer{
+		apartmentsService: apartmentsService,
+		buildingsService:  buildingsService,
+	})
+	bmsv1.RegisterApartmentsServiceServer(s, &apartmentsServer{
+		apartmentsService: apartmentsService,
+	})
+
+	return s
+}
+
+// Shutdown stops the server once the running calls are done or, when ctx is done first,
+// cancels them.
+func Shutdown(ctx context.Context, s *grpc.Server) error {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.Stop()
+		return ctx.Err()
+	}
+}
+
+// statusError maps the service errors to the gRPC status codes. The details of the internal
+// errors are logged by logCalls and logStreams, they aren't sent.
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return &internalError{err: err}
+	}
+}
+
+// internalError keeps the cause of an internal error for the logs, the client only gets its status.
+type internalError struct {
+	err error
+}
+
+func (e *internalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *internalError) Unwrap() error {
+	return e.err
+}
+
+func (e *internalError) GRPCStatus() *status.Status {
+	return status.New(codes.Internal, "the request couldn't be processed")
+}
+
+func invalidArgument(message string) error {
+	return status.Error(codes.InvalidArgument, message)
+}
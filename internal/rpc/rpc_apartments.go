@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	bmsv1 "github.com/sotskov-do/oms-assignment/internal/rpc/pb/bms/v1"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+)
+
+type apartmentsServer struct {
+	bmsv1.UnimplementedApartmentsServiceServer
+
+	apartmentsService apartments.ApartmentsService
+}
+
+func (s *apartmentsServer) ListApartments(_ *bmsv1.ListApartmentsRequest, stream bmsv1.ApartmentsService_ListApartmentsServer) error {
+	apartments, err := s.apartmentsService.GetApartments(stream.Context())
+	if err != nil {
+		return statusError(err)
+	}
+
+	return sendApartments(stream, apartments)
+}
+
+func (s *apartmentsServer) GetApartment(ctx context.Context, req *bmsv1.GetApartmentRequest) (*bmsv1.Apartment, error) {
+	if req.GetId() <= 0 {
+		return nil, invalidArgument("id must be positive")
+	}
+
+	apartment, err := s.apartmentsService.GetApartment(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return newApartment(apartment), nil
+}
+
+func (s *apartmentsServer) ListApartmentsInBuilding(req *bmsv1.ListApartmentsInBuildingRequest, stream bmsv1.ApartmentsService_ListApartmentsInBuildingServer) error {
+	if req.GetBuildingId() <= 0 {
+		return invalidArgument("building_id must be positive")
+	}
+
+	apartments, err := s.apartmentsService.GetApartmentsInBuilding(stream.Context(), int(req.GetBuildingId()))
+	if err != nil {
+		return statusError(err)
+	}
+
+	return sendApartments(stream, apartments)
+}
+
+func (s *apartmentsServer) CreateApartment(ctx context.Context, req *bmsv1.CreateApartmentRequest) (*bmsv1.Apartment, error) {
+	if req.GetApartment() == nil {
+		return nil, invalidArgument("apartment is required")
+	}
+	if req.GetApartment().GetId() < 0 {
+		return nil, invalidArgument("id can't be negative")
+	}
+	if req.GetApartment().GetBuildingId() <= 0 {
+		return nil, invalidArgument("building_id must be positive")
+	}
+
+	apartment := apartmentModel(req.GetApartment())
+	err := s.apartmentsService.CreateApartment(ctx, apartment)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return newApartment(apartment), nil
+}
+
+func (s *apartmentsServer) DeleteApartment(ctx context.Context, req *bmsv1.DeleteApartmentRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, invalidArgument("id must be positive")
+	}
+
+	err := s.apartmentsService.DeleteApartment(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// sendApartments streams the apartments one message each, the send errors are the stream's.
+func sendApartments(stream interface{ Send(*bmsv1.Apartment) error }, apartments models.ApartmentSlice) error {
+	for _, a := range apartments {
+		err := stream.Send(newApartment(a))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
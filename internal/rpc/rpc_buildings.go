@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	bmsv1 "github.com/sotskov-do/oms-assignment/internal/rpc/pb/bms/v1"
+	"github.com/sotskov-do/oms-assignment/internal/service/apartments"
+	"github.com/sotskov-do/oms-assignment/internal/service/buildings"
+)
+
+type buildingsServer struct {
+	bmsv1.UnimplementedBuildingsServiceServer
+
+	apartmentsService apartments.ApartmentsService
+	buildingsService  buildings.BuildingsService
+}
+
+func (s *buildingsServer) ListBuildings(_ *bmsv1.ListBuildingsRequest, stream bmsv1.BuildingsService_ListBuildingsServer) error {
+	buildings, err := s.buildingsService.GetBuildings(stream.Context())
+	if err != nil {
+		return statusError(err)
+	}
+
+	for _, b := range buildings {
+		err = stream.Send(newBuilding(b))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *buildingsServer) GetBuilding(ctx context.Context, req *bmsv1.GetBuildingRequest) (*bmsv1.Building, error) {
+	if req.GetId() <= 0 {
+		return nil, invalidArgument("id must be positive")
+	}
+
+	building, err := s.buildingsService.GetBuilding(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return newBuilding(building), nil
+}
+
+func (s *buildingsServer) CreateBuilding(ctx context.Context, req *bmsv1.CreateBuildingRequest) (*bmsv1.Building, error) {
+	if req.GetBuilding() == nil {
+		return nil, invalidArgument("building is required")
+	}
+	if req.GetBuilding().GetId() < 0 {
+		return nil, invalidArgument("id can't be negative")
+	}
+
+	building := buildingModel(req.GetBuilding())
+	err := s.buildingsService.CreateBuilding(ctx, building)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return newBuilding(building), nil
+}
+
+func (s *buildingsServer) DeleteBuilding(ctx context.Context, req *bmsv1.DeleteBuildingRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, invalidArgument("id must be positive")
+	}
+
+	err := s.buildingsService.DeleteBuilding(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
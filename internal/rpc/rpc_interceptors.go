@@ -0,0 +1,162 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+// The metadata keys of the calls, the lowercase HTTP headers of the REST API.
+const (
+	MetadataAPIKey        = "x-api-key"
+	MetadataAuthorization = "authorization"
+	MetadataTenantID      = "x-tenant-id"
+)
+
+// contextFunc derives the context of a call, an error rejects it. The unary and the stream
+// interceptors share them.
+type contextFunc func(ctx context.Context) (context.Context, error)
+
+func unaryInterceptor(funcs ...contextFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := apply(ctx, funcs)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamInterceptor(funcs ...contextFunc) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := apply(ss.Context(), funcs)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func apply(ctx context.Context, funcs []contextFunc) (context.Context, error) {
+	var err error
+	for _, f := range funcs {
+		ctx, err = f(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ctx, nil
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate rejects the calls without valid credentials with Unauthenticated and stores the
+// principal of the others in the context. A nil authenticator disables the authentication.
+func authenticate(authenticator *auth.Authenticator) contextFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		if authenticator == nil {
+			return ctx, nil
+		}
+
+		principal, err := authenticator.Authenticate(ctx, header(ctx, MetadataAPIKey), header(ctx, MetadataAuthorization))
+		if errors.Is(err, auth.ErrUnauthenticated) {
+			logger.FromContext(ctx).DebugContext(ctx, "authentication failed", "error", err)
+			return nil, status.Error(codes.Unauthenticated, auth.ErrUnauthenticated.Error())
+		}
+		if err != nil {
+			return nil, &internalError{err: err}
+		}
+
+		ctx = auth.WithPrincipal(ctx, principal)
+		ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("principal", principal.Subject))
+		return ctx, nil
+	}
+}
+
+// resolveTenant stores the tenant of the call in the context, see tenant.Resolve.
+func resolveTenant(ctx context.Context) (context.Context, error) {
+	var bound string
+	if principal, ok := auth.FromContext(ctx); ok {
+		bound = principal.Tenant
+	}
+
+	tenantID, err := tenant.Resolve(header(ctx, MetadataTenantID), bound)
+	if errors.Is(err, tenant.ErrForeign) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+
+	ctx = tenant.WithID(ctx, tenantID)
+	ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("tenant", tenantID))
+	return ctx, nil
+}
+
+// header returns the first value of the metadata key of the call.
+func header(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// logCalls stores a logger with the method of the call in the context and logs every call once
+// it is handled, like the access log of the HTTP server.
+func logCalls(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("rpc", info.FullMethod))
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, start, err)
+
+	return resp, err
+}
+
+func logStreams(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := logger.WithLogger(ss.Context(), logger.FromContext(ss.Context()).With("rpc", info.FullMethod))
+	start := time.Now()
+	err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	logCall(ctx, start, err)
+
+	return err
+}
+
+func logCall(ctx context.Context, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("code", code.String()),
+		slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logger.FromContext(ctx).LogAttrs(ctx, level, "call", attrs...)
+}
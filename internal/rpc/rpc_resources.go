@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	bmsv1 "github.com/sotskov-do/oms-assignment/internal/rpc/pb/bms/v1"
+)
+
+func newBuilding(b *models.Building) *bmsv1.Building {
+	return &bmsv1.Building{
+		Id:       int32(b.ID),
+		Name:     b.Name,
+		Address:  b.Address.Ptr(),
+		TenantId: b.TenantID,
+	}
+}
+
+// buildingModel returns the model of the building of a request, its tenant is the one of the call.
+func buildingModel(b *bmsv1.Building) *models.Building {
+	return &models.Building{
+		ID:      int(b.GetId()),
+		Name:    b.GetName(),
+		Address: null.StringFromPtr(b.Address),
+	}
+}
+
+func newApartment(a *models.Apartment) *bmsv1.Apartment {
+	return &bmsv1.Apartment{
+		Id:         int32(a.ID),
+		BuildingId: int32(a.BuildingID),
+		Number:     a.Number.Ptr(),
+		Floor:      int32Ptr(a.Floor),
+		SqMeters:   int32Ptr(a.SQMeters),
+		TenantId:   a.TenantID,
+	}
+}
+
+// apartmentModel returns the model of the apartment of a request, its tenant is the one of the call.
+func apartmentModel(a *bmsv1.Apartment) *models.Apartment {
+	return &models.Apartment{
+		ID:         int(a.GetId()),
+		BuildingID: int(a.GetBuildingId()),
+		Number:     null.StringFromPtr(a.Number),
+		Floor:      nullInt(a.Floor),
+		SQMeters:   nullInt(a.SqMeters),
+	}
+}
+
+func int32Ptr(i null.Int) *int32 {
+	if !i.Valid {
+		return nil
+	}
+	v := int32(i.Int)
+	return &v
+}
+
+func nullInt(i *int32) null.Int {
+	if i == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(*i))
+}
@@ -0,0 +1,290 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	bmsv1 "github.com/sotskov-do/oms-assignment/internal/rpc/pb/bms/v1"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/mocks"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+// dial serves s on an in-process listener and returns a connection to it.
+func dial(t *testing.T, s *grpc.Server) *grpc.ClientConn {
+	t.Helper()
+
+	ln := bufconn.Listen(1 << 20)
+	go func() {
+		_ = s.Serve(ln)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return ln.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+type apiKeys map[string]*auth.Principal
+
+func (k apiKeys) AuthenticateAPIKey(_ context.Context, key string) (*auth.Principal, error) {
+	p, ok := k[key]
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	if p == nil {
+		return nil, errors.New("storage is down")
+	}
+	return p, nil
+}
+
+func Test_Buildings(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	buildingsService.GetBuildingsMock.Return(models.BuildingSlice{
+		{ID: 1, Name: "Tower", Address: null.StringFrom("1 Main St"), TenantID: tenant.Default},
+		{ID: 2, Name: "Annex", TenantID: tenant.Default},
+	}, nil)
+	buildingsService.GetBuildingMock.Set(func(_ context.Context, id int) (*models.Building, error) {
+		return nil, fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, id)
+	})
+	buildingsService.CreateBuildingMock.Set(func(ctx context.Context, b *models.Building) error {
+		b.ID = 3
+		b.TenantID = tenant.FromContext(ctx)
+		return nil
+	})
+	buildingsService.DeleteBuildingMock.Expect(minimock.AnyContext, 2).Return(nil)
+
+	client := bmsv1.NewBuildingsServiceClient(dial(t, NewServer(mocks.NewApartmentsServiceMock(mc), buildingsService, nil)))
+	ctx := context.Background()
+
+	stream, err := client.ListBuildings(ctx, &bmsv1.ListBuildingsRequest{})
+	require.NoError(t, err)
+	var names []string
+	for {
+		b, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, b.GetName())
+	}
+	assert.Equal(t, []string{"Tower", "Annex"}, names)
+
+	_, err = client.GetBuilding(ctx, &bmsv1.GetBuildingRequest{Id: 9})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	created, err := client.CreateBuilding(metadata.AppendToOutgoingContext(ctx, MetadataTenantID, "acme"),
+		&bmsv1.CreateBuildingRequest{Building: &bmsv1.Building{Name: "New", Address: proto.String("5 Main St")}})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&bmsv1.Building{Id: 3, Name: "New", Address: proto.String("5 Main St"), TenantId: "acme"}, created))
+
+	_, err = client.DeleteBuilding(ctx, &bmsv1.DeleteBuildingRequest{Id: 2})
+	require.NoError(t, err)
+}
+
+func Test_Apartments(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	apartmentsService := mocks.NewApartmentsServiceMock(mc)
+	apartmentsService.GetApartmentsInBuildingMock.Expect(minimock.AnyContext, 1).Return(models.ApartmentSlice{
+		{ID: 1, BuildingID: 1, Number: null.StringFrom("1A"), Floor: null.IntFrom(1), SQMeters: null.IntFrom(40)},
+		{ID: 2, BuildingID: 1},
+	}, nil)
+	apartmentsService.CreateApartmentMock.Set(func(_ context.Context, a *models.Apartment) error {
+		assert.Equal(t, null.IntFrom(2), a.Floor)
+		assert.False(t, a.SQMeters.Valid)
+		return nil
+	})
+
+	client := bmsv1.NewApartmentsServiceClient(dial(t, NewServer(apartmentsService, mocks.NewBuildingsServiceMock(mc), nil)))
+	ctx := context.Background()
+
+	stream, err := client.ListApartmentsInBuilding(ctx, &bmsv1.ListApartmentsInBuildingRequest{BuildingId: 1})
+	require.NoError(t, err)
+	var got []*bmsv1.Apartment
+	for {
+		a, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, a)
+	}
+	require.Len(t, got, 2)
+	assert.True(t, proto.Equal(&bmsv1.Apartment{Id: 1, BuildingId: 1, Number: proto.String("1A"), Floor: proto.Int32(1), SqMeters: proto.Int32(40)}, got[0]))
+	assert.True(t, proto.Equal(&bmsv1.Apartment{Id: 2, BuildingId: 1}, got[1]))
+
+	_, err = client.CreateApartment(ctx, &bmsv1.CreateApartmentRequest{Apartment: &bmsv1.Apartment{BuildingId: 1, Floor: proto.Int32(2)}})
+	require.NoError(t, err)
+}
+
+func Test_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{name: "forbidden", err: fmt.Errorf("%w: building [1]", service.ErrForbidden), wantCode: codes.PermissionDenied, wantMsg: "forbidden: building [1]"},
+		{name: "notFound", err: fmt.Errorf("%w: no apartment with id [1]", service.ErrNotFound), wantCode: codes.NotFound, wantMsg: "not found: no apartment with id [1]"},
+		{name: "internal", err: errors.New("connection refused"), wantCode: codes.Internal, wantMsg: "the request couldn't be processed"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			apartmentsService := mocks.NewApartmentsServiceMock(mc)
+			apartmentsService.GetApartmentMock.Return(nil, tt.err)
+			apartmentsService.GetApartmentsMock.Return(nil, tt.err)
+			client := bmsv1.NewApartmentsServiceClient(dial(t, NewServer(apartmentsService, mocks.NewBuildingsServiceMock(mc), nil)))
+
+			_, err := client.GetApartment(context.Background(), &bmsv1.GetApartmentRequest{Id: 1})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantMsg, status.Convert(err).Message())
+
+			// The errors of the streams are received with the first message.
+			stream, err := client.ListApartments(context.Background(), &bmsv1.ListApartmentsRequest{})
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+
+	t.Run("invalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		client := bmsv1.NewBuildingsServiceClient(dial(t, NewServer(mocks.NewApartmentsServiceMock(mc), mocks.NewBuildingsServiceMock(mc), nil)))
+
+		_, err := client.GetBuilding(context.Background(), &bmsv1.GetBuildingRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = client.CreateBuilding(context.Background(), &bmsv1.CreateBuildingRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func Test_Authentication(t *testing.T) {
+	t.Parallel()
+
+	authenticator := auth.NewAuthenticator(apiKeys{
+		"oms_valid":  {Subject: "deploy-bot", Method: auth.MethodAPIKey},
+		"oms_bound":  {Subject: "acme-bot", Method: auth.MethodAPIKey, Tenant: "acme"},
+		"oms_broken": nil,
+	}, nil)
+
+	tests := []struct {
+		name       string
+		md         []string
+		wantCode   codes.Code
+		wantTenant string
+	}{
+		{name: "apiKey", md: []string{MetadataAPIKey, "oms_valid"}, wantCode: codes.OK, wantTenant: tenant.Default},
+		{name: "bearer", md: []string{MetadataAuthorization, "Bearer oms_valid"}, wantCode: codes.OK, wantTenant: tenant.Default},
+		{name: "tenant", md: []string{MetadataAPIKey, "oms_valid", MetadataTenantID, "acme"}, wantCode: codes.OK, wantTenant: "acme"},
+		{name: "boundTenant", md: []string{MetadataAPIKey, "oms_bound"}, wantCode: codes.OK, wantTenant: "acme"},
+		{name: "foreignTenant", md: []string{MetadataAPIKey, "oms_bound", MetadataTenantID, "globex"}, wantCode: codes.PermissionDenied},
+		{name: "invalidTenant", md: []string{MetadataAPIKey, "oms_valid", MetadataTenantID, "Not A Slug"}, wantCode: codes.InvalidArgument},
+		{name: "invalid", md: []string{MetadataAPIKey, "oms_invalid"}, wantCode: codes.Unauthenticated},
+		{name: "missing", wantCode: codes.Unauthenticated},
+		{name: "storageError", md: []string{MetadataAPIKey, "oms_broken"}, wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			buildingsService := mocks.NewBuildingsServiceMock(mc)
+			var gotTenant string
+			buildingsService.GetBuildingMock.Optional().Set(func(ctx context.Context, id int) (*models.Building, error) {
+				gotTenant = tenant.FromContext(ctx)
+				return &models.Building{ID: id}, nil
+			})
+			buildingsService.GetBuildingsMock.Optional().Return(models.BuildingSlice{}, nil)
+			client := bmsv1.NewBuildingsServiceClient(dial(t, NewServer(mocks.NewApartmentsServiceMock(mc), buildingsService, authenticator)))
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), tt.md...)
+			_, err := client.GetBuilding(ctx, &bmsv1.GetBuildingRequest{Id: 1})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.wantTenant, gotTenant)
+			}
+
+			// The streams go through the same interceptors.
+			stream, err := client.ListBuildings(ctx, &bmsv1.ListBuildingsRequest{})
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			if tt.wantCode == codes.OK {
+				assert.ErrorIs(t, err, io.EOF)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func Test_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	started := make(chan struct{})
+	buildingsService := mocks.NewBuildingsServiceMock(mc)
+	buildingsService.GetBuildingsMock.Set(func(ctx context.Context) (models.BuildingSlice, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	s := NewServer(mocks.NewApartmentsServiceMock(mc), buildingsService, nil)
+	conn := dial(t, s)
+
+	stream, err := bmsv1.NewBuildingsServiceClient(conn).ListBuildings(context.Background(), &bmsv1.ListBuildingsRequest{})
+	require.NoError(t, err)
+	<-started
+
+	// The call never ends by itself, it is cancelled once the shutdown times out.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, Shutdown(ctx, s), context.DeadlineExceeded)
+
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
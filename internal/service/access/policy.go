@@ -0,0 +1,157 @@
+package access
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+type Role string
+
+const (
+	RoleViewer  Role = "viewer"
+	RoleManager Role = "manager"
+	RoleAdmin   Role = "admin"
+)
+
+type Action int
+
+const (
+	// ActionRead reads the buildings and their apartments.
+	ActionRead Action = iota
+	// ActionWrite creates, updates and deletes the buildings and their apartments.
+	ActionWrite
+	// ActionManage manages the grants.
+	ActionManage
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionRead:
+		return "read"
+	case ActionWrite:
+		return "write"
+	case ActionManage:
+		return "manage"
+	default:
+		return fmt.Sprintf("action(%d)", int(a))
+	}
+}
+
+func ParseRole(s string) (Role, error) {
+	switch r := Role(s); r {
+	case RoleViewer, RoleManager, RoleAdmin:
+		return r, nil
+	default:
+		return "", fmt.Errorf("unknown role [%v]", s)
+	}
+}
+
+// Allows reports whether the role permits the action: viewers read, managers also write
+// and admins also manage the grants.
+func (r Role) Allows(a Action) bool {
+	switch r {
+	case RoleViewer:
+		return a == ActionRead
+	case RoleManager:
+		return a == ActionRead || a == ActionWrite
+	case RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Scope is what a principal may do: a global role, which applies to every building,
+// and the roles granted on single buildings. The zero Scope permits nothing.
+type Scope struct {
+	global    Role
+	buildings map[int]Role
+}
+
+// Unrestricted permits everything, it is the scope of the requests without a principal,
+// i.e. when the authentication is disabled.
+func Unrestricted() Scope {
+	return Scope{global: RoleAdmin}
+}
+
+// NewScope builds the scope of the grants, a grant without a building applies globally.
+func NewScope(grants ...*storage.Grant) Scope {
+	s := Scope{buildings: make(map[int]Role)}
+	for _, g := range grants {
+		if g.BuildingID == nil {
+			s.global = higher(s.global, Role(g.Role))
+			continue
+		}
+		s.buildings[*g.BuildingID] = higher(s.buildings[*g.BuildingID], Role(g.Role))
+	}
+	return s
+}
+
+// Can reports whether the action is permitted on the building.
+func (s Scope) Can(a Action, buildingID int) bool {
+	if s.global.Allows(a) {
+		return true
+	}
+	return s.buildings[buildingID].Allows(a)
+}
+
+// CanAll reports whether the action is permitted on every building.
+func (s Scope) CanAll(a Action) bool {
+	return s.global.Allows(a)
+}
+
+// Buildings returns the sorted IDs of the buildings the action is permitted on,
+// all is true when it is permitted on every building and the IDs are not listed.
+func (s Scope) Buildings(a Action) (ids []int, all bool) {
+	if s.global.Allows(a) {
+		return nil, true
+	}
+
+	ids = make([]int, 0, len(s.buildings))
+	for id, r := range s.buildings {
+		if r.Allows(a) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids, false
+}
+
+// Filter returns the IDs among buildingIDs of the buildings the action is permitted on, in the same order.
+func (s Scope) Filter(a Action, buildingIDs []int) []int {
+	if s.global.Allows(a) {
+		return buildingIDs
+	}
+
+	ids := make([]int, 0, len(buildingIDs))
+	for _, id := range buildingIDs {
+		if s.buildings[id].Allows(a) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func rank(r Role) int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleManager:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func higher(a, b Role) Role {
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
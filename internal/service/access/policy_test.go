@@ -0,0 +1,205 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func grant(role Role, buildingID ...int) *storage.Grant {
+	g := &storage.Grant{Role: string(role)}
+	if len(buildingID) > 0 {
+		g.BuildingID = &buildingID[0]
+	}
+	return g
+}
+
+func Test_Policy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		grants   []*storage.Grant
+		action   Action
+		building int
+		want     bool
+	}{
+		{"noGrants", nil, ActionRead, 1, false},
+		{"viewerReads", []*storage.Grant{grant(RoleViewer, 1)}, ActionRead, 1, true},
+		{"viewerCantWrite", []*storage.Grant{grant(RoleViewer, 1)}, ActionWrite, 1, false},
+		{"viewerOtherBuilding", []*storage.Grant{grant(RoleViewer, 1)}, ActionRead, 2, false},
+		{"managerWrites", []*storage.Grant{grant(RoleManager, 1)}, ActionWrite, 1, true},
+		{"managerOtherBuilding", []*storage.Grant{grant(RoleManager, 1)}, ActionWrite, 2, false},
+		{"managerCantManage", []*storage.Grant{grant(RoleManager, 1)}, ActionManage, 1, false},
+		{"buildingAdminManages", []*storage.Grant{grant(RoleAdmin, 1)}, ActionManage, 1, true},
+		{"globalViewer", []*storage.Grant{grant(RoleViewer)}, ActionRead, 42, true},
+		{"globalViewerCantWrite", []*storage.Grant{grant(RoleViewer)}, ActionWrite, 42, false},
+		{"globalViewerBuildingManager", []*storage.Grant{grant(RoleViewer), grant(RoleManager, 2)}, ActionWrite, 2, true},
+		{"highestRoleWins", []*storage.Grant{grant(RoleManager, 1), grant(RoleViewer, 1)}, ActionWrite, 1, true},
+		{"globalManagerNewBuilding", []*storage.Grant{grant(RoleManager)}, ActionWrite, 0, true},
+		{"buildingManagerNewBuilding", []*storage.Grant{grant(RoleManager, 1)}, ActionWrite, 0, false},
+		{"unknownRole", []*storage.Grant{{Role: "owner"}}, ActionRead, 1, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scope := NewScope(tt.grants...)
+			assert.Equal(t, tt.want, scope.Can(tt.action, tt.building))
+
+			err := Authorize(scope, tt.action, tt.building)
+			if tt.want {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, service.ErrForbidden)
+			}
+		})
+	}
+}
+
+func Test_ScopeBuildings(t *testing.T) {
+	t.Parallel()
+
+	scope := NewScope(grant(RoleViewer, 3), grant(RoleManager, 1), grant(RoleViewer, 2))
+
+	ids, all := scope.Buildings(ActionRead)
+	assert.False(t, all)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	ids, all = scope.Buildings(ActionWrite)
+	assert.False(t, all)
+	assert.Equal(t, []int{1}, ids)
+
+	ids, all = Scope{}.Buildings(ActionRead)
+	assert.False(t, all)
+	assert.Empty(t, ids)
+
+	_, all = NewScope(grant(RoleViewer)).Buildings(ActionRead)
+	assert.True(t, all)
+	_, all = Unrestricted().Buildings(ActionManage)
+	assert.True(t, all)
+}
+
+func Test_ScopeFilter(t *testing.T) {
+	t.Parallel()
+
+	scope := NewScope(grant(RoleViewer, 3), grant(RoleManager, 1))
+
+	assert.Equal(t, []int{3, 1}, scope.Filter(ActionRead, []int{3, 2, 1}))
+	assert.Equal(t, []int{1}, scope.Filter(ActionWrite, []int{3, 2, 1}))
+	assert.Empty(t, Scope{}.Filter(ActionRead, []int{1}))
+	assert.Equal(t, []int{4, 5}, NewScope(grant(RoleViewer)).Filter(ActionRead, []int{4, 5}))
+}
+
+func Test_Scope(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	grantsStorage := storage_mocks.NewGrantsStorageMock(mc).
+		GetGrantsBySubjectMock.
+		Expect(minimock.AnyContext, "manager@example.com").
+		Return([]*storage.Grant{grant(RoleManager, 1)}, nil)
+	s := Service{grantsStorage: grantsStorage}
+
+	// Without a principal the authentication is disabled.
+	scope, err := s.Scope(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Unrestricted(), scope)
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "manager@example.com"})
+	scope, err = s.Scope(ctx)
+	require.NoError(t, err)
+	assert.True(t, scope.Can(ActionWrite, 1))
+	assert.False(t, scope.Can(ActionRead, 2))
+}
+
+func Test_CreateGrant(t *testing.T) {
+	t.Parallel()
+
+	buildingID := 1
+	adminCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "admin"})
+	managerCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "manager"})
+
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		grant            *storage.Grant
+		getGrantsStorage func(mc *minimock.Controller) storage.GrantsStorage
+		wantForbidden    bool
+		wantErr          bool
+	}{
+		{
+			name:  "admin",
+			ctx:   adminCtx,
+			grant: &storage.Grant{Subject: "manager", Role: "manager", BuildingID: &buildingID},
+			getGrantsStorage: func(mc *minimock.Controller) storage.GrantsStorage {
+				return storage_mocks.NewGrantsStorageMock(mc).
+					GetGrantsBySubjectMock.Expect(minimock.AnyContext, "admin").Return([]*storage.Grant{grant(RoleAdmin)}, nil).
+					CreateGrantMock.Return(nil)
+			},
+		},
+		{
+			name:  "buildingAdmin",
+			ctx:   managerCtx,
+			grant: &storage.Grant{Subject: "manager", Role: "admin"},
+			getGrantsStorage: func(mc *minimock.Controller) storage.GrantsStorage {
+				return storage_mocks.NewGrantsStorageMock(mc).
+					GetGrantsBySubjectMock.Expect(minimock.AnyContext, "manager").Return([]*storage.Grant{grant(RoleAdmin, 1)}, nil)
+			},
+			wantForbidden: true,
+		},
+		{
+			name:  "unknownRole",
+			ctx:   adminCtx,
+			grant: &storage.Grant{Subject: "manager", Role: "owner"},
+			getGrantsStorage: func(mc *minimock.Controller) storage.GrantsStorage {
+				return storage_mocks.NewGrantsStorageMock(mc).
+					GetGrantsBySubjectMock.Expect(minimock.AnyContext, "admin").Return([]*storage.Grant{grant(RoleAdmin)}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "storageError",
+			ctx:   adminCtx,
+			grant: &storage.Grant{Subject: "manager", Role: "viewer"},
+			getGrantsStorage: func(mc *minimock.Controller) storage.GrantsStorage {
+				return storage_mocks.NewGrantsStorageMock(mc).
+					GetGrantsBySubjectMock.Expect(minimock.AnyContext, "admin").Return(nil, errors.New("storageError"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			s := Service{grantsStorage: tt.getGrantsStorage(mc)}
+
+			err := s.CreateGrant(tt.ctx, tt.grant)
+			switch {
+			case tt.wantForbidden:
+				assert.ErrorIs(t, err, service.ErrForbidden)
+			case tt.wantErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, service.ErrForbidden)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
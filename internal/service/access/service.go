@@ -0,0 +1,143 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Resolver returns the scope of the principal of the request.
+type Resolver interface {
+	Scope(ctx context.Context) (Scope, error)
+}
+
+// Fixed resolves every request to the same scope.
+type Fixed Scope
+
+func (f Fixed) Scope(context.Context) (Scope, error) {
+	return Scope(f), nil
+}
+
+type Service struct {
+	grantsStorage storage.GrantsStorage
+}
+
+func NewService(grantsStorage storage.GrantsStorage) *Service {
+	return &Service{
+		grantsStorage: grantsStorage,
+	}
+}
+
+// Scope loads the grants of the principal of the request, the requests without a principal are unrestricted.
+func (s *Service) Scope(ctx context.Context) (_ Scope, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return Unrestricted(), nil
+	}
+
+	ctx, span := tracing.Start(ctx, "access.Scope")
+	defer tracing.End(span, &err)
+
+	grants, err := s.grantsStorage.GetGrantsBySubject(ctx, principal.Subject)
+	if err != nil {
+		return Scope{}, err
+	}
+
+	return NewScope(grants...), nil
+}
+
+// Authorize returns service.ErrForbidden unless the action is permitted on the building.
+func Authorize(scope Scope, a Action, buildingID int) error {
+	if !scope.Can(a, buildingID) {
+		return fmt.Errorf("%w: %v building [%v]", service.ErrForbidden, a, buildingID)
+	}
+	return nil
+}
+
+func (s *Service) GetGrants(ctx context.Context) (_ []*storage.Grant, err error) {
+	ctx, span := tracing.Start(ctx, "access.GetGrants")
+	defer tracing.End(span, &err)
+
+	err = s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.grantsStorage.GetGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+func (s *Service) CreateGrant(ctx context.Context, grant *storage.Grant) (err error) {
+	ctx, span := tracing.Start(ctx, "access.CreateGrant")
+	defer tracing.End(span, &err)
+
+	err = s.authorizeManage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if grant.Subject == "" {
+		return errors.New("subject is required")
+	}
+	_, err = ParseRole(grant.Role)
+	if err != nil {
+		return err
+	}
+	if grant.BuildingID != nil && *grant.BuildingID <= 0 {
+		return errors.New("building id less or equal 0")
+	}
+
+	err = s.grantsStorage.CreateGrant(ctx, grant)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteGrant(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "access.DeleteGrant", attribute.Int("grant.id", id))
+	defer tracing.End(span, &err)
+
+	err = s.authorizeManage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if id <= 0 {
+		return errors.New("id less or equal 0")
+	}
+
+	n, err := s.grantsStorage.DeleteGrant(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("no grant with id [%v]", id)
+	}
+
+	return nil
+}
+
+// authorizeManage permits managing the grants to the global admins only.
+func (s *Service) authorizeManage(ctx context.Context) error {
+	scope, err := s.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	if !scope.CanAll(ActionManage) {
+		return fmt.Errorf("%w: managing grants requires the global admin role", service.ErrForbidden)
+	}
+	return nil
+}
@@ -2,11 +2,17 @@ package apartments
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
 	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 //go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/apartments.ApartmentsService -o ../mocks/
@@ -14,22 +20,51 @@ type ApartmentsService interface {
 	GetApartments(ctx context.Context) (models.ApartmentSlice, error)
 	GetApartment(ctx context.Context, id int) (*models.Apartment, error)
 	GetApartmentsInBuilding(ctx context.Context, buildingId int) (models.ApartmentSlice, error)
+	GetApartmentsInBuildings(ctx context.Context, buildingIds []int) (models.ApartmentSlice, error)
+	GetApartmentsOnFloor(ctx context.Context, buildingId int, floor int) (models.ApartmentSlice, error)
+	StreamApartments(ctx context.Context, send func(a *models.Apartment) error) error
+	StreamApartmentsInBuilding(ctx context.Context, buildingId int, send func(a *models.Apartment) error) error
+	GetStats(ctx context.Context) (*storage.Stats, error)
+	GetBuildingStats(ctx context.Context, buildingId int) (*storage.Stats, error)
 	CreateApartment(ctx context.Context, apartment *models.Apartment) error
 	DeleteApartment(ctx context.Context, id int) error
 }
 
 type Service struct {
 	apartmentsStorage storage.ApartmentsStorage
+	floorsStorage     storage.FloorsStorage
+	scopes            access.Resolver
 }
 
-func NewService(apartmentsStorage storage.ApartmentsStorage) *Service {
+func NewService(apartmentsStorage storage.ApartmentsStorage, floorsStorage storage.FloorsStorage, scopes access.Resolver) *Service {
 	return &Service{
 		apartmentsStorage: apartmentsStorage,
+		floorsStorage:     floorsStorage,
+		scopes:            scopes,
 	}
 }
 
-func (s *Service) GetApartments(ctx context.Context) (models.ApartmentSlice, error) {
-	apartments, err := s.apartmentsStorage.GetApartments(ctx)
+func (s *Service) GetApartments(ctx context.Context) (_ models.ApartmentSlice, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetApartments")
+	defer tracing.End(span, &err)
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the apartments of the buildings the principal may read are listed.
+	buildingIds, all := scope.Buildings(access.ActionRead)
+	if !all && len(buildingIds) == 0 {
+		return models.ApartmentSlice{}, nil
+	}
+
+	var apartments models.ApartmentSlice
+	if all {
+		apartments, err = s.apartmentsStorage.GetApartments(ctx)
+	} else {
+		apartments, err = s.apartmentsStorage.GetApartmentsInBuildings(ctx, buildingIds)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -37,12 +72,23 @@ func (s *Service) GetApartments(ctx context.Context) (models.ApartmentSlice, err
 	return apartments, nil
 }
 
-func (s *Service) GetApartment(ctx context.Context, id int) (*models.Apartment, error) {
+func (s *Service) GetApartment(ctx context.Context, id int) (_ *models.Apartment, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetApartment", attribute.Int("apartment.id", id))
+	defer tracing.End(span, &err)
+
 	if id <= 0 {
 		return nil, errors.New("id less or equal 0")
 	}
 
 	apartment, err := s.apartmentsStorage.GetApartment(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, service.WithLegacyMessage(fmt.Errorf("%w: no apartment with id [%v]", service.ErrNotFound, id), err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorize(ctx, access.ActionRead, apartment.BuildingID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,11 +96,19 @@ func (s *Service) GetApartment(ctx context.Context, id int) (*models.Apartment,
 	return apartment, nil
 }
 
-func (s *Service) GetApartmentsInBuilding(ctx context.Context, buildingId int) (models.ApartmentSlice, error) {
+func (s *Service) GetApartmentsInBuilding(ctx context.Context, buildingId int) (_ models.ApartmentSlice, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetApartmentsInBuilding", attribute.Int("building.id", buildingId))
+	defer tracing.End(span, &err)
+
 	if buildingId <= 0 {
 		return nil, errors.New("building id less or equal 0")
 	}
 
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return nil, err
+	}
+
 	apartmentsInBuilding, err := s.apartmentsStorage.GetApartmentsInBuilding(ctx, buildingId)
 	if err != nil {
 		return nil, err
@@ -63,8 +117,173 @@ func (s *Service) GetApartmentsInBuilding(ctx context.Context, buildingId int) (
 	return apartmentsInBuilding, nil
 }
 
-func (s *Service) CreateApartment(ctx context.Context, apartment *models.Apartment) error {
-	err := s.apartmentsStorage.CreateApartment(ctx, apartment)
+// GetApartmentsInBuildings returns the apartments of the buildings among buildingIds the principal may read.
+func (s *Service) GetApartmentsInBuildings(ctx context.Context, buildingIds []int) (_ models.ApartmentSlice, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetApartmentsInBuildings", attribute.Int("buildings.count", len(buildingIds)))
+	defer tracing.End(span, &err)
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buildingIds = scope.Filter(access.ActionRead, buildingIds)
+	if len(buildingIds) == 0 {
+		return models.ApartmentSlice{}, nil
+	}
+
+	return s.apartmentsStorage.GetApartmentsInBuildings(ctx, buildingIds)
+}
+
+// GetApartmentsOnFloor returns the apartments on a floor of the building, which must be one of its floors.
+func (s *Service) GetApartmentsOnFloor(ctx context.Context, buildingId int, floor int) (_ models.ApartmentSlice, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetApartmentsOnFloor",
+		attribute.Int("building.id", buildingId), attribute.Int("floor.number", floor))
+	defer tracing.End(span, &err)
+
+	if buildingId <= 0 {
+		return nil, fmt.Errorf("%w: building id less or equal 0", service.ErrInvalid)
+	}
+
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.floorsStorage.GetFloor(ctx, buildingId, floor)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no floor [%v] in building [%v]", service.ErrNotFound, floor, buildingId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.apartmentsStorage.GetApartmentsOnFloor(ctx, buildingId, floor)
+}
+
+// StreamApartments calls send with the apartments GetApartments returns, as they are read.
+func (s *Service) StreamApartments(ctx context.Context, send func(a *models.Apartment) error) (err error) {
+	ctx, span := tracing.Start(ctx, "apartments.StreamApartments")
+	defer tracing.End(span, &err)
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only the apartments of the buildings the principal may read are listed.
+	buildingIds, all := scope.Buildings(access.ActionRead)
+	if all {
+		buildingIds = nil
+	} else if len(buildingIds) == 0 {
+		return nil
+	}
+
+	return s.apartmentsStorage.StreamApartments(ctx, buildingIds, send)
+}
+
+// StreamApartmentsInBuilding calls send with the apartments GetApartmentsInBuilding returns, as they are read.
+func (s *Service) StreamApartmentsInBuilding(ctx context.Context, buildingId int, send func(a *models.Apartment) error) (err error) {
+	ctx, span := tracing.Start(ctx, "apartments.StreamApartmentsInBuilding", attribute.Int("building.id", buildingId))
+	defer tracing.End(span, &err)
+
+	if buildingId <= 0 {
+		return errors.New("building id less or equal 0")
+	}
+
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return err
+	}
+
+	return s.apartmentsStorage.StreamApartments(ctx, []int{buildingId}, send)
+}
+
+// GetStats aggregates the buildings the principal may read and their apartments.
+func (s *Service) GetStats(ctx context.Context) (_ *storage.Stats, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetStats")
+	defer tracing.End(span, &err)
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buildingIds, all := scope.Buildings(access.ActionRead)
+	if all {
+		buildingIds = nil
+	} else if len(buildingIds) == 0 {
+		return &storage.Stats{Floors: []storage.FloorCount{}}, nil
+	}
+
+	return s.apartmentsStorage.GetStats(ctx, buildingIds)
+}
+
+// GetBuildingStats aggregates the apartments of the building.
+func (s *Service) GetBuildingStats(ctx context.Context, buildingId int) (_ *storage.Stats, err error) {
+	ctx, span := tracing.Start(ctx, "apartments.GetBuildingStats", attribute.Int("building.id", buildingId))
+	defer tracing.End(span, &err)
+
+	if buildingId <= 0 {
+		return nil, errors.New("building id less or equal 0")
+	}
+
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.apartmentsStorage.GetStats(ctx, []int{buildingId})
+	if err != nil {
+		return nil, err
+	}
+	if stats.Buildings == 0 {
+		return nil, fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, buildingId)
+	}
+
+	return stats, nil
+}
+
+func (s *Service) CreateApartment(ctx context.Context, apartment *models.Apartment) (err error) {
+	ctx, span := tracing.Start(ctx, "apartments.CreateApartment")
+	defer tracing.End(span, &err)
+
+	if apartment == nil {
+		return errors.New("apartment is required")
+	}
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	err = access.Authorize(scope, access.ActionWrite, apartment.BuildingID)
+	if err != nil {
+		return err
+	}
+
+	// An update may move the apartment out of another building, which must be writable too.
+	if apartment.ID > 0 && !scope.CanAll(access.ActionWrite) {
+		existing, err := s.apartmentsStorage.GetApartment(ctx, apartment.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil {
+			err = access.Authorize(scope, access.ActionWrite, existing.BuildingID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = s.validateFloor(ctx, apartment)
+	if err != nil {
+		return err
+	}
+
+	err = s.apartmentsStorage.CreateApartment(ctx, apartment)
+	if errors.Is(err, storage.ErrNoFloor) {
+		return fmt.Errorf("%w: building [%v] has no floor [%v]", service.ErrInvalid, apartment.BuildingID, apartment.Floor.Int)
+	}
 	if err != nil {
 		return err
 	}
@@ -72,19 +291,75 @@ func (s *Service) CreateApartment(ctx context.Context, apartment *models.Apartme
 	return nil
 }
 
-func (s *Service) DeleteApartment(ctx context.Context, id int) error {
+// validateFloor fails if the floor of the apartment isn't one of the floors of its building. The
+// buildings without floors accept any floor. The storage checks it again with the building locked,
+// for the floors written since.
+func (s *Service) validateFloor(ctx context.Context, apartment *models.Apartment) error {
+	if !apartment.Floor.Valid {
+		return nil
+	}
+
+	floors, err := s.floorsStorage.GetFloors(ctx, apartment.BuildingID)
+	if err != nil {
+		return err
+	}
+	if len(floors) == 0 || slices.ContainsFunc(floors, func(f *storage.Floor) bool { return f.Number == apartment.Floor.Int }) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: building [%v] has %d floors, from [%v] to [%v], none is floor [%v]", service.ErrInvalid,
+		apartment.BuildingID, len(floors), floors[0].Label, floors[len(floors)-1].Label, apartment.Floor.Int)
+}
+
+func (s *Service) DeleteApartment(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "apartments.DeleteApartment", attribute.Int("apartment.id", id))
+	defer tracing.End(span, &err)
+
 	if id <= 0 {
 		return errors.New("id less or equal 0")
 	}
 
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	if !scope.CanAll(access.ActionWrite) {
+		apartment, err := s.apartmentsStorage.GetApartment(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return noApartment(id)
+		}
+		if err != nil {
+			return err
+		}
+		err = access.Authorize(scope, access.ActionWrite, apartment.BuildingID)
+		if err != nil {
+			return err
+		}
+	}
+
 	n, err := s.apartmentsStorage.DeleteApartment(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	if n == 0 {
-		return fmt.Errorf("no apartment with id [%v]", id)
+		return noApartment(id)
 	}
 
 	return nil
 }
+
+func (s *Service) authorize(ctx context.Context, a access.Action, buildingID int) error {
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	return access.Authorize(scope, a, buildingID)
+}
+
+// noApartment is the error of the deletion of a missing apartment, the v1 API keeps its former message.
+func noApartment(id int) error {
+	msg := fmt.Sprintf("no apartment with id [%v]", id)
+	return service.WithLegacyMessage(fmt.Errorf("%w: %s", service.ErrNotFound, msg), msg)
+}
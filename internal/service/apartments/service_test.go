@@ -2,11 +2,15 @@ package apartments
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
 	"github.com/sotskov-do/oms-assignment/internal/storage"
 	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
 	"github.com/stretchr/testify/assert"
@@ -82,7 +86,7 @@ func Test_GetApartments(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			apartmentsStorage := tt.getApartmentsStorage(mc)
-			s := Service{apartmentsStorage: apartmentsStorage}
+			s := Service{apartmentsStorage: apartmentsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			got, err := s.GetApartments(context.Background())
 			if tt.wantErr {
@@ -166,7 +170,7 @@ func Test_GetApartment(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			apartmentsStorage := tt.getApartmentsStorage(mc)
-			s := Service{apartmentsStorage: apartmentsStorage}
+			s := Service{apartmentsStorage: apartmentsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			got, err := s.GetApartment(context.Background(), tt.args.id)
 			if tt.wantErr {
@@ -268,7 +272,7 @@ func Test_GetApartmentsInBuilding(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			apartmentsStorage := tt.getApartmentsStorage(mc)
-			s := Service{apartmentsStorage: apartmentsStorage}
+			s := Service{apartmentsStorage: apartmentsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			got, err := s.GetApartmentsInBuilding(context.Background(), tt.args.buildingId)
 			if tt.wantErr {
@@ -287,11 +291,26 @@ func Test_CreateApartment(t *testing.T) {
 		apartment *models.Apartment
 	}
 
+	errStorage := errors.New("storageError")
+	noFloors := func(mc *minimock.Controller) storage.FloorsStorage {
+		return storage_mocks.NewFloorsStorageMock(mc).
+			GetFloorsMock.
+			Expect(minimock.AnyContext, 1).
+			Return([]*storage.Floor{}, nil)
+	}
+	threeFloors := func(mc *minimock.Controller) storage.FloorsStorage {
+		return storage_mocks.NewFloorsStorageMock(mc).
+			GetFloorsMock.
+			Expect(minimock.AnyContext, 1).
+			Return([]*storage.Floor{{Number: -1, Label: "B1"}, {Number: 0, Label: "G"}, {Number: 2, Label: "2"}}, nil)
+	}
+
 	tests := []struct {
 		name                 string
 		args                 args
 		getApartmentsStorage func(mc *minimock.Controller) storage.ApartmentsStorage
-		wantErr              bool
+		getFloorsStorage     func(mc *minimock.Controller) storage.FloorsStorage
+		wantErr              error
 	}{
 		{
 			name: "valid",
@@ -314,6 +333,61 @@ func Test_CreateApartment(t *testing.T) {
 					}).
 					Return(nil)
 			},
+			getFloorsStorage: noFloors,
+		},
+		{
+			name: "onFloor",
+			args: args{
+				apartment: &models.Apartment{BuildingID: 1, Floor: null.IntFrom(2)},
+			},
+			getApartmentsStorage: func(mc *minimock.Controller) storage.ApartmentsStorage {
+				return storage_mocks.NewApartmentsStorageMock(mc).
+					CreateApartmentMock.
+					Expect(minimock.AnyContext, &models.Apartment{BuildingID: 1, Floor: null.IntFrom(2)}).
+					Return(nil)
+			},
+			getFloorsStorage: threeFloors,
+		},
+		{
+			name: "withoutFloor",
+			args: args{
+				apartment: &models.Apartment{BuildingID: 1},
+			},
+			getApartmentsStorage: func(mc *minimock.Controller) storage.ApartmentsStorage {
+				return storage_mocks.NewApartmentsStorageMock(mc).
+					CreateApartmentMock.
+					Expect(minimock.AnyContext, &models.Apartment{BuildingID: 1}).
+					Return(nil)
+			},
+			getFloorsStorage: func(mc *minimock.Controller) storage.FloorsStorage {
+				return storage_mocks.NewFloorsStorageMock(mc)
+			},
+		},
+		{
+			name: "noSuchFloor",
+			args: args{
+				apartment: &models.Apartment{BuildingID: 1, Floor: null.IntFrom(12)},
+			},
+			getApartmentsStorage: func(mc *minimock.Controller) storage.ApartmentsStorage {
+				return storage_mocks.NewApartmentsStorageMock(mc)
+			},
+			getFloorsStorage: threeFloors,
+			wantErr:          service.ErrInvalid,
+		},
+		{
+			name: "floorsError",
+			args: args{
+				apartment: &models.Apartment{BuildingID: 1, Floor: null.IntFrom(2)},
+			},
+			getApartmentsStorage: func(mc *minimock.Controller) storage.ApartmentsStorage {
+				return storage_mocks.NewApartmentsStorageMock(mc)
+			},
+			getFloorsStorage: func(mc *minimock.Controller) storage.FloorsStorage {
+				return storage_mocks.NewFloorsStorageMock(mc).
+					GetFloorsMock.
+					Return(nil, sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
 		},
 		{
 			name: "storageError",
@@ -334,9 +408,10 @@ func Test_CreateApartment(t *testing.T) {
 						Floor:      null.Int{Valid: true, Int: 2},
 						SQMeters:   null.Int{Valid: true, Int: 20},
 					}).
-					Return(errors.New("storageError"))
+					Return(errStorage)
 			},
-			wantErr: true,
+			getFloorsStorage: noFloors,
+			wantErr:          errStorage,
 		},
 	}
 
@@ -347,16 +422,94 @@ func Test_CreateApartment(t *testing.T) {
 			t.Parallel()
 
 			mc := minimock.NewController(t)
-			apartmentsStorage := tt.getApartmentsStorage(mc)
-			s := Service{apartmentsStorage: apartmentsStorage}
+			s := NewService(tt.getApartmentsStorage(mc), tt.getFloorsStorage(mc), access.Fixed(access.Unrestricted()))
 
 			err := s.CreateApartment(context.Background(), tt.args.apartment)
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
+			assert.NoError(t, err)
 		})
 	}
+
+	t.Run("noSuchFloorMessage", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		s := NewService(storage_mocks.NewApartmentsStorageMock(mc), threeFloors(mc), access.Fixed(access.Unrestricted()))
+
+		err := s.CreateApartment(context.Background(), &models.Apartment{BuildingID: 1, Floor: null.IntFrom(12)})
+		assert.EqualError(t, err, "invalid: building [1] has 3 floors, from [B1] to [2], none is floor [12]")
+	})
+
+	t.Run("floorDeletedSince", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			CreateApartmentMock.
+			Return(fmt.Errorf("%w: floor [2] of building [1]", storage.ErrNoFloor))
+		s := NewService(apartmentsStorage, threeFloors(mc), access.Fixed(access.Unrestricted()))
+
+		err := s.CreateApartment(context.Background(), &models.Apartment{BuildingID: 1, Floor: null.IntFrom(2)})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.EqualError(t, err, "invalid: building [1] has no floor [2]")
+	})
+}
+
+func Test_GetApartmentsOnFloor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("onFloor", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsOnFloorMock.
+			Expect(minimock.AnyContext, 1, -1).
+			Return(models.ApartmentSlice{{ID: 3, BuildingID: 1, Floor: null.IntFrom(-1)}}, nil)
+		floorsStorage := storage_mocks.NewFloorsStorageMock(mc).
+			GetFloorMock.
+			Expect(minimock.AnyContext, 1, -1).
+			Return(&storage.Floor{ID: 7, BuildingID: 1, Number: -1, Label: "B1"}, nil)
+		s := NewService(apartmentsStorage, floorsStorage, scopeOf(grantOf(access.RoleViewer, 1)))
+
+		got, err := s.GetApartmentsOnFloor(context.Background(), 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ApartmentSlice{{ID: 3, BuildingID: 1, Floor: null.IntFrom(-1)}}, got)
+	})
+
+	t.Run("noSuchFloor", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		floorsStorage := storage_mocks.NewFloorsStorageMock(mc).
+			GetFloorMock.
+			Return(nil, sql.ErrNoRows)
+		s := NewService(storage_mocks.NewApartmentsStorageMock(mc), floorsStorage, access.Fixed(access.Unrestricted()))
+
+		_, err := s.GetApartmentsOnFloor(context.Background(), 1, 12)
+		assert.ErrorIs(t, err, service.ErrNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, scopeOf(grantOf(access.RoleViewer, 2)))
+
+		_, err := s.GetApartmentsOnFloor(context.Background(), 1, 0)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("invalidBuilding", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, access.Fixed(access.Unrestricted()))
+
+		_, err := s.GetApartmentsOnFloor(context.Background(), 0, 0)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+	})
 }
 
 func Test_DeleteApartment(t *testing.T) {
@@ -430,7 +583,7 @@ func Test_DeleteApartment(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			apartmentsStorage := tt.getApartmentsStorage(mc)
-			s := Service{apartmentsStorage: apartmentsStorage}
+			s := Service{apartmentsStorage: apartmentsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			err := s.DeleteApartment(context.Background(), tt.args.id)
 			if tt.wantErr {
@@ -440,3 +593,235 @@ func Test_DeleteApartment(t *testing.T) {
 		})
 	}
 }
+
+func scopeOf(grants ...*storage.Grant) access.Resolver {
+	return access.Fixed(access.NewScope(grants...))
+}
+
+func grantOf(role access.Role, buildingID int) *storage.Grant {
+	return &storage.Grant{Role: string(role), BuildingID: &buildingID}
+}
+
+func Test_ApartmentsPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("getApartmentsFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsInBuildingsMock.
+			Expect(minimock.AnyContext, []int{1, 2}).
+			Return(models.ApartmentSlice{{ID: 1, BuildingID: 1}}, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleViewer, 2), grantOf(access.RoleManager, 1))}
+
+		got, err := s.GetApartments(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, models.ApartmentSlice{{ID: 1, BuildingID: 1}}, got)
+	})
+
+	t.Run("getApartmentsInBuildingsFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsInBuildingsMock.
+			Expect(minimock.AnyContext, []int{1}).
+			Return(models.ApartmentSlice{{ID: 1, BuildingID: 1}}, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		got, err := s.GetApartmentsInBuildings(context.Background(), []int{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, models.ApartmentSlice{{ID: 1, BuildingID: 1}}, got)
+	})
+
+	t.Run("getApartmentsInBuildingsOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		got, err := s.GetApartmentsInBuildings(context.Background(), []int{2})
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("getApartmentOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentMock.
+			Expect(minimock.AnyContext, 5).
+			Return(&models.Apartment{ID: 5, BuildingID: 2}, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		_, err := s.GetApartment(context.Background(), 5)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("getApartmentsInBuildingOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		_, err := s.GetApartmentsInBuilding(context.Background(), 2)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("streamApartmentsFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			StreamApartmentsMock.
+			Set(func(_ context.Context, buildingIds []int, send func(a *models.Apartment) error) error {
+				assert.Equal(t, []int{1, 2}, buildingIds)
+				return send(&models.Apartment{ID: 1, BuildingID: 1})
+			})
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleViewer, 2), grantOf(access.RoleManager, 1))}
+
+		var got models.ApartmentSlice
+		err := s.StreamApartments(context.Background(), func(a *models.Apartment) error {
+			got = append(got, a)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.ApartmentSlice{{ID: 1, BuildingID: 1}}, got)
+	})
+
+	t.Run("streamApartmentsUnrestricted", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			StreamApartmentsMock.
+			Set(func(_ context.Context, buildingIds []int, _ func(a *models.Apartment) error) error {
+				assert.Nil(t, buildingIds)
+				return nil
+			})
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: access.Fixed(access.Unrestricted())}
+
+		assert.NoError(t, s.StreamApartments(context.Background(), func(*models.Apartment) error { return nil }))
+	})
+
+	t.Run("streamApartmentsInBuildingOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		err := s.StreamApartmentsInBuilding(context.Background(), 2, func(*models.Apartment) error { return nil })
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("statsFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		stats := &storage.Stats{Buildings: 2, Apartments: 3}
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetStatsMock.
+			Expect(minimock.AnyContext, []int{1, 2}).
+			Return(stats, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleViewer, 2), grantOf(access.RoleManager, 1))}
+
+		got, err := s.GetStats(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, stats, got)
+	})
+
+	t.Run("statsWithoutGrants", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf()}
+
+		got, err := s.GetStats(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &storage.Stats{Floors: []storage.FloorCount{}}, got)
+	})
+
+	t.Run("buildingStatsOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		_, err := s.GetBuildingStats(context.Background(), 2)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("buildingStatsNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetStatsMock.
+			Expect(minimock.AnyContext, []int{3}).
+			Return(&storage.Stats{}, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: access.Fixed(access.Unrestricted())}
+
+		_, err := s.GetBuildingStats(context.Background(), 3)
+		assert.ErrorIs(t, err, service.ErrNotFound)
+	})
+
+	t.Run("createApartmentAsViewer", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(grantOf(access.RoleViewer, 1))}
+
+		err := s.CreateApartment(context.Background(), &models.Apartment{BuildingID: 1, Number: null.StringFrom("1")})
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("createApartmentOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(grantOf(access.RoleManager, 1))}
+
+		err := s.CreateApartment(context.Background(), &models.Apartment{BuildingID: 2, Number: null.StringFrom("1")})
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("moveApartmentFromAnotherBuilding", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentMock.
+			Expect(minimock.AnyContext, 5).
+			Return(&models.Apartment{ID: 5, BuildingID: 2}, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleManager, 1))}
+
+		err := s.CreateApartment(context.Background(), &models.Apartment{ID: 5, BuildingID: 1})
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("createApartmentInScope", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartment := &models.Apartment{ID: 6, BuildingID: 1}
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentMock.
+			Expect(minimock.AnyContext, 6).
+			Return(nil, sql.ErrNoRows).
+			CreateApartmentMock.
+			Expect(minimock.AnyContext, apartment).
+			Return(nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleManager, 1))}
+
+		assert.NoError(t, s.CreateApartment(context.Background(), apartment))
+	})
+
+	t.Run("deleteApartmentOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentMock.
+			Expect(minimock.AnyContext, 5).
+			Return(&models.Apartment{ID: 5, BuildingID: 2}, nil)
+		s := Service{apartmentsStorage: apartmentsStorage, scopes: scopeOf(grantOf(access.RoleManager, 1))}
+
+		err := s.DeleteApartment(context.Background(), 5)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+}
@@ -0,0 +1,162 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	prefixBytes = 4
+	secretBytes = 24
+)
+
+type Service struct {
+	apiKeysStorage storage.APIKeysStorage
+	now            func() time.Time
+}
+
+func NewService(apiKeysStorage storage.APIKeysStorage) *Service {
+	return &Service{
+		apiKeysStorage: apiKeysStorage,
+		now:            time.Now,
+	}
+}
+
+// CreateAPIKey stores a new key for the subject and returns it, the raw key can't be recovered later.
+// An empty tenant doesn't bind the key to a tenant, its requests choose one with the X-Tenant-ID header.
+func (s *Service) CreateAPIKey(ctx context.Context, name, subject, tenantID string, ttl time.Duration) (_ string, _ *storage.APIKey, err error) {
+	ctx, span := tracing.Start(ctx, "apikeys.CreateAPIKey")
+	defer tracing.End(span, &err)
+
+	if name == "" || subject == "" {
+		return "", nil, errors.New("name and subject are required")
+	}
+	if ttl < 0 {
+		return "", nil, errors.New("ttl less than 0")
+	}
+	if tenantID != "" {
+		err = tenant.Validate(tenantID)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	prefix, err := randomHex(prefixBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	secret, err := randomHex(secretBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	key := auth.APIKeyPrefix + prefix + "_" + secret
+
+	apiKey := &storage.APIKey{
+		Name:    name,
+		Subject: subject,
+		Prefix:  prefix,
+		Hash:    hashKey(key),
+	}
+	if tenantID != "" {
+		apiKey.Tenant = &tenantID
+	}
+	if ttl > 0 {
+		expiresAt := s.now().Add(ttl)
+		apiKey.ExpiresAt = &expiresAt
+	}
+
+	err = s.apiKeysStorage.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return key, apiKey, nil
+}
+
+func (s *Service) GetAPIKeys(ctx context.Context) (_ []*storage.APIKey, err error) {
+	ctx, span := tracing.Start(ctx, "apikeys.GetAPIKeys")
+	defer tracing.End(span, &err)
+
+	keys, err := s.apiKeysStorage.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "apikeys.RevokeAPIKey", attribute.Int("api_key.id", id))
+	defer tracing.End(span, &err)
+
+	if id <= 0 {
+		return errors.New("id less or equal 0")
+	}
+
+	n, err := s.apiKeysStorage.RevokeAPIKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("no active api key to revoke")
+	}
+
+	return nil
+}
+
+// AuthenticateAPIKey implements auth.APIKeyAuthenticator.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, key string) (_ *auth.Principal, err error) {
+	ctx, span := tracing.Start(ctx, "apikeys.AuthenticateAPIKey")
+	defer tracing.End(span, &err)
+
+	if !strings.HasPrefix(key, auth.APIKeyPrefix) {
+		return nil, fmt.Errorf("%w: malformed api key", auth.ErrUnauthenticated)
+	}
+
+	apiKey, err := s.apiKeysStorage.GetAPIKeyByHash(ctx, hashKey(key))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: unknown api key", auth.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if apiKey.RevokedAt != nil {
+		return nil, fmt.Errorf("%w: api key revoked", auth.ErrUnauthenticated)
+	}
+	if apiKey.ExpiresAt != nil && !s.now().Before(*apiKey.ExpiresAt) {
+		return nil, fmt.Errorf("%w: api key expired", auth.ErrUnauthenticated)
+	}
+
+	principal := &auth.Principal{Subject: apiKey.Subject, Method: auth.MethodAPIKey}
+	if apiKey.Tenant != nil {
+		principal.Tenant = *apiKey.Tenant
+	}
+
+	return principal, nil
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,156 @@
+package apikeys
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/auth"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	mc := minimock.NewController(t)
+
+	var stored *storage.APIKey
+	apiKeysStorage := storage_mocks.NewAPIKeysStorageMock(mc).
+		CreateAPIKeyMock.
+		Set(func(_ context.Context, apiKey *storage.APIKey) error {
+			stored = apiKey
+			apiKey.ID = 1
+			return nil
+		})
+	s := Service{apiKeysStorage: apiKeysStorage, now: func() time.Time { return now }}
+
+	key, apiKey, err := s.CreateAPIKey(context.Background(), "ci", "deploy-bot", "acme", time.Hour)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(key, auth.APIKeyPrefix+apiKey.Prefix+"_"))
+	assert.Equal(t, hashKey(key), stored.Hash)
+	assert.NotContains(t, stored.Hash, key)
+	assert.Equal(t, now.Add(time.Hour), *stored.ExpiresAt)
+	assert.Equal(t, "acme", *stored.Tenant)
+	assert.Equal(t, 1, apiKey.ID)
+
+	_, _, err = s.CreateAPIKey(context.Background(), "", "deploy-bot", "", 0)
+	assert.Error(t, err)
+	_, _, err = s.CreateAPIKey(context.Background(), "ci", "deploy-bot", "Not A Tenant", 0)
+	assert.Error(t, err)
+}
+
+func Test_AuthenticateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	const key = "oms_0a1b2c3d_secret"
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	tenantID := "acme"
+
+	tests := []struct {
+		name              string
+		key               string
+		getAPIKeysStorage func(mc *minimock.Controller) storage.APIKeysStorage
+		want              *auth.Principal
+		wantUnauth        bool
+		wantErr           bool
+	}{
+		{
+			name: "valid",
+			key:  key,
+			getAPIKeysStorage: func(mc *minimock.Controller) storage.APIKeysStorage {
+				return storage_mocks.NewAPIKeysStorageMock(mc).
+					GetAPIKeyByHashMock.
+					Expect(minimock.AnyContext, hashKey(key)).
+					Return(&storage.APIKey{ID: 1, Subject: "deploy-bot", Tenant: &tenantID, ExpiresAt: &future}, nil)
+			},
+			want: &auth.Principal{Subject: "deploy-bot", Method: auth.MethodAPIKey, Tenant: "acme"},
+		},
+		{
+			name: "malformed",
+			key:  "secret",
+			getAPIKeysStorage: func(mc *minimock.Controller) storage.APIKeysStorage {
+				return nil
+			},
+			wantUnauth: true,
+		},
+		{
+			name: "unknown",
+			key:  key,
+			getAPIKeysStorage: func(mc *minimock.Controller) storage.APIKeysStorage {
+				return storage_mocks.NewAPIKeysStorageMock(mc).
+					GetAPIKeyByHashMock.
+					Expect(minimock.AnyContext, hashKey(key)).
+					Return(nil, sql.ErrNoRows)
+			},
+			wantUnauth: true,
+		},
+		{
+			name: "revoked",
+			key:  key,
+			getAPIKeysStorage: func(mc *minimock.Controller) storage.APIKeysStorage {
+				return storage_mocks.NewAPIKeysStorageMock(mc).
+					GetAPIKeyByHashMock.
+					Expect(minimock.AnyContext, hashKey(key)).
+					Return(&storage.APIKey{ID: 1, Subject: "deploy-bot", RevokedAt: &past}, nil)
+			},
+			wantUnauth: true,
+		},
+		{
+			name: "expired",
+			key:  key,
+			getAPIKeysStorage: func(mc *minimock.Controller) storage.APIKeysStorage {
+				return storage_mocks.NewAPIKeysStorageMock(mc).
+					GetAPIKeyByHashMock.
+					Expect(minimock.AnyContext, hashKey(key)).
+					Return(&storage.APIKey{ID: 1, Subject: "deploy-bot", ExpiresAt: &past}, nil)
+			},
+			wantUnauth: true,
+		},
+		{
+			name: "storageError",
+			key:  key,
+			getAPIKeysStorage: func(mc *minimock.Controller) storage.APIKeysStorage {
+				return storage_mocks.NewAPIKeysStorageMock(mc).
+					GetAPIKeyByHashMock.
+					Expect(minimock.AnyContext, hashKey(key)).
+					Return(nil, errors.New("storageError"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			s := Service{apiKeysStorage: tt.getAPIKeysStorage(mc), now: func() time.Time { return now }}
+
+			got, err := s.AuthenticateAPIKey(context.Background(), tt.key)
+			switch {
+			case tt.wantUnauth:
+				assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+				return
+			case tt.wantErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, auth.ErrUnauthenticated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
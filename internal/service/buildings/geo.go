@@ -0,0 +1,144 @@
+package buildings
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+)
+
+const (
+	// MaxRadius bounds the radius of the searches by distance, in meters.
+	MaxRadius = 100_000
+	// DefaultGeoLimit is the number of buildings of the geospatial searches when the limit isn't given.
+	DefaultGeoLimit = 100
+	// MaxGeoLimit bounds the number of buildings of the geospatial searches.
+	MaxGeoLimit = 1000
+)
+
+// GetBuildingsNearby searches the buildings the principal may read by their distance to the
+// center. The storages that aren't a storage.GeoStorage are searched in memory.
+func (s *Service) GetBuildingsNearby(ctx context.Context, center geo.Point, radius float64, limit int) (_ []*storage.NearbyBuilding, err error) {
+	ctx, span := tracing.Start(ctx, "buildings.GetBuildingsNearby",
+		attribute.Float64("geo.radius", radius), attribute.Int("geo.limit", limit))
+	defer tracing.End(span, &err)
+
+	err = center.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalid, err)
+	}
+	if !(radius > 0 && radius <= MaxRadius) {
+		return nil, fmt.Errorf("%w: radius must be between 0 and %d meters", service.ErrInvalid, MaxRadius)
+	}
+	err = validateLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, all, err := s.readable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !all && len(ids) == 0 {
+		return []*storage.NearbyBuilding{}, nil
+	}
+
+	if geoStorage, ok := s.buildingsStorage.(storage.GeoStorage); ok {
+		return geoStorage.GetBuildingsNearby(ctx, center, radius, ids, limit)
+	}
+
+	buildings, err := s.readableBuildings(ctx, ids, all)
+	if err != nil {
+		return nil, err
+	}
+	nearby := []*storage.NearbyBuilding{}
+	for _, b := range buildings {
+		if !b.Latitude.Valid {
+			continue
+		}
+		if d := geo.Distance(center, geo.Point{Lat: b.Latitude.Float64, Lon: b.Longitude.Float64}); d <= radius {
+			nearby = append(nearby, &storage.NearbyBuilding{Building: b, DistanceM: d})
+		}
+	}
+	slices.SortFunc(nearby, func(a, b *storage.NearbyBuilding) int {
+		return cmp.Or(cmp.Compare(a.DistanceM, b.DistanceM), cmp.Compare(a.Building.ID, b.Building.ID))
+	})
+
+	return nearby[:min(limit, len(nearby))], nil
+}
+
+// GetBuildingsInBox searches the buildings the principal may read in the box. The storages that
+// aren't a storage.GeoStorage are searched in memory.
+func (s *Service) GetBuildingsInBox(ctx context.Context, box geo.Box, limit int) (_ models.BuildingSlice, err error) {
+	ctx, span := tracing.Start(ctx, "buildings.GetBuildingsInBox", attribute.Int("geo.limit", limit))
+	defer tracing.End(span, &err)
+
+	err = box.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalid, err)
+	}
+	err = validateLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, all, err := s.readable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !all && len(ids) == 0 {
+		return models.BuildingSlice{}, nil
+	}
+
+	if geoStorage, ok := s.buildingsStorage.(storage.GeoStorage); ok {
+		return geoStorage.GetBuildingsInBox(ctx, box, ids, limit)
+	}
+
+	buildings, err := s.readableBuildings(ctx, ids, all)
+	if err != nil {
+		return nil, err
+	}
+	buildings = slices.DeleteFunc(slices.Clone(buildings), func(b *models.Building) bool {
+		return !b.Latitude.Valid || !box.Contains(geo.Point{Lat: b.Latitude.Float64, Lon: b.Longitude.Float64})
+	})
+	slices.SortFunc(buildings, func(a, b *models.Building) int { return cmp.Compare(a.ID, b.ID) })
+
+	return buildings[:min(limit, len(buildings))], nil
+}
+
+func validateLimit(limit int) error {
+	if limit < 1 || limit > MaxGeoLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", service.ErrInvalid, MaxGeoLimit)
+	}
+	return nil
+}
+
+// readable returns the buildings the principal may read, nil if all of them.
+func (s *Service) readable(ctx context.Context) (ids []int, all bool, err error) {
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ids, all = scope.Buildings(access.ActionRead)
+	if all {
+		return nil, true, nil
+	}
+	return ids, false, nil
+}
+
+func (s *Service) readableBuildings(ctx context.Context, ids []int, all bool) (models.BuildingSlice, error) {
+	if all {
+		return s.buildingsStorage.GetBuildings(ctx)
+	}
+	return s.buildingsStorage.GetBuildingsByIDs(ctx, ids)
+}
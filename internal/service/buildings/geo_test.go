@@ -0,0 +1,257 @@
+package buildings
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+)
+
+// geoStorage is a buildings storage that searches itself by the coordinates.
+type geoStorage struct {
+	*storage_mocks.BuildingsStorageMock
+	nearby func(ctx context.Context, center geo.Point, radius float64, buildingIds []int, limit int) ([]*storage.NearbyBuilding, error)
+	inBox  func(ctx context.Context, box geo.Box, buildingIds []int, limit int) (models.BuildingSlice, error)
+}
+
+func (s *geoStorage) GetBuildingsNearby(ctx context.Context, center geo.Point, radius float64, buildingIds []int, limit int) ([]*storage.NearbyBuilding, error) {
+	return s.nearby(ctx, center, radius, buildingIds, limit)
+}
+
+func (s *geoStorage) GetBuildingsInBox(ctx context.Context, box geo.Box, buildingIds []int, limit int) (models.BuildingSlice, error) {
+	return s.inBox(ctx, box, buildingIds, limit)
+}
+
+var (
+	haifa = geo.Point{Lat: 32.8191, Lon: 34.9983}
+
+	geoBuildings = models.BuildingSlice{
+		{ID: 1, Name: "Meridor", Latitude: null.Float64From(32.8191), Longitude: null.Float64From(34.9983)},
+		{ID: 2, Name: "Harbor View", Latitude: null.Float64From(32.8200), Longitude: null.Float64From(34.9990)},
+		{ID: 3, Name: "Unlocated"},
+		{ID: 4, Name: "Tel Aviv", Latitude: null.Float64From(32.0853), Longitude: null.Float64From(34.7818)},
+	}
+)
+
+func Test_GetBuildingsNearby(t *testing.T) {
+	t.Parallel()
+
+	t.Run("storage", func(t *testing.T) {
+		t.Parallel()
+
+		want := []*storage.NearbyBuilding{{Building: geoBuildings[0], DistanceM: 0}}
+		buildingsStorage := &geoStorage{
+			BuildingsStorageMock: storage_mocks.NewBuildingsStorageMock(minimock.NewController(t)),
+			nearby: func(_ context.Context, center geo.Point, radius float64, buildingIds []int, limit int) ([]*storage.NearbyBuilding, error) {
+				assert.Equal(t, haifa, center)
+				assert.Equal(t, 2000.0, radius)
+				assert.Equal(t, []int{1, 3}, buildingIds)
+				assert.Equal(t, 5, limit)
+				return want, nil
+			},
+		}
+		s := NewService(buildingsStorage, scopeOf(managerOf(3), managerOf(1)), nil)
+
+		got, err := s.GetBuildingsNearby(context.Background(), haifa, 2000, 5)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsMock.
+			Return(geoBuildings, nil)
+		s := NewService(buildingsStorage, access.Fixed(access.Unrestricted()), nil)
+
+		got, err := s.GetBuildingsNearby(context.Background(), geo.Point{Lat: 32.8200, Lon: 34.9990}, 2000, DefaultGeoLimit)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, 2, got[0].Building.ID)
+		assert.Zero(t, got[0].DistanceM)
+		assert.Equal(t, 1, got[1].Building.ID)
+		assert.InDelta(t, 119.6, got[1].DistanceM, 0.1)
+
+		got, err = s.GetBuildingsNearby(context.Background(), haifa, 2000, 1)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, 1, got[0].Building.ID)
+	})
+
+	t.Run("fallbackFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsByIDsMock.
+			Expect(minimock.AnyContext, []int{2}).
+			Return(geoBuildings[1:2], nil)
+		s := NewService(buildingsStorage, scopeOf(managerOf(2)), nil)
+
+		got, err := s.GetBuildingsNearby(context.Background(), haifa, 2000, DefaultGeoLimit)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, 2, got[0].Building.ID)
+	})
+
+	t.Run("noGrants", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, scopeOf(), nil)
+
+		got, err := s.GetBuildingsNearby(context.Background(), haifa, 2000, DefaultGeoLimit)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, access.Fixed(access.Unrestricted()), nil)
+
+		for _, tt := range []struct {
+			center geo.Point
+			radius float64
+			limit  int
+		}{
+			{center: geo.Point{Lat: 91}, radius: 2000, limit: DefaultGeoLimit},
+			{center: haifa, radius: 0, limit: DefaultGeoLimit},
+			{center: haifa, radius: MaxRadius + 1, limit: DefaultGeoLimit},
+			{center: haifa, radius: 2000, limit: 0},
+			{center: haifa, radius: 2000, limit: MaxGeoLimit + 1},
+		} {
+			_, err := s.GetBuildingsNearby(context.Background(), tt.center, tt.radius, tt.limit)
+			assert.ErrorIs(t, err, service.ErrInvalid, "%+v", tt)
+		}
+	})
+}
+
+func Test_GetBuildingsInBox(t *testing.T) {
+	t.Parallel()
+
+	box := geo.Box{MinLat: 32.78, MinLon: 34.95, MaxLat: 32.83, MaxLon: 35.02}
+
+	t.Run("storage", func(t *testing.T) {
+		t.Parallel()
+
+		buildingsStorage := &geoStorage{
+			BuildingsStorageMock: storage_mocks.NewBuildingsStorageMock(minimock.NewController(t)),
+			inBox: func(_ context.Context, got geo.Box, buildingIds []int, limit int) (models.BuildingSlice, error) {
+				assert.Equal(t, box, got)
+				assert.Nil(t, buildingIds)
+				assert.Equal(t, DefaultGeoLimit, limit)
+				return geoBuildings[:2], nil
+			},
+		}
+		s := NewService(buildingsStorage, access.Fixed(access.Unrestricted()), nil)
+
+		got, err := s.GetBuildingsInBox(context.Background(), box, DefaultGeoLimit)
+		require.NoError(t, err)
+		assert.Equal(t, geoBuildings[:2], got)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsMock.
+			Return(geoBuildings, nil)
+		s := NewService(buildingsStorage, access.Fixed(access.Unrestricted()), nil)
+
+		got, err := s.GetBuildingsInBox(context.Background(), box, DefaultGeoLimit)
+		require.NoError(t, err)
+		assert.Equal(t, geoBuildings[:2], got)
+		assert.Len(t, geoBuildings, 4, "the buildings of the storage are left alone")
+
+		got, err = s.GetBuildingsInBox(context.Background(), geo.Box{MinLat: 30, MinLon: 34, MaxLat: 33, MaxLon: 35}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, models.BuildingSlice{geoBuildings[0], geoBuildings[1]}, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, access.Fixed(access.Unrestricted()), nil)
+
+		_, err := s.GetBuildingsInBox(context.Background(), geo.Box{MinLat: 33, MaxLat: 32}, DefaultGeoLimit)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		_, err = s.GetBuildingsInBox(context.Background(), box, MaxGeoLimit+1)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+	})
+}
+
+func Test_CreateBuildingGeocoded(t *testing.T) {
+	t.Parallel()
+
+	gazetteer, err := geo.ReadGazetteer(strings.NewReader(
+		"country,postal_code,city,street,house_number,latitude,longitude\nIL,,Haifa,HaMishlatim,4,32.8191,34.9983\n"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		building *models.Building
+		want     *models.Building
+	}{
+		{
+			name:     "geocoded",
+			building: &models.Building{Name: "Meridor", Address: null.StringFrom("HaMishlatim 4, Haifa, Israel")},
+			want: &models.Building{
+				Name: "Meridor", Address: null.StringFrom("HaMishlatim 4, Haifa, Israel"),
+				Street: null.StringFrom("HaMishlatim"), HouseNumber: null.StringFrom("4"), City: null.StringFrom("Haifa"),
+				Country: null.StringFrom("IL"), Latitude: null.Float64From(32.8191), Longitude: null.Float64From(34.9983),
+			},
+		},
+		{
+			name: "located",
+			building: &models.Building{
+				Name: "Meridor", Address: null.StringFrom("HaMishlatim 4, Haifa, Israel"),
+				Latitude: null.Float64From(32.82), Longitude: null.Float64From(35),
+			},
+			want: &models.Building{
+				Name: "Meridor", Address: null.StringFrom("HaMishlatim 4, Haifa, Israel"),
+				Street: null.StringFrom("HaMishlatim"), HouseNumber: null.StringFrom("4"), City: null.StringFrom("Haifa"),
+				Country: null.StringFrom("IL"), Latitude: null.Float64From(32.82), Longitude: null.Float64From(35),
+			},
+		},
+		{
+			name:     "unknown",
+			building: &models.Building{Name: "Harbor View", Address: null.StringFrom("Herzl 12, Haifa, Israel")},
+			want: &models.Building{
+				Name: "Harbor View", Address: null.StringFrom("Herzl 12, Haifa, Israel"),
+				Street: null.StringFrom("Herzl"), HouseNumber: null.StringFrom("12"), City: null.StringFrom("Haifa"),
+				Country: null.StringFrom("IL"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+				CreateBuildingMock.
+				Expect(minimock.AnyContext, tt.want).
+				Return(nil)
+			s := NewService(buildingsStorage, access.Fixed(access.Unrestricted()), gazetteer)
+
+			assert.NoError(t, s.CreateBuilding(context.Background(), tt.building))
+		})
+	}
+}
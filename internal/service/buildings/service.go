@@ -2,32 +2,75 @@ package buildings
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
 	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 //go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/buildings.BuildingsService -o ../mocks/
 type BuildingsService interface {
 	GetBuildings(ctx context.Context) (models.BuildingSlice, error)
+	// FindBuildings returns the buildings whose address matches the normalised filter, in the order.
+	FindBuildings(ctx context.Context, filter address.Filter, order address.Order) (models.BuildingSlice, error)
 	GetBuilding(ctx context.Context, id int) (*models.Building, error)
+	GetBuildingsByIDs(ctx context.Context, ids []int) (models.BuildingSlice, error)
+	// GetBuildingsNearby returns the limit buildings closest to the center within radius meters.
+	GetBuildingsNearby(ctx context.Context, center geo.Point, radius float64, limit int) ([]*storage.NearbyBuilding, error)
+	// GetBuildingsInBox returns the first limit buildings by ID in the box.
+	GetBuildingsInBox(ctx context.Context, box geo.Box, limit int) (models.BuildingSlice, error)
 	CreateBuilding(ctx context.Context, building *models.Building) error
 	DeleteBuilding(ctx context.Context, id int) error
 }
 
 type Service struct {
 	buildingsStorage storage.BuildingsStorage
+	scopes           access.Resolver
+	geocoder         geo.Geocoder
 }
 
-func NewService(buildingsStorage storage.BuildingsStorage) *Service {
+// NewService returns the buildings service, the geocoder locates the buildings written without
+// coordinates unless it is nil.
+func NewService(buildingsStorage storage.BuildingsStorage, scopes access.Resolver, geocoder geo.Geocoder) *Service {
 	return &Service{
 		buildingsStorage: buildingsStorage,
+		scopes:           scopes,
+		geocoder:         geocoder,
 	}
 }
 
-func (s *Service) GetBuildings(ctx context.Context) (models.BuildingSlice, error) {
-	buildings, err := s.buildingsStorage.GetBuildings(ctx)
+func (s *Service) GetBuildings(ctx context.Context) (_ models.BuildingSlice, err error) {
+	ctx, span := tracing.Start(ctx, "buildings.GetBuildings")
+	defer tracing.End(span, &err)
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the buildings the principal may read are listed.
+	ids, all := scope.Buildings(access.ActionRead)
+	if !all && len(ids) == 0 {
+		return models.BuildingSlice{}, nil
+	}
+
+	var buildings models.BuildingSlice
+	if all {
+		buildings, err = s.buildingsStorage.GetBuildings(ctx)
+	} else {
+		buildings, err = s.buildingsStorage.GetBuildingsByIDs(ctx, ids)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -35,12 +78,51 @@ func (s *Service) GetBuildings(ctx context.Context) (models.BuildingSlice, error
 	return buildings, nil
 }
 
-func (s *Service) GetBuilding(ctx context.Context, id int) (*models.Building, error) {
+// FindBuildings lists the buildings the principal may read by their address. The storages that
+// aren't a storage.AddressStorage are filtered and sorted in memory.
+func (s *Service) FindBuildings(ctx context.Context, filter address.Filter, order address.Order) (_ models.BuildingSlice, err error) {
+	ctx, span := tracing.Start(ctx, "buildings.FindBuildings", attribute.String("buildings.order", string(order)))
+	defer tracing.End(span, &err)
+
+	ids, all, err := s.readable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !all && len(ids) == 0 {
+		return models.BuildingSlice{}, nil
+	}
+
+	if addressStorage, ok := s.buildingsStorage.(storage.AddressStorage); ok {
+		return addressStorage.FindBuildings(ctx, filter, order, ids)
+	}
+
+	buildings, err := s.readableBuildings(ctx, ids, all)
+	if err != nil {
+		return nil, err
+	}
+	buildings = slices.Clone(address.FilterBuildings(buildings, filter))
+	address.SortBuildings(buildings, order)
+
+	return buildings, nil
+}
+
+func (s *Service) GetBuilding(ctx context.Context, id int) (_ *models.Building, err error) {
+	ctx, span := tracing.Start(ctx, "buildings.GetBuilding", attribute.Int("building.id", id))
+	defer tracing.End(span, &err)
+
 	if id <= 0 {
 		return nil, errors.New("id less or equal 0")
 	}
 
+	err = s.authorize(ctx, access.ActionRead, id)
+	if err != nil {
+		return nil, err
+	}
+
 	building, err := s.buildingsStorage.GetBuilding(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, service.WithLegacyMessage(fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, id), err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +130,49 @@ func (s *Service) GetBuilding(ctx context.Context, id int) (*models.Building, er
 	return building, nil
 }
 
-func (s *Service) CreateBuilding(ctx context.Context, building *models.Building) error {
-	err := s.buildingsStorage.CreateBuilding(ctx, building)
+// GetBuildingsByIDs returns the buildings among ids that exist and the principal may read, in no particular order.
+func (s *Service) GetBuildingsByIDs(ctx context.Context, ids []int) (_ models.BuildingSlice, err error) {
+	ctx, span := tracing.Start(ctx, "buildings.GetBuildingsByIDs", attribute.Int("buildings.count", len(ids)))
+	defer tracing.End(span, &err)
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids = scope.Filter(access.ActionRead, ids)
+	if len(ids) == 0 {
+		return models.BuildingSlice{}, nil
+	}
+
+	return s.buildingsStorage.GetBuildingsByIDs(ctx, ids)
+}
+
+func (s *Service) CreateBuilding(ctx context.Context, building *models.Building) (err error) {
+	ctx, span := tracing.Start(ctx, "buildings.CreateBuilding")
+	defer tracing.End(span, &err)
+
+	if building == nil {
+		return errors.New("building is required")
+	}
+
+	// A new building (without ID) can only be created with a global role.
+	err = s.authorize(ctx, access.ActionWrite, building.ID)
+	if err != nil {
+		return err
+	}
+
+	err = address.Resolve(building)
+	if err != nil {
+		return fmt.Errorf("%w: %v", service.ErrInvalid, err)
+	}
+	if s.geocoder != nil && !building.Latitude.Valid {
+		if p, ok := s.geocoder.Geocode(address.OfBuilding(building)); ok {
+			building.Latitude, building.Longitude = null.Float64From(p.Lat), null.Float64From(p.Lon)
+		}
+	}
+
+	err = s.buildingsStorage.CreateBuilding(ctx, building)
 	if err != nil {
 		return err
 	}
@@ -57,19 +180,36 @@ func (s *Service) CreateBuilding(ctx context.Context, building *models.Building)
 	return nil
 }
 
-func (s *Service) DeleteBuilding(ctx context.Context, id int) error {
+func (s *Service) DeleteBuilding(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "buildings.DeleteBuilding", attribute.Int("building.id", id))
+	defer tracing.End(span, &err)
+
 	if id <= 0 {
 		return errors.New("id less or equal 0")
 	}
 
+	err = s.authorize(ctx, access.ActionWrite, id)
+	if err != nil {
+		return err
+	}
+
 	n, err := s.buildingsStorage.DeleteBuilding(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	if n == 0 {
-		return errors.New("no building with such id")
+		return service.WithLegacyMessage(fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, id), "no building with such id")
 	}
 
 	return nil
 }
+
+func (s *Service) authorize(ctx context.Context, a access.Action, buildingID int) error {
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	return access.Authorize(scope, a, buildingID)
+}
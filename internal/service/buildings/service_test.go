@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/address"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
 	"github.com/sotskov-do/oms-assignment/internal/storage"
 	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
 	"github.com/stretchr/testify/assert"
@@ -74,7 +77,7 @@ func Test_GetBuildings(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			buildingsStorage := tt.getBuildingsStorage(mc)
-			s := Service{buildingsStorage: buildingsStorage}
+			s := Service{buildingsStorage: buildingsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			got, err := s.GetBuildings(context.Background())
 			if tt.wantErr {
@@ -86,6 +89,89 @@ func Test_GetBuildings(t *testing.T) {
 	}
 }
 
+// addressStorage is a buildings storage that filters itself by the address.
+type addressStorage struct {
+	*storage_mocks.BuildingsStorageMock
+	find func(ctx context.Context, filter address.Filter, order address.Order, buildingIds []int) (models.BuildingSlice, error)
+}
+
+func (s *addressStorage) FindBuildings(ctx context.Context, filter address.Filter, order address.Order, buildingIds []int) (models.BuildingSlice, error) {
+	return s.find(ctx, filter, order, buildingIds)
+}
+
+func Test_FindBuildings(t *testing.T) {
+	t.Parallel()
+
+	buildings := models.BuildingSlice{
+		{ID: 1, Street: null.StringFrom("Main Street"), HouseNumber: null.StringFrom("12"), City: null.StringFrom("Springfield")},
+		{ID: 2, Address: null.StringFrom("Elm 3")},
+		{ID: 3, Street: null.StringFrom("main street"), HouseNumber: null.StringFrom("4A"), City: null.StringFrom("Springfield")},
+		{ID: 4, Street: null.StringFrom("Elm Street"), HouseNumber: null.StringFrom("7"), City: null.StringFrom("Springfield")},
+		{ID: 5, Street: null.StringFrom("Main Street"), HouseNumber: null.StringFrom("4"), City: null.StringFrom("Haifa")},
+	}
+
+	t.Run("storage", func(t *testing.T) {
+		t.Parallel()
+
+		filter := address.Filter{City: "Springfield"}
+		buildingsStorage := &addressStorage{
+			BuildingsStorageMock: storage_mocks.NewBuildingsStorageMock(minimock.NewController(t)),
+			find: func(_ context.Context, got address.Filter, order address.Order, buildingIds []int) (models.BuildingSlice, error) {
+				assert.Equal(t, filter, got)
+				assert.Equal(t, address.OrderStreet, order)
+				assert.Equal(t, []int{1, 3}, buildingIds)
+				return buildings[:1], nil
+			},
+		}
+		s := NewService(buildingsStorage, scopeOf(managerOf(3), managerOf(1)), nil)
+
+		got, err := s.FindBuildings(context.Background(), filter, address.OrderStreet)
+		assert.NoError(t, err)
+		assert.Equal(t, buildings[:1], got)
+	})
+
+	tests := []struct {
+		name    string
+		filter  address.Filter
+		order   address.Order
+		wantIDs []int
+	}{
+		{name: "byID", order: address.OrderID, wantIDs: []int{1, 2, 3, 4, 5}},
+		{name: "byStreet", order: address.OrderStreet, wantIDs: []int{4, 5, 3, 1, 2}},
+		{name: "filtered", filter: address.Filter{City: "springfield"}, order: address.OrderStreet, wantIDs: []int{4, 3, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+				GetBuildingsMock.
+				Return(buildings, nil)
+			s := NewService(buildingsStorage, access.Fixed(access.Unrestricted()), nil)
+
+			got, err := s.FindBuildings(context.Background(), tt.filter, tt.order)
+			assert.NoError(t, err)
+			ids := []int{}
+			for _, b := range got {
+				ids = append(ids, b.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+
+	t.Run("noGrants", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, scopeOf(), nil)
+
+		got, err := s.FindBuildings(context.Background(), address.Filter{}, address.OrderID)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
 func Test_GetBuilding(t *testing.T) {
 	t.Parallel()
 
@@ -154,7 +240,7 @@ func Test_GetBuilding(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			buildingsStorage := tt.getBuildingsStorage(mc)
-			s := Service{buildingsStorage: buildingsStorage}
+			s := Service{buildingsStorage: buildingsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			got, err := s.GetBuilding(context.Background(), tt.args.id)
 			if tt.wantErr {
@@ -193,10 +279,86 @@ func Test_CreateBuilding(t *testing.T) {
 					Expect(minimock.AnyContext, &models.Building{
 						Name:    "building_1",
 						Address: null.String{Valid: true, String: "address_1"},
+						Street:  null.String{Valid: true, String: "address_1"},
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name: "parsedAddress",
+			args: args{
+				building: &models.Building{
+					Name:    "building_1",
+					Address: null.StringFrom(" 4  Main St. , Springfield 62701, USA"),
+				},
+			},
+			getBuildingsStorage: func(mc *minimock.Controller) storage.BuildingsStorage {
+				return storage_mocks.NewBuildingsStorageMock(mc).
+					CreateBuildingMock.
+					Expect(minimock.AnyContext, &models.Building{
+						Name:        "building_1",
+						Address:     null.StringFrom(" 4  Main St. , Springfield 62701, USA"),
+						Street:      null.StringFrom("Main Street"),
+						HouseNumber: null.StringFrom("4"),
+						City:        null.StringFrom("Springfield"),
+						PostalCode:  null.StringFrom("62701"),
+						Country:     null.StringFrom("US"),
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name: "renderedAddress",
+			args: args{
+				building: &models.Building{
+					Name:        "building_1",
+					Street:      null.StringFrom("HaMishlatim"),
+					HouseNumber: null.StringFrom("4a"),
+					City:        null.StringFrom("Haifa"),
+					Country:     null.StringFrom("israel"),
+				},
+			},
+			getBuildingsStorage: func(mc *minimock.Controller) storage.BuildingsStorage {
+				return storage_mocks.NewBuildingsStorageMock(mc).
+					CreateBuildingMock.
+					Expect(minimock.AnyContext, &models.Building{
+						Name:        "building_1",
+						Address:     null.StringFrom("HaMishlatim 4A, Haifa, IL"),
+						Street:      null.StringFrom("HaMishlatim"),
+						HouseNumber: null.StringFrom("4A"),
+						City:        null.StringFrom("Haifa"),
+						Country:     null.StringFrom("IL"),
 					}).
 					Return(nil)
 			},
 		},
+		{
+			name: "addressNotMatchingComponents",
+			args: args{
+				building: &models.Building{
+					Name:    "building_1",
+					Address: null.StringFrom("Herzl 12, Haifa"),
+					Street:  null.StringFrom("HaMishlatim"),
+				},
+			},
+			getBuildingsStorage: func(mc *minimock.Controller) storage.BuildingsStorage {
+				return storage_mocks.NewBuildingsStorageMock(mc)
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidAddress",
+			args: args{
+				building: &models.Building{
+					Name:    "building_1",
+					Country: null.StringFrom("Atlantis"),
+				},
+			},
+			getBuildingsStorage: func(mc *minimock.Controller) storage.BuildingsStorage {
+				return storage_mocks.NewBuildingsStorageMock(mc)
+			},
+			wantErr: true,
+		},
 		{
 			name: "storageError",
 			args: args{
@@ -213,6 +375,7 @@ func Test_CreateBuilding(t *testing.T) {
 						ID:      1,
 						Name:    "building_1",
 						Address: null.String{Valid: true, String: "address_1"},
+						Street:  null.String{Valid: true, String: "address_1"},
 					}).
 					Return(errors.New("storageError"))
 			},
@@ -228,13 +391,14 @@ func Test_CreateBuilding(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			buildingsStorage := tt.getBuildingsStorage(mc)
-			s := Service{buildingsStorage: buildingsStorage}
+			s := Service{buildingsStorage: buildingsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			err := s.CreateBuilding(context.Background(), tt.args.building)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
 			}
+			assert.NoError(t, err)
 		})
 	}
 }
@@ -310,7 +474,7 @@ func Test_DeleteBuilding(t *testing.T) {
 
 			mc := minimock.NewController(t)
 			buildingsStorage := tt.getBuildingsStorage(mc)
-			s := Service{buildingsStorage: buildingsStorage}
+			s := Service{buildingsStorage: buildingsStorage, scopes: access.Fixed(access.Unrestricted())}
 
 			err := s.DeleteBuilding(context.Background(), tt.args.id)
 			if tt.wantErr {
@@ -320,3 +484,106 @@ func Test_DeleteBuilding(t *testing.T) {
 		})
 	}
 }
+
+func scopeOf(grants ...*storage.Grant) access.Resolver {
+	return access.Fixed(access.NewScope(grants...))
+}
+
+func managerOf(buildingID int) *storage.Grant {
+	return &storage.Grant{Role: string(access.RoleManager), BuildingID: &buildingID}
+}
+
+func Test_BuildingsPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("getBuildingsFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsByIDsMock.
+			Expect(minimock.AnyContext, []int{1, 3}).
+			Return(models.BuildingSlice{{ID: 1}, {ID: 3}}, nil)
+		s := Service{buildingsStorage: buildingsStorage, scopes: scopeOf(managerOf(3), managerOf(1))}
+
+		got, err := s.GetBuildings(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, models.BuildingSlice{{ID: 1}, {ID: 3}}, got)
+	})
+
+	t.Run("getBuildingsNoGrants", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf()}
+
+		got, err := s.GetBuildings(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("getBuildingsByIDsFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsByIDsMock.
+			Expect(minimock.AnyContext, []int{3}).
+			Return(models.BuildingSlice{{ID: 3}}, nil)
+		s := Service{buildingsStorage: buildingsStorage, scopes: scopeOf(managerOf(3), managerOf(1))}
+
+		got, err := s.GetBuildingsByIDs(context.Background(), []int{2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, models.BuildingSlice{{ID: 3}}, got)
+	})
+
+	t.Run("getBuildingsByIDsOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(managerOf(1))}
+
+		got, err := s.GetBuildingsByIDs(context.Background(), []int{2, 3})
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("getBuildingForbidden", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(managerOf(1))}
+
+		_, err := s.GetBuilding(context.Background(), 2)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("createBuildingWithoutGlobalRole", func(t *testing.T) {
+		t.Parallel()
+
+		s := Service{scopes: scopeOf(managerOf(1))}
+
+		err := s.CreateBuilding(context.Background(), &models.Building{Name: "building_2"})
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("deleteBuildingInScope", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			DeleteBuildingMock.
+			Expect(minimock.AnyContext, 1).
+			Return(1, nil)
+		s := Service{buildingsStorage: buildingsStorage, scopes: scopeOf(managerOf(1))}
+
+		assert.NoError(t, s.DeleteBuilding(context.Background(), 1))
+	})
+
+	t.Run("deleteBuildingOutOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		viewer := &storage.Grant{Role: string(access.RoleViewer)}
+		s := Service{scopes: scopeOf(managerOf(1), viewer)}
+
+		err := s.DeleteBuilding(context.Background(), 2)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+}
@@ -0,0 +1,40 @@
+package service
+
+import "errors"
+
+// ErrForbidden is returned when the principal of the request may not perform the operation.
+var ErrForbidden = errors.New("forbidden")
+
+// ErrInvalid is returned when the input of the operation is invalid.
+var ErrInvalid = errors.New("invalid")
+
+// ErrNotFound is returned when the resource of the operation doesn't exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrConflict is returned when the state of the resource prevents the operation, e.g. deleting a
+// floor that still has apartments.
+var ErrConflict = errors.New("conflict")
+
+// legacyError keeps the message that the v1 API sent for an error before it wrapped ErrNotFound.
+type legacyError struct {
+	error
+	legacy string
+}
+
+func (e *legacyError) Unwrap() error {
+	return e.error
+}
+
+// WithLegacyMessage returns err, sent as msg by the v1 API.
+func WithLegacyMessage(err error, msg string) error {
+	return &legacyError{error: err, legacy: msg}
+}
+
+// LegacyMessage returns the message of err sent by the v1 API, its own message unless it has a legacy one.
+func LegacyMessage(err error) string {
+	var l *legacyError
+	if errors.As(err, &l) {
+		return l.legacy
+	}
+	return err.Error()
+}
@@ -0,0 +1,165 @@
+// Package floors manages the floors of the buildings, against which the floors of the apartments
+// are validated.
+package floors
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+)
+
+// MaxLabelLength bounds the labels of the floors, in characters.
+const MaxLabelLength = 16
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/floors.FloorsService -o ../mocks/
+type FloorsService interface {
+	GetFloors(ctx context.Context, buildingId int) ([]*storage.Floor, error)
+	GetFloor(ctx context.Context, buildingId int, number int) (*storage.Floor, error)
+	CreateFloor(ctx context.Context, floor *storage.Floor) error
+	DeleteFloor(ctx context.Context, buildingId int, number int) error
+}
+
+type Service struct {
+	floorsStorage     storage.FloorsStorage
+	apartmentsStorage storage.ApartmentsStorage
+	scopes            access.Resolver
+}
+
+func NewService(floorsStorage storage.FloorsStorage, apartmentsStorage storage.ApartmentsStorage, scopes access.Resolver) *Service {
+	return &Service{
+		floorsStorage:     floorsStorage,
+		apartmentsStorage: apartmentsStorage,
+		scopes:            scopes,
+	}
+}
+
+// GetFloors returns the floors of the building by number.
+func (s *Service) GetFloors(ctx context.Context, buildingId int) (_ []*storage.Floor, err error) {
+	ctx, span := tracing.Start(ctx, "floors.GetFloors", attribute.Int("building.id", buildingId))
+	defer tracing.End(span, &err)
+
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.floorsStorage.GetFloors(ctx, buildingId)
+}
+
+func (s *Service) GetFloor(ctx context.Context, buildingId int, number int) (_ *storage.Floor, err error) {
+	ctx, span := tracing.Start(ctx, "floors.GetFloor",
+		attribute.Int("building.id", buildingId), attribute.Int("floor.number", number))
+	defer tracing.End(span, &err)
+
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return nil, err
+	}
+
+	floor, err := s.floorsStorage.GetFloor(ctx, buildingId, number)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no floor [%v] in building [%v]", service.ErrNotFound, number, buildingId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return floor, nil
+}
+
+// CreateFloor creates the floor of the building or replaces the one with the same number. The label
+// is the number when it is empty. The first floor of a building also creates the floors of its
+// apartments, see storage.FloorsStorage.
+func (s *Service) CreateFloor(ctx context.Context, floor *storage.Floor) (err error) {
+	ctx, span := tracing.Start(ctx, "floors.CreateFloor")
+	defer tracing.End(span, &err)
+
+	if floor == nil {
+		return fmt.Errorf("%w: floor is required", service.ErrInvalid)
+	}
+
+	err = s.authorize(ctx, access.ActionWrite, floor.BuildingID)
+	if err != nil {
+		return err
+	}
+
+	floor.Label = strings.Join(strings.Fields(floor.Label), " ")
+	if floor.Label == "" {
+		floor.Label = strconv.Itoa(floor.Number)
+	}
+	if utf8.RuneCountInString(floor.Label) > MaxLabelLength {
+		return fmt.Errorf("%w: label longer than %d characters", service.ErrInvalid, MaxLabelLength)
+	}
+	if floor.SQMeters != nil && *floor.SQMeters <= 0 {
+		return fmt.Errorf("%w: sq_meters less or equal 0", service.ErrInvalid)
+	}
+
+	err = s.floorsStorage.CreateFloor(ctx, floor)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: no building with id [%v]", service.ErrNotFound, floor.BuildingID)
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFloor deletes the floor of the building, unless apartments are on it. The storage checks it
+// again with the building locked, for the apartments written since.
+func (s *Service) DeleteFloor(ctx context.Context, buildingId int, number int) (err error) {
+	ctx, span := tracing.Start(ctx, "floors.DeleteFloor",
+		attribute.Int("building.id", buildingId), attribute.Int("floor.number", number))
+	defer tracing.End(span, &err)
+
+	err = s.authorize(ctx, access.ActionWrite, buildingId)
+	if err != nil {
+		return err
+	}
+
+	apartments, err := s.apartmentsStorage.GetApartmentsOnFloor(ctx, buildingId, number)
+	if err != nil {
+		return err
+	}
+	if len(apartments) > 0 {
+		return fmt.Errorf("%w: %d apartments are on floor [%v] of building [%v]", service.ErrConflict, len(apartments), number, buildingId)
+	}
+
+	n, err := s.floorsStorage.DeleteFloor(ctx, buildingId, number)
+	if errors.Is(err, storage.ErrReferenced) {
+		return fmt.Errorf("%w: apartments are on floor [%v] of building [%v]", service.ErrConflict, number, buildingId)
+	}
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: no floor [%v] in building [%v]", service.ErrNotFound, number, buildingId)
+	}
+
+	return nil
+}
+
+func (s *Service) authorize(ctx context.Context, a access.Action, buildingID int) error {
+	if buildingID <= 0 {
+		return fmt.Errorf("%w: building id less or equal 0", service.ErrInvalid)
+	}
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	return access.Authorize(scope, a, buildingID)
+}
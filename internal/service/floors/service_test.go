@@ -0,0 +1,257 @@
+package floors
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+)
+
+func scopeOf(grants ...*storage.Grant) access.Resolver {
+	return access.Fixed(access.NewScope(grants...))
+}
+
+func grantOf(role access.Role, buildingID int) *storage.Grant {
+	return &storage.Grant{Role: string(role), BuildingID: &buildingID}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func Test_GetFloors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inScope", func(t *testing.T) {
+		t.Parallel()
+
+		want := []*storage.Floor{{ID: 1, BuildingID: 1, Number: 0, Label: "G"}, {ID: 2, BuildingID: 1, Number: 1, Label: "1"}}
+		floorsStorage := storage_mocks.NewFloorsStorageMock(minimock.NewController(t)).
+			GetFloorsMock.
+			Expect(minimock.AnyContext, 1).
+			Return(want, nil)
+		s := NewService(floorsStorage, nil, scopeOf(grantOf(access.RoleViewer, 1)))
+
+		got, err := s.GetFloors(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("outOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, scopeOf(grantOf(access.RoleViewer, 1)))
+
+		_, err := s.GetFloors(context.Background(), 2)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("invalidBuilding", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, access.Fixed(access.Unrestricted()))
+
+		_, err := s.GetFloors(context.Background(), 0)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+	})
+}
+
+func Test_GetFloor(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	floorsStorage := storage_mocks.NewFloorsStorageMock(mc).
+		GetFloorMock.
+		When(minimock.AnyContext, 1, -1).Then(&storage.Floor{ID: 1, BuildingID: 1, Number: -1, Label: "B1"}, nil).
+		GetFloorMock.
+		When(minimock.AnyContext, 1, 5).Then(nil, sql.ErrNoRows)
+	s := NewService(floorsStorage, nil, access.Fixed(access.Unrestricted()))
+
+	got, err := s.GetFloor(context.Background(), 1, -1)
+	require.NoError(t, err)
+	assert.Equal(t, &storage.Floor{ID: 1, BuildingID: 1, Number: -1, Label: "B1"}, got)
+
+	_, err = s.GetFloor(context.Background(), 1, 5)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	assert.EqualError(t, err, "not found: no floor [5] in building [1]")
+}
+
+func Test_CreateFloor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		floor            *storage.Floor
+		getFloorsStorage func(mc *minimock.Controller) storage.FloorsStorage
+		wantErr          error
+	}{
+		{
+			name:  "valid",
+			floor: &storage.Floor{BuildingID: 1, Number: -1, Label: "  B1 ", SQMeters: intPtr(400), Accessible: true},
+			getFloorsStorage: func(mc *minimock.Controller) storage.FloorsStorage {
+				return storage_mocks.NewFloorsStorageMock(mc).
+					CreateFloorMock.
+					Expect(minimock.AnyContext, &storage.Floor{BuildingID: 1, Number: -1, Label: "B1", SQMeters: intPtr(400), Accessible: true}).
+					Return(nil)
+			},
+		},
+		{
+			name:  "defaultLabel",
+			floor: &storage.Floor{BuildingID: 1, Number: 3},
+			getFloorsStorage: func(mc *minimock.Controller) storage.FloorsStorage {
+				return storage_mocks.NewFloorsStorageMock(mc).
+					CreateFloorMock.
+					Expect(minimock.AnyContext, &storage.Floor{BuildingID: 1, Number: 3, Label: "3"}).
+					Return(nil)
+			},
+		},
+		{
+			name:  "unknownBuilding",
+			floor: &storage.Floor{BuildingID: 9, Number: 0, Label: "G"},
+			getFloorsStorage: func(mc *minimock.Controller) storage.FloorsStorage {
+				return storage_mocks.NewFloorsStorageMock(mc).
+					CreateFloorMock.
+					Return(sql.ErrNoRows)
+			},
+			wantErr: service.ErrNotFound,
+		},
+		{
+			name:    "longLabel",
+			floor:   &storage.Floor{BuildingID: 1, Number: 0, Label: "Ground floor and the lobby"},
+			wantErr: service.ErrInvalid,
+		},
+		{
+			name:    "nonPositiveArea",
+			floor:   &storage.Floor{BuildingID: 1, Number: 0, SQMeters: intPtr(0)},
+			wantErr: service.ErrInvalid,
+		},
+		{
+			name:    "invalidBuilding",
+			floor:   &storage.Floor{Number: 0},
+			wantErr: service.ErrInvalid,
+		},
+		{
+			name:    "nil",
+			wantErr: service.ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			var floorsStorage storage.FloorsStorage = storage_mocks.NewFloorsStorageMock(mc)
+			if tt.getFloorsStorage != nil {
+				floorsStorage = tt.getFloorsStorage(mc)
+			}
+			s := NewService(floorsStorage, nil, access.Fixed(access.Unrestricted()))
+
+			err := s.CreateFloor(context.Background(), tt.floor)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, scopeOf(grantOf(access.RoleViewer, 1)))
+
+		err := s.CreateFloor(context.Background(), &storage.Floor{BuildingID: 1, Number: 0})
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+}
+
+func Test_DeleteFloor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsOnFloorMock.
+			Expect(minimock.AnyContext, 1, 2).
+			Return(models.ApartmentSlice{}, nil)
+		floorsStorage := storage_mocks.NewFloorsStorageMock(mc).
+			DeleteFloorMock.
+			Expect(minimock.AnyContext, 1, 2).
+			Return(1, nil)
+		s := NewService(floorsStorage, apartmentsStorage, scopeOf(grantOf(access.RoleManager, 1)))
+
+		assert.NoError(t, s.DeleteFloor(context.Background(), 1, 2))
+	})
+
+	t.Run("withApartments", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsOnFloorMock.
+			Return(models.ApartmentSlice{{ID: 3, BuildingID: 1, Floor: null.IntFrom(2)}}, nil)
+		s := NewService(storage_mocks.NewFloorsStorageMock(mc), apartmentsStorage, access.Fixed(access.Unrestricted()))
+
+		err := s.DeleteFloor(context.Background(), 1, 2)
+		assert.ErrorIs(t, err, service.ErrConflict)
+		assert.EqualError(t, err, "conflict: 1 apartments are on floor [2] of building [1]")
+	})
+
+	t.Run("apartmentWrittenSince", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsOnFloorMock.
+			Return(models.ApartmentSlice{}, nil)
+		floorsStorage := storage_mocks.NewFloorsStorageMock(mc).
+			DeleteFloorMock.
+			Return(0, fmt.Errorf("%w: floor [2] of building [1] has apartments", storage.ErrReferenced))
+		s := NewService(floorsStorage, apartmentsStorage, access.Fixed(access.Unrestricted()))
+
+		err := s.DeleteFloor(context.Background(), 1, 2)
+		assert.ErrorIs(t, err, service.ErrConflict)
+		assert.EqualError(t, err, "conflict: apartments are on floor [2] of building [1]")
+	})
+
+	t.Run("notFound", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsOnFloorMock.
+			Return(models.ApartmentSlice{}, nil)
+		floorsStorage := storage_mocks.NewFloorsStorageMock(mc).
+			DeleteFloorMock.
+			Return(0, nil)
+		s := NewService(floorsStorage, apartmentsStorage, access.Fixed(access.Unrestricted()))
+
+		err := s.DeleteFloor(context.Background(), 1, 2)
+		assert.ErrorIs(t, err, service.ErrNotFound)
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, scopeOf(grantOf(access.RoleViewer, 1)))
+
+		err := s.DeleteFloor(context.Background(), 1, 2)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+}
@@ -0,0 +1,454 @@
+// Package leases manages the leases of the apartments: their rent terms, their renewals and their
+// termination. The leases of an apartment never overlap. The lessee is personal data, it isn't
+// logged nor traced, and isn't repeated in the error messages.
+package leases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+)
+
+const (
+	// MaxLesseeLength bounds the names of the lessees, in characters.
+	MaxLesseeLength = 200
+	// MaxIndexationRate bounds the indexation rates, in percent.
+	MaxIndexationRate = 100
+
+	// DefaultExpiringDays is the horizon of the expiring leases when it isn't given.
+	DefaultExpiringDays = 30
+	// MaxExpiringDays bounds the horizon of the expiring leases, about ten years.
+	MaxExpiringDays = 3650
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Renewal is the term of the lease renewing an active one, from the day after its end.
+type Renewal struct {
+	// EndDate is the last day of the renewal, nil for an open-ended renewal.
+	EndDate *storage.Date `json:"end_date,omitempty"`
+	// MonthlyRent is the rent of the renewal, the one of the renewed lease when it is nil.
+	MonthlyRent *int64 `json:"monthly_rent,omitempty"`
+}
+
+// Termination ends an active lease before its term.
+type Termination struct {
+	// EndDate is the last day of the terminated lease.
+	EndDate storage.Date `json:"end_date"`
+}
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/leases.LeasesService -o ../mocks/
+type LeasesService interface {
+	GetApartmentLeases(ctx context.Context, apartmentId int) ([]*storage.Lease, error)
+	GetExpiringLeases(ctx context.Context, days int) ([]*storage.Lease, error)
+	GetLease(ctx context.Context, id int) (*storage.Lease, error)
+	CreateLease(ctx context.Context, lease *storage.Lease) error
+	RenewLease(ctx context.Context, id int, renewal *Renewal) (*storage.Lease, error)
+	TerminateLease(ctx context.Context, id int, termination *Termination) (*storage.Lease, error)
+	DeleteLease(ctx context.Context, id int) error
+}
+
+type Service struct {
+	leasesStorage     storage.LeasesStorage
+	apartmentsStorage storage.ApartmentsStorage
+	scopes            access.Resolver
+}
+
+func NewService(leasesStorage storage.LeasesStorage, apartmentsStorage storage.ApartmentsStorage, scopes access.Resolver) *Service {
+	return &Service{
+		leasesStorage:     leasesStorage,
+		apartmentsStorage: apartmentsStorage,
+		scopes:            scopes,
+	}
+}
+
+// GetApartmentLeases returns the leases of the apartment by start date.
+func (s *Service) GetApartmentLeases(ctx context.Context, apartmentId int) (_ []*storage.Lease, err error) {
+	ctx, span := tracing.Start(ctx, "leases.GetApartmentLeases", attribute.Int("apartment.id", apartmentId))
+	defer tracing.End(span, &err)
+
+	_, err = s.authorizeApartment(ctx, access.ActionRead, apartmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.leasesStorage.GetLeasesOfApartment(ctx, apartmentId)
+}
+
+// GetExpiringLeases returns the active leases of the buildings the principal may read ending from
+// today to days later and not renewed yet, by end date.
+func (s *Service) GetExpiringLeases(ctx context.Context, days int) (_ []*storage.Lease, err error) {
+	ctx, span := tracing.Start(ctx, "leases.GetExpiringLeases", attribute.Int("leases.days", days))
+	defer tracing.End(span, &err)
+
+	if days < 0 || days > MaxExpiringDays {
+		return nil, fmt.Errorf("%w: days must be between 0 and %d", service.ErrInvalid, MaxExpiringDays)
+	}
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buildingIds, all := scope.Buildings(access.ActionRead)
+	if all {
+		buildingIds = nil
+	} else if len(buildingIds) == 0 {
+		return []*storage.Lease{}, nil
+	}
+
+	return s.leasesStorage.GetExpiringLeases(ctx, buildingIds, days)
+}
+
+func (s *Service) GetLease(ctx context.Context, id int) (_ *storage.Lease, err error) {
+	ctx, span := tracing.Start(ctx, "leases.GetLease", attribute.Int("lease.id", id))
+	defer tracing.End(span, &err)
+
+	lease, err := s.getLease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorize(ctx, access.ActionRead, lease.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// CreateLease creates the lease of an apartment, a draft unless it is active, or, if its ID isn't
+// 0, replaces the draft with this ID. The signed leases are only renewed or terminated.
+func (s *Service) CreateLease(ctx context.Context, lease *storage.Lease) (err error) {
+	ctx, span := tracing.Start(ctx, "leases.CreateLease")
+	defer tracing.End(span, &err)
+
+	if lease == nil {
+		return fmt.Errorf("%w: lease is required", service.ErrInvalid)
+	}
+	err = normalize(lease)
+	if err != nil {
+		return err
+	}
+	switch lease.Status {
+	case "":
+		lease.Status = storage.LeaseDraft
+	case storage.LeaseDraft, storage.LeaseActive:
+	case storage.LeaseTerminated:
+		return fmt.Errorf("%w: a lease is terminated with its own request", service.ErrInvalid)
+	default:
+		return fmt.Errorf("%w: status [%v] isn't draft or active", service.ErrInvalid, lease.Status)
+	}
+
+	apartment, err := s.authorizeApartment(ctx, access.ActionWrite, lease.ApartmentID)
+	if err != nil {
+		return err
+	}
+	lease.BuildingID = apartment.BuildingID
+
+	// Only the renewals refer to another lease, and they keep referring to it.
+	lease.RenewalOf = nil
+	if lease.ID > 0 {
+		existing, err := s.leasesStorage.GetLease(ctx, lease.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil {
+			if existing.BuildingID != lease.BuildingID {
+				err = s.authorize(ctx, access.ActionWrite, existing.BuildingID)
+				if err != nil {
+					return err
+				}
+			}
+			if existing.Status != storage.LeaseDraft {
+				return fmt.Errorf("%w: lease [%v] is %v, it can only be renewed or terminated", service.ErrInvalid, existing.ID, existing.Status)
+			}
+			lease.RenewalOf = existing.RenewalOf
+		}
+	}
+
+	return s.save(ctx, lease)
+}
+
+// RenewLease creates the active lease continuing the active lease with the ID from the day after
+// its end, with the same terms but the ones of the renewal.
+func (s *Service) RenewLease(ctx context.Context, id int, renewal *Renewal) (_ *storage.Lease, err error) {
+	ctx, span := tracing.Start(ctx, "leases.RenewLease", attribute.Int("lease.id", id))
+	defer tracing.End(span, &err)
+
+	if renewal == nil {
+		return nil, fmt.Errorf("%w: renewal is required", service.ErrInvalid)
+	}
+
+	lease, err := s.getLease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.authorize(ctx, access.ActionWrite, lease.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	if lease.Status != storage.LeaseActive {
+		return nil, fmt.Errorf("%w: lease [%v] is %v, only an active lease is renewed", service.ErrInvalid, id, lease.Status)
+	}
+	if lease.EndDate == nil {
+		return nil, fmt.Errorf("%w: lease [%v] is open-ended", service.ErrInvalid, id)
+	}
+
+	next := *lease
+	next.ID = 0
+	next.StartDate = lease.EndDate.AddDays(1)
+	next.EndDate = renewal.EndDate
+	if renewal.MonthlyRent != nil {
+		next.MonthlyRent = *renewal.MonthlyRent
+	}
+	next.RenewalOf = &lease.ID
+	err = normalize(&next)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.save(ctx, &next)
+	if err != nil {
+		return nil, err
+	}
+
+	return &next, nil
+}
+
+// TerminateLease ends the active lease with the ID on the last day of the termination, no later
+// than its end. A lease with an active renewal is only terminated once the renewal is.
+func (s *Service) TerminateLease(ctx context.Context, id int, termination *Termination) (_ *storage.Lease, err error) {
+	ctx, span := tracing.Start(ctx, "leases.TerminateLease", attribute.Int("lease.id", id))
+	defer tracing.End(span, &err)
+
+	if termination == nil || termination.EndDate.IsZero() {
+		return nil, fmt.Errorf("%w: end_date is required", service.ErrInvalid)
+	}
+
+	lease, err := s.getLease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.authorize(ctx, access.ActionWrite, lease.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	if lease.Status != storage.LeaseActive {
+		return nil, fmt.Errorf("%w: lease [%v] is %v, only an active lease is terminated", service.ErrInvalid, id, lease.Status)
+	}
+
+	endDate := termination.EndDate
+	if endDate.Before(lease.StartDate) {
+		return nil, fmt.Errorf("%w: end_date [%v] before start_date [%v]", service.ErrInvalid, endDate, lease.StartDate)
+	}
+	if lease.EndDate != nil && lease.EndDate.Before(endDate) {
+		return nil, fmt.Errorf("%w: end_date [%v] after the end of lease [%v], [%v]", service.ErrInvalid, endDate, id, lease.EndDate)
+	}
+
+	leases, err := s.leasesStorage.GetLeasesOfApartment(ctx, lease.ApartmentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range leases {
+		if other.RenewalOf != nil && *other.RenewalOf == id && other.Status == storage.LeaseActive {
+			return nil, fmt.Errorf("%w: lease [%v] is renewed by the active lease [%v], terminate it first", service.ErrInvalid, id, other.ID)
+		}
+	}
+
+	lease.EndDate = &endDate
+	lease.Status = storage.LeaseTerminated
+
+	err = s.leasesStorage.CreateLease(ctx, lease)
+	if errors.Is(err, storage.ErrOverlap) {
+		return nil, overlapError(lease)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// DeleteLease deletes the draft with the ID, the signed leases are kept.
+func (s *Service) DeleteLease(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "leases.DeleteLease", attribute.Int("lease.id", id))
+	defer tracing.End(span, &err)
+
+	lease, err := s.getLease(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorize(ctx, access.ActionWrite, lease.BuildingID)
+	if err != nil {
+		return err
+	}
+	if lease.Status != storage.LeaseDraft {
+		return fmt.Errorf("%w: lease [%v] is %v, only a draft is deleted", service.ErrInvalid, id, lease.Status)
+	}
+
+	n, err := s.leasesStorage.DeleteLease(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: no lease with id [%v]", service.ErrNotFound, id)
+	}
+
+	return nil
+}
+
+// normalize collapses the whitespace of the lessee, defaults the indexation to none and validates
+// the terms of the lease.
+func normalize(l *storage.Lease) error {
+	if l.ApartmentID <= 0 {
+		return fmt.Errorf("%w: apartment id less or equal 0", service.ErrInvalid)
+	}
+
+	l.Lessee = strings.Join(strings.Fields(l.Lessee), " ")
+	if l.Lessee == "" {
+		return fmt.Errorf("%w: lessee is required", service.ErrInvalid)
+	}
+	if utf8.RuneCountInString(l.Lessee) > MaxLesseeLength {
+		return fmt.Errorf("%w: lessee longer than %d characters", service.ErrInvalid, MaxLesseeLength)
+	}
+
+	if l.StartDate.IsZero() {
+		return fmt.Errorf("%w: start_date is required", service.ErrInvalid)
+	}
+	if l.EndDate != nil && l.EndDate.Before(l.StartDate) {
+		return fmt.Errorf("%w: end_date [%v] before start_date [%v]", service.ErrInvalid, l.EndDate, l.StartDate)
+	}
+
+	if l.MonthlyRent <= 0 {
+		return fmt.Errorf("%w: monthly_rent less or equal 0", service.ErrInvalid)
+	}
+	if l.Deposit < 0 {
+		return fmt.Errorf("%w: deposit less than 0", service.ErrInvalid)
+	}
+	l.Currency = strings.ToUpper(strings.TrimSpace(l.Currency))
+	if !currencyPattern.MatchString(l.Currency) {
+		return fmt.Errorf("%w: currency [%v] isn't an ISO 4217 code", service.ErrInvalid, l.Currency)
+	}
+
+	if l.Indexation == "" {
+		l.Indexation = storage.IndexationNone
+	}
+	switch l.Indexation {
+	case storage.IndexationNone:
+		if l.IndexationRate != nil {
+			return fmt.Errorf("%w: indexation_rate without indexation", service.ErrInvalid)
+		}
+	case storage.IndexationFixed:
+		if l.IndexationRate == nil {
+			return fmt.Errorf("%w: indexation_rate is required with a fixed indexation", service.ErrInvalid)
+		}
+	case storage.IndexationCPI:
+	default:
+		return fmt.Errorf("%w: indexation [%v] isn't none, fixed or cpi", service.ErrInvalid, l.Indexation)
+	}
+	if l.IndexationRate != nil && (*l.IndexationRate <= 0 || *l.IndexationRate > MaxIndexationRate) {
+		return fmt.Errorf("%w: indexation_rate must be more than 0 and at most %d", service.ErrInvalid, MaxIndexationRate)
+	}
+
+	return nil
+}
+
+// save stores the lease unless it overlaps another lease of its apartment.
+func (s *Service) save(ctx context.Context, lease *storage.Lease) error {
+	leases, err := s.leasesStorage.GetLeasesOfApartment(ctx, lease.ApartmentID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range leases {
+		if other.ID != lease.ID && other.Overlaps(lease) {
+			end := "open-ended"
+			if other.EndDate != nil {
+				end = "to [" + other.EndDate.String() + "]"
+			}
+			return fmt.Errorf("%w: lease [%v] of apartment [%v] runs from [%v] %v",
+				service.ErrInvalid, other.ID, lease.ApartmentID, other.StartDate, end)
+		}
+	}
+
+	err = s.leasesStorage.CreateLease(ctx, lease)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: no lease with id [%v]", service.ErrNotFound, lease.ID)
+	}
+	if errors.Is(err, storage.ErrOverlap) {
+		return overlapError(lease)
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// overlapError is the error of a lease that the storage rejects for overlapping another lease of
+// its apartment, written after the leases were checked.
+func overlapError(lease *storage.Lease) error {
+	return fmt.Errorf("%w: the lease overlaps another lease of apartment [%v]", service.ErrInvalid, lease.ApartmentID)
+}
+
+func (s *Service) getLease(ctx context.Context, id int) (*storage.Lease, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: id less or equal 0", service.ErrInvalid)
+	}
+
+	lease, err := s.leasesStorage.GetLease(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no lease with id [%v]", service.ErrNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// authorizeApartment returns the apartment if the principal may perform the action on its building.
+func (s *Service) authorizeApartment(ctx context.Context, a access.Action, apartmentId int) (*models.Apartment, error) {
+	if apartmentId <= 0 {
+		return nil, fmt.Errorf("%w: apartment id less or equal 0", service.ErrInvalid)
+	}
+
+	apartment, err := s.apartmentsStorage.GetApartment(ctx, apartmentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no apartment with id [%v]", service.ErrNotFound, apartmentId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorize(ctx, a, apartment.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return apartment, nil
+}
+
+func (s *Service) authorize(ctx context.Context, a access.Action, buildingID int) error {
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	return access.Authorize(scope, a, buildingID)
+}
@@ -0,0 +1,420 @@
+package leases
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+)
+
+func scopeOf(grants ...*storage.Grant) access.Resolver {
+	return access.Fixed(access.NewScope(grants...))
+}
+
+func grantOf(role access.Role, buildingID int) *storage.Grant {
+	return &storage.Grant{Role: string(role), BuildingID: &buildingID}
+}
+
+func datePtr(d storage.Date) *storage.Date {
+	return &d
+}
+
+var (
+	jan1  = storage.NewDate(2026, time.January, 1)
+	jun30 = storage.NewDate(2026, time.June, 30)
+	dec31 = storage.NewDate(2026, time.December, 31)
+)
+
+// apartments returns apartment 7 of building 1 and apartment 8 of building 2.
+func apartments(mc *minimock.Controller) storage.ApartmentsStorage {
+	return storage_mocks.NewApartmentsStorageMock(mc).
+		GetApartmentMock.
+		Optional().
+		Set(func(_ context.Context, id int) (*models.Apartment, error) {
+			switch id {
+			case 7:
+				return &models.Apartment{ID: 7, BuildingID: 1}, nil
+			case 8:
+				return &models.Apartment{ID: 8, BuildingID: 2}, nil
+			default:
+				return nil, sql.ErrNoRows
+			}
+		})
+}
+
+// active is the lease 3 of apartment 7 in 2026.
+func active() *storage.Lease {
+	return &storage.Lease{
+		ID: 3, ApartmentID: 7, BuildingID: 1, Lessee: "Ada Lovelace", StartDate: jan1, EndDate: datePtr(dec31),
+		MonthlyRent: 120000, Deposit: 240000, Currency: "EUR", Indexation: storage.IndexationNone, Status: storage.LeaseActive,
+	}
+}
+
+func Test_CreateLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("draft", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeasesOfApartmentMock.Expect(minimock.AnyContext, 7).Return([]*storage.Lease{active()}, nil).
+			CreateLeaseMock.Set(func(_ context.Context, lease *storage.Lease) error {
+			lease.ID = 4
+			return nil
+		})
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		renewalOf := 1
+		lease := &storage.Lease{
+			ApartmentID: 7, Lessee: "  Charles   Babbage ", StartDate: dec31.AddDays(1), MonthlyRent: 125000,
+			Currency: "eur", RenewalOf: &renewalOf,
+		}
+		require.NoError(t, s.CreateLease(context.Background(), lease))
+		assert.Equal(t, &storage.Lease{
+			ID: 4, ApartmentID: 7, BuildingID: 1, Lessee: "Charles Babbage", StartDate: storage.NewDate(2027, time.January, 1),
+			MonthlyRent: 125000, Currency: "EUR", Indexation: storage.IndexationNone, Status: storage.LeaseDraft,
+		}, lease)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeasesOfApartmentMock.Return([]*storage.Lease{active()}, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		err := s.CreateLease(context.Background(), &storage.Lease{
+			ApartmentID: 7, Lessee: "Charles Babbage", StartDate: dec31, MonthlyRent: 125000, Currency: "EUR",
+		})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.EqualError(t, err, "invalid: lease [3] of apartment [7] runs from [2026-01-01] to [2026-12-31]")
+		assert.NotContains(t, err.Error(), "Charles")
+	})
+
+	t.Run("concurrentOverlap", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeasesOfApartmentMock.Return(nil, nil).
+			CreateLeaseMock.Return(fmt.Errorf("%w: lease_no_overlap", storage.ErrOverlap))
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		err := s.CreateLease(context.Background(), &storage.Lease{
+			ApartmentID: 7, Lessee: "Charles Babbage", StartDate: dec31, MonthlyRent: 125000, Currency: "EUR",
+		})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.EqualError(t, err, "invalid: the lease overlaps another lease of apartment [7]")
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeaseMock.Expect(minimock.AnyContext, 3).Return(active(), nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		lease := active()
+		lease.MonthlyRent = 100000
+		err := s.CreateLease(context.Background(), lease)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "lease [3] is active, it can only be renewed or terminated")
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		s := NewService(storage_mocks.NewLeasesStorageMock(mc), apartments(mc), scopeOf(grantOf(access.RoleViewer, 1)))
+
+		err := s.CreateLease(context.Background(), &storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Currency: "EUR"})
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	rate := 2.5
+	tests := []struct {
+		name    string
+		lease   storage.Lease
+		wantErr string
+	}{
+		{"noLessee", storage.Lease{ApartmentID: 7, StartDate: jan1, MonthlyRent: 1, Currency: "EUR"}, "lessee is required"},
+		{"noStart", storage.Lease{ApartmentID: 7, Lessee: "Ada", MonthlyRent: 1, Currency: "EUR"}, "start_date is required"},
+		{
+			"endBeforeStart",
+			storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jun30, EndDate: datePtr(jan1), MonthlyRent: 1, Currency: "EUR"},
+			"end_date [2026-01-01] before start_date [2026-06-30]",
+		},
+		{"noRent", storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, Currency: "EUR"}, "monthly_rent less or equal 0"},
+		{"deposit", storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Deposit: -1, Currency: "EUR"}, "deposit less than 0"},
+		{"currency", storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Currency: "euro"}, "currency [EURO] isn't an ISO 4217 code"},
+		{
+			"rateWithoutIndexation",
+			storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Currency: "EUR", IndexationRate: &rate},
+			"indexation_rate without indexation",
+		},
+		{
+			"fixedWithoutRate",
+			storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Currency: "EUR", Indexation: storage.IndexationFixed},
+			"indexation_rate is required with a fixed indexation",
+		},
+		{
+			"indexation",
+			storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Currency: "EUR", Indexation: "rpi"},
+			"indexation [rpi] isn't none, fixed or cpi",
+		},
+		{
+			"terminated",
+			storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: jan1, MonthlyRent: 1, Currency: "EUR", Status: storage.LeaseTerminated},
+			"a lease is terminated with its own request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			s := NewService(storage_mocks.NewLeasesStorageMock(mc), apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+			err := s.CreateLease(context.Background(), &tt.lease)
+			assert.ErrorIs(t, err, service.ErrInvalid)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_RenewLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renewed", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeaseMock.Expect(minimock.AnyContext, 3).Return(active(), nil).
+			GetLeasesOfApartmentMock.Return([]*storage.Lease{active()}, nil).
+			CreateLeaseMock.Set(func(_ context.Context, lease *storage.Lease) error {
+			lease.ID = 4
+			return nil
+		})
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		rent := int64(125000)
+		got, err := s.RenewLease(context.Background(), 3, &Renewal{MonthlyRent: &rent})
+		require.NoError(t, err)
+
+		want, renewalOf := active(), 3
+		want.ID, want.StartDate, want.EndDate, want.MonthlyRent, want.RenewalOf = 4, storage.NewDate(2027, time.January, 1), nil, rent, &renewalOf
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("alreadyRenewed", func(t *testing.T) {
+		t.Parallel()
+
+		renewalOf := 3
+		renewal := &storage.Lease{ID: 4, ApartmentID: 7, StartDate: storage.NewDate(2027, time.January, 1), Status: storage.LeaseActive, RenewalOf: &renewalOf}
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeaseMock.Return(active(), nil).
+			GetLeasesOfApartmentMock.Return([]*storage.Lease{active(), renewal}, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.RenewLease(context.Background(), 3, &Renewal{EndDate: datePtr(storage.NewDate(2027, time.December, 31))})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "lease [4] of apartment [7] runs from [2027-01-01] open-ended")
+	})
+
+	t.Run("openEnded", func(t *testing.T) {
+		t.Parallel()
+
+		lease := active()
+		lease.EndDate = nil
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).GetLeaseMock.Return(lease, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.RenewLease(context.Background(), 3, &Renewal{})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "lease [3] is open-ended")
+	})
+
+	t.Run("draft", func(t *testing.T) {
+		t.Parallel()
+
+		lease := active()
+		lease.Status = storage.LeaseDraft
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).GetLeaseMock.Return(lease, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.RenewLease(context.Background(), 3, &Renewal{})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "lease [3] is draft, only an active lease is renewed")
+	})
+
+	t.Run("notFound", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).GetLeaseMock.Return(nil, sql.ErrNoRows)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.RenewLease(context.Background(), 3, &Renewal{})
+		assert.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
+
+func Test_TerminateLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("terminated", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		// A terminated renewal doesn't prevent the termination.
+		renewalOf := 3
+		renewal := &storage.Lease{ID: 4, ApartmentID: 7, StartDate: dec31.AddDays(1), Status: storage.LeaseTerminated, RenewalOf: &renewalOf}
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeaseMock.Return(active(), nil).
+			GetLeasesOfApartmentMock.Expect(minimock.AnyContext, 7).Return([]*storage.Lease{active(), renewal}, nil).
+			CreateLeaseMock.Return(nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		got, err := s.TerminateLease(context.Background(), 3, &Termination{EndDate: jun30})
+		require.NoError(t, err)
+		assert.Equal(t, storage.LeaseTerminated, got.Status)
+		assert.Equal(t, &jun30, got.EndDate)
+	})
+
+	t.Run("renewed", func(t *testing.T) {
+		t.Parallel()
+
+		renewalOf := 3
+		renewal := &storage.Lease{ID: 5, ApartmentID: 7, StartDate: dec31.AddDays(1), Status: storage.LeaseActive, RenewalOf: &renewalOf}
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeaseMock.Return(active(), nil).
+			GetLeasesOfApartmentMock.Return([]*storage.Lease{active(), renewal}, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.TerminateLease(context.Background(), 3, &Termination{EndDate: jun30})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "lease [3] is renewed by the active lease [5], terminate it first")
+	})
+
+	t.Run("afterEnd", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).GetLeaseMock.Return(active(), nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.TerminateLease(context.Background(), 3, &Termination{EndDate: dec31.AddDays(1)})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "end_date [2027-01-01] after the end of lease [3], [2026-12-31]")
+	})
+
+	t.Run("noEndDate", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		s := NewService(storage_mocks.NewLeasesStorageMock(mc), apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		_, err := s.TerminateLease(context.Background(), 3, &Termination{})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+	})
+}
+
+func Test_DeleteLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("draft", func(t *testing.T) {
+		t.Parallel()
+
+		lease := active()
+		lease.Status = storage.LeaseDraft
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetLeaseMock.Return(lease, nil).
+			DeleteLeaseMock.Expect(minimock.AnyContext, 3).Return(1, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		assert.NoError(t, s.DeleteLease(context.Background(), 3))
+	})
+
+	t.Run("active", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).GetLeaseMock.Return(active(), nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleManager, 1)))
+
+		err := s.DeleteLease(context.Background(), 3)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.ErrorContains(t, err, "lease [3] is active, only a draft is deleted")
+	})
+}
+
+func Test_GetExpiringLeases(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scoped", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		want := []*storage.Lease{active()}
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetExpiringLeasesMock.Expect(minimock.AnyContext, []int{1}, 30).Return(want, nil)
+		s := NewService(leasesStorage, apartments(mc), scopeOf(grantOf(access.RoleViewer, 1)))
+
+		got, err := s.GetExpiringLeases(context.Background(), 30)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("unrestricted", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		leasesStorage := storage_mocks.NewLeasesStorageMock(mc).
+			GetExpiringLeasesMock.Expect(minimock.AnyContext, nil, 0).Return([]*storage.Lease{}, nil)
+		s := NewService(leasesStorage, apartments(mc), access.Fixed(access.Unrestricted()))
+
+		_, err := s.GetExpiringLeases(context.Background(), 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("noBuildings", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		s := NewService(storage_mocks.NewLeasesStorageMock(mc), apartments(mc), scopeOf())
+
+		got, err := s.GetExpiringLeases(context.Background(), 30)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("outOfRange", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		s := NewService(storage_mocks.NewLeasesStorageMock(mc), apartments(mc), scopeOf())
+
+		_, err := s.GetExpiringLeases(context.Background(), MaxExpiringDays+1)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
 )
 
 // ApartmentsServiceMock implements apartments.ApartmentsService
@@ -48,6 +49,42 @@ type ApartmentsServiceMock struct {
 	afterGetApartmentsInBuildingCounter  uint64
 	beforeGetApartmentsInBuildingCounter uint64
 	GetApartmentsInBuildingMock          mApartmentsServiceMockGetApartmentsInBuilding
+
+	funcGetApartmentsInBuildings          func(ctx context.Context, buildingIds []int) (a1 models.ApartmentSlice, err error)
+	inspectFuncGetApartmentsInBuildings   func(ctx context.Context, buildingIds []int)
+	afterGetApartmentsInBuildingsCounter  uint64
+	beforeGetApartmentsInBuildingsCounter uint64
+	GetApartmentsInBuildingsMock          mApartmentsServiceMockGetApartmentsInBuildings
+
+	funcGetApartmentsOnFloor          func(ctx context.Context, buildingId int, floor int) (a1 models.ApartmentSlice, err error)
+	inspectFuncGetApartmentsOnFloor   func(ctx context.Context, buildingId int, floor int)
+	afterGetApartmentsOnFloorCounter  uint64
+	beforeGetApartmentsOnFloorCounter uint64
+	GetApartmentsOnFloorMock          mApartmentsServiceMockGetApartmentsOnFloor
+
+	funcGetBuildingStats          func(ctx context.Context, buildingId int) (sp1 *storage.Stats, err error)
+	inspectFuncGetBuildingStats   func(ctx context.Context, buildingId int)
+	afterGetBuildingStatsCounter  uint64
+	beforeGetBuildingStatsCounter uint64
+	GetBuildingStatsMock          mApartmentsServiceMockGetBuildingStats
+
+	funcGetStats          func(ctx context.Context) (sp1 *storage.Stats, err error)
+	inspectFuncGetStats   func(ctx context.Context)
+	afterGetStatsCounter  uint64
+	beforeGetStatsCounter uint64
+	GetStatsMock          mApartmentsServiceMockGetStats
+
+	funcStreamApartments          func(ctx context.Context, send func(a *models.Apartment) error) (err error)
+	inspectFuncStreamApartments   func(ctx context.Context, send func(a *models.Apartment) error)
+	afterStreamApartmentsCounter  uint64
+	beforeStreamApartmentsCounter uint64
+	StreamApartmentsMock          mApartmentsServiceMockStreamApartments
+
+	funcStreamApartmentsInBuilding          func(ctx context.Context, buildingId int, send func(a *models.Apartment) error) (err error)
+	inspectFuncStreamApartmentsInBuilding   func(ctx context.Context, buildingId int, send func(a *models.Apartment) error)
+	afterStreamApartmentsInBuildingCounter  uint64
+	beforeStreamApartmentsInBuildingCounter uint64
+	StreamApartmentsInBuildingMock          mApartmentsServiceMockStreamApartmentsInBuilding
 }
 
 // NewApartmentsServiceMock returns a mock for apartments.ApartmentsService
@@ -73,6 +110,24 @@ func NewApartmentsServiceMock(t minimock.Tester) *ApartmentsServiceMock {
 	m.GetApartmentsInBuildingMock = mApartmentsServiceMockGetApartmentsInBuilding{mock: m}
 	m.GetApartmentsInBuildingMock.callArgs = []*ApartmentsServiceMockGetApartmentsInBuildingParams{}
 
+	m.GetApartmentsInBuildingsMock = mApartmentsServiceMockGetApartmentsInBuildings{mock: m}
+	m.GetApartmentsInBuildingsMock.callArgs = []*ApartmentsServiceMockGetApartmentsInBuildingsParams{}
+
+	m.GetApartmentsOnFloorMock = mApartmentsServiceMockGetApartmentsOnFloor{mock: m}
+	m.GetApartmentsOnFloorMock.callArgs = []*ApartmentsServiceMockGetApartmentsOnFloorParams{}
+
+	m.GetBuildingStatsMock = mApartmentsServiceMockGetBuildingStats{mock: m}
+	m.GetBuildingStatsMock.callArgs = []*ApartmentsServiceMockGetBuildingStatsParams{}
+
+	m.GetStatsMock = mApartmentsServiceMockGetStats{mock: m}
+	m.GetStatsMock.callArgs = []*ApartmentsServiceMockGetStatsParams{}
+
+	m.StreamApartmentsMock = mApartmentsServiceMockStreamApartments{mock: m}
+	m.StreamApartmentsMock.callArgs = []*ApartmentsServiceMockStreamApartmentsParams{}
+
+	m.StreamApartmentsInBuildingMock = mApartmentsServiceMockStreamApartmentsInBuilding{mock: m}
+	m.StreamApartmentsInBuildingMock.callArgs = []*ApartmentsServiceMockStreamApartmentsInBuildingParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -1653,19 +1708,1983 @@ func (m *ApartmentsServiceMock) MinimockGetApartmentsInBuildingInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ApartmentsServiceMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateApartmentInspect()
+type mApartmentsServiceMockGetApartmentsInBuildings struct {
+	optional           bool
+	mock               *ApartmentsServiceMock
+	defaultExpectation *ApartmentsServiceMockGetApartmentsInBuildingsExpectation
+	expectations       []*ApartmentsServiceMockGetApartmentsInBuildingsExpectation
 
-			m.MinimockDeleteApartmentInspect()
+	callArgs []*ApartmentsServiceMockGetApartmentsInBuildingsParams
+	mutex    sync.RWMutex
 
-			m.MinimockGetApartmentInspect()
+	expectedInvocations uint64
+}
 
-			m.MinimockGetApartmentsInspect()
+// ApartmentsServiceMockGetApartmentsInBuildingsExpectation specifies expectation struct of the ApartmentsService.GetApartmentsInBuildings
+type ApartmentsServiceMockGetApartmentsInBuildingsExpectation struct {
+	mock      *ApartmentsServiceMock
+	params    *ApartmentsServiceMockGetApartmentsInBuildingsParams
+	paramPtrs *ApartmentsServiceMockGetApartmentsInBuildingsParamPtrs
+	results   *ApartmentsServiceMockGetApartmentsInBuildingsResults
+	Counter   uint64
+}
 
-			m.MinimockGetApartmentsInBuildingInspect()
+// ApartmentsServiceMockGetApartmentsInBuildingsParams contains parameters of the ApartmentsService.GetApartmentsInBuildings
+type ApartmentsServiceMockGetApartmentsInBuildingsParams struct {
+	ctx         context.Context
+	buildingIds []int
+}
+
+// ApartmentsServiceMockGetApartmentsInBuildingsParamPtrs contains pointers to parameters of the ApartmentsService.GetApartmentsInBuildings
+type ApartmentsServiceMockGetApartmentsInBuildingsParamPtrs struct {
+	ctx         *context.Context
+	buildingIds *[]int
+}
+
+// ApartmentsServiceMockGetApartmentsInBuildingsResults contains results of the ApartmentsService.GetApartmentsInBuildings
+type ApartmentsServiceMockGetApartmentsInBuildingsResults struct {
+	a1  models.ApartmentSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Optional() *mApartmentsServiceMockGetApartmentsInBuildings {
+	mmGetApartmentsInBuildings.optional = true
+	return mmGetApartmentsInBuildings
+}
+
+// Expect sets up expected params for ApartmentsService.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Expect(ctx context.Context, buildingIds []int) *mApartmentsServiceMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsServiceMockGetApartmentsInBuildingsExpectation{}
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.paramPtrs != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by ExpectParams functions")
+	}
+
+	mmGetApartmentsInBuildings.defaultExpectation.params = &ApartmentsServiceMockGetApartmentsInBuildingsParams{ctx, buildingIds}
+	for _, e := range mmGetApartmentsInBuildings.expectations {
+		if minimock.Equal(e.params, mmGetApartmentsInBuildings.defaultExpectation.params) {
+			mmGetApartmentsInBuildings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetApartmentsInBuildings.defaultExpectation.params)
+		}
+	}
+
+	return mmGetApartmentsInBuildings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsService.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) ExpectCtxParam1(ctx context.Context) *mApartmentsServiceMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsServiceMockGetApartmentsInBuildingsExpectation{}
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.params != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Expect")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsInBuildings.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetApartmentsInBuildingsParamPtrs{}
+	}
+	mmGetApartmentsInBuildings.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetApartmentsInBuildings
+}
+
+// ExpectBuildingIdsParam2 sets up expected param buildingIds for ApartmentsService.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) ExpectBuildingIdsParam2(buildingIds []int) *mApartmentsServiceMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsServiceMockGetApartmentsInBuildingsExpectation{}
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.params != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Expect")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsInBuildings.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetApartmentsInBuildingsParamPtrs{}
+	}
+	mmGetApartmentsInBuildings.defaultExpectation.paramPtrs.buildingIds = &buildingIds
+
+	return mmGetApartmentsInBuildings
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsService.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Inspect(f func(ctx context.Context, buildingIds []int)) *mApartmentsServiceMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.inspectFuncGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Inspect function is already set for ApartmentsServiceMock.GetApartmentsInBuildings")
+	}
+
+	mmGetApartmentsInBuildings.mock.inspectFuncGetApartmentsInBuildings = f
+
+	return mmGetApartmentsInBuildings
+}
+
+// Return sets up results that will be returned by ApartmentsService.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Return(a1 models.ApartmentSlice, err error) *ApartmentsServiceMock {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsServiceMockGetApartmentsInBuildingsExpectation{mock: mmGetApartmentsInBuildings.mock}
+	}
+	mmGetApartmentsInBuildings.defaultExpectation.results = &ApartmentsServiceMockGetApartmentsInBuildingsResults{a1, err}
+	return mmGetApartmentsInBuildings.mock
+}
+
+// Set uses given function f to mock the ApartmentsService.GetApartmentsInBuildings method
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Set(f func(ctx context.Context, buildingIds []int) (a1 models.ApartmentSlice, err error)) *ApartmentsServiceMock {
+	if mmGetApartmentsInBuildings.defaultExpectation != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Default expectation is already set for the ApartmentsService.GetApartmentsInBuildings method")
+	}
+
+	if len(mmGetApartmentsInBuildings.expectations) > 0 {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Some expectations are already set for the ApartmentsService.GetApartmentsInBuildings method")
+	}
+
+	mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings = f
+	return mmGetApartmentsInBuildings.mock
+}
+
+// When sets expectation for the ApartmentsService.GetApartmentsInBuildings which will trigger the result defined by the following
+// Then helper
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) When(ctx context.Context, buildingIds []int) *ApartmentsServiceMockGetApartmentsInBuildingsExpectation {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	expectation := &ApartmentsServiceMockGetApartmentsInBuildingsExpectation{
+		mock:   mmGetApartmentsInBuildings.mock,
+		params: &ApartmentsServiceMockGetApartmentsInBuildingsParams{ctx, buildingIds},
+	}
+	mmGetApartmentsInBuildings.expectations = append(mmGetApartmentsInBuildings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsService.GetApartmentsInBuildings return parameters for the expectation previously defined by the When method
+func (e *ApartmentsServiceMockGetApartmentsInBuildingsExpectation) Then(a1 models.ApartmentSlice, err error) *ApartmentsServiceMock {
+	e.results = &ApartmentsServiceMockGetApartmentsInBuildingsResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsService.GetApartmentsInBuildings should be invoked
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Times(n uint64) *mApartmentsServiceMockGetApartmentsInBuildings {
+	if n == 0 {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Times of ApartmentsServiceMock.GetApartmentsInBuildings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetApartmentsInBuildings.expectedInvocations, n)
+	return mmGetApartmentsInBuildings
+}
+
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) invocationsDone() bool {
+	if len(mmGetApartmentsInBuildings.expectations) == 0 && mmGetApartmentsInBuildings.defaultExpectation == nil && mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.mock.afterGetApartmentsInBuildingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetApartmentsInBuildings implements apartments.ApartmentsService
+func (mmGetApartmentsInBuildings *ApartmentsServiceMock) GetApartmentsInBuildings(ctx context.Context, buildingIds []int) (a1 models.ApartmentSlice, err error) {
+	mm_atomic.AddUint64(&mmGetApartmentsInBuildings.beforeGetApartmentsInBuildingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetApartmentsInBuildings.afterGetApartmentsInBuildingsCounter, 1)
+
+	if mmGetApartmentsInBuildings.inspectFuncGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.inspectFuncGetApartmentsInBuildings(ctx, buildingIds)
+	}
+
+	mm_params := ApartmentsServiceMockGetApartmentsInBuildingsParams{ctx, buildingIds}
+
+	// Record call args
+	mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.mutex.Lock()
+	mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.callArgs = append(mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.callArgs, &mm_params)
+	mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.mutex.Unlock()
+
+	for _, e := range mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsServiceMockGetApartmentsInBuildingsParams{ctx, buildingIds}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetApartmentsInBuildings.t.Errorf("ApartmentsServiceMock.GetApartmentsInBuildings got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingIds != nil && !minimock.Equal(*mm_want_ptrs.buildingIds, mm_got.buildingIds) {
+				mmGetApartmentsInBuildings.t.Errorf("ApartmentsServiceMock.GetApartmentsInBuildings got unexpected parameter buildingIds, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingIds, mm_got.buildingIds, minimock.Diff(*mm_want_ptrs.buildingIds, mm_got.buildingIds))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetApartmentsInBuildings.t.Errorf("ApartmentsServiceMock.GetApartmentsInBuildings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetApartmentsInBuildings.t.Fatal("No results are set for the ApartmentsServiceMock.GetApartmentsInBuildings")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetApartmentsInBuildings.funcGetApartmentsInBuildings != nil {
+		return mmGetApartmentsInBuildings.funcGetApartmentsInBuildings(ctx, buildingIds)
+	}
+	mmGetApartmentsInBuildings.t.Fatalf("Unexpected call to ApartmentsServiceMock.GetApartmentsInBuildings. %v %v", ctx, buildingIds)
+	return
+}
+
+// GetApartmentsInBuildingsAfterCounter returns a count of finished ApartmentsServiceMock.GetApartmentsInBuildings invocations
+func (mmGetApartmentsInBuildings *ApartmentsServiceMock) GetApartmentsInBuildingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.afterGetApartmentsInBuildingsCounter)
+}
+
+// GetApartmentsInBuildingsBeforeCounter returns a count of ApartmentsServiceMock.GetApartmentsInBuildings invocations
+func (mmGetApartmentsInBuildings *ApartmentsServiceMock) GetApartmentsInBuildingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.beforeGetApartmentsInBuildingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsServiceMock.GetApartmentsInBuildings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetApartmentsInBuildings *mApartmentsServiceMockGetApartmentsInBuildings) Calls() []*ApartmentsServiceMockGetApartmentsInBuildingsParams {
+	mmGetApartmentsInBuildings.mutex.RLock()
+
+	argCopy := make([]*ApartmentsServiceMockGetApartmentsInBuildingsParams, len(mmGetApartmentsInBuildings.callArgs))
+	copy(argCopy, mmGetApartmentsInBuildings.callArgs)
+
+	mmGetApartmentsInBuildings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetApartmentsInBuildingsDone returns true if the count of the GetApartmentsInBuildings invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsServiceMock) MinimockGetApartmentsInBuildingsDone() bool {
+	if m.GetApartmentsInBuildingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetApartmentsInBuildingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetApartmentsInBuildingsMock.invocationsDone()
+}
+
+// MinimockGetApartmentsInBuildingsInspect logs each unmet expectation
+func (m *ApartmentsServiceMock) MinimockGetApartmentsInBuildingsInspect() {
+	for _, e := range m.GetApartmentsInBuildingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetApartmentsInBuildings with params: %#v", *e.params)
+		}
+	}
+
+	afterGetApartmentsInBuildingsCounter := mm_atomic.LoadUint64(&m.afterGetApartmentsInBuildingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetApartmentsInBuildingsMock.defaultExpectation != nil && afterGetApartmentsInBuildingsCounter < 1 {
+		if m.GetApartmentsInBuildingsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsServiceMock.GetApartmentsInBuildings")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetApartmentsInBuildings with params: %#v", *m.GetApartmentsInBuildingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetApartmentsInBuildings != nil && afterGetApartmentsInBuildingsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsServiceMock.GetApartmentsInBuildings")
+	}
+
+	if !m.GetApartmentsInBuildingsMock.invocationsDone() && afterGetApartmentsInBuildingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsServiceMock.GetApartmentsInBuildings but found %d calls",
+			mm_atomic.LoadUint64(&m.GetApartmentsInBuildingsMock.expectedInvocations), afterGetApartmentsInBuildingsCounter)
+	}
+}
+
+type mApartmentsServiceMockGetApartmentsOnFloor struct {
+	optional           bool
+	mock               *ApartmentsServiceMock
+	defaultExpectation *ApartmentsServiceMockGetApartmentsOnFloorExpectation
+	expectations       []*ApartmentsServiceMockGetApartmentsOnFloorExpectation
+
+	callArgs []*ApartmentsServiceMockGetApartmentsOnFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsServiceMockGetApartmentsOnFloorExpectation specifies expectation struct of the ApartmentsService.GetApartmentsOnFloor
+type ApartmentsServiceMockGetApartmentsOnFloorExpectation struct {
+	mock      *ApartmentsServiceMock
+	params    *ApartmentsServiceMockGetApartmentsOnFloorParams
+	paramPtrs *ApartmentsServiceMockGetApartmentsOnFloorParamPtrs
+	results   *ApartmentsServiceMockGetApartmentsOnFloorResults
+	Counter   uint64
+}
+
+// ApartmentsServiceMockGetApartmentsOnFloorParams contains parameters of the ApartmentsService.GetApartmentsOnFloor
+type ApartmentsServiceMockGetApartmentsOnFloorParams struct {
+	ctx        context.Context
+	buildingId int
+	floor      int
+}
+
+// ApartmentsServiceMockGetApartmentsOnFloorParamPtrs contains pointers to parameters of the ApartmentsService.GetApartmentsOnFloor
+type ApartmentsServiceMockGetApartmentsOnFloorParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	floor      *int
+}
+
+// ApartmentsServiceMockGetApartmentsOnFloorResults contains results of the ApartmentsService.GetApartmentsOnFloor
+type ApartmentsServiceMockGetApartmentsOnFloorResults struct {
+	a1  models.ApartmentSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Optional() *mApartmentsServiceMockGetApartmentsOnFloor {
+	mmGetApartmentsOnFloor.optional = true
+	return mmGetApartmentsOnFloor
+}
+
+// Expect sets up expected params for ApartmentsService.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Expect(ctx context.Context, buildingId int, floor int) *mApartmentsServiceMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsServiceMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by ExpectParams functions")
+	}
+
+	mmGetApartmentsOnFloor.defaultExpectation.params = &ApartmentsServiceMockGetApartmentsOnFloorParams{ctx, buildingId, floor}
+	for _, e := range mmGetApartmentsOnFloor.expectations {
+		if minimock.Equal(e.params, mmGetApartmentsOnFloor.defaultExpectation.params) {
+			mmGetApartmentsOnFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetApartmentsOnFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetApartmentsOnFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsService.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) ExpectCtxParam1(ctx context.Context) *mApartmentsServiceMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsServiceMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.params != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Expect")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsOnFloor.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetApartmentsOnFloorParamPtrs{}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetApartmentsOnFloor
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for ApartmentsService.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) ExpectBuildingIdParam2(buildingId int) *mApartmentsServiceMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsServiceMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.params != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Expect")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsOnFloor.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetApartmentsOnFloorParamPtrs{}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetApartmentsOnFloor
+}
+
+// ExpectFloorParam3 sets up expected param floor for ApartmentsService.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) ExpectFloorParam3(floor int) *mApartmentsServiceMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsServiceMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.params != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Expect")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsOnFloor.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetApartmentsOnFloorParamPtrs{}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.paramPtrs.floor = &floor
+
+	return mmGetApartmentsOnFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsService.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Inspect(f func(ctx context.Context, buildingId int, floor int)) *mApartmentsServiceMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.inspectFuncGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Inspect function is already set for ApartmentsServiceMock.GetApartmentsOnFloor")
+	}
+
+	mmGetApartmentsOnFloor.mock.inspectFuncGetApartmentsOnFloor = f
+
+	return mmGetApartmentsOnFloor
+}
+
+// Return sets up results that will be returned by ApartmentsService.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Return(a1 models.ApartmentSlice, err error) *ApartmentsServiceMock {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsServiceMockGetApartmentsOnFloorExpectation{mock: mmGetApartmentsOnFloor.mock}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.results = &ApartmentsServiceMockGetApartmentsOnFloorResults{a1, err}
+	return mmGetApartmentsOnFloor.mock
+}
+
+// Set uses given function f to mock the ApartmentsService.GetApartmentsOnFloor method
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Set(f func(ctx context.Context, buildingId int, floor int) (a1 models.ApartmentSlice, err error)) *ApartmentsServiceMock {
+	if mmGetApartmentsOnFloor.defaultExpectation != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Default expectation is already set for the ApartmentsService.GetApartmentsOnFloor method")
+	}
+
+	if len(mmGetApartmentsOnFloor.expectations) > 0 {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Some expectations are already set for the ApartmentsService.GetApartmentsOnFloor method")
+	}
+
+	mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor = f
+	return mmGetApartmentsOnFloor.mock
+}
+
+// When sets expectation for the ApartmentsService.GetApartmentsOnFloor which will trigger the result defined by the following
+// Then helper
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) When(ctx context.Context, buildingId int, floor int) *ApartmentsServiceMockGetApartmentsOnFloorExpectation {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsServiceMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	expectation := &ApartmentsServiceMockGetApartmentsOnFloorExpectation{
+		mock:   mmGetApartmentsOnFloor.mock,
+		params: &ApartmentsServiceMockGetApartmentsOnFloorParams{ctx, buildingId, floor},
+	}
+	mmGetApartmentsOnFloor.expectations = append(mmGetApartmentsOnFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsService.GetApartmentsOnFloor return parameters for the expectation previously defined by the When method
+func (e *ApartmentsServiceMockGetApartmentsOnFloorExpectation) Then(a1 models.ApartmentSlice, err error) *ApartmentsServiceMock {
+	e.results = &ApartmentsServiceMockGetApartmentsOnFloorResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsService.GetApartmentsOnFloor should be invoked
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Times(n uint64) *mApartmentsServiceMockGetApartmentsOnFloor {
+	if n == 0 {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Times of ApartmentsServiceMock.GetApartmentsOnFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetApartmentsOnFloor.expectedInvocations, n)
+	return mmGetApartmentsOnFloor
+}
+
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) invocationsDone() bool {
+	if len(mmGetApartmentsOnFloor.expectations) == 0 && mmGetApartmentsOnFloor.defaultExpectation == nil && mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.mock.afterGetApartmentsOnFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetApartmentsOnFloor implements apartments.ApartmentsService
+func (mmGetApartmentsOnFloor *ApartmentsServiceMock) GetApartmentsOnFloor(ctx context.Context, buildingId int, floor int) (a1 models.ApartmentSlice, err error) {
+	mm_atomic.AddUint64(&mmGetApartmentsOnFloor.beforeGetApartmentsOnFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetApartmentsOnFloor.afterGetApartmentsOnFloorCounter, 1)
+
+	if mmGetApartmentsOnFloor.inspectFuncGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.inspectFuncGetApartmentsOnFloor(ctx, buildingId, floor)
+	}
+
+	mm_params := ApartmentsServiceMockGetApartmentsOnFloorParams{ctx, buildingId, floor}
+
+	// Record call args
+	mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.mutex.Lock()
+	mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.callArgs = append(mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.callArgs, &mm_params)
+	mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.mutex.Unlock()
+
+	for _, e := range mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsServiceMockGetApartmentsOnFloorParams{ctx, buildingId, floor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetApartmentsOnFloor.t.Errorf("ApartmentsServiceMock.GetApartmentsOnFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetApartmentsOnFloor.t.Errorf("ApartmentsServiceMock.GetApartmentsOnFloor got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.floor != nil && !minimock.Equal(*mm_want_ptrs.floor, mm_got.floor) {
+				mmGetApartmentsOnFloor.t.Errorf("ApartmentsServiceMock.GetApartmentsOnFloor got unexpected parameter floor, want: %#v, got: %#v%s\n", *mm_want_ptrs.floor, mm_got.floor, minimock.Diff(*mm_want_ptrs.floor, mm_got.floor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetApartmentsOnFloor.t.Errorf("ApartmentsServiceMock.GetApartmentsOnFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetApartmentsOnFloor.t.Fatal("No results are set for the ApartmentsServiceMock.GetApartmentsOnFloor")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetApartmentsOnFloor.funcGetApartmentsOnFloor != nil {
+		return mmGetApartmentsOnFloor.funcGetApartmentsOnFloor(ctx, buildingId, floor)
+	}
+	mmGetApartmentsOnFloor.t.Fatalf("Unexpected call to ApartmentsServiceMock.GetApartmentsOnFloor. %v %v %v", ctx, buildingId, floor)
+	return
+}
+
+// GetApartmentsOnFloorAfterCounter returns a count of finished ApartmentsServiceMock.GetApartmentsOnFloor invocations
+func (mmGetApartmentsOnFloor *ApartmentsServiceMock) GetApartmentsOnFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.afterGetApartmentsOnFloorCounter)
+}
+
+// GetApartmentsOnFloorBeforeCounter returns a count of ApartmentsServiceMock.GetApartmentsOnFloor invocations
+func (mmGetApartmentsOnFloor *ApartmentsServiceMock) GetApartmentsOnFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.beforeGetApartmentsOnFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsServiceMock.GetApartmentsOnFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetApartmentsOnFloor *mApartmentsServiceMockGetApartmentsOnFloor) Calls() []*ApartmentsServiceMockGetApartmentsOnFloorParams {
+	mmGetApartmentsOnFloor.mutex.RLock()
+
+	argCopy := make([]*ApartmentsServiceMockGetApartmentsOnFloorParams, len(mmGetApartmentsOnFloor.callArgs))
+	copy(argCopy, mmGetApartmentsOnFloor.callArgs)
+
+	mmGetApartmentsOnFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetApartmentsOnFloorDone returns true if the count of the GetApartmentsOnFloor invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsServiceMock) MinimockGetApartmentsOnFloorDone() bool {
+	if m.GetApartmentsOnFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetApartmentsOnFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetApartmentsOnFloorMock.invocationsDone()
+}
+
+// MinimockGetApartmentsOnFloorInspect logs each unmet expectation
+func (m *ApartmentsServiceMock) MinimockGetApartmentsOnFloorInspect() {
+	for _, e := range m.GetApartmentsOnFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetApartmentsOnFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterGetApartmentsOnFloorCounter := mm_atomic.LoadUint64(&m.afterGetApartmentsOnFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetApartmentsOnFloorMock.defaultExpectation != nil && afterGetApartmentsOnFloorCounter < 1 {
+		if m.GetApartmentsOnFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsServiceMock.GetApartmentsOnFloor")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetApartmentsOnFloor with params: %#v", *m.GetApartmentsOnFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetApartmentsOnFloor != nil && afterGetApartmentsOnFloorCounter < 1 {
+		m.t.Error("Expected call to ApartmentsServiceMock.GetApartmentsOnFloor")
+	}
+
+	if !m.GetApartmentsOnFloorMock.invocationsDone() && afterGetApartmentsOnFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsServiceMock.GetApartmentsOnFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.GetApartmentsOnFloorMock.expectedInvocations), afterGetApartmentsOnFloorCounter)
+	}
+}
+
+type mApartmentsServiceMockGetBuildingStats struct {
+	optional           bool
+	mock               *ApartmentsServiceMock
+	defaultExpectation *ApartmentsServiceMockGetBuildingStatsExpectation
+	expectations       []*ApartmentsServiceMockGetBuildingStatsExpectation
+
+	callArgs []*ApartmentsServiceMockGetBuildingStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsServiceMockGetBuildingStatsExpectation specifies expectation struct of the ApartmentsService.GetBuildingStats
+type ApartmentsServiceMockGetBuildingStatsExpectation struct {
+	mock      *ApartmentsServiceMock
+	params    *ApartmentsServiceMockGetBuildingStatsParams
+	paramPtrs *ApartmentsServiceMockGetBuildingStatsParamPtrs
+	results   *ApartmentsServiceMockGetBuildingStatsResults
+	Counter   uint64
+}
+
+// ApartmentsServiceMockGetBuildingStatsParams contains parameters of the ApartmentsService.GetBuildingStats
+type ApartmentsServiceMockGetBuildingStatsParams struct {
+	ctx        context.Context
+	buildingId int
+}
+
+// ApartmentsServiceMockGetBuildingStatsParamPtrs contains pointers to parameters of the ApartmentsService.GetBuildingStats
+type ApartmentsServiceMockGetBuildingStatsParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+}
+
+// ApartmentsServiceMockGetBuildingStatsResults contains results of the ApartmentsService.GetBuildingStats
+type ApartmentsServiceMockGetBuildingStatsResults struct {
+	sp1 *storage.Stats
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Optional() *mApartmentsServiceMockGetBuildingStats {
+	mmGetBuildingStats.optional = true
+	return mmGetBuildingStats
+}
+
+// Expect sets up expected params for ApartmentsService.GetBuildingStats
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Expect(ctx context.Context, buildingId int) *mApartmentsServiceMockGetBuildingStats {
+	if mmGetBuildingStats.mock.funcGetBuildingStats != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Set")
+	}
+
+	if mmGetBuildingStats.defaultExpectation == nil {
+		mmGetBuildingStats.defaultExpectation = &ApartmentsServiceMockGetBuildingStatsExpectation{}
+	}
+
+	if mmGetBuildingStats.defaultExpectation.paramPtrs != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by ExpectParams functions")
+	}
+
+	mmGetBuildingStats.defaultExpectation.params = &ApartmentsServiceMockGetBuildingStatsParams{ctx, buildingId}
+	for _, e := range mmGetBuildingStats.expectations {
+		if minimock.Equal(e.params, mmGetBuildingStats.defaultExpectation.params) {
+			mmGetBuildingStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBuildingStats.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBuildingStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsService.GetBuildingStats
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) ExpectCtxParam1(ctx context.Context) *mApartmentsServiceMockGetBuildingStats {
+	if mmGetBuildingStats.mock.funcGetBuildingStats != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Set")
+	}
+
+	if mmGetBuildingStats.defaultExpectation == nil {
+		mmGetBuildingStats.defaultExpectation = &ApartmentsServiceMockGetBuildingStatsExpectation{}
+	}
+
+	if mmGetBuildingStats.defaultExpectation.params != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Expect")
+	}
+
+	if mmGetBuildingStats.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingStats.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetBuildingStatsParamPtrs{}
+	}
+	mmGetBuildingStats.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBuildingStats
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for ApartmentsService.GetBuildingStats
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) ExpectBuildingIdParam2(buildingId int) *mApartmentsServiceMockGetBuildingStats {
+	if mmGetBuildingStats.mock.funcGetBuildingStats != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Set")
+	}
+
+	if mmGetBuildingStats.defaultExpectation == nil {
+		mmGetBuildingStats.defaultExpectation = &ApartmentsServiceMockGetBuildingStatsExpectation{}
+	}
+
+	if mmGetBuildingStats.defaultExpectation.params != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Expect")
+	}
+
+	if mmGetBuildingStats.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingStats.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetBuildingStatsParamPtrs{}
+	}
+	mmGetBuildingStats.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetBuildingStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsService.GetBuildingStats
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Inspect(f func(ctx context.Context, buildingId int)) *mApartmentsServiceMockGetBuildingStats {
+	if mmGetBuildingStats.mock.inspectFuncGetBuildingStats != nil {
+		mmGetBuildingStats.mock.t.Fatalf("Inspect function is already set for ApartmentsServiceMock.GetBuildingStats")
+	}
+
+	mmGetBuildingStats.mock.inspectFuncGetBuildingStats = f
+
+	return mmGetBuildingStats
+}
+
+// Return sets up results that will be returned by ApartmentsService.GetBuildingStats
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Return(sp1 *storage.Stats, err error) *ApartmentsServiceMock {
+	if mmGetBuildingStats.mock.funcGetBuildingStats != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Set")
+	}
+
+	if mmGetBuildingStats.defaultExpectation == nil {
+		mmGetBuildingStats.defaultExpectation = &ApartmentsServiceMockGetBuildingStatsExpectation{mock: mmGetBuildingStats.mock}
+	}
+	mmGetBuildingStats.defaultExpectation.results = &ApartmentsServiceMockGetBuildingStatsResults{sp1, err}
+	return mmGetBuildingStats.mock
+}
+
+// Set uses given function f to mock the ApartmentsService.GetBuildingStats method
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Set(f func(ctx context.Context, buildingId int) (sp1 *storage.Stats, err error)) *ApartmentsServiceMock {
+	if mmGetBuildingStats.defaultExpectation != nil {
+		mmGetBuildingStats.mock.t.Fatalf("Default expectation is already set for the ApartmentsService.GetBuildingStats method")
+	}
+
+	if len(mmGetBuildingStats.expectations) > 0 {
+		mmGetBuildingStats.mock.t.Fatalf("Some expectations are already set for the ApartmentsService.GetBuildingStats method")
+	}
+
+	mmGetBuildingStats.mock.funcGetBuildingStats = f
+	return mmGetBuildingStats.mock
+}
+
+// When sets expectation for the ApartmentsService.GetBuildingStats which will trigger the result defined by the following
+// Then helper
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) When(ctx context.Context, buildingId int) *ApartmentsServiceMockGetBuildingStatsExpectation {
+	if mmGetBuildingStats.mock.funcGetBuildingStats != nil {
+		mmGetBuildingStats.mock.t.Fatalf("ApartmentsServiceMock.GetBuildingStats mock is already set by Set")
+	}
+
+	expectation := &ApartmentsServiceMockGetBuildingStatsExpectation{
+		mock:   mmGetBuildingStats.mock,
+		params: &ApartmentsServiceMockGetBuildingStatsParams{ctx, buildingId},
+	}
+	mmGetBuildingStats.expectations = append(mmGetBuildingStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsService.GetBuildingStats return parameters for the expectation previously defined by the When method
+func (e *ApartmentsServiceMockGetBuildingStatsExpectation) Then(sp1 *storage.Stats, err error) *ApartmentsServiceMock {
+	e.results = &ApartmentsServiceMockGetBuildingStatsResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsService.GetBuildingStats should be invoked
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Times(n uint64) *mApartmentsServiceMockGetBuildingStats {
+	if n == 0 {
+		mmGetBuildingStats.mock.t.Fatalf("Times of ApartmentsServiceMock.GetBuildingStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBuildingStats.expectedInvocations, n)
+	return mmGetBuildingStats
+}
+
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) invocationsDone() bool {
+	if len(mmGetBuildingStats.expectations) == 0 && mmGetBuildingStats.defaultExpectation == nil && mmGetBuildingStats.mock.funcGetBuildingStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBuildingStats.mock.afterGetBuildingStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBuildingStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBuildingStats implements apartments.ApartmentsService
+func (mmGetBuildingStats *ApartmentsServiceMock) GetBuildingStats(ctx context.Context, buildingId int) (sp1 *storage.Stats, err error) {
+	mm_atomic.AddUint64(&mmGetBuildingStats.beforeGetBuildingStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBuildingStats.afterGetBuildingStatsCounter, 1)
+
+	if mmGetBuildingStats.inspectFuncGetBuildingStats != nil {
+		mmGetBuildingStats.inspectFuncGetBuildingStats(ctx, buildingId)
+	}
+
+	mm_params := ApartmentsServiceMockGetBuildingStatsParams{ctx, buildingId}
+
+	// Record call args
+	mmGetBuildingStats.GetBuildingStatsMock.mutex.Lock()
+	mmGetBuildingStats.GetBuildingStatsMock.callArgs = append(mmGetBuildingStats.GetBuildingStatsMock.callArgs, &mm_params)
+	mmGetBuildingStats.GetBuildingStatsMock.mutex.Unlock()
+
+	for _, e := range mmGetBuildingStats.GetBuildingStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetBuildingStats.GetBuildingStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBuildingStats.GetBuildingStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBuildingStats.GetBuildingStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBuildingStats.GetBuildingStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsServiceMockGetBuildingStatsParams{ctx, buildingId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBuildingStats.t.Errorf("ApartmentsServiceMock.GetBuildingStats got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetBuildingStats.t.Errorf("ApartmentsServiceMock.GetBuildingStats got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBuildingStats.t.Errorf("ApartmentsServiceMock.GetBuildingStats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBuildingStats.GetBuildingStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBuildingStats.t.Fatal("No results are set for the ApartmentsServiceMock.GetBuildingStats")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetBuildingStats.funcGetBuildingStats != nil {
+		return mmGetBuildingStats.funcGetBuildingStats(ctx, buildingId)
+	}
+	mmGetBuildingStats.t.Fatalf("Unexpected call to ApartmentsServiceMock.GetBuildingStats. %v %v", ctx, buildingId)
+	return
+}
+
+// GetBuildingStatsAfterCounter returns a count of finished ApartmentsServiceMock.GetBuildingStats invocations
+func (mmGetBuildingStats *ApartmentsServiceMock) GetBuildingStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingStats.afterGetBuildingStatsCounter)
+}
+
+// GetBuildingStatsBeforeCounter returns a count of ApartmentsServiceMock.GetBuildingStats invocations
+func (mmGetBuildingStats *ApartmentsServiceMock) GetBuildingStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingStats.beforeGetBuildingStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsServiceMock.GetBuildingStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBuildingStats *mApartmentsServiceMockGetBuildingStats) Calls() []*ApartmentsServiceMockGetBuildingStatsParams {
+	mmGetBuildingStats.mutex.RLock()
+
+	argCopy := make([]*ApartmentsServiceMockGetBuildingStatsParams, len(mmGetBuildingStats.callArgs))
+	copy(argCopy, mmGetBuildingStats.callArgs)
+
+	mmGetBuildingStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBuildingStatsDone returns true if the count of the GetBuildingStats invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsServiceMock) MinimockGetBuildingStatsDone() bool {
+	if m.GetBuildingStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBuildingStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBuildingStatsMock.invocationsDone()
+}
+
+// MinimockGetBuildingStatsInspect logs each unmet expectation
+func (m *ApartmentsServiceMock) MinimockGetBuildingStatsInspect() {
+	for _, e := range m.GetBuildingStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetBuildingStats with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBuildingStatsCounter := mm_atomic.LoadUint64(&m.afterGetBuildingStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBuildingStatsMock.defaultExpectation != nil && afterGetBuildingStatsCounter < 1 {
+		if m.GetBuildingStatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsServiceMock.GetBuildingStats")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetBuildingStats with params: %#v", *m.GetBuildingStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBuildingStats != nil && afterGetBuildingStatsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsServiceMock.GetBuildingStats")
+	}
+
+	if !m.GetBuildingStatsMock.invocationsDone() && afterGetBuildingStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsServiceMock.GetBuildingStats but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBuildingStatsMock.expectedInvocations), afterGetBuildingStatsCounter)
+	}
+}
+
+type mApartmentsServiceMockGetStats struct {
+	optional           bool
+	mock               *ApartmentsServiceMock
+	defaultExpectation *ApartmentsServiceMockGetStatsExpectation
+	expectations       []*ApartmentsServiceMockGetStatsExpectation
+
+	callArgs []*ApartmentsServiceMockGetStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsServiceMockGetStatsExpectation specifies expectation struct of the ApartmentsService.GetStats
+type ApartmentsServiceMockGetStatsExpectation struct {
+	mock      *ApartmentsServiceMock
+	params    *ApartmentsServiceMockGetStatsParams
+	paramPtrs *ApartmentsServiceMockGetStatsParamPtrs
+	results   *ApartmentsServiceMockGetStatsResults
+	Counter   uint64
+}
+
+// ApartmentsServiceMockGetStatsParams contains parameters of the ApartmentsService.GetStats
+type ApartmentsServiceMockGetStatsParams struct {
+	ctx context.Context
+}
+
+// ApartmentsServiceMockGetStatsParamPtrs contains pointers to parameters of the ApartmentsService.GetStats
+type ApartmentsServiceMockGetStatsParamPtrs struct {
+	ctx *context.Context
+}
+
+// ApartmentsServiceMockGetStatsResults contains results of the ApartmentsService.GetStats
+type ApartmentsServiceMockGetStatsResults struct {
+	sp1 *storage.Stats
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStats *mApartmentsServiceMockGetStats) Optional() *mApartmentsServiceMockGetStats {
+	mmGetStats.optional = true
+	return mmGetStats
+}
+
+// Expect sets up expected params for ApartmentsService.GetStats
+func (mmGetStats *mApartmentsServiceMockGetStats) Expect(ctx context.Context) *mApartmentsServiceMockGetStats {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsServiceMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsServiceMockGetStatsExpectation{}
+	}
+
+	if mmGetStats.defaultExpectation.paramPtrs != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsServiceMock.GetStats mock is already set by ExpectParams functions")
+	}
+
+	mmGetStats.defaultExpectation.params = &ApartmentsServiceMockGetStatsParams{ctx}
+	for _, e := range mmGetStats.expectations {
+		if minimock.Equal(e.params, mmGetStats.defaultExpectation.params) {
+			mmGetStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStats.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsService.GetStats
+func (mmGetStats *mApartmentsServiceMockGetStats) ExpectCtxParam1(ctx context.Context) *mApartmentsServiceMockGetStats {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsServiceMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsServiceMockGetStatsExpectation{}
+	}
+
+	if mmGetStats.defaultExpectation.params != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsServiceMock.GetStats mock is already set by Expect")
+	}
+
+	if mmGetStats.defaultExpectation.paramPtrs == nil {
+		mmGetStats.defaultExpectation.paramPtrs = &ApartmentsServiceMockGetStatsParamPtrs{}
+	}
+	mmGetStats.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsService.GetStats
+func (mmGetStats *mApartmentsServiceMockGetStats) Inspect(f func(ctx context.Context)) *mApartmentsServiceMockGetStats {
+	if mmGetStats.mock.inspectFuncGetStats != nil {
+		mmGetStats.mock.t.Fatalf("Inspect function is already set for ApartmentsServiceMock.GetStats")
+	}
+
+	mmGetStats.mock.inspectFuncGetStats = f
+
+	return mmGetStats
+}
+
+// Return sets up results that will be returned by ApartmentsService.GetStats
+func (mmGetStats *mApartmentsServiceMockGetStats) Return(sp1 *storage.Stats, err error) *ApartmentsServiceMock {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsServiceMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsServiceMockGetStatsExpectation{mock: mmGetStats.mock}
+	}
+	mmGetStats.defaultExpectation.results = &ApartmentsServiceMockGetStatsResults{sp1, err}
+	return mmGetStats.mock
+}
+
+// Set uses given function f to mock the ApartmentsService.GetStats method
+func (mmGetStats *mApartmentsServiceMockGetStats) Set(f func(ctx context.Context) (sp1 *storage.Stats, err error)) *ApartmentsServiceMock {
+	if mmGetStats.defaultExpectation != nil {
+		mmGetStats.mock.t.Fatalf("Default expectation is already set for the ApartmentsService.GetStats method")
+	}
+
+	if len(mmGetStats.expectations) > 0 {
+		mmGetStats.mock.t.Fatalf("Some expectations are already set for the ApartmentsService.GetStats method")
+	}
+
+	mmGetStats.mock.funcGetStats = f
+	return mmGetStats.mock
+}
+
+// When sets expectation for the ApartmentsService.GetStats which will trigger the result defined by the following
+// Then helper
+func (mmGetStats *mApartmentsServiceMockGetStats) When(ctx context.Context) *ApartmentsServiceMockGetStatsExpectation {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsServiceMock.GetStats mock is already set by Set")
+	}
+
+	expectation := &ApartmentsServiceMockGetStatsExpectation{
+		mock:   mmGetStats.mock,
+		params: &ApartmentsServiceMockGetStatsParams{ctx},
+	}
+	mmGetStats.expectations = append(mmGetStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsService.GetStats return parameters for the expectation previously defined by the When method
+func (e *ApartmentsServiceMockGetStatsExpectation) Then(sp1 *storage.Stats, err error) *ApartmentsServiceMock {
+	e.results = &ApartmentsServiceMockGetStatsResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsService.GetStats should be invoked
+func (mmGetStats *mApartmentsServiceMockGetStats) Times(n uint64) *mApartmentsServiceMockGetStats {
+	if n == 0 {
+		mmGetStats.mock.t.Fatalf("Times of ApartmentsServiceMock.GetStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStats.expectedInvocations, n)
+	return mmGetStats
+}
+
+func (mmGetStats *mApartmentsServiceMockGetStats) invocationsDone() bool {
+	if len(mmGetStats.expectations) == 0 && mmGetStats.defaultExpectation == nil && mmGetStats.mock.funcGetStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStats.mock.afterGetStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStats implements apartments.ApartmentsService
+func (mmGetStats *ApartmentsServiceMock) GetStats(ctx context.Context) (sp1 *storage.Stats, err error) {
+	mm_atomic.AddUint64(&mmGetStats.beforeGetStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStats.afterGetStatsCounter, 1)
+
+	if mmGetStats.inspectFuncGetStats != nil {
+		mmGetStats.inspectFuncGetStats(ctx)
+	}
+
+	mm_params := ApartmentsServiceMockGetStatsParams{ctx}
+
+	// Record call args
+	mmGetStats.GetStatsMock.mutex.Lock()
+	mmGetStats.GetStatsMock.callArgs = append(mmGetStats.GetStatsMock.callArgs, &mm_params)
+	mmGetStats.GetStatsMock.mutex.Unlock()
+
+	for _, e := range mmGetStats.GetStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetStats.GetStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStats.GetStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStats.GetStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStats.GetStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsServiceMockGetStatsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStats.t.Errorf("ApartmentsServiceMock.GetStats got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStats.t.Errorf("ApartmentsServiceMock.GetStats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStats.GetStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStats.t.Fatal("No results are set for the ApartmentsServiceMock.GetStats")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetStats.funcGetStats != nil {
+		return mmGetStats.funcGetStats(ctx)
+	}
+	mmGetStats.t.Fatalf("Unexpected call to ApartmentsServiceMock.GetStats. %v", ctx)
+	return
+}
+
+// GetStatsAfterCounter returns a count of finished ApartmentsServiceMock.GetStats invocations
+func (mmGetStats *ApartmentsServiceMock) GetStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStats.afterGetStatsCounter)
+}
+
+// GetStatsBeforeCounter returns a count of ApartmentsServiceMock.GetStats invocations
+func (mmGetStats *ApartmentsServiceMock) GetStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStats.beforeGetStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsServiceMock.GetStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStats *mApartmentsServiceMockGetStats) Calls() []*ApartmentsServiceMockGetStatsParams {
+	mmGetStats.mutex.RLock()
+
+	argCopy := make([]*ApartmentsServiceMockGetStatsParams, len(mmGetStats.callArgs))
+	copy(argCopy, mmGetStats.callArgs)
+
+	mmGetStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStatsDone returns true if the count of the GetStats invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsServiceMock) MinimockGetStatsDone() bool {
+	if m.GetStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStatsMock.invocationsDone()
+}
+
+// MinimockGetStatsInspect logs each unmet expectation
+func (m *ApartmentsServiceMock) MinimockGetStatsInspect() {
+	for _, e := range m.GetStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetStats with params: %#v", *e.params)
+		}
+	}
+
+	afterGetStatsCounter := mm_atomic.LoadUint64(&m.afterGetStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStatsMock.defaultExpectation != nil && afterGetStatsCounter < 1 {
+		if m.GetStatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsServiceMock.GetStats")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.GetStats with params: %#v", *m.GetStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStats != nil && afterGetStatsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsServiceMock.GetStats")
+	}
+
+	if !m.GetStatsMock.invocationsDone() && afterGetStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsServiceMock.GetStats but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStatsMock.expectedInvocations), afterGetStatsCounter)
+	}
+}
+
+type mApartmentsServiceMockStreamApartments struct {
+	optional           bool
+	mock               *ApartmentsServiceMock
+	defaultExpectation *ApartmentsServiceMockStreamApartmentsExpectation
+	expectations       []*ApartmentsServiceMockStreamApartmentsExpectation
+
+	callArgs []*ApartmentsServiceMockStreamApartmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsServiceMockStreamApartmentsExpectation specifies expectation struct of the ApartmentsService.StreamApartments
+type ApartmentsServiceMockStreamApartmentsExpectation struct {
+	mock      *ApartmentsServiceMock
+	params    *ApartmentsServiceMockStreamApartmentsParams
+	paramPtrs *ApartmentsServiceMockStreamApartmentsParamPtrs
+	results   *ApartmentsServiceMockStreamApartmentsResults
+	Counter   uint64
+}
+
+// ApartmentsServiceMockStreamApartmentsParams contains parameters of the ApartmentsService.StreamApartments
+type ApartmentsServiceMockStreamApartmentsParams struct {
+	ctx  context.Context
+	send func(a *models.Apartment) error
+}
+
+// ApartmentsServiceMockStreamApartmentsParamPtrs contains pointers to parameters of the ApartmentsService.StreamApartments
+type ApartmentsServiceMockStreamApartmentsParamPtrs struct {
+	ctx  *context.Context
+	send *func(a *models.Apartment) error
+}
+
+// ApartmentsServiceMockStreamApartmentsResults contains results of the ApartmentsService.StreamApartments
+type ApartmentsServiceMockStreamApartmentsResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Optional() *mApartmentsServiceMockStreamApartments {
+	mmStreamApartments.optional = true
+	return mmStreamApartments
+}
+
+// Expect sets up expected params for ApartmentsService.StreamApartments
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Expect(ctx context.Context, send func(a *models.Apartment) error) *mApartmentsServiceMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsServiceMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by ExpectParams functions")
+	}
+
+	mmStreamApartments.defaultExpectation.params = &ApartmentsServiceMockStreamApartmentsParams{ctx, send}
+	for _, e := range mmStreamApartments.expectations {
+		if minimock.Equal(e.params, mmStreamApartments.defaultExpectation.params) {
+			mmStreamApartments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStreamApartments.defaultExpectation.params)
+		}
+	}
+
+	return mmStreamApartments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsService.StreamApartments
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) ExpectCtxParam1(ctx context.Context) *mApartmentsServiceMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsServiceMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.params != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Expect")
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs == nil {
+		mmStreamApartments.defaultExpectation.paramPtrs = &ApartmentsServiceMockStreamApartmentsParamPtrs{}
+	}
+	mmStreamApartments.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmStreamApartments
+}
+
+// ExpectSendParam2 sets up expected param send for ApartmentsService.StreamApartments
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) ExpectSendParam2(send func(a *models.Apartment) error) *mApartmentsServiceMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsServiceMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.params != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Expect")
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs == nil {
+		mmStreamApartments.defaultExpectation.paramPtrs = &ApartmentsServiceMockStreamApartmentsParamPtrs{}
+	}
+	mmStreamApartments.defaultExpectation.paramPtrs.send = &send
+
+	return mmStreamApartments
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsService.StreamApartments
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Inspect(f func(ctx context.Context, send func(a *models.Apartment) error)) *mApartmentsServiceMockStreamApartments {
+	if mmStreamApartments.mock.inspectFuncStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("Inspect function is already set for ApartmentsServiceMock.StreamApartments")
+	}
+
+	mmStreamApartments.mock.inspectFuncStreamApartments = f
+
+	return mmStreamApartments
+}
+
+// Return sets up results that will be returned by ApartmentsService.StreamApartments
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Return(err error) *ApartmentsServiceMock {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsServiceMockStreamApartmentsExpectation{mock: mmStreamApartments.mock}
+	}
+	mmStreamApartments.defaultExpectation.results = &ApartmentsServiceMockStreamApartmentsResults{err}
+	return mmStreamApartments.mock
+}
+
+// Set uses given function f to mock the ApartmentsService.StreamApartments method
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Set(f func(ctx context.Context, send func(a *models.Apartment) error) (err error)) *ApartmentsServiceMock {
+	if mmStreamApartments.defaultExpectation != nil {
+		mmStreamApartments.mock.t.Fatalf("Default expectation is already set for the ApartmentsService.StreamApartments method")
+	}
+
+	if len(mmStreamApartments.expectations) > 0 {
+		mmStreamApartments.mock.t.Fatalf("Some expectations are already set for the ApartmentsService.StreamApartments method")
+	}
+
+	mmStreamApartments.mock.funcStreamApartments = f
+	return mmStreamApartments.mock
+}
+
+// When sets expectation for the ApartmentsService.StreamApartments which will trigger the result defined by the following
+// Then helper
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) When(ctx context.Context, send func(a *models.Apartment) error) *ApartmentsServiceMockStreamApartmentsExpectation {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsServiceMock.StreamApartments mock is already set by Set")
+	}
+
+	expectation := &ApartmentsServiceMockStreamApartmentsExpectation{
+		mock:   mmStreamApartments.mock,
+		params: &ApartmentsServiceMockStreamApartmentsParams{ctx, send},
+	}
+	mmStreamApartments.expectations = append(mmStreamApartments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsService.StreamApartments return parameters for the expectation previously defined by the When method
+func (e *ApartmentsServiceMockStreamApartmentsExpectation) Then(err error) *ApartmentsServiceMock {
+	e.results = &ApartmentsServiceMockStreamApartmentsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsService.StreamApartments should be invoked
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Times(n uint64) *mApartmentsServiceMockStreamApartments {
+	if n == 0 {
+		mmStreamApartments.mock.t.Fatalf("Times of ApartmentsServiceMock.StreamApartments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStreamApartments.expectedInvocations, n)
+	return mmStreamApartments
+}
+
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) invocationsDone() bool {
+	if len(mmStreamApartments.expectations) == 0 && mmStreamApartments.defaultExpectation == nil && mmStreamApartments.mock.funcStreamApartments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStreamApartments.mock.afterStreamApartmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStreamApartments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StreamApartments implements apartments.ApartmentsService
+func (mmStreamApartments *ApartmentsServiceMock) StreamApartments(ctx context.Context, send func(a *models.Apartment) error) (err error) {
+	mm_atomic.AddUint64(&mmStreamApartments.beforeStreamApartmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmStreamApartments.afterStreamApartmentsCounter, 1)
+
+	if mmStreamApartments.inspectFuncStreamApartments != nil {
+		mmStreamApartments.inspectFuncStreamApartments(ctx, send)
+	}
+
+	mm_params := ApartmentsServiceMockStreamApartmentsParams{ctx, send}
+
+	// Record call args
+	mmStreamApartments.StreamApartmentsMock.mutex.Lock()
+	mmStreamApartments.StreamApartmentsMock.callArgs = append(mmStreamApartments.StreamApartmentsMock.callArgs, &mm_params)
+	mmStreamApartments.StreamApartmentsMock.mutex.Unlock()
+
+	for _, e := range mmStreamApartments.StreamApartmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStreamApartments.StreamApartmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStreamApartments.StreamApartmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmStreamApartments.StreamApartmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmStreamApartments.StreamApartmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsServiceMockStreamApartmentsParams{ctx, send}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStreamApartments.t.Errorf("ApartmentsServiceMock.StreamApartments got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmStreamApartments.t.Errorf("ApartmentsServiceMock.StreamApartments got unexpected parameter send, want: %#v, got: %#v%s\n", *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStreamApartments.t.Errorf("ApartmentsServiceMock.StreamApartments got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStreamApartments.StreamApartmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStreamApartments.t.Fatal("No results are set for the ApartmentsServiceMock.StreamApartments")
+		}
+		return (*mm_results).err
+	}
+	if mmStreamApartments.funcStreamApartments != nil {
+		return mmStreamApartments.funcStreamApartments(ctx, send)
+	}
+	mmStreamApartments.t.Fatalf("Unexpected call to ApartmentsServiceMock.StreamApartments. %v %v", ctx, send)
+	return
+}
+
+// StreamApartmentsAfterCounter returns a count of finished ApartmentsServiceMock.StreamApartments invocations
+func (mmStreamApartments *ApartmentsServiceMock) StreamApartmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamApartments.afterStreamApartmentsCounter)
+}
+
+// StreamApartmentsBeforeCounter returns a count of ApartmentsServiceMock.StreamApartments invocations
+func (mmStreamApartments *ApartmentsServiceMock) StreamApartmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamApartments.beforeStreamApartmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsServiceMock.StreamApartments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStreamApartments *mApartmentsServiceMockStreamApartments) Calls() []*ApartmentsServiceMockStreamApartmentsParams {
+	mmStreamApartments.mutex.RLock()
+
+	argCopy := make([]*ApartmentsServiceMockStreamApartmentsParams, len(mmStreamApartments.callArgs))
+	copy(argCopy, mmStreamApartments.callArgs)
+
+	mmStreamApartments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStreamApartmentsDone returns true if the count of the StreamApartments invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsServiceMock) MinimockStreamApartmentsDone() bool {
+	if m.StreamApartmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StreamApartmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StreamApartmentsMock.invocationsDone()
+}
+
+// MinimockStreamApartmentsInspect logs each unmet expectation
+func (m *ApartmentsServiceMock) MinimockStreamApartmentsInspect() {
+	for _, e := range m.StreamApartmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.StreamApartments with params: %#v", *e.params)
+		}
+	}
+
+	afterStreamApartmentsCounter := mm_atomic.LoadUint64(&m.afterStreamApartmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StreamApartmentsMock.defaultExpectation != nil && afterStreamApartmentsCounter < 1 {
+		if m.StreamApartmentsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsServiceMock.StreamApartments")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.StreamApartments with params: %#v", *m.StreamApartmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStreamApartments != nil && afterStreamApartmentsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsServiceMock.StreamApartments")
+	}
+
+	if !m.StreamApartmentsMock.invocationsDone() && afterStreamApartmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsServiceMock.StreamApartments but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamApartmentsMock.expectedInvocations), afterStreamApartmentsCounter)
+	}
+}
+
+type mApartmentsServiceMockStreamApartmentsInBuilding struct {
+	optional           bool
+	mock               *ApartmentsServiceMock
+	defaultExpectation *ApartmentsServiceMockStreamApartmentsInBuildingExpectation
+	expectations       []*ApartmentsServiceMockStreamApartmentsInBuildingExpectation
+
+	callArgs []*ApartmentsServiceMockStreamApartmentsInBuildingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsServiceMockStreamApartmentsInBuildingExpectation specifies expectation struct of the ApartmentsService.StreamApartmentsInBuilding
+type ApartmentsServiceMockStreamApartmentsInBuildingExpectation struct {
+	mock      *ApartmentsServiceMock
+	params    *ApartmentsServiceMockStreamApartmentsInBuildingParams
+	paramPtrs *ApartmentsServiceMockStreamApartmentsInBuildingParamPtrs
+	results   *ApartmentsServiceMockStreamApartmentsInBuildingResults
+	Counter   uint64
+}
+
+// ApartmentsServiceMockStreamApartmentsInBuildingParams contains parameters of the ApartmentsService.StreamApartmentsInBuilding
+type ApartmentsServiceMockStreamApartmentsInBuildingParams struct {
+	ctx        context.Context
+	buildingId int
+	send       func(a *models.Apartment) error
+}
+
+// ApartmentsServiceMockStreamApartmentsInBuildingParamPtrs contains pointers to parameters of the ApartmentsService.StreamApartmentsInBuilding
+type ApartmentsServiceMockStreamApartmentsInBuildingParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	send       *func(a *models.Apartment) error
+}
+
+// ApartmentsServiceMockStreamApartmentsInBuildingResults contains results of the ApartmentsService.StreamApartmentsInBuilding
+type ApartmentsServiceMockStreamApartmentsInBuildingResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Optional() *mApartmentsServiceMockStreamApartmentsInBuilding {
+	mmStreamApartmentsInBuilding.optional = true
+	return mmStreamApartmentsInBuilding
+}
+
+// Expect sets up expected params for ApartmentsService.StreamApartmentsInBuilding
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Expect(ctx context.Context, buildingId int, send func(a *models.Apartment) error) *mApartmentsServiceMockStreamApartmentsInBuilding {
+	if mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Set")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation = &ApartmentsServiceMockStreamApartmentsInBuildingExpectation{}
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by ExpectParams functions")
+	}
+
+	mmStreamApartmentsInBuilding.defaultExpectation.params = &ApartmentsServiceMockStreamApartmentsInBuildingParams{ctx, buildingId, send}
+	for _, e := range mmStreamApartmentsInBuilding.expectations {
+		if minimock.Equal(e.params, mmStreamApartmentsInBuilding.defaultExpectation.params) {
+			mmStreamApartmentsInBuilding.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStreamApartmentsInBuilding.defaultExpectation.params)
+		}
+	}
+
+	return mmStreamApartmentsInBuilding
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsService.StreamApartmentsInBuilding
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) ExpectCtxParam1(ctx context.Context) *mApartmentsServiceMockStreamApartmentsInBuilding {
+	if mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Set")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation = &ApartmentsServiceMockStreamApartmentsInBuildingExpectation{}
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.params != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Expect")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs = &ApartmentsServiceMockStreamApartmentsInBuildingParamPtrs{}
+	}
+	mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmStreamApartmentsInBuilding
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for ApartmentsService.StreamApartmentsInBuilding
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) ExpectBuildingIdParam2(buildingId int) *mApartmentsServiceMockStreamApartmentsInBuilding {
+	if mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Set")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation = &ApartmentsServiceMockStreamApartmentsInBuildingExpectation{}
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.params != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Expect")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs = &ApartmentsServiceMockStreamApartmentsInBuildingParamPtrs{}
+	}
+	mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmStreamApartmentsInBuilding
+}
+
+// ExpectSendParam3 sets up expected param send for ApartmentsService.StreamApartmentsInBuilding
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) ExpectSendParam3(send func(a *models.Apartment) error) *mApartmentsServiceMockStreamApartmentsInBuilding {
+	if mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Set")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation = &ApartmentsServiceMockStreamApartmentsInBuildingExpectation{}
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.params != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Expect")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs = &ApartmentsServiceMockStreamApartmentsInBuildingParamPtrs{}
+	}
+	mmStreamApartmentsInBuilding.defaultExpectation.paramPtrs.send = &send
+
+	return mmStreamApartmentsInBuilding
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsService.StreamApartmentsInBuilding
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Inspect(f func(ctx context.Context, buildingId int, send func(a *models.Apartment) error)) *mApartmentsServiceMockStreamApartmentsInBuilding {
+	if mmStreamApartmentsInBuilding.mock.inspectFuncStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("Inspect function is already set for ApartmentsServiceMock.StreamApartmentsInBuilding")
+	}
+
+	mmStreamApartmentsInBuilding.mock.inspectFuncStreamApartmentsInBuilding = f
+
+	return mmStreamApartmentsInBuilding
+}
+
+// Return sets up results that will be returned by ApartmentsService.StreamApartmentsInBuilding
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Return(err error) *ApartmentsServiceMock {
+	if mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Set")
+	}
+
+	if mmStreamApartmentsInBuilding.defaultExpectation == nil {
+		mmStreamApartmentsInBuilding.defaultExpectation = &ApartmentsServiceMockStreamApartmentsInBuildingExpectation{mock: mmStreamApartmentsInBuilding.mock}
+	}
+	mmStreamApartmentsInBuilding.defaultExpectation.results = &ApartmentsServiceMockStreamApartmentsInBuildingResults{err}
+	return mmStreamApartmentsInBuilding.mock
+}
+
+// Set uses given function f to mock the ApartmentsService.StreamApartmentsInBuilding method
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Set(f func(ctx context.Context, buildingId int, send func(a *models.Apartment) error) (err error)) *ApartmentsServiceMock {
+	if mmStreamApartmentsInBuilding.defaultExpectation != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("Default expectation is already set for the ApartmentsService.StreamApartmentsInBuilding method")
+	}
+
+	if len(mmStreamApartmentsInBuilding.expectations) > 0 {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("Some expectations are already set for the ApartmentsService.StreamApartmentsInBuilding method")
+	}
+
+	mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding = f
+	return mmStreamApartmentsInBuilding.mock
+}
+
+// When sets expectation for the ApartmentsService.StreamApartmentsInBuilding which will trigger the result defined by the following
+// Then helper
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) When(ctx context.Context, buildingId int, send func(a *models.Apartment) error) *ApartmentsServiceMockStreamApartmentsInBuildingExpectation {
+	if mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("ApartmentsServiceMock.StreamApartmentsInBuilding mock is already set by Set")
+	}
+
+	expectation := &ApartmentsServiceMockStreamApartmentsInBuildingExpectation{
+		mock:   mmStreamApartmentsInBuilding.mock,
+		params: &ApartmentsServiceMockStreamApartmentsInBuildingParams{ctx, buildingId, send},
+	}
+	mmStreamApartmentsInBuilding.expectations = append(mmStreamApartmentsInBuilding.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsService.StreamApartmentsInBuilding return parameters for the expectation previously defined by the When method
+func (e *ApartmentsServiceMockStreamApartmentsInBuildingExpectation) Then(err error) *ApartmentsServiceMock {
+	e.results = &ApartmentsServiceMockStreamApartmentsInBuildingResults{err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsService.StreamApartmentsInBuilding should be invoked
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Times(n uint64) *mApartmentsServiceMockStreamApartmentsInBuilding {
+	if n == 0 {
+		mmStreamApartmentsInBuilding.mock.t.Fatalf("Times of ApartmentsServiceMock.StreamApartmentsInBuilding mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStreamApartmentsInBuilding.expectedInvocations, n)
+	return mmStreamApartmentsInBuilding
+}
+
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) invocationsDone() bool {
+	if len(mmStreamApartmentsInBuilding.expectations) == 0 && mmStreamApartmentsInBuilding.defaultExpectation == nil && mmStreamApartmentsInBuilding.mock.funcStreamApartmentsInBuilding == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStreamApartmentsInBuilding.mock.afterStreamApartmentsInBuildingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStreamApartmentsInBuilding.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StreamApartmentsInBuilding implements apartments.ApartmentsService
+func (mmStreamApartmentsInBuilding *ApartmentsServiceMock) StreamApartmentsInBuilding(ctx context.Context, buildingId int, send func(a *models.Apartment) error) (err error) {
+	mm_atomic.AddUint64(&mmStreamApartmentsInBuilding.beforeStreamApartmentsInBuildingCounter, 1)
+	defer mm_atomic.AddUint64(&mmStreamApartmentsInBuilding.afterStreamApartmentsInBuildingCounter, 1)
+
+	if mmStreamApartmentsInBuilding.inspectFuncStreamApartmentsInBuilding != nil {
+		mmStreamApartmentsInBuilding.inspectFuncStreamApartmentsInBuilding(ctx, buildingId, send)
+	}
+
+	mm_params := ApartmentsServiceMockStreamApartmentsInBuildingParams{ctx, buildingId, send}
+
+	// Record call args
+	mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.mutex.Lock()
+	mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.callArgs = append(mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.callArgs, &mm_params)
+	mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.mutex.Unlock()
+
+	for _, e := range mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.defaultExpectation.Counter, 1)
+		mm_want := mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.defaultExpectation.params
+		mm_want_ptrs := mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsServiceMockStreamApartmentsInBuildingParams{ctx, buildingId, send}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStreamApartmentsInBuilding.t.Errorf("ApartmentsServiceMock.StreamApartmentsInBuilding got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmStreamApartmentsInBuilding.t.Errorf("ApartmentsServiceMock.StreamApartmentsInBuilding got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmStreamApartmentsInBuilding.t.Errorf("ApartmentsServiceMock.StreamApartmentsInBuilding got unexpected parameter send, want: %#v, got: %#v%s\n", *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStreamApartmentsInBuilding.t.Errorf("ApartmentsServiceMock.StreamApartmentsInBuilding got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStreamApartmentsInBuilding.StreamApartmentsInBuildingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStreamApartmentsInBuilding.t.Fatal("No results are set for the ApartmentsServiceMock.StreamApartmentsInBuilding")
+		}
+		return (*mm_results).err
+	}
+	if mmStreamApartmentsInBuilding.funcStreamApartmentsInBuilding != nil {
+		return mmStreamApartmentsInBuilding.funcStreamApartmentsInBuilding(ctx, buildingId, send)
+	}
+	mmStreamApartmentsInBuilding.t.Fatalf("Unexpected call to ApartmentsServiceMock.StreamApartmentsInBuilding. %v %v %v", ctx, buildingId, send)
+	return
+}
+
+// StreamApartmentsInBuildingAfterCounter returns a count of finished ApartmentsServiceMock.StreamApartmentsInBuilding invocations
+func (mmStreamApartmentsInBuilding *ApartmentsServiceMock) StreamApartmentsInBuildingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamApartmentsInBuilding.afterStreamApartmentsInBuildingCounter)
+}
+
+// StreamApartmentsInBuildingBeforeCounter returns a count of ApartmentsServiceMock.StreamApartmentsInBuilding invocations
+func (mmStreamApartmentsInBuilding *ApartmentsServiceMock) StreamApartmentsInBuildingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamApartmentsInBuilding.beforeStreamApartmentsInBuildingCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsServiceMock.StreamApartmentsInBuilding.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStreamApartmentsInBuilding *mApartmentsServiceMockStreamApartmentsInBuilding) Calls() []*ApartmentsServiceMockStreamApartmentsInBuildingParams {
+	mmStreamApartmentsInBuilding.mutex.RLock()
+
+	argCopy := make([]*ApartmentsServiceMockStreamApartmentsInBuildingParams, len(mmStreamApartmentsInBuilding.callArgs))
+	copy(argCopy, mmStreamApartmentsInBuilding.callArgs)
+
+	mmStreamApartmentsInBuilding.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStreamApartmentsInBuildingDone returns true if the count of the StreamApartmentsInBuilding invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsServiceMock) MinimockStreamApartmentsInBuildingDone() bool {
+	if m.StreamApartmentsInBuildingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StreamApartmentsInBuildingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StreamApartmentsInBuildingMock.invocationsDone()
+}
+
+// MinimockStreamApartmentsInBuildingInspect logs each unmet expectation
+func (m *ApartmentsServiceMock) MinimockStreamApartmentsInBuildingInspect() {
+	for _, e := range m.StreamApartmentsInBuildingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.StreamApartmentsInBuilding with params: %#v", *e.params)
+		}
+	}
+
+	afterStreamApartmentsInBuildingCounter := mm_atomic.LoadUint64(&m.afterStreamApartmentsInBuildingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StreamApartmentsInBuildingMock.defaultExpectation != nil && afterStreamApartmentsInBuildingCounter < 1 {
+		if m.StreamApartmentsInBuildingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsServiceMock.StreamApartmentsInBuilding")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsServiceMock.StreamApartmentsInBuilding with params: %#v", *m.StreamApartmentsInBuildingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStreamApartmentsInBuilding != nil && afterStreamApartmentsInBuildingCounter < 1 {
+		m.t.Error("Expected call to ApartmentsServiceMock.StreamApartmentsInBuilding")
+	}
+
+	if !m.StreamApartmentsInBuildingMock.invocationsDone() && afterStreamApartmentsInBuildingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsServiceMock.StreamApartmentsInBuilding but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamApartmentsInBuildingMock.expectedInvocations), afterStreamApartmentsInBuildingCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ApartmentsServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateApartmentInspect()
+
+			m.MinimockDeleteApartmentInspect()
+
+			m.MinimockGetApartmentInspect()
+
+			m.MinimockGetApartmentsInspect()
+
+			m.MinimockGetApartmentsInBuildingInspect()
+
+			m.MinimockGetApartmentsInBuildingsInspect()
+
+			m.MinimockGetApartmentsOnFloorInspect()
+
+			m.MinimockGetBuildingStatsInspect()
+
+			m.MinimockGetStatsInspect()
+
+			m.MinimockStreamApartmentsInspect()
+
+			m.MinimockStreamApartmentsInBuildingInspect()
 		}
 	})
 }
@@ -1693,5 +3712,11 @@ func (m *ApartmentsServiceMock) minimockDone() bool {
 		m.MinimockDeleteApartmentDone() &&
 		m.MinimockGetApartmentDone() &&
 		m.MinimockGetApartmentsDone() &&
-		m.MinimockGetApartmentsInBuildingDone()
+		m.MinimockGetApartmentsInBuildingDone() &&
+		m.MinimockGetApartmentsInBuildingsDone() &&
+		m.MinimockGetApartmentsOnFloorDone() &&
+		m.MinimockGetBuildingStatsDone() &&
+		m.MinimockGetStatsDone() &&
+		m.MinimockStreamApartmentsDone() &&
+		m.MinimockStreamApartmentsInBuildingDone()
 }
@@ -11,7 +11,10 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
 )
 
 // BuildingsServiceMock implements buildings.BuildingsService
@@ -31,6 +34,12 @@ type BuildingsServiceMock struct {
 	beforeDeleteBuildingCounter uint64
 	DeleteBuildingMock          mBuildingsServiceMockDeleteBuilding
 
+	funcFindBuildings          func(ctx context.Context, filter address.Filter, order address.Order) (b1 models.BuildingSlice, err error)
+	inspectFuncFindBuildings   func(ctx context.Context, filter address.Filter, order address.Order)
+	afterFindBuildingsCounter  uint64
+	beforeFindBuildingsCounter uint64
+	FindBuildingsMock          mBuildingsServiceMockFindBuildings
+
 	funcGetBuilding          func(ctx context.Context, id int) (bp1 *models.Building, err error)
 	inspectFuncGetBuilding   func(ctx context.Context, id int)
 	afterGetBuildingCounter  uint64
@@ -42,6 +51,24 @@ type BuildingsServiceMock struct {
 	afterGetBuildingsCounter  uint64
 	beforeGetBuildingsCounter uint64
 	GetBuildingsMock          mBuildingsServiceMockGetBuildings
+
+	funcGetBuildingsByIDs          func(ctx context.Context, ids []int) (b1 models.BuildingSlice, err error)
+	inspectFuncGetBuildingsByIDs   func(ctx context.Context, ids []int)
+	afterGetBuildingsByIDsCounter  uint64
+	beforeGetBuildingsByIDsCounter uint64
+	GetBuildingsByIDsMock          mBuildingsServiceMockGetBuildingsByIDs
+
+	funcGetBuildingsInBox          func(ctx context.Context, box geo.Box, limit int) (b1 models.BuildingSlice, err error)
+	inspectFuncGetBuildingsInBox   func(ctx context.Context, box geo.Box, limit int)
+	afterGetBuildingsInBoxCounter  uint64
+	beforeGetBuildingsInBoxCounter uint64
+	GetBuildingsInBoxMock          mBuildingsServiceMockGetBuildingsInBox
+
+	funcGetBuildingsNearby          func(ctx context.Context, center geo.Point, radius float64, limit int) (npa1 []*storage.NearbyBuilding, err error)
+	inspectFuncGetBuildingsNearby   func(ctx context.Context, center geo.Point, radius float64, limit int)
+	afterGetBuildingsNearbyCounter  uint64
+	beforeGetBuildingsNearbyCounter uint64
+	GetBuildingsNearbyMock          mBuildingsServiceMockGetBuildingsNearby
 }
 
 // NewBuildingsServiceMock returns a mock for buildings.BuildingsService
@@ -58,12 +85,24 @@ func NewBuildingsServiceMock(t minimock.Tester) *BuildingsServiceMock {
 	m.DeleteBuildingMock = mBuildingsServiceMockDeleteBuilding{mock: m}
 	m.DeleteBuildingMock.callArgs = []*BuildingsServiceMockDeleteBuildingParams{}
 
+	m.FindBuildingsMock = mBuildingsServiceMockFindBuildings{mock: m}
+	m.FindBuildingsMock.callArgs = []*BuildingsServiceMockFindBuildingsParams{}
+
 	m.GetBuildingMock = mBuildingsServiceMockGetBuilding{mock: m}
 	m.GetBuildingMock.callArgs = []*BuildingsServiceMockGetBuildingParams{}
 
 	m.GetBuildingsMock = mBuildingsServiceMockGetBuildings{mock: m}
 	m.GetBuildingsMock.callArgs = []*BuildingsServiceMockGetBuildingsParams{}
 
+	m.GetBuildingsByIDsMock = mBuildingsServiceMockGetBuildingsByIDs{mock: m}
+	m.GetBuildingsByIDsMock.callArgs = []*BuildingsServiceMockGetBuildingsByIDsParams{}
+
+	m.GetBuildingsInBoxMock = mBuildingsServiceMockGetBuildingsInBox{mock: m}
+	m.GetBuildingsInBoxMock.callArgs = []*BuildingsServiceMockGetBuildingsInBoxParams{}
+
+	m.GetBuildingsNearbyMock = mBuildingsServiceMockGetBuildingsNearby{mock: m}
+	m.GetBuildingsNearbyMock.callArgs = []*BuildingsServiceMockGetBuildingsNearbyParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -709,6 +748,355 @@ func (m *BuildingsServiceMock) MinimockDeleteBuildingInspect() {
 	}
 }
 
+type mBuildingsServiceMockFindBuildings struct {
+	optional           bool
+	mock               *BuildingsServiceMock
+	defaultExpectation *BuildingsServiceMockFindBuildingsExpectation
+	expectations       []*BuildingsServiceMockFindBuildingsExpectation
+
+	callArgs []*BuildingsServiceMockFindBuildingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BuildingsServiceMockFindBuildingsExpectation specifies expectation struct of the BuildingsService.FindBuildings
+type BuildingsServiceMockFindBuildingsExpectation struct {
+	mock      *BuildingsServiceMock
+	params    *BuildingsServiceMockFindBuildingsParams
+	paramPtrs *BuildingsServiceMockFindBuildingsParamPtrs
+	results   *BuildingsServiceMockFindBuildingsResults
+	Counter   uint64
+}
+
+// BuildingsServiceMockFindBuildingsParams contains parameters of the BuildingsService.FindBuildings
+type BuildingsServiceMockFindBuildingsParams struct {
+	ctx    context.Context
+	filter address.Filter
+	order  address.Order
+}
+
+// BuildingsServiceMockFindBuildingsParamPtrs contains pointers to parameters of the BuildingsService.FindBuildings
+type BuildingsServiceMockFindBuildingsParamPtrs struct {
+	ctx    *context.Context
+	filter *address.Filter
+	order  *address.Order
+}
+
+// BuildingsServiceMockFindBuildingsResults contains results of the BuildingsService.FindBuildings
+type BuildingsServiceMockFindBuildingsResults struct {
+	b1  models.BuildingSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Optional() *mBuildingsServiceMockFindBuildings {
+	mmFindBuildings.optional = true
+	return mmFindBuildings
+}
+
+// Expect sets up expected params for BuildingsService.FindBuildings
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Expect(ctx context.Context, filter address.Filter, order address.Order) *mBuildingsServiceMockFindBuildings {
+	if mmFindBuildings.mock.funcFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Set")
+	}
+
+	if mmFindBuildings.defaultExpectation == nil {
+		mmFindBuildings.defaultExpectation = &BuildingsServiceMockFindBuildingsExpectation{}
+	}
+
+	if mmFindBuildings.defaultExpectation.paramPtrs != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by ExpectParams functions")
+	}
+
+	mmFindBuildings.defaultExpectation.params = &BuildingsServiceMockFindBuildingsParams{ctx, filter, order}
+	for _, e := range mmFindBuildings.expectations {
+		if minimock.Equal(e.params, mmFindBuildings.defaultExpectation.params) {
+			mmFindBuildings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindBuildings.defaultExpectation.params)
+		}
+	}
+
+	return mmFindBuildings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BuildingsService.FindBuildings
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) ExpectCtxParam1(ctx context.Context) *mBuildingsServiceMockFindBuildings {
+	if mmFindBuildings.mock.funcFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Set")
+	}
+
+	if mmFindBuildings.defaultExpectation == nil {
+		mmFindBuildings.defaultExpectation = &BuildingsServiceMockFindBuildingsExpectation{}
+	}
+
+	if mmFindBuildings.defaultExpectation.params != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Expect")
+	}
+
+	if mmFindBuildings.defaultExpectation.paramPtrs == nil {
+		mmFindBuildings.defaultExpectation.paramPtrs = &BuildingsServiceMockFindBuildingsParamPtrs{}
+	}
+	mmFindBuildings.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFindBuildings
+}
+
+// ExpectFilterParam2 sets up expected param filter for BuildingsService.FindBuildings
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) ExpectFilterParam2(filter address.Filter) *mBuildingsServiceMockFindBuildings {
+	if mmFindBuildings.mock.funcFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Set")
+	}
+
+	if mmFindBuildings.defaultExpectation == nil {
+		mmFindBuildings.defaultExpectation = &BuildingsServiceMockFindBuildingsExpectation{}
+	}
+
+	if mmFindBuildings.defaultExpectation.params != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Expect")
+	}
+
+	if mmFindBuildings.defaultExpectation.paramPtrs == nil {
+		mmFindBuildings.defaultExpectation.paramPtrs = &BuildingsServiceMockFindBuildingsParamPtrs{}
+	}
+	mmFindBuildings.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmFindBuildings
+}
+
+// ExpectOrderParam3 sets up expected param order for BuildingsService.FindBuildings
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) ExpectOrderParam3(order address.Order) *mBuildingsServiceMockFindBuildings {
+	if mmFindBuildings.mock.funcFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Set")
+	}
+
+	if mmFindBuildings.defaultExpectation == nil {
+		mmFindBuildings.defaultExpectation = &BuildingsServiceMockFindBuildingsExpectation{}
+	}
+
+	if mmFindBuildings.defaultExpectation.params != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Expect")
+	}
+
+	if mmFindBuildings.defaultExpectation.paramPtrs == nil {
+		mmFindBuildings.defaultExpectation.paramPtrs = &BuildingsServiceMockFindBuildingsParamPtrs{}
+	}
+	mmFindBuildings.defaultExpectation.paramPtrs.order = &order
+
+	return mmFindBuildings
+}
+
+// Inspect accepts an inspector function that has same arguments as the BuildingsService.FindBuildings
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Inspect(f func(ctx context.Context, filter address.Filter, order address.Order)) *mBuildingsServiceMockFindBuildings {
+	if mmFindBuildings.mock.inspectFuncFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("Inspect function is already set for BuildingsServiceMock.FindBuildings")
+	}
+
+	mmFindBuildings.mock.inspectFuncFindBuildings = f
+
+	return mmFindBuildings
+}
+
+// Return sets up results that will be returned by BuildingsService.FindBuildings
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Return(b1 models.BuildingSlice, err error) *BuildingsServiceMock {
+	if mmFindBuildings.mock.funcFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Set")
+	}
+
+	if mmFindBuildings.defaultExpectation == nil {
+		mmFindBuildings.defaultExpectation = &BuildingsServiceMockFindBuildingsExpectation{mock: mmFindBuildings.mock}
+	}
+	mmFindBuildings.defaultExpectation.results = &BuildingsServiceMockFindBuildingsResults{b1, err}
+	return mmFindBuildings.mock
+}
+
+// Set uses given function f to mock the BuildingsService.FindBuildings method
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Set(f func(ctx context.Context, filter address.Filter, order address.Order) (b1 models.BuildingSlice, err error)) *BuildingsServiceMock {
+	if mmFindBuildings.defaultExpectation != nil {
+		mmFindBuildings.mock.t.Fatalf("Default expectation is already set for the BuildingsService.FindBuildings method")
+	}
+
+	if len(mmFindBuildings.expectations) > 0 {
+		mmFindBuildings.mock.t.Fatalf("Some expectations are already set for the BuildingsService.FindBuildings method")
+	}
+
+	mmFindBuildings.mock.funcFindBuildings = f
+	return mmFindBuildings.mock
+}
+
+// When sets expectation for the BuildingsService.FindBuildings which will trigger the result defined by the following
+// Then helper
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) When(ctx context.Context, filter address.Filter, order address.Order) *BuildingsServiceMockFindBuildingsExpectation {
+	if mmFindBuildings.mock.funcFindBuildings != nil {
+		mmFindBuildings.mock.t.Fatalf("BuildingsServiceMock.FindBuildings mock is already set by Set")
+	}
+
+	expectation := &BuildingsServiceMockFindBuildingsExpectation{
+		mock:   mmFindBuildings.mock,
+		params: &BuildingsServiceMockFindBuildingsParams{ctx, filter, order},
+	}
+	mmFindBuildings.expectations = append(mmFindBuildings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BuildingsService.FindBuildings return parameters for the expectation previously defined by the When method
+func (e *BuildingsServiceMockFindBuildingsExpectation) Then(b1 models.BuildingSlice, err error) *BuildingsServiceMock {
+	e.results = &BuildingsServiceMockFindBuildingsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times BuildingsService.FindBuildings should be invoked
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Times(n uint64) *mBuildingsServiceMockFindBuildings {
+	if n == 0 {
+		mmFindBuildings.mock.t.Fatalf("Times of BuildingsServiceMock.FindBuildings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindBuildings.expectedInvocations, n)
+	return mmFindBuildings
+}
+
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) invocationsDone() bool {
+	if len(mmFindBuildings.expectations) == 0 && mmFindBuildings.defaultExpectation == nil && mmFindBuildings.mock.funcFindBuildings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindBuildings.mock.afterFindBuildingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindBuildings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindBuildings implements buildings.BuildingsService
+func (mmFindBuildings *BuildingsServiceMock) FindBuildings(ctx context.Context, filter address.Filter, order address.Order) (b1 models.BuildingSlice, err error) {
+	mm_atomic.AddUint64(&mmFindBuildings.beforeFindBuildingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindBuildings.afterFindBuildingsCounter, 1)
+
+	if mmFindBuildings.inspectFuncFindBuildings != nil {
+		mmFindBuildings.inspectFuncFindBuildings(ctx, filter, order)
+	}
+
+	mm_params := BuildingsServiceMockFindBuildingsParams{ctx, filter, order}
+
+	// Record call args
+	mmFindBuildings.FindBuildingsMock.mutex.Lock()
+	mmFindBuildings.FindBuildingsMock.callArgs = append(mmFindBuildings.FindBuildingsMock.callArgs, &mm_params)
+	mmFindBuildings.FindBuildingsMock.mutex.Unlock()
+
+	for _, e := range mmFindBuildings.FindBuildingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmFindBuildings.FindBuildingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindBuildings.FindBuildingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindBuildings.FindBuildingsMock.defaultExpectation.params
+		mm_want_ptrs := mmFindBuildings.FindBuildingsMock.defaultExpectation.paramPtrs
+
+		mm_got := BuildingsServiceMockFindBuildingsParams{ctx, filter, order}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindBuildings.t.Errorf("BuildingsServiceMock.FindBuildings got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmFindBuildings.t.Errorf("BuildingsServiceMock.FindBuildings got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmFindBuildings.t.Errorf("BuildingsServiceMock.FindBuildings got unexpected parameter order, want: %#v, got: %#v%s\n", *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindBuildings.t.Errorf("BuildingsServiceMock.FindBuildings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindBuildings.FindBuildingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindBuildings.t.Fatal("No results are set for the BuildingsServiceMock.FindBuildings")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmFindBuildings.funcFindBuildings != nil {
+		return mmFindBuildings.funcFindBuildings(ctx, filter, order)
+	}
+	mmFindBuildings.t.Fatalf("Unexpected call to BuildingsServiceMock.FindBuildings. %v %v %v", ctx, filter, order)
+	return
+}
+
+// FindBuildingsAfterCounter returns a count of finished BuildingsServiceMock.FindBuildings invocations
+func (mmFindBuildings *BuildingsServiceMock) FindBuildingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindBuildings.afterFindBuildingsCounter)
+}
+
+// FindBuildingsBeforeCounter returns a count of BuildingsServiceMock.FindBuildings invocations
+func (mmFindBuildings *BuildingsServiceMock) FindBuildingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindBuildings.beforeFindBuildingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BuildingsServiceMock.FindBuildings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindBuildings *mBuildingsServiceMockFindBuildings) Calls() []*BuildingsServiceMockFindBuildingsParams {
+	mmFindBuildings.mutex.RLock()
+
+	argCopy := make([]*BuildingsServiceMockFindBuildingsParams, len(mmFindBuildings.callArgs))
+	copy(argCopy, mmFindBuildings.callArgs)
+
+	mmFindBuildings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindBuildingsDone returns true if the count of the FindBuildings invocations corresponds
+// the number of defined expectations
+func (m *BuildingsServiceMock) MinimockFindBuildingsDone() bool {
+	if m.FindBuildingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindBuildingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindBuildingsMock.invocationsDone()
+}
+
+// MinimockFindBuildingsInspect logs each unmet expectation
+func (m *BuildingsServiceMock) MinimockFindBuildingsInspect() {
+	for _, e := range m.FindBuildingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BuildingsServiceMock.FindBuildings with params: %#v", *e.params)
+		}
+	}
+
+	afterFindBuildingsCounter := mm_atomic.LoadUint64(&m.afterFindBuildingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindBuildingsMock.defaultExpectation != nil && afterFindBuildingsCounter < 1 {
+		if m.FindBuildingsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BuildingsServiceMock.FindBuildings")
+		} else {
+			m.t.Errorf("Expected call to BuildingsServiceMock.FindBuildings with params: %#v", *m.FindBuildingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindBuildings != nil && afterFindBuildingsCounter < 1 {
+		m.t.Error("Expected call to BuildingsServiceMock.FindBuildings")
+	}
+
+	if !m.FindBuildingsMock.invocationsDone() && afterFindBuildingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BuildingsServiceMock.FindBuildings but found %d calls",
+			mm_atomic.LoadUint64(&m.FindBuildingsMock.expectedInvocations), afterFindBuildingsCounter)
+	}
+}
+
 type mBuildingsServiceMockGetBuilding struct {
 	optional           bool
 	mock               *BuildingsServiceMock
@@ -1323,17 +1711,1072 @@ func (m *BuildingsServiceMock) MinimockGetBuildingsInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *BuildingsServiceMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateBuildingInspect()
+type mBuildingsServiceMockGetBuildingsByIDs struct {
+	optional           bool
+	mock               *BuildingsServiceMock
+	defaultExpectation *BuildingsServiceMockGetBuildingsByIDsExpectation
+	expectations       []*BuildingsServiceMockGetBuildingsByIDsExpectation
 
-			m.MinimockDeleteBuildingInspect()
+	callArgs []*BuildingsServiceMockGetBuildingsByIDsParams
+	mutex    sync.RWMutex
 
-			m.MinimockGetBuildingInspect()
+	expectedInvocations uint64
+}
 
-			m.MinimockGetBuildingsInspect()
+// BuildingsServiceMockGetBuildingsByIDsExpectation specifies expectation struct of the BuildingsService.GetBuildingsByIDs
+type BuildingsServiceMockGetBuildingsByIDsExpectation struct {
+	mock      *BuildingsServiceMock
+	params    *BuildingsServiceMockGetBuildingsByIDsParams
+	paramPtrs *BuildingsServiceMockGetBuildingsByIDsParamPtrs
+	results   *BuildingsServiceMockGetBuildingsByIDsResults
+	Counter   uint64
+}
+
+// BuildingsServiceMockGetBuildingsByIDsParams contains parameters of the BuildingsService.GetBuildingsByIDs
+type BuildingsServiceMockGetBuildingsByIDsParams struct {
+	ctx context.Context
+	ids []int
+}
+
+// BuildingsServiceMockGetBuildingsByIDsParamPtrs contains pointers to parameters of the BuildingsService.GetBuildingsByIDs
+type BuildingsServiceMockGetBuildingsByIDsParamPtrs struct {
+	ctx *context.Context
+	ids *[]int
+}
+
+// BuildingsServiceMockGetBuildingsByIDsResults contains results of the BuildingsService.GetBuildingsByIDs
+type BuildingsServiceMockGetBuildingsByIDsResults struct {
+	b1  models.BuildingSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Optional() *mBuildingsServiceMockGetBuildingsByIDs {
+	mmGetBuildingsByIDs.optional = true
+	return mmGetBuildingsByIDs
+}
+
+// Expect sets up expected params for BuildingsService.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Expect(ctx context.Context, ids []int) *mBuildingsServiceMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsServiceMockGetBuildingsByIDsExpectation{}
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.paramPtrs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by ExpectParams functions")
+	}
+
+	mmGetBuildingsByIDs.defaultExpectation.params = &BuildingsServiceMockGetBuildingsByIDsParams{ctx, ids}
+	for _, e := range mmGetBuildingsByIDs.expectations {
+		if minimock.Equal(e.params, mmGetBuildingsByIDs.defaultExpectation.params) {
+			mmGetBuildingsByIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBuildingsByIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBuildingsByIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BuildingsService.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) ExpectCtxParam1(ctx context.Context) *mBuildingsServiceMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsServiceMockGetBuildingsByIDsExpectation{}
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.params != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Expect")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsByIDs.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsByIDsParamPtrs{}
+	}
+	mmGetBuildingsByIDs.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBuildingsByIDs
+}
+
+// ExpectIdsParam2 sets up expected param ids for BuildingsService.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) ExpectIdsParam2(ids []int) *mBuildingsServiceMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsServiceMockGetBuildingsByIDsExpectation{}
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.params != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Expect")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsByIDs.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsByIDsParamPtrs{}
+	}
+	mmGetBuildingsByIDs.defaultExpectation.paramPtrs.ids = &ids
+
+	return mmGetBuildingsByIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the BuildingsService.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Inspect(f func(ctx context.Context, ids []int)) *mBuildingsServiceMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.inspectFuncGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Inspect function is already set for BuildingsServiceMock.GetBuildingsByIDs")
+	}
+
+	mmGetBuildingsByIDs.mock.inspectFuncGetBuildingsByIDs = f
+
+	return mmGetBuildingsByIDs
+}
+
+// Return sets up results that will be returned by BuildingsService.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Return(b1 models.BuildingSlice, err error) *BuildingsServiceMock {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsServiceMockGetBuildingsByIDsExpectation{mock: mmGetBuildingsByIDs.mock}
+	}
+	mmGetBuildingsByIDs.defaultExpectation.results = &BuildingsServiceMockGetBuildingsByIDsResults{b1, err}
+	return mmGetBuildingsByIDs.mock
+}
+
+// Set uses given function f to mock the BuildingsService.GetBuildingsByIDs method
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Set(f func(ctx context.Context, ids []int) (b1 models.BuildingSlice, err error)) *BuildingsServiceMock {
+	if mmGetBuildingsByIDs.defaultExpectation != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Default expectation is already set for the BuildingsService.GetBuildingsByIDs method")
+	}
+
+	if len(mmGetBuildingsByIDs.expectations) > 0 {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Some expectations are already set for the BuildingsService.GetBuildingsByIDs method")
+	}
+
+	mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs = f
+	return mmGetBuildingsByIDs.mock
+}
+
+// When sets expectation for the BuildingsService.GetBuildingsByIDs which will trigger the result defined by the following
+// Then helper
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) When(ctx context.Context, ids []int) *BuildingsServiceMockGetBuildingsByIDsExpectation {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	expectation := &BuildingsServiceMockGetBuildingsByIDsExpectation{
+		mock:   mmGetBuildingsByIDs.mock,
+		params: &BuildingsServiceMockGetBuildingsByIDsParams{ctx, ids},
+	}
+	mmGetBuildingsByIDs.expectations = append(mmGetBuildingsByIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BuildingsService.GetBuildingsByIDs return parameters for the expectation previously defined by the When method
+func (e *BuildingsServiceMockGetBuildingsByIDsExpectation) Then(b1 models.BuildingSlice, err error) *BuildingsServiceMock {
+	e.results = &BuildingsServiceMockGetBuildingsByIDsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times BuildingsService.GetBuildingsByIDs should be invoked
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Times(n uint64) *mBuildingsServiceMockGetBuildingsByIDs {
+	if n == 0 {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Times of BuildingsServiceMock.GetBuildingsByIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBuildingsByIDs.expectedInvocations, n)
+	return mmGetBuildingsByIDs
+}
+
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) invocationsDone() bool {
+	if len(mmGetBuildingsByIDs.expectations) == 0 && mmGetBuildingsByIDs.defaultExpectation == nil && mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBuildingsByIDs.mock.afterGetBuildingsByIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBuildingsByIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBuildingsByIDs implements buildings.BuildingsService
+func (mmGetBuildingsByIDs *BuildingsServiceMock) GetBuildingsByIDs(ctx context.Context, ids []int) (b1 models.BuildingSlice, err error) {
+	mm_atomic.AddUint64(&mmGetBuildingsByIDs.beforeGetBuildingsByIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBuildingsByIDs.afterGetBuildingsByIDsCounter, 1)
+
+	if mmGetBuildingsByIDs.inspectFuncGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.inspectFuncGetBuildingsByIDs(ctx, ids)
+	}
+
+	mm_params := BuildingsServiceMockGetBuildingsByIDsParams{ctx, ids}
+
+	// Record call args
+	mmGetBuildingsByIDs.GetBuildingsByIDsMock.mutex.Lock()
+	mmGetBuildingsByIDs.GetBuildingsByIDsMock.callArgs = append(mmGetBuildingsByIDs.GetBuildingsByIDsMock.callArgs, &mm_params)
+	mmGetBuildingsByIDs.GetBuildingsByIDsMock.mutex.Unlock()
+
+	for _, e := range mmGetBuildingsByIDs.GetBuildingsByIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := BuildingsServiceMockGetBuildingsByIDsParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBuildingsByIDs.t.Errorf("BuildingsServiceMock.GetBuildingsByIDs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmGetBuildingsByIDs.t.Errorf("BuildingsServiceMock.GetBuildingsByIDs got unexpected parameter ids, want: %#v, got: %#v%s\n", *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBuildingsByIDs.t.Errorf("BuildingsServiceMock.GetBuildingsByIDs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBuildingsByIDs.t.Fatal("No results are set for the BuildingsServiceMock.GetBuildingsByIDs")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmGetBuildingsByIDs.funcGetBuildingsByIDs != nil {
+		return mmGetBuildingsByIDs.funcGetBuildingsByIDs(ctx, ids)
+	}
+	mmGetBuildingsByIDs.t.Fatalf("Unexpected call to BuildingsServiceMock.GetBuildingsByIDs. %v %v", ctx, ids)
+	return
+}
+
+// GetBuildingsByIDsAfterCounter returns a count of finished BuildingsServiceMock.GetBuildingsByIDs invocations
+func (mmGetBuildingsByIDs *BuildingsServiceMock) GetBuildingsByIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsByIDs.afterGetBuildingsByIDsCounter)
+}
+
+// GetBuildingsByIDsBeforeCounter returns a count of BuildingsServiceMock.GetBuildingsByIDs invocations
+func (mmGetBuildingsByIDs *BuildingsServiceMock) GetBuildingsByIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsByIDs.beforeGetBuildingsByIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BuildingsServiceMock.GetBuildingsByIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBuildingsByIDs *mBuildingsServiceMockGetBuildingsByIDs) Calls() []*BuildingsServiceMockGetBuildingsByIDsParams {
+	mmGetBuildingsByIDs.mutex.RLock()
+
+	argCopy := make([]*BuildingsServiceMockGetBuildingsByIDsParams, len(mmGetBuildingsByIDs.callArgs))
+	copy(argCopy, mmGetBuildingsByIDs.callArgs)
+
+	mmGetBuildingsByIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBuildingsByIDsDone returns true if the count of the GetBuildingsByIDs invocations corresponds
+// the number of defined expectations
+func (m *BuildingsServiceMock) MinimockGetBuildingsByIDsDone() bool {
+	if m.GetBuildingsByIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBuildingsByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBuildingsByIDsMock.invocationsDone()
+}
+
+// MinimockGetBuildingsByIDsInspect logs each unmet expectation
+func (m *BuildingsServiceMock) MinimockGetBuildingsByIDsInspect() {
+	for _, e := range m.GetBuildingsByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BuildingsServiceMock.GetBuildingsByIDs with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBuildingsByIDsCounter := mm_atomic.LoadUint64(&m.afterGetBuildingsByIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBuildingsByIDsMock.defaultExpectation != nil && afterGetBuildingsByIDsCounter < 1 {
+		if m.GetBuildingsByIDsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BuildingsServiceMock.GetBuildingsByIDs")
+		} else {
+			m.t.Errorf("Expected call to BuildingsServiceMock.GetBuildingsByIDs with params: %#v", *m.GetBuildingsByIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBuildingsByIDs != nil && afterGetBuildingsByIDsCounter < 1 {
+		m.t.Error("Expected call to BuildingsServiceMock.GetBuildingsByIDs")
+	}
+
+	if !m.GetBuildingsByIDsMock.invocationsDone() && afterGetBuildingsByIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BuildingsServiceMock.GetBuildingsByIDs but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBuildingsByIDsMock.expectedInvocations), afterGetBuildingsByIDsCounter)
+	}
+}
+
+type mBuildingsServiceMockGetBuildingsInBox struct {
+	optional           bool
+	mock               *BuildingsServiceMock
+	defaultExpectation *BuildingsServiceMockGetBuildingsInBoxExpectation
+	expectations       []*BuildingsServiceMockGetBuildingsInBoxExpectation
+
+	callArgs []*BuildingsServiceMockGetBuildingsInBoxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BuildingsServiceMockGetBuildingsInBoxExpectation specifies expectation struct of the BuildingsService.GetBuildingsInBox
+type BuildingsServiceMockGetBuildingsInBoxExpectation struct {
+	mock      *BuildingsServiceMock
+	params    *BuildingsServiceMockGetBuildingsInBoxParams
+	paramPtrs *BuildingsServiceMockGetBuildingsInBoxParamPtrs
+	results   *BuildingsServiceMockGetBuildingsInBoxResults
+	Counter   uint64
+}
+
+// BuildingsServiceMockGetBuildingsInBoxParams contains parameters of the BuildingsService.GetBuildingsInBox
+type BuildingsServiceMockGetBuildingsInBoxParams struct {
+	ctx   context.Context
+	box   geo.Box
+	limit int
+}
+
+// BuildingsServiceMockGetBuildingsInBoxParamPtrs contains pointers to parameters of the BuildingsService.GetBuildingsInBox
+type BuildingsServiceMockGetBuildingsInBoxParamPtrs struct {
+	ctx   *context.Context
+	box   *geo.Box
+	limit *int
+}
+
+// BuildingsServiceMockGetBuildingsInBoxResults contains results of the BuildingsService.GetBuildingsInBox
+type BuildingsServiceMockGetBuildingsInBoxResults struct {
+	b1  models.BuildingSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Optional() *mBuildingsServiceMockGetBuildingsInBox {
+	mmGetBuildingsInBox.optional = true
+	return mmGetBuildingsInBox
+}
+
+// Expect sets up expected params for BuildingsService.GetBuildingsInBox
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Expect(ctx context.Context, box geo.Box, limit int) *mBuildingsServiceMockGetBuildingsInBox {
+	if mmGetBuildingsInBox.mock.funcGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Set")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation == nil {
+		mmGetBuildingsInBox.defaultExpectation = &BuildingsServiceMockGetBuildingsInBoxExpectation{}
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.paramPtrs != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by ExpectParams functions")
+	}
+
+	mmGetBuildingsInBox.defaultExpectation.params = &BuildingsServiceMockGetBuildingsInBoxParams{ctx, box, limit}
+	for _, e := range mmGetBuildingsInBox.expectations {
+		if minimock.Equal(e.params, mmGetBuildingsInBox.defaultExpectation.params) {
+			mmGetBuildingsInBox.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBuildingsInBox.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBuildingsInBox
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BuildingsService.GetBuildingsInBox
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) ExpectCtxParam1(ctx context.Context) *mBuildingsServiceMockGetBuildingsInBox {
+	if mmGetBuildingsInBox.mock.funcGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Set")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation == nil {
+		mmGetBuildingsInBox.defaultExpectation = &BuildingsServiceMockGetBuildingsInBoxExpectation{}
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.params != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Expect")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsInBox.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsInBoxParamPtrs{}
+	}
+	mmGetBuildingsInBox.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBuildingsInBox
+}
+
+// ExpectBoxParam2 sets up expected param box for BuildingsService.GetBuildingsInBox
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) ExpectBoxParam2(box geo.Box) *mBuildingsServiceMockGetBuildingsInBox {
+	if mmGetBuildingsInBox.mock.funcGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Set")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation == nil {
+		mmGetBuildingsInBox.defaultExpectation = &BuildingsServiceMockGetBuildingsInBoxExpectation{}
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.params != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Expect")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsInBox.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsInBoxParamPtrs{}
+	}
+	mmGetBuildingsInBox.defaultExpectation.paramPtrs.box = &box
+
+	return mmGetBuildingsInBox
+}
+
+// ExpectLimitParam3 sets up expected param limit for BuildingsService.GetBuildingsInBox
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) ExpectLimitParam3(limit int) *mBuildingsServiceMockGetBuildingsInBox {
+	if mmGetBuildingsInBox.mock.funcGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Set")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation == nil {
+		mmGetBuildingsInBox.defaultExpectation = &BuildingsServiceMockGetBuildingsInBoxExpectation{}
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.params != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Expect")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsInBox.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsInBoxParamPtrs{}
+	}
+	mmGetBuildingsInBox.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmGetBuildingsInBox
+}
+
+// Inspect accepts an inspector function that has same arguments as the BuildingsService.GetBuildingsInBox
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Inspect(f func(ctx context.Context, box geo.Box, limit int)) *mBuildingsServiceMockGetBuildingsInBox {
+	if mmGetBuildingsInBox.mock.inspectFuncGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("Inspect function is already set for BuildingsServiceMock.GetBuildingsInBox")
+	}
+
+	mmGetBuildingsInBox.mock.inspectFuncGetBuildingsInBox = f
+
+	return mmGetBuildingsInBox
+}
+
+// Return sets up results that will be returned by BuildingsService.GetBuildingsInBox
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Return(b1 models.BuildingSlice, err error) *BuildingsServiceMock {
+	if mmGetBuildingsInBox.mock.funcGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Set")
+	}
+
+	if mmGetBuildingsInBox.defaultExpectation == nil {
+		mmGetBuildingsInBox.defaultExpectation = &BuildingsServiceMockGetBuildingsInBoxExpectation{mock: mmGetBuildingsInBox.mock}
+	}
+	mmGetBuildingsInBox.defaultExpectation.results = &BuildingsServiceMockGetBuildingsInBoxResults{b1, err}
+	return mmGetBuildingsInBox.mock
+}
+
+// Set uses given function f to mock the BuildingsService.GetBuildingsInBox method
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Set(f func(ctx context.Context, box geo.Box, limit int) (b1 models.BuildingSlice, err error)) *BuildingsServiceMock {
+	if mmGetBuildingsInBox.defaultExpectation != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("Default expectation is already set for the BuildingsService.GetBuildingsInBox method")
+	}
+
+	if len(mmGetBuildingsInBox.expectations) > 0 {
+		mmGetBuildingsInBox.mock.t.Fatalf("Some expectations are already set for the BuildingsService.GetBuildingsInBox method")
+	}
+
+	mmGetBuildingsInBox.mock.funcGetBuildingsInBox = f
+	return mmGetBuildingsInBox.mock
+}
+
+// When sets expectation for the BuildingsService.GetBuildingsInBox which will trigger the result defined by the following
+// Then helper
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) When(ctx context.Context, box geo.Box, limit int) *BuildingsServiceMockGetBuildingsInBoxExpectation {
+	if mmGetBuildingsInBox.mock.funcGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsInBox mock is already set by Set")
+	}
+
+	expectation := &BuildingsServiceMockGetBuildingsInBoxExpectation{
+		mock:   mmGetBuildingsInBox.mock,
+		params: &BuildingsServiceMockGetBuildingsInBoxParams{ctx, box, limit},
+	}
+	mmGetBuildingsInBox.expectations = append(mmGetBuildingsInBox.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BuildingsService.GetBuildingsInBox return parameters for the expectation previously defined by the When method
+func (e *BuildingsServiceMockGetBuildingsInBoxExpectation) Then(b1 models.BuildingSlice, err error) *BuildingsServiceMock {
+	e.results = &BuildingsServiceMockGetBuildingsInBoxResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times BuildingsService.GetBuildingsInBox should be invoked
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Times(n uint64) *mBuildingsServiceMockGetBuildingsInBox {
+	if n == 0 {
+		mmGetBuildingsInBox.mock.t.Fatalf("Times of BuildingsServiceMock.GetBuildingsInBox mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBuildingsInBox.expectedInvocations, n)
+	return mmGetBuildingsInBox
+}
+
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) invocationsDone() bool {
+	if len(mmGetBuildingsInBox.expectations) == 0 && mmGetBuildingsInBox.defaultExpectation == nil && mmGetBuildingsInBox.mock.funcGetBuildingsInBox == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBuildingsInBox.mock.afterGetBuildingsInBoxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBuildingsInBox.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBuildingsInBox implements buildings.BuildingsService
+func (mmGetBuildingsInBox *BuildingsServiceMock) GetBuildingsInBox(ctx context.Context, box geo.Box, limit int) (b1 models.BuildingSlice, err error) {
+	mm_atomic.AddUint64(&mmGetBuildingsInBox.beforeGetBuildingsInBoxCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBuildingsInBox.afterGetBuildingsInBoxCounter, 1)
+
+	if mmGetBuildingsInBox.inspectFuncGetBuildingsInBox != nil {
+		mmGetBuildingsInBox.inspectFuncGetBuildingsInBox(ctx, box, limit)
+	}
+
+	mm_params := BuildingsServiceMockGetBuildingsInBoxParams{ctx, box, limit}
+
+	// Record call args
+	mmGetBuildingsInBox.GetBuildingsInBoxMock.mutex.Lock()
+	mmGetBuildingsInBox.GetBuildingsInBoxMock.callArgs = append(mmGetBuildingsInBox.GetBuildingsInBoxMock.callArgs, &mm_params)
+	mmGetBuildingsInBox.GetBuildingsInBoxMock.mutex.Unlock()
+
+	for _, e := range mmGetBuildingsInBox.GetBuildingsInBoxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmGetBuildingsInBox.GetBuildingsInBoxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBuildingsInBox.GetBuildingsInBoxMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBuildingsInBox.GetBuildingsInBoxMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBuildingsInBox.GetBuildingsInBoxMock.defaultExpectation.paramPtrs
+
+		mm_got := BuildingsServiceMockGetBuildingsInBoxParams{ctx, box, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBuildingsInBox.t.Errorf("BuildingsServiceMock.GetBuildingsInBox got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.box != nil && !minimock.Equal(*mm_want_ptrs.box, mm_got.box) {
+				mmGetBuildingsInBox.t.Errorf("BuildingsServiceMock.GetBuildingsInBox got unexpected parameter box, want: %#v, got: %#v%s\n", *mm_want_ptrs.box, mm_got.box, minimock.Diff(*mm_want_ptrs.box, mm_got.box))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetBuildingsInBox.t.Errorf("BuildingsServiceMock.GetBuildingsInBox got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBuildingsInBox.t.Errorf("BuildingsServiceMock.GetBuildingsInBox got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBuildingsInBox.GetBuildingsInBoxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBuildingsInBox.t.Fatal("No results are set for the BuildingsServiceMock.GetBuildingsInBox")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmGetBuildingsInBox.funcGetBuildingsInBox != nil {
+		return mmGetBuildingsInBox.funcGetBuildingsInBox(ctx, box, limit)
+	}
+	mmGetBuildingsInBox.t.Fatalf("Unexpected call to BuildingsServiceMock.GetBuildingsInBox. %v %v %v", ctx, box, limit)
+	return
+}
+
+// GetBuildingsInBoxAfterCounter returns a count of finished BuildingsServiceMock.GetBuildingsInBox invocations
+func (mmGetBuildingsInBox *BuildingsServiceMock) GetBuildingsInBoxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsInBox.afterGetBuildingsInBoxCounter)
+}
+
+// GetBuildingsInBoxBeforeCounter returns a count of BuildingsServiceMock.GetBuildingsInBox invocations
+func (mmGetBuildingsInBox *BuildingsServiceMock) GetBuildingsInBoxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsInBox.beforeGetBuildingsInBoxCounter)
+}
+
+// Calls returns a list of arguments used in each call to BuildingsServiceMock.GetBuildingsInBox.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBuildingsInBox *mBuildingsServiceMockGetBuildingsInBox) Calls() []*BuildingsServiceMockGetBuildingsInBoxParams {
+	mmGetBuildingsInBox.mutex.RLock()
+
+	argCopy := make([]*BuildingsServiceMockGetBuildingsInBoxParams, len(mmGetBuildingsInBox.callArgs))
+	copy(argCopy, mmGetBuildingsInBox.callArgs)
+
+	mmGetBuildingsInBox.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBuildingsInBoxDone returns true if the count of the GetBuildingsInBox invocations corresponds
+// the number of defined expectations
+func (m *BuildingsServiceMock) MinimockGetBuildingsInBoxDone() bool {
+	if m.GetBuildingsInBoxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBuildingsInBoxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBuildingsInBoxMock.invocationsDone()
+}
+
+// MinimockGetBuildingsInBoxInspect logs each unmet expectation
+func (m *BuildingsServiceMock) MinimockGetBuildingsInBoxInspect() {
+	for _, e := range m.GetBuildingsInBoxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BuildingsServiceMock.GetBuildingsInBox with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBuildingsInBoxCounter := mm_atomic.LoadUint64(&m.afterGetBuildingsInBoxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBuildingsInBoxMock.defaultExpectation != nil && afterGetBuildingsInBoxCounter < 1 {
+		if m.GetBuildingsInBoxMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BuildingsServiceMock.GetBuildingsInBox")
+		} else {
+			m.t.Errorf("Expected call to BuildingsServiceMock.GetBuildingsInBox with params: %#v", *m.GetBuildingsInBoxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBuildingsInBox != nil && afterGetBuildingsInBoxCounter < 1 {
+		m.t.Error("Expected call to BuildingsServiceMock.GetBuildingsInBox")
+	}
+
+	if !m.GetBuildingsInBoxMock.invocationsDone() && afterGetBuildingsInBoxCounter > 0 {
+		m.t.Errorf("Expected %d calls to BuildingsServiceMock.GetBuildingsInBox but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBuildingsInBoxMock.expectedInvocations), afterGetBuildingsInBoxCounter)
+	}
+}
+
+type mBuildingsServiceMockGetBuildingsNearby struct {
+	optional           bool
+	mock               *BuildingsServiceMock
+	defaultExpectation *BuildingsServiceMockGetBuildingsNearbyExpectation
+	expectations       []*BuildingsServiceMockGetBuildingsNearbyExpectation
+
+	callArgs []*BuildingsServiceMockGetBuildingsNearbyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BuildingsServiceMockGetBuildingsNearbyExpectation specifies expectation struct of the BuildingsService.GetBuildingsNearby
+type BuildingsServiceMockGetBuildingsNearbyExpectation struct {
+	mock      *BuildingsServiceMock
+	params    *BuildingsServiceMockGetBuildingsNearbyParams
+	paramPtrs *BuildingsServiceMockGetBuildingsNearbyParamPtrs
+	results   *BuildingsServiceMockGetBuildingsNearbyResults
+	Counter   uint64
+}
+
+// BuildingsServiceMockGetBuildingsNearbyParams contains parameters of the BuildingsService.GetBuildingsNearby
+type BuildingsServiceMockGetBuildingsNearbyParams struct {
+	ctx    context.Context
+	center geo.Point
+	radius float64
+	limit  int
+}
+
+// BuildingsServiceMockGetBuildingsNearbyParamPtrs contains pointers to parameters of the BuildingsService.GetBuildingsNearby
+type BuildingsServiceMockGetBuildingsNearbyParamPtrs struct {
+	ctx    *context.Context
+	center *geo.Point
+	radius *float64
+	limit  *int
+}
+
+// BuildingsServiceMockGetBuildingsNearbyResults contains results of the BuildingsService.GetBuildingsNearby
+type BuildingsServiceMockGetBuildingsNearbyResults struct {
+	npa1 []*storage.NearbyBuilding
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Optional() *mBuildingsServiceMockGetBuildingsNearby {
+	mmGetBuildingsNearby.optional = true
+	return mmGetBuildingsNearby
+}
+
+// Expect sets up expected params for BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Expect(ctx context.Context, center geo.Point, radius float64, limit int) *mBuildingsServiceMockGetBuildingsNearby {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation == nil {
+		mmGetBuildingsNearby.defaultExpectation = &BuildingsServiceMockGetBuildingsNearbyExpectation{}
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.paramPtrs != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by ExpectParams functions")
+	}
+
+	mmGetBuildingsNearby.defaultExpectation.params = &BuildingsServiceMockGetBuildingsNearbyParams{ctx, center, radius, limit}
+	for _, e := range mmGetBuildingsNearby.expectations {
+		if minimock.Equal(e.params, mmGetBuildingsNearby.defaultExpectation.params) {
+			mmGetBuildingsNearby.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBuildingsNearby.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBuildingsNearby
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) ExpectCtxParam1(ctx context.Context) *mBuildingsServiceMockGetBuildingsNearby {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation == nil {
+		mmGetBuildingsNearby.defaultExpectation = &BuildingsServiceMockGetBuildingsNearbyExpectation{}
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.params != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Expect")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsNearby.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsNearbyParamPtrs{}
+	}
+	mmGetBuildingsNearby.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBuildingsNearby
+}
+
+// ExpectCenterParam2 sets up expected param center for BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) ExpectCenterParam2(center geo.Point) *mBuildingsServiceMockGetBuildingsNearby {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation == nil {
+		mmGetBuildingsNearby.defaultExpectation = &BuildingsServiceMockGetBuildingsNearbyExpectation{}
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.params != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Expect")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsNearby.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsNearbyParamPtrs{}
+	}
+	mmGetBuildingsNearby.defaultExpectation.paramPtrs.center = &center
+
+	return mmGetBuildingsNearby
+}
+
+// ExpectRadiusParam3 sets up expected param radius for BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) ExpectRadiusParam3(radius float64) *mBuildingsServiceMockGetBuildingsNearby {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation == nil {
+		mmGetBuildingsNearby.defaultExpectation = &BuildingsServiceMockGetBuildingsNearbyExpectation{}
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.params != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Expect")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsNearby.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsNearbyParamPtrs{}
+	}
+	mmGetBuildingsNearby.defaultExpectation.paramPtrs.radius = &radius
+
+	return mmGetBuildingsNearby
+}
+
+// ExpectLimitParam4 sets up expected param limit for BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) ExpectLimitParam4(limit int) *mBuildingsServiceMockGetBuildingsNearby {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation == nil {
+		mmGetBuildingsNearby.defaultExpectation = &BuildingsServiceMockGetBuildingsNearbyExpectation{}
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.params != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Expect")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsNearby.defaultExpectation.paramPtrs = &BuildingsServiceMockGetBuildingsNearbyParamPtrs{}
+	}
+	mmGetBuildingsNearby.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmGetBuildingsNearby
+}
+
+// Inspect accepts an inspector function that has same arguments as the BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Inspect(f func(ctx context.Context, center geo.Point, radius float64, limit int)) *mBuildingsServiceMockGetBuildingsNearby {
+	if mmGetBuildingsNearby.mock.inspectFuncGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("Inspect function is already set for BuildingsServiceMock.GetBuildingsNearby")
+	}
+
+	mmGetBuildingsNearby.mock.inspectFuncGetBuildingsNearby = f
+
+	return mmGetBuildingsNearby
+}
+
+// Return sets up results that will be returned by BuildingsService.GetBuildingsNearby
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Return(npa1 []*storage.NearbyBuilding, err error) *BuildingsServiceMock {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	if mmGetBuildingsNearby.defaultExpectation == nil {
+		mmGetBuildingsNearby.defaultExpectation = &BuildingsServiceMockGetBuildingsNearbyExpectation{mock: mmGetBuildingsNearby.mock}
+	}
+	mmGetBuildingsNearby.defaultExpectation.results = &BuildingsServiceMockGetBuildingsNearbyResults{npa1, err}
+	return mmGetBuildingsNearby.mock
+}
+
+// Set uses given function f to mock the BuildingsService.GetBuildingsNearby method
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Set(f func(ctx context.Context, center geo.Point, radius float64, limit int) (npa1 []*storage.NearbyBuilding, err error)) *BuildingsServiceMock {
+	if mmGetBuildingsNearby.defaultExpectation != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("Default expectation is already set for the BuildingsService.GetBuildingsNearby method")
+	}
+
+	if len(mmGetBuildingsNearby.expectations) > 0 {
+		mmGetBuildingsNearby.mock.t.Fatalf("Some expectations are already set for the BuildingsService.GetBuildingsNearby method")
+	}
+
+	mmGetBuildingsNearby.mock.funcGetBuildingsNearby = f
+	return mmGetBuildingsNearby.mock
+}
+
+// When sets expectation for the BuildingsService.GetBuildingsNearby which will trigger the result defined by the following
+// Then helper
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) When(ctx context.Context, center geo.Point, radius float64, limit int) *BuildingsServiceMockGetBuildingsNearbyExpectation {
+	if mmGetBuildingsNearby.mock.funcGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.mock.t.Fatalf("BuildingsServiceMock.GetBuildingsNearby mock is already set by Set")
+	}
+
+	expectation := &BuildingsServiceMockGetBuildingsNearbyExpectation{
+		mock:   mmGetBuildingsNearby.mock,
+		params: &BuildingsServiceMockGetBuildingsNearbyParams{ctx, center, radius, limit},
+	}
+	mmGetBuildingsNearby.expectations = append(mmGetBuildingsNearby.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BuildingsService.GetBuildingsNearby return parameters for the expectation previously defined by the When method
+func (e *BuildingsServiceMockGetBuildingsNearbyExpectation) Then(npa1 []*storage.NearbyBuilding, err error) *BuildingsServiceMock {
+	e.results = &BuildingsServiceMockGetBuildingsNearbyResults{npa1, err}
+	return e.mock
+}
+
+// Times sets number of times BuildingsService.GetBuildingsNearby should be invoked
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Times(n uint64) *mBuildingsServiceMockGetBuildingsNearby {
+	if n == 0 {
+		mmGetBuildingsNearby.mock.t.Fatalf("Times of BuildingsServiceMock.GetBuildingsNearby mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBuildingsNearby.expectedInvocations, n)
+	return mmGetBuildingsNearby
+}
+
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) invocationsDone() bool {
+	if len(mmGetBuildingsNearby.expectations) == 0 && mmGetBuildingsNearby.defaultExpectation == nil && mmGetBuildingsNearby.mock.funcGetBuildingsNearby == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBuildingsNearby.mock.afterGetBuildingsNearbyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBuildingsNearby.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBuildingsNearby implements buildings.BuildingsService
+func (mmGetBuildingsNearby *BuildingsServiceMock) GetBuildingsNearby(ctx context.Context, center geo.Point, radius float64, limit int) (npa1 []*storage.NearbyBuilding, err error) {
+	mm_atomic.AddUint64(&mmGetBuildingsNearby.beforeGetBuildingsNearbyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBuildingsNearby.afterGetBuildingsNearbyCounter, 1)
+
+	if mmGetBuildingsNearby.inspectFuncGetBuildingsNearby != nil {
+		mmGetBuildingsNearby.inspectFuncGetBuildingsNearby(ctx, center, radius, limit)
+	}
+
+	mm_params := BuildingsServiceMockGetBuildingsNearbyParams{ctx, center, radius, limit}
+
+	// Record call args
+	mmGetBuildingsNearby.GetBuildingsNearbyMock.mutex.Lock()
+	mmGetBuildingsNearby.GetBuildingsNearbyMock.callArgs = append(mmGetBuildingsNearby.GetBuildingsNearbyMock.callArgs, &mm_params)
+	mmGetBuildingsNearby.GetBuildingsNearbyMock.mutex.Unlock()
+
+	for _, e := range mmGetBuildingsNearby.GetBuildingsNearbyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.npa1, e.results.err
+		}
+	}
+
+	if mmGetBuildingsNearby.GetBuildingsNearbyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBuildingsNearby.GetBuildingsNearbyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBuildingsNearby.GetBuildingsNearbyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBuildingsNearby.GetBuildingsNearbyMock.defaultExpectation.paramPtrs
+
+		mm_got := BuildingsServiceMockGetBuildingsNearbyParams{ctx, center, radius, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBuildingsNearby.t.Errorf("BuildingsServiceMock.GetBuildingsNearby got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.center != nil && !minimock.Equal(*mm_want_ptrs.center, mm_got.center) {
+				mmGetBuildingsNearby.t.Errorf("BuildingsServiceMock.GetBuildingsNearby got unexpected parameter center, want: %#v, got: %#v%s\n", *mm_want_ptrs.center, mm_got.center, minimock.Diff(*mm_want_ptrs.center, mm_got.center))
+			}
+
+			if mm_want_ptrs.radius != nil && !minimock.Equal(*mm_want_ptrs.radius, mm_got.radius) {
+				mmGetBuildingsNearby.t.Errorf("BuildingsServiceMock.GetBuildingsNearby got unexpected parameter radius, want: %#v, got: %#v%s\n", *mm_want_ptrs.radius, mm_got.radius, minimock.Diff(*mm_want_ptrs.radius, mm_got.radius))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetBuildingsNearby.t.Errorf("BuildingsServiceMock.GetBuildingsNearby got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBuildingsNearby.t.Errorf("BuildingsServiceMock.GetBuildingsNearby got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBuildingsNearby.GetBuildingsNearbyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBuildingsNearby.t.Fatal("No results are set for the BuildingsServiceMock.GetBuildingsNearby")
+		}
+		return (*mm_results).npa1, (*mm_results).err
+	}
+	if mmGetBuildingsNearby.funcGetBuildingsNearby != nil {
+		return mmGetBuildingsNearby.funcGetBuildingsNearby(ctx, center, radius, limit)
+	}
+	mmGetBuildingsNearby.t.Fatalf("Unexpected call to BuildingsServiceMock.GetBuildingsNearby. %v %v %v %v", ctx, center, radius, limit)
+	return
+}
+
+// GetBuildingsNearbyAfterCounter returns a count of finished BuildingsServiceMock.GetBuildingsNearby invocations
+func (mmGetBuildingsNearby *BuildingsServiceMock) GetBuildingsNearbyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsNearby.afterGetBuildingsNearbyCounter)
+}
+
+// GetBuildingsNearbyBeforeCounter returns a count of BuildingsServiceMock.GetBuildingsNearby invocations
+func (mmGetBuildingsNearby *BuildingsServiceMock) GetBuildingsNearbyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsNearby.beforeGetBuildingsNearbyCounter)
+}
+
+// Calls returns a list of arguments used in each call to BuildingsServiceMock.GetBuildingsNearby.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBuildingsNearby *mBuildingsServiceMockGetBuildingsNearby) Calls() []*BuildingsServiceMockGetBuildingsNearbyParams {
+	mmGetBuildingsNearby.mutex.RLock()
+
+	argCopy := make([]*BuildingsServiceMockGetBuildingsNearbyParams, len(mmGetBuildingsNearby.callArgs))
+	copy(argCopy, mmGetBuildingsNearby.callArgs)
+
+	mmGetBuildingsNearby.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBuildingsNearbyDone returns true if the count of the GetBuildingsNearby invocations corresponds
+// the number of defined expectations
+func (m *BuildingsServiceMock) MinimockGetBuildingsNearbyDone() bool {
+	if m.GetBuildingsNearbyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBuildingsNearbyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBuildingsNearbyMock.invocationsDone()
+}
+
+// MinimockGetBuildingsNearbyInspect logs each unmet expectation
+func (m *BuildingsServiceMock) MinimockGetBuildingsNearbyInspect() {
+	for _, e := range m.GetBuildingsNearbyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BuildingsServiceMock.GetBuildingsNearby with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBuildingsNearbyCounter := mm_atomic.LoadUint64(&m.afterGetBuildingsNearbyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBuildingsNearbyMock.defaultExpectation != nil && afterGetBuildingsNearbyCounter < 1 {
+		if m.GetBuildingsNearbyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BuildingsServiceMock.GetBuildingsNearby")
+		} else {
+			m.t.Errorf("Expected call to BuildingsServiceMock.GetBuildingsNearby with params: %#v", *m.GetBuildingsNearbyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBuildingsNearby != nil && afterGetBuildingsNearbyCounter < 1 {
+		m.t.Error("Expected call to BuildingsServiceMock.GetBuildingsNearby")
+	}
+
+	if !m.GetBuildingsNearbyMock.invocationsDone() && afterGetBuildingsNearbyCounter > 0 {
+		m.t.Errorf("Expected %d calls to BuildingsServiceMock.GetBuildingsNearby but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBuildingsNearbyMock.expectedInvocations), afterGetBuildingsNearbyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BuildingsServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateBuildingInspect()
+
+			m.MinimockDeleteBuildingInspect()
+
+			m.MinimockFindBuildingsInspect()
+
+			m.MinimockGetBuildingInspect()
+
+			m.MinimockGetBuildingsInspect()
+
+			m.MinimockGetBuildingsByIDsInspect()
+
+			m.MinimockGetBuildingsInBoxInspect()
+
+			m.MinimockGetBuildingsNearbyInspect()
 		}
 	})
 }
@@ -1359,6 +2802,10 @@ func (m *BuildingsServiceMock) minimockDone() bool {
 	return done &&
 		m.MinimockCreateBuildingDone() &&
 		m.MinimockDeleteBuildingDone() &&
+		m.MinimockFindBuildingsDone() &&
 		m.MinimockGetBuildingDone() &&
-		m.MinimockGetBuildingsDone()
+		m.MinimockGetBuildingsDone() &&
+		m.MinimockGetBuildingsByIDsDone() &&
+		m.MinimockGetBuildingsInBoxDone() &&
+		m.MinimockGetBuildingsNearbyDone()
 }
@@ -0,0 +1,1448 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/floors.FloorsService -o floors_service_mock_test.go -n FloorsServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// FloorsServiceMock implements floors.FloorsService
+type FloorsServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateFloor          func(ctx context.Context, floor *storage.Floor) (err error)
+	inspectFuncCreateFloor   func(ctx context.Context, floor *storage.Floor)
+	afterCreateFloorCounter  uint64
+	beforeCreateFloorCounter uint64
+	CreateFloorMock          mFloorsServiceMockCreateFloor
+
+	funcDeleteFloor          func(ctx context.Context, buildingId int, number int) (err error)
+	inspectFuncDeleteFloor   func(ctx context.Context, buildingId int, number int)
+	afterDeleteFloorCounter  uint64
+	beforeDeleteFloorCounter uint64
+	DeleteFloorMock          mFloorsServiceMockDeleteFloor
+
+	funcGetFloor          func(ctx context.Context, buildingId int, number int) (fp1 *storage.Floor, err error)
+	inspectFuncGetFloor   func(ctx context.Context, buildingId int, number int)
+	afterGetFloorCounter  uint64
+	beforeGetFloorCounter uint64
+	GetFloorMock          mFloorsServiceMockGetFloor
+
+	funcGetFloors          func(ctx context.Context, buildingId int) (fpa1 []*storage.Floor, err error)
+	inspectFuncGetFloors   func(ctx context.Context, buildingId int)
+	afterGetFloorsCounter  uint64
+	beforeGetFloorsCounter uint64
+	GetFloorsMock          mFloorsServiceMockGetFloors
+}
+
+// NewFloorsServiceMock returns a mock for floors.FloorsService
+func NewFloorsServiceMock(t minimock.Tester) *FloorsServiceMock {
+	m := &FloorsServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateFloorMock = mFloorsServiceMockCreateFloor{mock: m}
+	m.CreateFloorMock.callArgs = []*FloorsServiceMockCreateFloorParams{}
+
+	m.DeleteFloorMock = mFloorsServiceMockDeleteFloor{mock: m}
+	m.DeleteFloorMock.callArgs = []*FloorsServiceMockDeleteFloorParams{}
+
+	m.GetFloorMock = mFloorsServiceMockGetFloor{mock: m}
+	m.GetFloorMock.callArgs = []*FloorsServiceMockGetFloorParams{}
+
+	m.GetFloorsMock = mFloorsServiceMockGetFloors{mock: m}
+	m.GetFloorsMock.callArgs = []*FloorsServiceMockGetFloorsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFloorsServiceMockCreateFloor struct {
+	optional           bool
+	mock               *FloorsServiceMock
+	defaultExpectation *FloorsServiceMockCreateFloorExpectation
+	expectations       []*FloorsServiceMockCreateFloorExpectation
+
+	callArgs []*FloorsServiceMockCreateFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsServiceMockCreateFloorExpectation specifies expectation struct of the FloorsService.CreateFloor
+type FloorsServiceMockCreateFloorExpectation struct {
+	mock      *FloorsServiceMock
+	params    *FloorsServiceMockCreateFloorParams
+	paramPtrs *FloorsServiceMockCreateFloorParamPtrs
+	results   *FloorsServiceMockCreateFloorResults
+	Counter   uint64
+}
+
+// FloorsServiceMockCreateFloorParams contains parameters of the FloorsService.CreateFloor
+type FloorsServiceMockCreateFloorParams struct {
+	ctx   context.Context
+	floor *storage.Floor
+}
+
+// FloorsServiceMockCreateFloorParamPtrs contains pointers to parameters of the FloorsService.CreateFloor
+type FloorsServiceMockCreateFloorParamPtrs struct {
+	ctx   *context.Context
+	floor **storage.Floor
+}
+
+// FloorsServiceMockCreateFloorResults contains results of the FloorsService.CreateFloor
+type FloorsServiceMockCreateFloorResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Optional() *mFloorsServiceMockCreateFloor {
+	mmCreateFloor.optional = true
+	return mmCreateFloor
+}
+
+// Expect sets up expected params for FloorsService.CreateFloor
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Expect(ctx context.Context, floor *storage.Floor) *mFloorsServiceMockCreateFloor {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsServiceMockCreateFloorExpectation{}
+	}
+
+	if mmCreateFloor.defaultExpectation.paramPtrs != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by ExpectParams functions")
+	}
+
+	mmCreateFloor.defaultExpectation.params = &FloorsServiceMockCreateFloorParams{ctx, floor}
+	for _, e := range mmCreateFloor.expectations {
+		if minimock.Equal(e.params, mmCreateFloor.defaultExpectation.params) {
+			mmCreateFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsService.CreateFloor
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) ExpectCtxParam1(ctx context.Context) *mFloorsServiceMockCreateFloor {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsServiceMockCreateFloorExpectation{}
+	}
+
+	if mmCreateFloor.defaultExpectation.params != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Expect")
+	}
+
+	if mmCreateFloor.defaultExpectation.paramPtrs == nil {
+		mmCreateFloor.defaultExpectation.paramPtrs = &FloorsServiceMockCreateFloorParamPtrs{}
+	}
+	mmCreateFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateFloor
+}
+
+// ExpectFloorParam2 sets up expected param floor for FloorsService.CreateFloor
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) ExpectFloorParam2(floor *storage.Floor) *mFloorsServiceMockCreateFloor {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsServiceMockCreateFloorExpectation{}
+	}
+
+	if mmCreateFloor.defaultExpectation.params != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Expect")
+	}
+
+	if mmCreateFloor.defaultExpectation.paramPtrs == nil {
+		mmCreateFloor.defaultExpectation.paramPtrs = &FloorsServiceMockCreateFloorParamPtrs{}
+	}
+	mmCreateFloor.defaultExpectation.paramPtrs.floor = &floor
+
+	return mmCreateFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsService.CreateFloor
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Inspect(f func(ctx context.Context, floor *storage.Floor)) *mFloorsServiceMockCreateFloor {
+	if mmCreateFloor.mock.inspectFuncCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("Inspect function is already set for FloorsServiceMock.CreateFloor")
+	}
+
+	mmCreateFloor.mock.inspectFuncCreateFloor = f
+
+	return mmCreateFloor
+}
+
+// Return sets up results that will be returned by FloorsService.CreateFloor
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Return(err error) *FloorsServiceMock {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsServiceMockCreateFloorExpectation{mock: mmCreateFloor.mock}
+	}
+	mmCreateFloor.defaultExpectation.results = &FloorsServiceMockCreateFloorResults{err}
+	return mmCreateFloor.mock
+}
+
+// Set uses given function f to mock the FloorsService.CreateFloor method
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Set(f func(ctx context.Context, floor *storage.Floor) (err error)) *FloorsServiceMock {
+	if mmCreateFloor.defaultExpectation != nil {
+		mmCreateFloor.mock.t.Fatalf("Default expectation is already set for the FloorsService.CreateFloor method")
+	}
+
+	if len(mmCreateFloor.expectations) > 0 {
+		mmCreateFloor.mock.t.Fatalf("Some expectations are already set for the FloorsService.CreateFloor method")
+	}
+
+	mmCreateFloor.mock.funcCreateFloor = f
+	return mmCreateFloor.mock
+}
+
+// When sets expectation for the FloorsService.CreateFloor which will trigger the result defined by the following
+// Then helper
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) When(ctx context.Context, floor *storage.Floor) *FloorsServiceMockCreateFloorExpectation {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsServiceMock.CreateFloor mock is already set by Set")
+	}
+
+	expectation := &FloorsServiceMockCreateFloorExpectation{
+		mock:   mmCreateFloor.mock,
+		params: &FloorsServiceMockCreateFloorParams{ctx, floor},
+	}
+	mmCreateFloor.expectations = append(mmCreateFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsService.CreateFloor return parameters for the expectation previously defined by the When method
+func (e *FloorsServiceMockCreateFloorExpectation) Then(err error) *FloorsServiceMock {
+	e.results = &FloorsServiceMockCreateFloorResults{err}
+	return e.mock
+}
+
+// Times sets number of times FloorsService.CreateFloor should be invoked
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Times(n uint64) *mFloorsServiceMockCreateFloor {
+	if n == 0 {
+		mmCreateFloor.mock.t.Fatalf("Times of FloorsServiceMock.CreateFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateFloor.expectedInvocations, n)
+	return mmCreateFloor
+}
+
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) invocationsDone() bool {
+	if len(mmCreateFloor.expectations) == 0 && mmCreateFloor.defaultExpectation == nil && mmCreateFloor.mock.funcCreateFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateFloor.mock.afterCreateFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateFloor implements floors.FloorsService
+func (mmCreateFloor *FloorsServiceMock) CreateFloor(ctx context.Context, floor *storage.Floor) (err error) {
+	mm_atomic.AddUint64(&mmCreateFloor.beforeCreateFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateFloor.afterCreateFloorCounter, 1)
+
+	if mmCreateFloor.inspectFuncCreateFloor != nil {
+		mmCreateFloor.inspectFuncCreateFloor(ctx, floor)
+	}
+
+	mm_params := FloorsServiceMockCreateFloorParams{ctx, floor}
+
+	// Record call args
+	mmCreateFloor.CreateFloorMock.mutex.Lock()
+	mmCreateFloor.CreateFloorMock.callArgs = append(mmCreateFloor.CreateFloorMock.callArgs, &mm_params)
+	mmCreateFloor.CreateFloorMock.mutex.Unlock()
+
+	for _, e := range mmCreateFloor.CreateFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateFloor.CreateFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateFloor.CreateFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateFloor.CreateFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateFloor.CreateFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsServiceMockCreateFloorParams{ctx, floor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateFloor.t.Errorf("FloorsServiceMock.CreateFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.floor != nil && !minimock.Equal(*mm_want_ptrs.floor, mm_got.floor) {
+				mmCreateFloor.t.Errorf("FloorsServiceMock.CreateFloor got unexpected parameter floor, want: %#v, got: %#v%s\n", *mm_want_ptrs.floor, mm_got.floor, minimock.Diff(*mm_want_ptrs.floor, mm_got.floor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateFloor.t.Errorf("FloorsServiceMock.CreateFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateFloor.CreateFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateFloor.t.Fatal("No results are set for the FloorsServiceMock.CreateFloor")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateFloor.funcCreateFloor != nil {
+		return mmCreateFloor.funcCreateFloor(ctx, floor)
+	}
+	mmCreateFloor.t.Fatalf("Unexpected call to FloorsServiceMock.CreateFloor. %v %v", ctx, floor)
+	return
+}
+
+// CreateFloorAfterCounter returns a count of finished FloorsServiceMock.CreateFloor invocations
+func (mmCreateFloor *FloorsServiceMock) CreateFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFloor.afterCreateFloorCounter)
+}
+
+// CreateFloorBeforeCounter returns a count of FloorsServiceMock.CreateFloor invocations
+func (mmCreateFloor *FloorsServiceMock) CreateFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFloor.beforeCreateFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsServiceMock.CreateFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateFloor *mFloorsServiceMockCreateFloor) Calls() []*FloorsServiceMockCreateFloorParams {
+	mmCreateFloor.mutex.RLock()
+
+	argCopy := make([]*FloorsServiceMockCreateFloorParams, len(mmCreateFloor.callArgs))
+	copy(argCopy, mmCreateFloor.callArgs)
+
+	mmCreateFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateFloorDone returns true if the count of the CreateFloor invocations corresponds
+// the number of defined expectations
+func (m *FloorsServiceMock) MinimockCreateFloorDone() bool {
+	if m.CreateFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateFloorMock.invocationsDone()
+}
+
+// MinimockCreateFloorInspect logs each unmet expectation
+func (m *FloorsServiceMock) MinimockCreateFloorInspect() {
+	for _, e := range m.CreateFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsServiceMock.CreateFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateFloorCounter := mm_atomic.LoadUint64(&m.afterCreateFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateFloorMock.defaultExpectation != nil && afterCreateFloorCounter < 1 {
+		if m.CreateFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsServiceMock.CreateFloor")
+		} else {
+			m.t.Errorf("Expected call to FloorsServiceMock.CreateFloor with params: %#v", *m.CreateFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateFloor != nil && afterCreateFloorCounter < 1 {
+		m.t.Error("Expected call to FloorsServiceMock.CreateFloor")
+	}
+
+	if !m.CreateFloorMock.invocationsDone() && afterCreateFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsServiceMock.CreateFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateFloorMock.expectedInvocations), afterCreateFloorCounter)
+	}
+}
+
+type mFloorsServiceMockDeleteFloor struct {
+	optional           bool
+	mock               *FloorsServiceMock
+	defaultExpectation *FloorsServiceMockDeleteFloorExpectation
+	expectations       []*FloorsServiceMockDeleteFloorExpectation
+
+	callArgs []*FloorsServiceMockDeleteFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsServiceMockDeleteFloorExpectation specifies expectation struct of the FloorsService.DeleteFloor
+type FloorsServiceMockDeleteFloorExpectation struct {
+	mock      *FloorsServiceMock
+	params    *FloorsServiceMockDeleteFloorParams
+	paramPtrs *FloorsServiceMockDeleteFloorParamPtrs
+	results   *FloorsServiceMockDeleteFloorResults
+	Counter   uint64
+}
+
+// FloorsServiceMockDeleteFloorParams contains parameters of the FloorsService.DeleteFloor
+type FloorsServiceMockDeleteFloorParams struct {
+	ctx        context.Context
+	buildingId int
+	number     int
+}
+
+// FloorsServiceMockDeleteFloorParamPtrs contains pointers to parameters of the FloorsService.DeleteFloor
+type FloorsServiceMockDeleteFloorParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	number     *int
+}
+
+// FloorsServiceMockDeleteFloorResults contains results of the FloorsService.DeleteFloor
+type FloorsServiceMockDeleteFloorResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Optional() *mFloorsServiceMockDeleteFloor {
+	mmDeleteFloor.optional = true
+	return mmDeleteFloor
+}
+
+// Expect sets up expected params for FloorsService.DeleteFloor
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Expect(ctx context.Context, buildingId int, number int) *mFloorsServiceMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsServiceMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFloor.defaultExpectation.params = &FloorsServiceMockDeleteFloorParams{ctx, buildingId, number}
+	for _, e := range mmDeleteFloor.expectations {
+		if minimock.Equal(e.params, mmDeleteFloor.defaultExpectation.params) {
+			mmDeleteFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsService.DeleteFloor
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) ExpectCtxParam1(ctx context.Context) *mFloorsServiceMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsServiceMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.params != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Expect")
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs == nil {
+		mmDeleteFloor.defaultExpectation.paramPtrs = &FloorsServiceMockDeleteFloorParamPtrs{}
+	}
+	mmDeleteFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteFloor
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for FloorsService.DeleteFloor
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) ExpectBuildingIdParam2(buildingId int) *mFloorsServiceMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsServiceMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.params != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Expect")
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs == nil {
+		mmDeleteFloor.defaultExpectation.paramPtrs = &FloorsServiceMockDeleteFloorParamPtrs{}
+	}
+	mmDeleteFloor.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmDeleteFloor
+}
+
+// ExpectNumberParam3 sets up expected param number for FloorsService.DeleteFloor
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) ExpectNumberParam3(number int) *mFloorsServiceMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsServiceMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.params != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Expect")
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs == nil {
+		mmDeleteFloor.defaultExpectation.paramPtrs = &FloorsServiceMockDeleteFloorParamPtrs{}
+	}
+	mmDeleteFloor.defaultExpectation.paramPtrs.number = &number
+
+	return mmDeleteFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsService.DeleteFloor
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Inspect(f func(ctx context.Context, buildingId int, number int)) *mFloorsServiceMockDeleteFloor {
+	if mmDeleteFloor.mock.inspectFuncDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("Inspect function is already set for FloorsServiceMock.DeleteFloor")
+	}
+
+	mmDeleteFloor.mock.inspectFuncDeleteFloor = f
+
+	return mmDeleteFloor
+}
+
+// Return sets up results that will be returned by FloorsService.DeleteFloor
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Return(err error) *FloorsServiceMock {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsServiceMockDeleteFloorExpectation{mock: mmDeleteFloor.mock}
+	}
+	mmDeleteFloor.defaultExpectation.results = &FloorsServiceMockDeleteFloorResults{err}
+	return mmDeleteFloor.mock
+}
+
+// Set uses given function f to mock the FloorsService.DeleteFloor method
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Set(f func(ctx context.Context, buildingId int, number int) (err error)) *FloorsServiceMock {
+	if mmDeleteFloor.defaultExpectation != nil {
+		mmDeleteFloor.mock.t.Fatalf("Default expectation is already set for the FloorsService.DeleteFloor method")
+	}
+
+	if len(mmDeleteFloor.expectations) > 0 {
+		mmDeleteFloor.mock.t.Fatalf("Some expectations are already set for the FloorsService.DeleteFloor method")
+	}
+
+	mmDeleteFloor.mock.funcDeleteFloor = f
+	return mmDeleteFloor.mock
+}
+
+// When sets expectation for the FloorsService.DeleteFloor which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) When(ctx context.Context, buildingId int, number int) *FloorsServiceMockDeleteFloorExpectation {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsServiceMock.DeleteFloor mock is already set by Set")
+	}
+
+	expectation := &FloorsServiceMockDeleteFloorExpectation{
+		mock:   mmDeleteFloor.mock,
+		params: &FloorsServiceMockDeleteFloorParams{ctx, buildingId, number},
+	}
+	mmDeleteFloor.expectations = append(mmDeleteFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsService.DeleteFloor return parameters for the expectation previously defined by the When method
+func (e *FloorsServiceMockDeleteFloorExpectation) Then(err error) *FloorsServiceMock {
+	e.results = &FloorsServiceMockDeleteFloorResults{err}
+	return e.mock
+}
+
+// Times sets number of times FloorsService.DeleteFloor should be invoked
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Times(n uint64) *mFloorsServiceMockDeleteFloor {
+	if n == 0 {
+		mmDeleteFloor.mock.t.Fatalf("Times of FloorsServiceMock.DeleteFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFloor.expectedInvocations, n)
+	return mmDeleteFloor
+}
+
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) invocationsDone() bool {
+	if len(mmDeleteFloor.expectations) == 0 && mmDeleteFloor.defaultExpectation == nil && mmDeleteFloor.mock.funcDeleteFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFloor.mock.afterDeleteFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFloor implements floors.FloorsService
+func (mmDeleteFloor *FloorsServiceMock) DeleteFloor(ctx context.Context, buildingId int, number int) (err error) {
+	mm_atomic.AddUint64(&mmDeleteFloor.beforeDeleteFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFloor.afterDeleteFloorCounter, 1)
+
+	if mmDeleteFloor.inspectFuncDeleteFloor != nil {
+		mmDeleteFloor.inspectFuncDeleteFloor(ctx, buildingId, number)
+	}
+
+	mm_params := FloorsServiceMockDeleteFloorParams{ctx, buildingId, number}
+
+	// Record call args
+	mmDeleteFloor.DeleteFloorMock.mutex.Lock()
+	mmDeleteFloor.DeleteFloorMock.callArgs = append(mmDeleteFloor.DeleteFloorMock.callArgs, &mm_params)
+	mmDeleteFloor.DeleteFloorMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFloor.DeleteFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteFloor.DeleteFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFloor.DeleteFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFloor.DeleteFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFloor.DeleteFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsServiceMockDeleteFloorParams{ctx, buildingId, number}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFloor.t.Errorf("FloorsServiceMock.DeleteFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmDeleteFloor.t.Errorf("FloorsServiceMock.DeleteFloor got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.number != nil && !minimock.Equal(*mm_want_ptrs.number, mm_got.number) {
+				mmDeleteFloor.t.Errorf("FloorsServiceMock.DeleteFloor got unexpected parameter number, want: %#v, got: %#v%s\n", *mm_want_ptrs.number, mm_got.number, minimock.Diff(*mm_want_ptrs.number, mm_got.number))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFloor.t.Errorf("FloorsServiceMock.DeleteFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFloor.DeleteFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFloor.t.Fatal("No results are set for the FloorsServiceMock.DeleteFloor")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteFloor.funcDeleteFloor != nil {
+		return mmDeleteFloor.funcDeleteFloor(ctx, buildingId, number)
+	}
+	mmDeleteFloor.t.Fatalf("Unexpected call to FloorsServiceMock.DeleteFloor. %v %v %v", ctx, buildingId, number)
+	return
+}
+
+// DeleteFloorAfterCounter returns a count of finished FloorsServiceMock.DeleteFloor invocations
+func (mmDeleteFloor *FloorsServiceMock) DeleteFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFloor.afterDeleteFloorCounter)
+}
+
+// DeleteFloorBeforeCounter returns a count of FloorsServiceMock.DeleteFloor invocations
+func (mmDeleteFloor *FloorsServiceMock) DeleteFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFloor.beforeDeleteFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsServiceMock.DeleteFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFloor *mFloorsServiceMockDeleteFloor) Calls() []*FloorsServiceMockDeleteFloorParams {
+	mmDeleteFloor.mutex.RLock()
+
+	argCopy := make([]*FloorsServiceMockDeleteFloorParams, len(mmDeleteFloor.callArgs))
+	copy(argCopy, mmDeleteFloor.callArgs)
+
+	mmDeleteFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFloorDone returns true if the count of the DeleteFloor invocations corresponds
+// the number of defined expectations
+func (m *FloorsServiceMock) MinimockDeleteFloorDone() bool {
+	if m.DeleteFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFloorMock.invocationsDone()
+}
+
+// MinimockDeleteFloorInspect logs each unmet expectation
+func (m *FloorsServiceMock) MinimockDeleteFloorInspect() {
+	for _, e := range m.DeleteFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsServiceMock.DeleteFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteFloorCounter := mm_atomic.LoadUint64(&m.afterDeleteFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFloorMock.defaultExpectation != nil && afterDeleteFloorCounter < 1 {
+		if m.DeleteFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsServiceMock.DeleteFloor")
+		} else {
+			m.t.Errorf("Expected call to FloorsServiceMock.DeleteFloor with params: %#v", *m.DeleteFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFloor != nil && afterDeleteFloorCounter < 1 {
+		m.t.Error("Expected call to FloorsServiceMock.DeleteFloor")
+	}
+
+	if !m.DeleteFloorMock.invocationsDone() && afterDeleteFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsServiceMock.DeleteFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFloorMock.expectedInvocations), afterDeleteFloorCounter)
+	}
+}
+
+type mFloorsServiceMockGetFloor struct {
+	optional           bool
+	mock               *FloorsServiceMock
+	defaultExpectation *FloorsServiceMockGetFloorExpectation
+	expectations       []*FloorsServiceMockGetFloorExpectation
+
+	callArgs []*FloorsServiceMockGetFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsServiceMockGetFloorExpectation specifies expectation struct of the FloorsService.GetFloor
+type FloorsServiceMockGetFloorExpectation struct {
+	mock      *FloorsServiceMock
+	params    *FloorsServiceMockGetFloorParams
+	paramPtrs *FloorsServiceMockGetFloorParamPtrs
+	results   *FloorsServiceMockGetFloorResults
+	Counter   uint64
+}
+
+// FloorsServiceMockGetFloorParams contains parameters of the FloorsService.GetFloor
+type FloorsServiceMockGetFloorParams struct {
+	ctx        context.Context
+	buildingId int
+	number     int
+}
+
+// FloorsServiceMockGetFloorParamPtrs contains pointers to parameters of the FloorsService.GetFloor
+type FloorsServiceMockGetFloorParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	number     *int
+}
+
+// FloorsServiceMockGetFloorResults contains results of the FloorsService.GetFloor
+type FloorsServiceMockGetFloorResults struct {
+	fp1 *storage.Floor
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFloor *mFloorsServiceMockGetFloor) Optional() *mFloorsServiceMockGetFloor {
+	mmGetFloor.optional = true
+	return mmGetFloor
+}
+
+// Expect sets up expected params for FloorsService.GetFloor
+func (mmGetFloor *mFloorsServiceMockGetFloor) Expect(ctx context.Context, buildingId int, number int) *mFloorsServiceMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsServiceMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by ExpectParams functions")
+	}
+
+	mmGetFloor.defaultExpectation.params = &FloorsServiceMockGetFloorParams{ctx, buildingId, number}
+	for _, e := range mmGetFloor.expectations {
+		if minimock.Equal(e.params, mmGetFloor.defaultExpectation.params) {
+			mmGetFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsService.GetFloor
+func (mmGetFloor *mFloorsServiceMockGetFloor) ExpectCtxParam1(ctx context.Context) *mFloorsServiceMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsServiceMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.params != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Expect")
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs == nil {
+		mmGetFloor.defaultExpectation.paramPtrs = &FloorsServiceMockGetFloorParamPtrs{}
+	}
+	mmGetFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetFloor
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for FloorsService.GetFloor
+func (mmGetFloor *mFloorsServiceMockGetFloor) ExpectBuildingIdParam2(buildingId int) *mFloorsServiceMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsServiceMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.params != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Expect")
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs == nil {
+		mmGetFloor.defaultExpectation.paramPtrs = &FloorsServiceMockGetFloorParamPtrs{}
+	}
+	mmGetFloor.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetFloor
+}
+
+// ExpectNumberParam3 sets up expected param number for FloorsService.GetFloor
+func (mmGetFloor *mFloorsServiceMockGetFloor) ExpectNumberParam3(number int) *mFloorsServiceMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsServiceMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.params != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Expect")
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs == nil {
+		mmGetFloor.defaultExpectation.paramPtrs = &FloorsServiceMockGetFloorParamPtrs{}
+	}
+	mmGetFloor.defaultExpectation.paramPtrs.number = &number
+
+	return mmGetFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsService.GetFloor
+func (mmGetFloor *mFloorsServiceMockGetFloor) Inspect(f func(ctx context.Context, buildingId int, number int)) *mFloorsServiceMockGetFloor {
+	if mmGetFloor.mock.inspectFuncGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("Inspect function is already set for FloorsServiceMock.GetFloor")
+	}
+
+	mmGetFloor.mock.inspectFuncGetFloor = f
+
+	return mmGetFloor
+}
+
+// Return sets up results that will be returned by FloorsService.GetFloor
+func (mmGetFloor *mFloorsServiceMockGetFloor) Return(fp1 *storage.Floor, err error) *FloorsServiceMock {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsServiceMockGetFloorExpectation{mock: mmGetFloor.mock}
+	}
+	mmGetFloor.defaultExpectation.results = &FloorsServiceMockGetFloorResults{fp1, err}
+	return mmGetFloor.mock
+}
+
+// Set uses given function f to mock the FloorsService.GetFloor method
+func (mmGetFloor *mFloorsServiceMockGetFloor) Set(f func(ctx context.Context, buildingId int, number int) (fp1 *storage.Floor, err error)) *FloorsServiceMock {
+	if mmGetFloor.defaultExpectation != nil {
+		mmGetFloor.mock.t.Fatalf("Default expectation is already set for the FloorsService.GetFloor method")
+	}
+
+	if len(mmGetFloor.expectations) > 0 {
+		mmGetFloor.mock.t.Fatalf("Some expectations are already set for the FloorsService.GetFloor method")
+	}
+
+	mmGetFloor.mock.funcGetFloor = f
+	return mmGetFloor.mock
+}
+
+// When sets expectation for the FloorsService.GetFloor which will trigger the result defined by the following
+// Then helper
+func (mmGetFloor *mFloorsServiceMockGetFloor) When(ctx context.Context, buildingId int, number int) *FloorsServiceMockGetFloorExpectation {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsServiceMock.GetFloor mock is already set by Set")
+	}
+
+	expectation := &FloorsServiceMockGetFloorExpectation{
+		mock:   mmGetFloor.mock,
+		params: &FloorsServiceMockGetFloorParams{ctx, buildingId, number},
+	}
+	mmGetFloor.expectations = append(mmGetFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsService.GetFloor return parameters for the expectation previously defined by the When method
+func (e *FloorsServiceMockGetFloorExpectation) Then(fp1 *storage.Floor, err error) *FloorsServiceMock {
+	e.results = &FloorsServiceMockGetFloorResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times FloorsService.GetFloor should be invoked
+func (mmGetFloor *mFloorsServiceMockGetFloor) Times(n uint64) *mFloorsServiceMockGetFloor {
+	if n == 0 {
+		mmGetFloor.mock.t.Fatalf("Times of FloorsServiceMock.GetFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFloor.expectedInvocations, n)
+	return mmGetFloor
+}
+
+func (mmGetFloor *mFloorsServiceMockGetFloor) invocationsDone() bool {
+	if len(mmGetFloor.expectations) == 0 && mmGetFloor.defaultExpectation == nil && mmGetFloor.mock.funcGetFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFloor.mock.afterGetFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFloor implements floors.FloorsService
+func (mmGetFloor *FloorsServiceMock) GetFloor(ctx context.Context, buildingId int, number int) (fp1 *storage.Floor, err error) {
+	mm_atomic.AddUint64(&mmGetFloor.beforeGetFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFloor.afterGetFloorCounter, 1)
+
+	if mmGetFloor.inspectFuncGetFloor != nil {
+		mmGetFloor.inspectFuncGetFloor(ctx, buildingId, number)
+	}
+
+	mm_params := FloorsServiceMockGetFloorParams{ctx, buildingId, number}
+
+	// Record call args
+	mmGetFloor.GetFloorMock.mutex.Lock()
+	mmGetFloor.GetFloorMock.callArgs = append(mmGetFloor.GetFloorMock.callArgs, &mm_params)
+	mmGetFloor.GetFloorMock.mutex.Unlock()
+
+	for _, e := range mmGetFloor.GetFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmGetFloor.GetFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFloor.GetFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFloor.GetFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFloor.GetFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsServiceMockGetFloorParams{ctx, buildingId, number}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFloor.t.Errorf("FloorsServiceMock.GetFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetFloor.t.Errorf("FloorsServiceMock.GetFloor got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.number != nil && !minimock.Equal(*mm_want_ptrs.number, mm_got.number) {
+				mmGetFloor.t.Errorf("FloorsServiceMock.GetFloor got unexpected parameter number, want: %#v, got: %#v%s\n", *mm_want_ptrs.number, mm_got.number, minimock.Diff(*mm_want_ptrs.number, mm_got.number))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFloor.t.Errorf("FloorsServiceMock.GetFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFloor.GetFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFloor.t.Fatal("No results are set for the FloorsServiceMock.GetFloor")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmGetFloor.funcGetFloor != nil {
+		return mmGetFloor.funcGetFloor(ctx, buildingId, number)
+	}
+	mmGetFloor.t.Fatalf("Unexpected call to FloorsServiceMock.GetFloor. %v %v %v", ctx, buildingId, number)
+	return
+}
+
+// GetFloorAfterCounter returns a count of finished FloorsServiceMock.GetFloor invocations
+func (mmGetFloor *FloorsServiceMock) GetFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloor.afterGetFloorCounter)
+}
+
+// GetFloorBeforeCounter returns a count of FloorsServiceMock.GetFloor invocations
+func (mmGetFloor *FloorsServiceMock) GetFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloor.beforeGetFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsServiceMock.GetFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFloor *mFloorsServiceMockGetFloor) Calls() []*FloorsServiceMockGetFloorParams {
+	mmGetFloor.mutex.RLock()
+
+	argCopy := make([]*FloorsServiceMockGetFloorParams, len(mmGetFloor.callArgs))
+	copy(argCopy, mmGetFloor.callArgs)
+
+	mmGetFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFloorDone returns true if the count of the GetFloor invocations corresponds
+// the number of defined expectations
+func (m *FloorsServiceMock) MinimockGetFloorDone() bool {
+	if m.GetFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFloorMock.invocationsDone()
+}
+
+// MinimockGetFloorInspect logs each unmet expectation
+func (m *FloorsServiceMock) MinimockGetFloorInspect() {
+	for _, e := range m.GetFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsServiceMock.GetFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterGetFloorCounter := mm_atomic.LoadUint64(&m.afterGetFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFloorMock.defaultExpectation != nil && afterGetFloorCounter < 1 {
+		if m.GetFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsServiceMock.GetFloor")
+		} else {
+			m.t.Errorf("Expected call to FloorsServiceMock.GetFloor with params: %#v", *m.GetFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFloor != nil && afterGetFloorCounter < 1 {
+		m.t.Error("Expected call to FloorsServiceMock.GetFloor")
+	}
+
+	if !m.GetFloorMock.invocationsDone() && afterGetFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsServiceMock.GetFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFloorMock.expectedInvocations), afterGetFloorCounter)
+	}
+}
+
+type mFloorsServiceMockGetFloors struct {
+	optional           bool
+	mock               *FloorsServiceMock
+	defaultExpectation *FloorsServiceMockGetFloorsExpectation
+	expectations       []*FloorsServiceMockGetFloorsExpectation
+
+	callArgs []*FloorsServiceMockGetFloorsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsServiceMockGetFloorsExpectation specifies expectation struct of the FloorsService.GetFloors
+type FloorsServiceMockGetFloorsExpectation struct {
+	mock      *FloorsServiceMock
+	params    *FloorsServiceMockGetFloorsParams
+	paramPtrs *FloorsServiceMockGetFloorsParamPtrs
+	results   *FloorsServiceMockGetFloorsResults
+	Counter   uint64
+}
+
+// FloorsServiceMockGetFloorsParams contains parameters of the FloorsService.GetFloors
+type FloorsServiceMockGetFloorsParams struct {
+	ctx        context.Context
+	buildingId int
+}
+
+// FloorsServiceMockGetFloorsParamPtrs contains pointers to parameters of the FloorsService.GetFloors
+type FloorsServiceMockGetFloorsParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+}
+
+// FloorsServiceMockGetFloorsResults contains results of the FloorsService.GetFloors
+type FloorsServiceMockGetFloorsResults struct {
+	fpa1 []*storage.Floor
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFloors *mFloorsServiceMockGetFloors) Optional() *mFloorsServiceMockGetFloors {
+	mmGetFloors.optional = true
+	return mmGetFloors
+}
+
+// Expect sets up expected params for FloorsService.GetFloors
+func (mmGetFloors *mFloorsServiceMockGetFloors) Expect(ctx context.Context, buildingId int) *mFloorsServiceMockGetFloors {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsServiceMockGetFloorsExpectation{}
+	}
+
+	if mmGetFloors.defaultExpectation.paramPtrs != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by ExpectParams functions")
+	}
+
+	mmGetFloors.defaultExpectation.params = &FloorsServiceMockGetFloorsParams{ctx, buildingId}
+	for _, e := range mmGetFloors.expectations {
+		if minimock.Equal(e.params, mmGetFloors.defaultExpectation.params) {
+			mmGetFloors.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFloors.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFloors
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsService.GetFloors
+func (mmGetFloors *mFloorsServiceMockGetFloors) ExpectCtxParam1(ctx context.Context) *mFloorsServiceMockGetFloors {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsServiceMockGetFloorsExpectation{}
+	}
+
+	if mmGetFloors.defaultExpectation.params != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Expect")
+	}
+
+	if mmGetFloors.defaultExpectation.paramPtrs == nil {
+		mmGetFloors.defaultExpectation.paramPtrs = &FloorsServiceMockGetFloorsParamPtrs{}
+	}
+	mmGetFloors.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetFloors
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for FloorsService.GetFloors
+func (mmGetFloors *mFloorsServiceMockGetFloors) ExpectBuildingIdParam2(buildingId int) *mFloorsServiceMockGetFloors {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsServiceMockGetFloorsExpectation{}
+	}
+
+	if mmGetFloors.defaultExpectation.params != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Expect")
+	}
+
+	if mmGetFloors.defaultExpectation.paramPtrs == nil {
+		mmGetFloors.defaultExpectation.paramPtrs = &FloorsServiceMockGetFloorsParamPtrs{}
+	}
+	mmGetFloors.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetFloors
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsService.GetFloors
+func (mmGetFloors *mFloorsServiceMockGetFloors) Inspect(f func(ctx context.Context, buildingId int)) *mFloorsServiceMockGetFloors {
+	if mmGetFloors.mock.inspectFuncGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("Inspect function is already set for FloorsServiceMock.GetFloors")
+	}
+
+	mmGetFloors.mock.inspectFuncGetFloors = f
+
+	return mmGetFloors
+}
+
+// Return sets up results that will be returned by FloorsService.GetFloors
+func (mmGetFloors *mFloorsServiceMockGetFloors) Return(fpa1 []*storage.Floor, err error) *FloorsServiceMock {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsServiceMockGetFloorsExpectation{mock: mmGetFloors.mock}
+	}
+	mmGetFloors.defaultExpectation.results = &FloorsServiceMockGetFloorsResults{fpa1, err}
+	return mmGetFloors.mock
+}
+
+// Set uses given function f to mock the FloorsService.GetFloors method
+func (mmGetFloors *mFloorsServiceMockGetFloors) Set(f func(ctx context.Context, buildingId int) (fpa1 []*storage.Floor, err error)) *FloorsServiceMock {
+	if mmGetFloors.defaultExpectation != nil {
+		mmGetFloors.mock.t.Fatalf("Default expectation is already set for the FloorsService.GetFloors method")
+	}
+
+	if len(mmGetFloors.expectations) > 0 {
+		mmGetFloors.mock.t.Fatalf("Some expectations are already set for the FloorsService.GetFloors method")
+	}
+
+	mmGetFloors.mock.funcGetFloors = f
+	return mmGetFloors.mock
+}
+
+// When sets expectation for the FloorsService.GetFloors which will trigger the result defined by the following
+// Then helper
+func (mmGetFloors *mFloorsServiceMockGetFloors) When(ctx context.Context, buildingId int) *FloorsServiceMockGetFloorsExpectation {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsServiceMock.GetFloors mock is already set by Set")
+	}
+
+	expectation := &FloorsServiceMockGetFloorsExpectation{
+		mock:   mmGetFloors.mock,
+		params: &FloorsServiceMockGetFloorsParams{ctx, buildingId},
+	}
+	mmGetFloors.expectations = append(mmGetFloors.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsService.GetFloors return parameters for the expectation previously defined by the When method
+func (e *FloorsServiceMockGetFloorsExpectation) Then(fpa1 []*storage.Floor, err error) *FloorsServiceMock {
+	e.results = &FloorsServiceMockGetFloorsResults{fpa1, err}
+	return e.mock
+}
+
+// Times sets number of times FloorsService.GetFloors should be invoked
+func (mmGetFloors *mFloorsServiceMockGetFloors) Times(n uint64) *mFloorsServiceMockGetFloors {
+	if n == 0 {
+		mmGetFloors.mock.t.Fatalf("Times of FloorsServiceMock.GetFloors mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFloors.expectedInvocations, n)
+	return mmGetFloors
+}
+
+func (mmGetFloors *mFloorsServiceMockGetFloors) invocationsDone() bool {
+	if len(mmGetFloors.expectations) == 0 && mmGetFloors.defaultExpectation == nil && mmGetFloors.mock.funcGetFloors == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFloors.mock.afterGetFloorsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFloors.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFloors implements floors.FloorsService
+func (mmGetFloors *FloorsServiceMock) GetFloors(ctx context.Context, buildingId int) (fpa1 []*storage.Floor, err error) {
+	mm_atomic.AddUint64(&mmGetFloors.beforeGetFloorsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFloors.afterGetFloorsCounter, 1)
+
+	if mmGetFloors.inspectFuncGetFloors != nil {
+		mmGetFloors.inspectFuncGetFloors(ctx, buildingId)
+	}
+
+	mm_params := FloorsServiceMockGetFloorsParams{ctx, buildingId}
+
+	// Record call args
+	mmGetFloors.GetFloorsMock.mutex.Lock()
+	mmGetFloors.GetFloorsMock.callArgs = append(mmGetFloors.GetFloorsMock.callArgs, &mm_params)
+	mmGetFloors.GetFloorsMock.mutex.Unlock()
+
+	for _, e := range mmGetFloors.GetFloorsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fpa1, e.results.err
+		}
+	}
+
+	if mmGetFloors.GetFloorsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFloors.GetFloorsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFloors.GetFloorsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFloors.GetFloorsMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsServiceMockGetFloorsParams{ctx, buildingId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFloors.t.Errorf("FloorsServiceMock.GetFloors got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetFloors.t.Errorf("FloorsServiceMock.GetFloors got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFloors.t.Errorf("FloorsServiceMock.GetFloors got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFloors.GetFloorsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFloors.t.Fatal("No results are set for the FloorsServiceMock.GetFloors")
+		}
+		return (*mm_results).fpa1, (*mm_results).err
+	}
+	if mmGetFloors.funcGetFloors != nil {
+		return mmGetFloors.funcGetFloors(ctx, buildingId)
+	}
+	mmGetFloors.t.Fatalf("Unexpected call to FloorsServiceMock.GetFloors. %v %v", ctx, buildingId)
+	return
+}
+
+// GetFloorsAfterCounter returns a count of finished FloorsServiceMock.GetFloors invocations
+func (mmGetFloors *FloorsServiceMock) GetFloorsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloors.afterGetFloorsCounter)
+}
+
+// GetFloorsBeforeCounter returns a count of FloorsServiceMock.GetFloors invocations
+func (mmGetFloors *FloorsServiceMock) GetFloorsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloors.beforeGetFloorsCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsServiceMock.GetFloors.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFloors *mFloorsServiceMockGetFloors) Calls() []*FloorsServiceMockGetFloorsParams {
+	mmGetFloors.mutex.RLock()
+
+	argCopy := make([]*FloorsServiceMockGetFloorsParams, len(mmGetFloors.callArgs))
+	copy(argCopy, mmGetFloors.callArgs)
+
+	mmGetFloors.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFloorsDone returns true if the count of the GetFloors invocations corresponds
+// the number of defined expectations
+func (m *FloorsServiceMock) MinimockGetFloorsDone() bool {
+	if m.GetFloorsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFloorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFloorsMock.invocationsDone()
+}
+
+// MinimockGetFloorsInspect logs each unmet expectation
+func (m *FloorsServiceMock) MinimockGetFloorsInspect() {
+	for _, e := range m.GetFloorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsServiceMock.GetFloors with params: %#v", *e.params)
+		}
+	}
+
+	afterGetFloorsCounter := mm_atomic.LoadUint64(&m.afterGetFloorsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFloorsMock.defaultExpectation != nil && afterGetFloorsCounter < 1 {
+		if m.GetFloorsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsServiceMock.GetFloors")
+		} else {
+			m.t.Errorf("Expected call to FloorsServiceMock.GetFloors with params: %#v", *m.GetFloorsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFloors != nil && afterGetFloorsCounter < 1 {
+		m.t.Error("Expected call to FloorsServiceMock.GetFloors")
+	}
+
+	if !m.GetFloorsMock.invocationsDone() && afterGetFloorsCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsServiceMock.GetFloors but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFloorsMock.expectedInvocations), afterGetFloorsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FloorsServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateFloorInspect()
+
+			m.MinimockDeleteFloorInspect()
+
+			m.MinimockGetFloorInspect()
+
+			m.MinimockGetFloorsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FloorsServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FloorsServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateFloorDone() &&
+		m.MinimockDeleteFloorDone() &&
+		m.MinimockGetFloorDone() &&
+		m.MinimockGetFloorsDone()
+}
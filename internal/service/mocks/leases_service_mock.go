@@ -0,0 +1,2448 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/leases.LeasesService -o leases_service_mock_test.go -n LeasesServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_leases "github.com/sotskov-do/oms-assignment/internal/service/leases"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// LeasesServiceMock implements leases.LeasesService
+type LeasesServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateLease          func(ctx context.Context, lease *storage.Lease) (err error)
+	inspectFuncCreateLease   func(ctx context.Context, lease *storage.Lease)
+	afterCreateLeaseCounter  uint64
+	beforeCreateLeaseCounter uint64
+	CreateLeaseMock          mLeasesServiceMockCreateLease
+
+	funcDeleteLease          func(ctx context.Context, id int) (err error)
+	inspectFuncDeleteLease   func(ctx context.Context, id int)
+	afterDeleteLeaseCounter  uint64
+	beforeDeleteLeaseCounter uint64
+	DeleteLeaseMock          mLeasesServiceMockDeleteLease
+
+	funcGetApartmentLeases          func(ctx context.Context, apartmentId int) (lpa1 []*storage.Lease, err error)
+	inspectFuncGetApartmentLeases   func(ctx context.Context, apartmentId int)
+	afterGetApartmentLeasesCounter  uint64
+	beforeGetApartmentLeasesCounter uint64
+	GetApartmentLeasesMock          mLeasesServiceMockGetApartmentLeases
+
+	funcGetExpiringLeases          func(ctx context.Context, days int) (lpa1 []*storage.Lease, err error)
+	inspectFuncGetExpiringLeases   func(ctx context.Context, days int)
+	afterGetExpiringLeasesCounter  uint64
+	beforeGetExpiringLeasesCounter uint64
+	GetExpiringLeasesMock          mLeasesServiceMockGetExpiringLeases
+
+	funcGetLease          func(ctx context.Context, id int) (lp1 *storage.Lease, err error)
+	inspectFuncGetLease   func(ctx context.Context, id int)
+	afterGetLeaseCounter  uint64
+	beforeGetLeaseCounter uint64
+	GetLeaseMock          mLeasesServiceMockGetLease
+
+	funcRenewLease          func(ctx context.Context, id int, renewal *mm_leases.Renewal) (lp1 *storage.Lease, err error)
+	inspectFuncRenewLease   func(ctx context.Context, id int, renewal *mm_leases.Renewal)
+	afterRenewLeaseCounter  uint64
+	beforeRenewLeaseCounter uint64
+	RenewLeaseMock          mLeasesServiceMockRenewLease
+
+	funcTerminateLease          func(ctx context.Context, id int, termination *mm_leases.Termination) (lp1 *storage.Lease, err error)
+	inspectFuncTerminateLease   func(ctx context.Context, id int, termination *mm_leases.Termination)
+	afterTerminateLeaseCounter  uint64
+	beforeTerminateLeaseCounter uint64
+	TerminateLeaseMock          mLeasesServiceMockTerminateLease
+}
+
+// NewLeasesServiceMock returns a mock for leases.LeasesService
+func NewLeasesServiceMock(t minimock.Tester) *LeasesServiceMock {
+	m := &LeasesServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateLeaseMock = mLeasesServiceMockCreateLease{mock: m}
+	m.CreateLeaseMock.callArgs = []*LeasesServiceMockCreateLeaseParams{}
+
+	m.DeleteLeaseMock = mLeasesServiceMockDeleteLease{mock: m}
+	m.DeleteLeaseMock.callArgs = []*LeasesServiceMockDeleteLeaseParams{}
+
+	m.GetApartmentLeasesMock = mLeasesServiceMockGetApartmentLeases{mock: m}
+	m.GetApartmentLeasesMock.callArgs = []*LeasesServiceMockGetApartmentLeasesParams{}
+
+	m.GetExpiringLeasesMock = mLeasesServiceMockGetExpiringLeases{mock: m}
+	m.GetExpiringLeasesMock.callArgs = []*LeasesServiceMockGetExpiringLeasesParams{}
+
+	m.GetLeaseMock = mLeasesServiceMockGetLease{mock: m}
+	m.GetLeaseMock.callArgs = []*LeasesServiceMockGetLeaseParams{}
+
+	m.RenewLeaseMock = mLeasesServiceMockRenewLease{mock: m}
+	m.RenewLeaseMock.callArgs = []*LeasesServiceMockRenewLeaseParams{}
+
+	m.TerminateLeaseMock = mLeasesServiceMockTerminateLease{mock: m}
+	m.TerminateLeaseMock.callArgs = []*LeasesServiceMockTerminateLeaseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLeasesServiceMockCreateLease struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockCreateLeaseExpectation
+	expectations       []*LeasesServiceMockCreateLeaseExpectation
+
+	callArgs []*LeasesServiceMockCreateLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockCreateLeaseExpectation specifies expectation struct of the LeasesService.CreateLease
+type LeasesServiceMockCreateLeaseExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockCreateLeaseParams
+	paramPtrs *LeasesServiceMockCreateLeaseParamPtrs
+	results   *LeasesServiceMockCreateLeaseResults
+	Counter   uint64
+}
+
+// LeasesServiceMockCreateLeaseParams contains parameters of the LeasesService.CreateLease
+type LeasesServiceMockCreateLeaseParams struct {
+	ctx   context.Context
+	lease *storage.Lease
+}
+
+// LeasesServiceMockCreateLeaseParamPtrs contains pointers to parameters of the LeasesService.CreateLease
+type LeasesServiceMockCreateLeaseParamPtrs struct {
+	ctx   *context.Context
+	lease **storage.Lease
+}
+
+// LeasesServiceMockCreateLeaseResults contains results of the LeasesService.CreateLease
+type LeasesServiceMockCreateLeaseResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateLease *mLeasesServiceMockCreateLease) Optional() *mLeasesServiceMockCreateLease {
+	mmCreateLease.optional = true
+	return mmCreateLease
+}
+
+// Expect sets up expected params for LeasesService.CreateLease
+func (mmCreateLease *mLeasesServiceMockCreateLease) Expect(ctx context.Context, lease *storage.Lease) *mLeasesServiceMockCreateLease {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesServiceMockCreateLeaseExpectation{}
+	}
+
+	if mmCreateLease.defaultExpectation.paramPtrs != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by ExpectParams functions")
+	}
+
+	mmCreateLease.defaultExpectation.params = &LeasesServiceMockCreateLeaseParams{ctx, lease}
+	for _, e := range mmCreateLease.expectations {
+		if minimock.Equal(e.params, mmCreateLease.defaultExpectation.params) {
+			mmCreateLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateLease.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.CreateLease
+func (mmCreateLease *mLeasesServiceMockCreateLease) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockCreateLease {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesServiceMockCreateLeaseExpectation{}
+	}
+
+	if mmCreateLease.defaultExpectation.params != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Expect")
+	}
+
+	if mmCreateLease.defaultExpectation.paramPtrs == nil {
+		mmCreateLease.defaultExpectation.paramPtrs = &LeasesServiceMockCreateLeaseParamPtrs{}
+	}
+	mmCreateLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateLease
+}
+
+// ExpectLeaseParam2 sets up expected param lease for LeasesService.CreateLease
+func (mmCreateLease *mLeasesServiceMockCreateLease) ExpectLeaseParam2(lease *storage.Lease) *mLeasesServiceMockCreateLease {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesServiceMockCreateLeaseExpectation{}
+	}
+
+	if mmCreateLease.defaultExpectation.params != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Expect")
+	}
+
+	if mmCreateLease.defaultExpectation.paramPtrs == nil {
+		mmCreateLease.defaultExpectation.paramPtrs = &LeasesServiceMockCreateLeaseParamPtrs{}
+	}
+	mmCreateLease.defaultExpectation.paramPtrs.lease = &lease
+
+	return mmCreateLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.CreateLease
+func (mmCreateLease *mLeasesServiceMockCreateLease) Inspect(f func(ctx context.Context, lease *storage.Lease)) *mLeasesServiceMockCreateLease {
+	if mmCreateLease.mock.inspectFuncCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.CreateLease")
+	}
+
+	mmCreateLease.mock.inspectFuncCreateLease = f
+
+	return mmCreateLease
+}
+
+// Return sets up results that will be returned by LeasesService.CreateLease
+func (mmCreateLease *mLeasesServiceMockCreateLease) Return(err error) *LeasesServiceMock {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesServiceMockCreateLeaseExpectation{mock: mmCreateLease.mock}
+	}
+	mmCreateLease.defaultExpectation.results = &LeasesServiceMockCreateLeaseResults{err}
+	return mmCreateLease.mock
+}
+
+// Set uses given function f to mock the LeasesService.CreateLease method
+func (mmCreateLease *mLeasesServiceMockCreateLease) Set(f func(ctx context.Context, lease *storage.Lease) (err error)) *LeasesServiceMock {
+	if mmCreateLease.defaultExpectation != nil {
+		mmCreateLease.mock.t.Fatalf("Default expectation is already set for the LeasesService.CreateLease method")
+	}
+
+	if len(mmCreateLease.expectations) > 0 {
+		mmCreateLease.mock.t.Fatalf("Some expectations are already set for the LeasesService.CreateLease method")
+	}
+
+	mmCreateLease.mock.funcCreateLease = f
+	return mmCreateLease.mock
+}
+
+// When sets expectation for the LeasesService.CreateLease which will trigger the result defined by the following
+// Then helper
+func (mmCreateLease *mLeasesServiceMockCreateLease) When(ctx context.Context, lease *storage.Lease) *LeasesServiceMockCreateLeaseExpectation {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesServiceMock.CreateLease mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockCreateLeaseExpectation{
+		mock:   mmCreateLease.mock,
+		params: &LeasesServiceMockCreateLeaseParams{ctx, lease},
+	}
+	mmCreateLease.expectations = append(mmCreateLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.CreateLease return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockCreateLeaseExpectation) Then(err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockCreateLeaseResults{err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.CreateLease should be invoked
+func (mmCreateLease *mLeasesServiceMockCreateLease) Times(n uint64) *mLeasesServiceMockCreateLease {
+	if n == 0 {
+		mmCreateLease.mock.t.Fatalf("Times of LeasesServiceMock.CreateLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateLease.expectedInvocations, n)
+	return mmCreateLease
+}
+
+func (mmCreateLease *mLeasesServiceMockCreateLease) invocationsDone() bool {
+	if len(mmCreateLease.expectations) == 0 && mmCreateLease.defaultExpectation == nil && mmCreateLease.mock.funcCreateLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateLease.mock.afterCreateLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateLease implements leases.LeasesService
+func (mmCreateLease *LeasesServiceMock) CreateLease(ctx context.Context, lease *storage.Lease) (err error) {
+	mm_atomic.AddUint64(&mmCreateLease.beforeCreateLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateLease.afterCreateLeaseCounter, 1)
+
+	if mmCreateLease.inspectFuncCreateLease != nil {
+		mmCreateLease.inspectFuncCreateLease(ctx, lease)
+	}
+
+	mm_params := LeasesServiceMockCreateLeaseParams{ctx, lease}
+
+	// Record call args
+	mmCreateLease.CreateLeaseMock.mutex.Lock()
+	mmCreateLease.CreateLeaseMock.callArgs = append(mmCreateLease.CreateLeaseMock.callArgs, &mm_params)
+	mmCreateLease.CreateLeaseMock.mutex.Unlock()
+
+	for _, e := range mmCreateLease.CreateLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateLease.CreateLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateLease.CreateLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateLease.CreateLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateLease.CreateLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockCreateLeaseParams{ctx, lease}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateLease.t.Errorf("LeasesServiceMock.CreateLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.lease != nil && !minimock.Equal(*mm_want_ptrs.lease, mm_got.lease) {
+				mmCreateLease.t.Errorf("LeasesServiceMock.CreateLease got unexpected parameter lease, want: %#v, got: %#v%s\n", *mm_want_ptrs.lease, mm_got.lease, minimock.Diff(*mm_want_ptrs.lease, mm_got.lease))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateLease.t.Errorf("LeasesServiceMock.CreateLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateLease.CreateLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateLease.t.Fatal("No results are set for the LeasesServiceMock.CreateLease")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateLease.funcCreateLease != nil {
+		return mmCreateLease.funcCreateLease(ctx, lease)
+	}
+	mmCreateLease.t.Fatalf("Unexpected call to LeasesServiceMock.CreateLease. %v %v", ctx, lease)
+	return
+}
+
+// CreateLeaseAfterCounter returns a count of finished LeasesServiceMock.CreateLease invocations
+func (mmCreateLease *LeasesServiceMock) CreateLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLease.afterCreateLeaseCounter)
+}
+
+// CreateLeaseBeforeCounter returns a count of LeasesServiceMock.CreateLease invocations
+func (mmCreateLease *LeasesServiceMock) CreateLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLease.beforeCreateLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.CreateLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateLease *mLeasesServiceMockCreateLease) Calls() []*LeasesServiceMockCreateLeaseParams {
+	mmCreateLease.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockCreateLeaseParams, len(mmCreateLease.callArgs))
+	copy(argCopy, mmCreateLease.callArgs)
+
+	mmCreateLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateLeaseDone returns true if the count of the CreateLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockCreateLeaseDone() bool {
+	if m.CreateLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateLeaseMock.invocationsDone()
+}
+
+// MinimockCreateLeaseInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockCreateLeaseInspect() {
+	for _, e := range m.CreateLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.CreateLease with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateLeaseCounter := mm_atomic.LoadUint64(&m.afterCreateLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateLeaseMock.defaultExpectation != nil && afterCreateLeaseCounter < 1 {
+		if m.CreateLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.CreateLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.CreateLease with params: %#v", *m.CreateLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateLease != nil && afterCreateLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.CreateLease")
+	}
+
+	if !m.CreateLeaseMock.invocationsDone() && afterCreateLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.CreateLease but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateLeaseMock.expectedInvocations), afterCreateLeaseCounter)
+	}
+}
+
+type mLeasesServiceMockDeleteLease struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockDeleteLeaseExpectation
+	expectations       []*LeasesServiceMockDeleteLeaseExpectation
+
+	callArgs []*LeasesServiceMockDeleteLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockDeleteLeaseExpectation specifies expectation struct of the LeasesService.DeleteLease
+type LeasesServiceMockDeleteLeaseExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockDeleteLeaseParams
+	paramPtrs *LeasesServiceMockDeleteLeaseParamPtrs
+	results   *LeasesServiceMockDeleteLeaseResults
+	Counter   uint64
+}
+
+// LeasesServiceMockDeleteLeaseParams contains parameters of the LeasesService.DeleteLease
+type LeasesServiceMockDeleteLeaseParams struct {
+	ctx context.Context
+	id  int
+}
+
+// LeasesServiceMockDeleteLeaseParamPtrs contains pointers to parameters of the LeasesService.DeleteLease
+type LeasesServiceMockDeleteLeaseParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// LeasesServiceMockDeleteLeaseResults contains results of the LeasesService.DeleteLease
+type LeasesServiceMockDeleteLeaseResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Optional() *mLeasesServiceMockDeleteLease {
+	mmDeleteLease.optional = true
+	return mmDeleteLease
+}
+
+// Expect sets up expected params for LeasesService.DeleteLease
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Expect(ctx context.Context, id int) *mLeasesServiceMockDeleteLease {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesServiceMockDeleteLeaseExpectation{}
+	}
+
+	if mmDeleteLease.defaultExpectation.paramPtrs != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteLease.defaultExpectation.params = &LeasesServiceMockDeleteLeaseParams{ctx, id}
+	for _, e := range mmDeleteLease.expectations {
+		if minimock.Equal(e.params, mmDeleteLease.defaultExpectation.params) {
+			mmDeleteLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteLease.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.DeleteLease
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockDeleteLease {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesServiceMockDeleteLeaseExpectation{}
+	}
+
+	if mmDeleteLease.defaultExpectation.params != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Expect")
+	}
+
+	if mmDeleteLease.defaultExpectation.paramPtrs == nil {
+		mmDeleteLease.defaultExpectation.paramPtrs = &LeasesServiceMockDeleteLeaseParamPtrs{}
+	}
+	mmDeleteLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteLease
+}
+
+// ExpectIdParam2 sets up expected param id for LeasesService.DeleteLease
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) ExpectIdParam2(id int) *mLeasesServiceMockDeleteLease {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesServiceMockDeleteLeaseExpectation{}
+	}
+
+	if mmDeleteLease.defaultExpectation.params != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Expect")
+	}
+
+	if mmDeleteLease.defaultExpectation.paramPtrs == nil {
+		mmDeleteLease.defaultExpectation.paramPtrs = &LeasesServiceMockDeleteLeaseParamPtrs{}
+	}
+	mmDeleteLease.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.DeleteLease
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Inspect(f func(ctx context.Context, id int)) *mLeasesServiceMockDeleteLease {
+	if mmDeleteLease.mock.inspectFuncDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.DeleteLease")
+	}
+
+	mmDeleteLease.mock.inspectFuncDeleteLease = f
+
+	return mmDeleteLease
+}
+
+// Return sets up results that will be returned by LeasesService.DeleteLease
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Return(err error) *LeasesServiceMock {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesServiceMockDeleteLeaseExpectation{mock: mmDeleteLease.mock}
+	}
+	mmDeleteLease.defaultExpectation.results = &LeasesServiceMockDeleteLeaseResults{err}
+	return mmDeleteLease.mock
+}
+
+// Set uses given function f to mock the LeasesService.DeleteLease method
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Set(f func(ctx context.Context, id int) (err error)) *LeasesServiceMock {
+	if mmDeleteLease.defaultExpectation != nil {
+		mmDeleteLease.mock.t.Fatalf("Default expectation is already set for the LeasesService.DeleteLease method")
+	}
+
+	if len(mmDeleteLease.expectations) > 0 {
+		mmDeleteLease.mock.t.Fatalf("Some expectations are already set for the LeasesService.DeleteLease method")
+	}
+
+	mmDeleteLease.mock.funcDeleteLease = f
+	return mmDeleteLease.mock
+}
+
+// When sets expectation for the LeasesService.DeleteLease which will trigger the result defined by the following
+// Then helper
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) When(ctx context.Context, id int) *LeasesServiceMockDeleteLeaseExpectation {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesServiceMock.DeleteLease mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockDeleteLeaseExpectation{
+		mock:   mmDeleteLease.mock,
+		params: &LeasesServiceMockDeleteLeaseParams{ctx, id},
+	}
+	mmDeleteLease.expectations = append(mmDeleteLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.DeleteLease return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockDeleteLeaseExpectation) Then(err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockDeleteLeaseResults{err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.DeleteLease should be invoked
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Times(n uint64) *mLeasesServiceMockDeleteLease {
+	if n == 0 {
+		mmDeleteLease.mock.t.Fatalf("Times of LeasesServiceMock.DeleteLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteLease.expectedInvocations, n)
+	return mmDeleteLease
+}
+
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) invocationsDone() bool {
+	if len(mmDeleteLease.expectations) == 0 && mmDeleteLease.defaultExpectation == nil && mmDeleteLease.mock.funcDeleteLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteLease.mock.afterDeleteLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteLease implements leases.LeasesService
+func (mmDeleteLease *LeasesServiceMock) DeleteLease(ctx context.Context, id int) (err error) {
+	mm_atomic.AddUint64(&mmDeleteLease.beforeDeleteLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteLease.afterDeleteLeaseCounter, 1)
+
+	if mmDeleteLease.inspectFuncDeleteLease != nil {
+		mmDeleteLease.inspectFuncDeleteLease(ctx, id)
+	}
+
+	mm_params := LeasesServiceMockDeleteLeaseParams{ctx, id}
+
+	// Record call args
+	mmDeleteLease.DeleteLeaseMock.mutex.Lock()
+	mmDeleteLease.DeleteLeaseMock.callArgs = append(mmDeleteLease.DeleteLeaseMock.callArgs, &mm_params)
+	mmDeleteLease.DeleteLeaseMock.mutex.Unlock()
+
+	for _, e := range mmDeleteLease.DeleteLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteLease.DeleteLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteLease.DeleteLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteLease.DeleteLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteLease.DeleteLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockDeleteLeaseParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteLease.t.Errorf("LeasesServiceMock.DeleteLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteLease.t.Errorf("LeasesServiceMock.DeleteLease got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteLease.t.Errorf("LeasesServiceMock.DeleteLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteLease.DeleteLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteLease.t.Fatal("No results are set for the LeasesServiceMock.DeleteLease")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteLease.funcDeleteLease != nil {
+		return mmDeleteLease.funcDeleteLease(ctx, id)
+	}
+	mmDeleteLease.t.Fatalf("Unexpected call to LeasesServiceMock.DeleteLease. %v %v", ctx, id)
+	return
+}
+
+// DeleteLeaseAfterCounter returns a count of finished LeasesServiceMock.DeleteLease invocations
+func (mmDeleteLease *LeasesServiceMock) DeleteLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteLease.afterDeleteLeaseCounter)
+}
+
+// DeleteLeaseBeforeCounter returns a count of LeasesServiceMock.DeleteLease invocations
+func (mmDeleteLease *LeasesServiceMock) DeleteLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteLease.beforeDeleteLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.DeleteLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteLease *mLeasesServiceMockDeleteLease) Calls() []*LeasesServiceMockDeleteLeaseParams {
+	mmDeleteLease.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockDeleteLeaseParams, len(mmDeleteLease.callArgs))
+	copy(argCopy, mmDeleteLease.callArgs)
+
+	mmDeleteLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteLeaseDone returns true if the count of the DeleteLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockDeleteLeaseDone() bool {
+	if m.DeleteLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteLeaseMock.invocationsDone()
+}
+
+// MinimockDeleteLeaseInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockDeleteLeaseInspect() {
+	for _, e := range m.DeleteLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.DeleteLease with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteLeaseCounter := mm_atomic.LoadUint64(&m.afterDeleteLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteLeaseMock.defaultExpectation != nil && afterDeleteLeaseCounter < 1 {
+		if m.DeleteLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.DeleteLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.DeleteLease with params: %#v", *m.DeleteLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteLease != nil && afterDeleteLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.DeleteLease")
+	}
+
+	if !m.DeleteLeaseMock.invocationsDone() && afterDeleteLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.DeleteLease but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteLeaseMock.expectedInvocations), afterDeleteLeaseCounter)
+	}
+}
+
+type mLeasesServiceMockGetApartmentLeases struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockGetApartmentLeasesExpectation
+	expectations       []*LeasesServiceMockGetApartmentLeasesExpectation
+
+	callArgs []*LeasesServiceMockGetApartmentLeasesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockGetApartmentLeasesExpectation specifies expectation struct of the LeasesService.GetApartmentLeases
+type LeasesServiceMockGetApartmentLeasesExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockGetApartmentLeasesParams
+	paramPtrs *LeasesServiceMockGetApartmentLeasesParamPtrs
+	results   *LeasesServiceMockGetApartmentLeasesResults
+	Counter   uint64
+}
+
+// LeasesServiceMockGetApartmentLeasesParams contains parameters of the LeasesService.GetApartmentLeases
+type LeasesServiceMockGetApartmentLeasesParams struct {
+	ctx         context.Context
+	apartmentId int
+}
+
+// LeasesServiceMockGetApartmentLeasesParamPtrs contains pointers to parameters of the LeasesService.GetApartmentLeases
+type LeasesServiceMockGetApartmentLeasesParamPtrs struct {
+	ctx         *context.Context
+	apartmentId *int
+}
+
+// LeasesServiceMockGetApartmentLeasesResults contains results of the LeasesService.GetApartmentLeases
+type LeasesServiceMockGetApartmentLeasesResults struct {
+	lpa1 []*storage.Lease
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Optional() *mLeasesServiceMockGetApartmentLeases {
+	mmGetApartmentLeases.optional = true
+	return mmGetApartmentLeases
+}
+
+// Expect sets up expected params for LeasesService.GetApartmentLeases
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Expect(ctx context.Context, apartmentId int) *mLeasesServiceMockGetApartmentLeases {
+	if mmGetApartmentLeases.mock.funcGetApartmentLeases != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Set")
+	}
+
+	if mmGetApartmentLeases.defaultExpectation == nil {
+		mmGetApartmentLeases.defaultExpectation = &LeasesServiceMockGetApartmentLeasesExpectation{}
+	}
+
+	if mmGetApartmentLeases.defaultExpectation.paramPtrs != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by ExpectParams functions")
+	}
+
+	mmGetApartmentLeases.defaultExpectation.params = &LeasesServiceMockGetApartmentLeasesParams{ctx, apartmentId}
+	for _, e := range mmGetApartmentLeases.expectations {
+		if minimock.Equal(e.params, mmGetApartmentLeases.defaultExpectation.params) {
+			mmGetApartmentLeases.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetApartmentLeases.defaultExpectation.params)
+		}
+	}
+
+	return mmGetApartmentLeases
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.GetApartmentLeases
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockGetApartmentLeases {
+	if mmGetApartmentLeases.mock.funcGetApartmentLeases != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Set")
+	}
+
+	if mmGetApartmentLeases.defaultExpectation == nil {
+		mmGetApartmentLeases.defaultExpectation = &LeasesServiceMockGetApartmentLeasesExpectation{}
+	}
+
+	if mmGetApartmentLeases.defaultExpectation.params != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Expect")
+	}
+
+	if mmGetApartmentLeases.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentLeases.defaultExpectation.paramPtrs = &LeasesServiceMockGetApartmentLeasesParamPtrs{}
+	}
+	mmGetApartmentLeases.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetApartmentLeases
+}
+
+// ExpectApartmentIdParam2 sets up expected param apartmentId for LeasesService.GetApartmentLeases
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) ExpectApartmentIdParam2(apartmentId int) *mLeasesServiceMockGetApartmentLeases {
+	if mmGetApartmentLeases.mock.funcGetApartmentLeases != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Set")
+	}
+
+	if mmGetApartmentLeases.defaultExpectation == nil {
+		mmGetApartmentLeases.defaultExpectation = &LeasesServiceMockGetApartmentLeasesExpectation{}
+	}
+
+	if mmGetApartmentLeases.defaultExpectation.params != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Expect")
+	}
+
+	if mmGetApartmentLeases.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentLeases.defaultExpectation.paramPtrs = &LeasesServiceMockGetApartmentLeasesParamPtrs{}
+	}
+	mmGetApartmentLeases.defaultExpectation.paramPtrs.apartmentId = &apartmentId
+
+	return mmGetApartmentLeases
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.GetApartmentLeases
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Inspect(f func(ctx context.Context, apartmentId int)) *mLeasesServiceMockGetApartmentLeases {
+	if mmGetApartmentLeases.mock.inspectFuncGetApartmentLeases != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.GetApartmentLeases")
+	}
+
+	mmGetApartmentLeases.mock.inspectFuncGetApartmentLeases = f
+
+	return mmGetApartmentLeases
+}
+
+// Return sets up results that will be returned by LeasesService.GetApartmentLeases
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Return(lpa1 []*storage.Lease, err error) *LeasesServiceMock {
+	if mmGetApartmentLeases.mock.funcGetApartmentLeases != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Set")
+	}
+
+	if mmGetApartmentLeases.defaultExpectation == nil {
+		mmGetApartmentLeases.defaultExpectation = &LeasesServiceMockGetApartmentLeasesExpectation{mock: mmGetApartmentLeases.mock}
+	}
+	mmGetApartmentLeases.defaultExpectation.results = &LeasesServiceMockGetApartmentLeasesResults{lpa1, err}
+	return mmGetApartmentLeases.mock
+}
+
+// Set uses given function f to mock the LeasesService.GetApartmentLeases method
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Set(f func(ctx context.Context, apartmentId int) (lpa1 []*storage.Lease, err error)) *LeasesServiceMock {
+	if mmGetApartmentLeases.defaultExpectation != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("Default expectation is already set for the LeasesService.GetApartmentLeases method")
+	}
+
+	if len(mmGetApartmentLeases.expectations) > 0 {
+		mmGetApartmentLeases.mock.t.Fatalf("Some expectations are already set for the LeasesService.GetApartmentLeases method")
+	}
+
+	mmGetApartmentLeases.mock.funcGetApartmentLeases = f
+	return mmGetApartmentLeases.mock
+}
+
+// When sets expectation for the LeasesService.GetApartmentLeases which will trigger the result defined by the following
+// Then helper
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) When(ctx context.Context, apartmentId int) *LeasesServiceMockGetApartmentLeasesExpectation {
+	if mmGetApartmentLeases.mock.funcGetApartmentLeases != nil {
+		mmGetApartmentLeases.mock.t.Fatalf("LeasesServiceMock.GetApartmentLeases mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockGetApartmentLeasesExpectation{
+		mock:   mmGetApartmentLeases.mock,
+		params: &LeasesServiceMockGetApartmentLeasesParams{ctx, apartmentId},
+	}
+	mmGetApartmentLeases.expectations = append(mmGetApartmentLeases.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.GetApartmentLeases return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockGetApartmentLeasesExpectation) Then(lpa1 []*storage.Lease, err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockGetApartmentLeasesResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.GetApartmentLeases should be invoked
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Times(n uint64) *mLeasesServiceMockGetApartmentLeases {
+	if n == 0 {
+		mmGetApartmentLeases.mock.t.Fatalf("Times of LeasesServiceMock.GetApartmentLeases mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetApartmentLeases.expectedInvocations, n)
+	return mmGetApartmentLeases
+}
+
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) invocationsDone() bool {
+	if len(mmGetApartmentLeases.expectations) == 0 && mmGetApartmentLeases.defaultExpectation == nil && mmGetApartmentLeases.mock.funcGetApartmentLeases == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetApartmentLeases.mock.afterGetApartmentLeasesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetApartmentLeases.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetApartmentLeases implements leases.LeasesService
+func (mmGetApartmentLeases *LeasesServiceMock) GetApartmentLeases(ctx context.Context, apartmentId int) (lpa1 []*storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmGetApartmentLeases.beforeGetApartmentLeasesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetApartmentLeases.afterGetApartmentLeasesCounter, 1)
+
+	if mmGetApartmentLeases.inspectFuncGetApartmentLeases != nil {
+		mmGetApartmentLeases.inspectFuncGetApartmentLeases(ctx, apartmentId)
+	}
+
+	mm_params := LeasesServiceMockGetApartmentLeasesParams{ctx, apartmentId}
+
+	// Record call args
+	mmGetApartmentLeases.GetApartmentLeasesMock.mutex.Lock()
+	mmGetApartmentLeases.GetApartmentLeasesMock.callArgs = append(mmGetApartmentLeases.GetApartmentLeasesMock.callArgs, &mm_params)
+	mmGetApartmentLeases.GetApartmentLeasesMock.mutex.Unlock()
+
+	for _, e := range mmGetApartmentLeases.GetApartmentLeasesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmGetApartmentLeases.GetApartmentLeasesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetApartmentLeases.GetApartmentLeasesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetApartmentLeases.GetApartmentLeasesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetApartmentLeases.GetApartmentLeasesMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockGetApartmentLeasesParams{ctx, apartmentId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetApartmentLeases.t.Errorf("LeasesServiceMock.GetApartmentLeases got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.apartmentId != nil && !minimock.Equal(*mm_want_ptrs.apartmentId, mm_got.apartmentId) {
+				mmGetApartmentLeases.t.Errorf("LeasesServiceMock.GetApartmentLeases got unexpected parameter apartmentId, want: %#v, got: %#v%s\n", *mm_want_ptrs.apartmentId, mm_got.apartmentId, minimock.Diff(*mm_want_ptrs.apartmentId, mm_got.apartmentId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetApartmentLeases.t.Errorf("LeasesServiceMock.GetApartmentLeases got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetApartmentLeases.GetApartmentLeasesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetApartmentLeases.t.Fatal("No results are set for the LeasesServiceMock.GetApartmentLeases")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmGetApartmentLeases.funcGetApartmentLeases != nil {
+		return mmGetApartmentLeases.funcGetApartmentLeases(ctx, apartmentId)
+	}
+	mmGetApartmentLeases.t.Fatalf("Unexpected call to LeasesServiceMock.GetApartmentLeases. %v %v", ctx, apartmentId)
+	return
+}
+
+// GetApartmentLeasesAfterCounter returns a count of finished LeasesServiceMock.GetApartmentLeases invocations
+func (mmGetApartmentLeases *LeasesServiceMock) GetApartmentLeasesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentLeases.afterGetApartmentLeasesCounter)
+}
+
+// GetApartmentLeasesBeforeCounter returns a count of LeasesServiceMock.GetApartmentLeases invocations
+func (mmGetApartmentLeases *LeasesServiceMock) GetApartmentLeasesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentLeases.beforeGetApartmentLeasesCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.GetApartmentLeases.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetApartmentLeases *mLeasesServiceMockGetApartmentLeases) Calls() []*LeasesServiceMockGetApartmentLeasesParams {
+	mmGetApartmentLeases.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockGetApartmentLeasesParams, len(mmGetApartmentLeases.callArgs))
+	copy(argCopy, mmGetApartmentLeases.callArgs)
+
+	mmGetApartmentLeases.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetApartmentLeasesDone returns true if the count of the GetApartmentLeases invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockGetApartmentLeasesDone() bool {
+	if m.GetApartmentLeasesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetApartmentLeasesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetApartmentLeasesMock.invocationsDone()
+}
+
+// MinimockGetApartmentLeasesInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockGetApartmentLeasesInspect() {
+	for _, e := range m.GetApartmentLeasesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.GetApartmentLeases with params: %#v", *e.params)
+		}
+	}
+
+	afterGetApartmentLeasesCounter := mm_atomic.LoadUint64(&m.afterGetApartmentLeasesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetApartmentLeasesMock.defaultExpectation != nil && afterGetApartmentLeasesCounter < 1 {
+		if m.GetApartmentLeasesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.GetApartmentLeases")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.GetApartmentLeases with params: %#v", *m.GetApartmentLeasesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetApartmentLeases != nil && afterGetApartmentLeasesCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.GetApartmentLeases")
+	}
+
+	if !m.GetApartmentLeasesMock.invocationsDone() && afterGetApartmentLeasesCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.GetApartmentLeases but found %d calls",
+			mm_atomic.LoadUint64(&m.GetApartmentLeasesMock.expectedInvocations), afterGetApartmentLeasesCounter)
+	}
+}
+
+type mLeasesServiceMockGetExpiringLeases struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockGetExpiringLeasesExpectation
+	expectations       []*LeasesServiceMockGetExpiringLeasesExpectation
+
+	callArgs []*LeasesServiceMockGetExpiringLeasesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockGetExpiringLeasesExpectation specifies expectation struct of the LeasesService.GetExpiringLeases
+type LeasesServiceMockGetExpiringLeasesExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockGetExpiringLeasesParams
+	paramPtrs *LeasesServiceMockGetExpiringLeasesParamPtrs
+	results   *LeasesServiceMockGetExpiringLeasesResults
+	Counter   uint64
+}
+
+// LeasesServiceMockGetExpiringLeasesParams contains parameters of the LeasesService.GetExpiringLeases
+type LeasesServiceMockGetExpiringLeasesParams struct {
+	ctx  context.Context
+	days int
+}
+
+// LeasesServiceMockGetExpiringLeasesParamPtrs contains pointers to parameters of the LeasesService.GetExpiringLeases
+type LeasesServiceMockGetExpiringLeasesParamPtrs struct {
+	ctx  *context.Context
+	days *int
+}
+
+// LeasesServiceMockGetExpiringLeasesResults contains results of the LeasesService.GetExpiringLeases
+type LeasesServiceMockGetExpiringLeasesResults struct {
+	lpa1 []*storage.Lease
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Optional() *mLeasesServiceMockGetExpiringLeases {
+	mmGetExpiringLeases.optional = true
+	return mmGetExpiringLeases
+}
+
+// Expect sets up expected params for LeasesService.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Expect(ctx context.Context, days int) *mLeasesServiceMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesServiceMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by ExpectParams functions")
+	}
+
+	mmGetExpiringLeases.defaultExpectation.params = &LeasesServiceMockGetExpiringLeasesParams{ctx, days}
+	for _, e := range mmGetExpiringLeases.expectations {
+		if minimock.Equal(e.params, mmGetExpiringLeases.defaultExpectation.params) {
+			mmGetExpiringLeases.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetExpiringLeases.defaultExpectation.params)
+		}
+	}
+
+	return mmGetExpiringLeases
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesServiceMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.params != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Expect")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs == nil {
+		mmGetExpiringLeases.defaultExpectation.paramPtrs = &LeasesServiceMockGetExpiringLeasesParamPtrs{}
+	}
+	mmGetExpiringLeases.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetExpiringLeases
+}
+
+// ExpectDaysParam2 sets up expected param days for LeasesService.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) ExpectDaysParam2(days int) *mLeasesServiceMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesServiceMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.params != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Expect")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs == nil {
+		mmGetExpiringLeases.defaultExpectation.paramPtrs = &LeasesServiceMockGetExpiringLeasesParamPtrs{}
+	}
+	mmGetExpiringLeases.defaultExpectation.paramPtrs.days = &days
+
+	return mmGetExpiringLeases
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Inspect(f func(ctx context.Context, days int)) *mLeasesServiceMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.inspectFuncGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.GetExpiringLeases")
+	}
+
+	mmGetExpiringLeases.mock.inspectFuncGetExpiringLeases = f
+
+	return mmGetExpiringLeases
+}
+
+// Return sets up results that will be returned by LeasesService.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Return(lpa1 []*storage.Lease, err error) *LeasesServiceMock {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesServiceMockGetExpiringLeasesExpectation{mock: mmGetExpiringLeases.mock}
+	}
+	mmGetExpiringLeases.defaultExpectation.results = &LeasesServiceMockGetExpiringLeasesResults{lpa1, err}
+	return mmGetExpiringLeases.mock
+}
+
+// Set uses given function f to mock the LeasesService.GetExpiringLeases method
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Set(f func(ctx context.Context, days int) (lpa1 []*storage.Lease, err error)) *LeasesServiceMock {
+	if mmGetExpiringLeases.defaultExpectation != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("Default expectation is already set for the LeasesService.GetExpiringLeases method")
+	}
+
+	if len(mmGetExpiringLeases.expectations) > 0 {
+		mmGetExpiringLeases.mock.t.Fatalf("Some expectations are already set for the LeasesService.GetExpiringLeases method")
+	}
+
+	mmGetExpiringLeases.mock.funcGetExpiringLeases = f
+	return mmGetExpiringLeases.mock
+}
+
+// When sets expectation for the LeasesService.GetExpiringLeases which will trigger the result defined by the following
+// Then helper
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) When(ctx context.Context, days int) *LeasesServiceMockGetExpiringLeasesExpectation {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesServiceMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockGetExpiringLeasesExpectation{
+		mock:   mmGetExpiringLeases.mock,
+		params: &LeasesServiceMockGetExpiringLeasesParams{ctx, days},
+	}
+	mmGetExpiringLeases.expectations = append(mmGetExpiringLeases.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.GetExpiringLeases return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockGetExpiringLeasesExpectation) Then(lpa1 []*storage.Lease, err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockGetExpiringLeasesResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.GetExpiringLeases should be invoked
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Times(n uint64) *mLeasesServiceMockGetExpiringLeases {
+	if n == 0 {
+		mmGetExpiringLeases.mock.t.Fatalf("Times of LeasesServiceMock.GetExpiringLeases mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetExpiringLeases.expectedInvocations, n)
+	return mmGetExpiringLeases
+}
+
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) invocationsDone() bool {
+	if len(mmGetExpiringLeases.expectations) == 0 && mmGetExpiringLeases.defaultExpectation == nil && mmGetExpiringLeases.mock.funcGetExpiringLeases == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetExpiringLeases.mock.afterGetExpiringLeasesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetExpiringLeases.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetExpiringLeases implements leases.LeasesService
+func (mmGetExpiringLeases *LeasesServiceMock) GetExpiringLeases(ctx context.Context, days int) (lpa1 []*storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmGetExpiringLeases.beforeGetExpiringLeasesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetExpiringLeases.afterGetExpiringLeasesCounter, 1)
+
+	if mmGetExpiringLeases.inspectFuncGetExpiringLeases != nil {
+		mmGetExpiringLeases.inspectFuncGetExpiringLeases(ctx, days)
+	}
+
+	mm_params := LeasesServiceMockGetExpiringLeasesParams{ctx, days}
+
+	// Record call args
+	mmGetExpiringLeases.GetExpiringLeasesMock.mutex.Lock()
+	mmGetExpiringLeases.GetExpiringLeasesMock.callArgs = append(mmGetExpiringLeases.GetExpiringLeasesMock.callArgs, &mm_params)
+	mmGetExpiringLeases.GetExpiringLeasesMock.mutex.Unlock()
+
+	for _, e := range mmGetExpiringLeases.GetExpiringLeasesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockGetExpiringLeasesParams{ctx, days}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetExpiringLeases.t.Errorf("LeasesServiceMock.GetExpiringLeases got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.days != nil && !minimock.Equal(*mm_want_ptrs.days, mm_got.days) {
+				mmGetExpiringLeases.t.Errorf("LeasesServiceMock.GetExpiringLeases got unexpected parameter days, want: %#v, got: %#v%s\n", *mm_want_ptrs.days, mm_got.days, minimock.Diff(*mm_want_ptrs.days, mm_got.days))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetExpiringLeases.t.Errorf("LeasesServiceMock.GetExpiringLeases got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetExpiringLeases.t.Fatal("No results are set for the LeasesServiceMock.GetExpiringLeases")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmGetExpiringLeases.funcGetExpiringLeases != nil {
+		return mmGetExpiringLeases.funcGetExpiringLeases(ctx, days)
+	}
+	mmGetExpiringLeases.t.Fatalf("Unexpected call to LeasesServiceMock.GetExpiringLeases. %v %v", ctx, days)
+	return
+}
+
+// GetExpiringLeasesAfterCounter returns a count of finished LeasesServiceMock.GetExpiringLeases invocations
+func (mmGetExpiringLeases *LeasesServiceMock) GetExpiringLeasesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiringLeases.afterGetExpiringLeasesCounter)
+}
+
+// GetExpiringLeasesBeforeCounter returns a count of LeasesServiceMock.GetExpiringLeases invocations
+func (mmGetExpiringLeases *LeasesServiceMock) GetExpiringLeasesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiringLeases.beforeGetExpiringLeasesCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.GetExpiringLeases.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetExpiringLeases *mLeasesServiceMockGetExpiringLeases) Calls() []*LeasesServiceMockGetExpiringLeasesParams {
+	mmGetExpiringLeases.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockGetExpiringLeasesParams, len(mmGetExpiringLeases.callArgs))
+	copy(argCopy, mmGetExpiringLeases.callArgs)
+
+	mmGetExpiringLeases.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetExpiringLeasesDone returns true if the count of the GetExpiringLeases invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockGetExpiringLeasesDone() bool {
+	if m.GetExpiringLeasesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetExpiringLeasesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetExpiringLeasesMock.invocationsDone()
+}
+
+// MinimockGetExpiringLeasesInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockGetExpiringLeasesInspect() {
+	for _, e := range m.GetExpiringLeasesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.GetExpiringLeases with params: %#v", *e.params)
+		}
+	}
+
+	afterGetExpiringLeasesCounter := mm_atomic.LoadUint64(&m.afterGetExpiringLeasesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExpiringLeasesMock.defaultExpectation != nil && afterGetExpiringLeasesCounter < 1 {
+		if m.GetExpiringLeasesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.GetExpiringLeases")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.GetExpiringLeases with params: %#v", *m.GetExpiringLeasesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExpiringLeases != nil && afterGetExpiringLeasesCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.GetExpiringLeases")
+	}
+
+	if !m.GetExpiringLeasesMock.invocationsDone() && afterGetExpiringLeasesCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.GetExpiringLeases but found %d calls",
+			mm_atomic.LoadUint64(&m.GetExpiringLeasesMock.expectedInvocations), afterGetExpiringLeasesCounter)
+	}
+}
+
+type mLeasesServiceMockGetLease struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockGetLeaseExpectation
+	expectations       []*LeasesServiceMockGetLeaseExpectation
+
+	callArgs []*LeasesServiceMockGetLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockGetLeaseExpectation specifies expectation struct of the LeasesService.GetLease
+type LeasesServiceMockGetLeaseExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockGetLeaseParams
+	paramPtrs *LeasesServiceMockGetLeaseParamPtrs
+	results   *LeasesServiceMockGetLeaseResults
+	Counter   uint64
+}
+
+// LeasesServiceMockGetLeaseParams contains parameters of the LeasesService.GetLease
+type LeasesServiceMockGetLeaseParams struct {
+	ctx context.Context
+	id  int
+}
+
+// LeasesServiceMockGetLeaseParamPtrs contains pointers to parameters of the LeasesService.GetLease
+type LeasesServiceMockGetLeaseParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// LeasesServiceMockGetLeaseResults contains results of the LeasesService.GetLease
+type LeasesServiceMockGetLeaseResults struct {
+	lp1 *storage.Lease
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLease *mLeasesServiceMockGetLease) Optional() *mLeasesServiceMockGetLease {
+	mmGetLease.optional = true
+	return mmGetLease
+}
+
+// Expect sets up expected params for LeasesService.GetLease
+func (mmGetLease *mLeasesServiceMockGetLease) Expect(ctx context.Context, id int) *mLeasesServiceMockGetLease {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesServiceMockGetLeaseExpectation{}
+	}
+
+	if mmGetLease.defaultExpectation.paramPtrs != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by ExpectParams functions")
+	}
+
+	mmGetLease.defaultExpectation.params = &LeasesServiceMockGetLeaseParams{ctx, id}
+	for _, e := range mmGetLease.expectations {
+		if minimock.Equal(e.params, mmGetLease.defaultExpectation.params) {
+			mmGetLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLease.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.GetLease
+func (mmGetLease *mLeasesServiceMockGetLease) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockGetLease {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesServiceMockGetLeaseExpectation{}
+	}
+
+	if mmGetLease.defaultExpectation.params != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Expect")
+	}
+
+	if mmGetLease.defaultExpectation.paramPtrs == nil {
+		mmGetLease.defaultExpectation.paramPtrs = &LeasesServiceMockGetLeaseParamPtrs{}
+	}
+	mmGetLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetLease
+}
+
+// ExpectIdParam2 sets up expected param id for LeasesService.GetLease
+func (mmGetLease *mLeasesServiceMockGetLease) ExpectIdParam2(id int) *mLeasesServiceMockGetLease {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesServiceMockGetLeaseExpectation{}
+	}
+
+	if mmGetLease.defaultExpectation.params != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Expect")
+	}
+
+	if mmGetLease.defaultExpectation.paramPtrs == nil {
+		mmGetLease.defaultExpectation.paramPtrs = &LeasesServiceMockGetLeaseParamPtrs{}
+	}
+	mmGetLease.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.GetLease
+func (mmGetLease *mLeasesServiceMockGetLease) Inspect(f func(ctx context.Context, id int)) *mLeasesServiceMockGetLease {
+	if mmGetLease.mock.inspectFuncGetLease != nil {
+		mmGetLease.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.GetLease")
+	}
+
+	mmGetLease.mock.inspectFuncGetLease = f
+
+	return mmGetLease
+}
+
+// Return sets up results that will be returned by LeasesService.GetLease
+func (mmGetLease *mLeasesServiceMockGetLease) Return(lp1 *storage.Lease, err error) *LeasesServiceMock {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesServiceMockGetLeaseExpectation{mock: mmGetLease.mock}
+	}
+	mmGetLease.defaultExpectation.results = &LeasesServiceMockGetLeaseResults{lp1, err}
+	return mmGetLease.mock
+}
+
+// Set uses given function f to mock the LeasesService.GetLease method
+func (mmGetLease *mLeasesServiceMockGetLease) Set(f func(ctx context.Context, id int) (lp1 *storage.Lease, err error)) *LeasesServiceMock {
+	if mmGetLease.defaultExpectation != nil {
+		mmGetLease.mock.t.Fatalf("Default expectation is already set for the LeasesService.GetLease method")
+	}
+
+	if len(mmGetLease.expectations) > 0 {
+		mmGetLease.mock.t.Fatalf("Some expectations are already set for the LeasesService.GetLease method")
+	}
+
+	mmGetLease.mock.funcGetLease = f
+	return mmGetLease.mock
+}
+
+// When sets expectation for the LeasesService.GetLease which will trigger the result defined by the following
+// Then helper
+func (mmGetLease *mLeasesServiceMockGetLease) When(ctx context.Context, id int) *LeasesServiceMockGetLeaseExpectation {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesServiceMock.GetLease mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockGetLeaseExpectation{
+		mock:   mmGetLease.mock,
+		params: &LeasesServiceMockGetLeaseParams{ctx, id},
+	}
+	mmGetLease.expectations = append(mmGetLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.GetLease return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockGetLeaseExpectation) Then(lp1 *storage.Lease, err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockGetLeaseResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.GetLease should be invoked
+func (mmGetLease *mLeasesServiceMockGetLease) Times(n uint64) *mLeasesServiceMockGetLease {
+	if n == 0 {
+		mmGetLease.mock.t.Fatalf("Times of LeasesServiceMock.GetLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLease.expectedInvocations, n)
+	return mmGetLease
+}
+
+func (mmGetLease *mLeasesServiceMockGetLease) invocationsDone() bool {
+	if len(mmGetLease.expectations) == 0 && mmGetLease.defaultExpectation == nil && mmGetLease.mock.funcGetLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLease.mock.afterGetLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLease implements leases.LeasesService
+func (mmGetLease *LeasesServiceMock) GetLease(ctx context.Context, id int) (lp1 *storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmGetLease.beforeGetLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLease.afterGetLeaseCounter, 1)
+
+	if mmGetLease.inspectFuncGetLease != nil {
+		mmGetLease.inspectFuncGetLease(ctx, id)
+	}
+
+	mm_params := LeasesServiceMockGetLeaseParams{ctx, id}
+
+	// Record call args
+	mmGetLease.GetLeaseMock.mutex.Lock()
+	mmGetLease.GetLeaseMock.callArgs = append(mmGetLease.GetLeaseMock.callArgs, &mm_params)
+	mmGetLease.GetLeaseMock.mutex.Unlock()
+
+	for _, e := range mmGetLease.GetLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetLease.GetLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLease.GetLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLease.GetLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLease.GetLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockGetLeaseParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLease.t.Errorf("LeasesServiceMock.GetLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetLease.t.Errorf("LeasesServiceMock.GetLease got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLease.t.Errorf("LeasesServiceMock.GetLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLease.GetLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLease.t.Fatal("No results are set for the LeasesServiceMock.GetLease")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetLease.funcGetLease != nil {
+		return mmGetLease.funcGetLease(ctx, id)
+	}
+	mmGetLease.t.Fatalf("Unexpected call to LeasesServiceMock.GetLease. %v %v", ctx, id)
+	return
+}
+
+// GetLeaseAfterCounter returns a count of finished LeasesServiceMock.GetLease invocations
+func (mmGetLease *LeasesServiceMock) GetLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLease.afterGetLeaseCounter)
+}
+
+// GetLeaseBeforeCounter returns a count of LeasesServiceMock.GetLease invocations
+func (mmGetLease *LeasesServiceMock) GetLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLease.beforeGetLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.GetLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLease *mLeasesServiceMockGetLease) Calls() []*LeasesServiceMockGetLeaseParams {
+	mmGetLease.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockGetLeaseParams, len(mmGetLease.callArgs))
+	copy(argCopy, mmGetLease.callArgs)
+
+	mmGetLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLeaseDone returns true if the count of the GetLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockGetLeaseDone() bool {
+	if m.GetLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLeaseMock.invocationsDone()
+}
+
+// MinimockGetLeaseInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockGetLeaseInspect() {
+	for _, e := range m.GetLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.GetLease with params: %#v", *e.params)
+		}
+	}
+
+	afterGetLeaseCounter := mm_atomic.LoadUint64(&m.afterGetLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLeaseMock.defaultExpectation != nil && afterGetLeaseCounter < 1 {
+		if m.GetLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.GetLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.GetLease with params: %#v", *m.GetLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLease != nil && afterGetLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.GetLease")
+	}
+
+	if !m.GetLeaseMock.invocationsDone() && afterGetLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.GetLease but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLeaseMock.expectedInvocations), afterGetLeaseCounter)
+	}
+}
+
+type mLeasesServiceMockRenewLease struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockRenewLeaseExpectation
+	expectations       []*LeasesServiceMockRenewLeaseExpectation
+
+	callArgs []*LeasesServiceMockRenewLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockRenewLeaseExpectation specifies expectation struct of the LeasesService.RenewLease
+type LeasesServiceMockRenewLeaseExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockRenewLeaseParams
+	paramPtrs *LeasesServiceMockRenewLeaseParamPtrs
+	results   *LeasesServiceMockRenewLeaseResults
+	Counter   uint64
+}
+
+// LeasesServiceMockRenewLeaseParams contains parameters of the LeasesService.RenewLease
+type LeasesServiceMockRenewLeaseParams struct {
+	ctx     context.Context
+	id      int
+	renewal *mm_leases.Renewal
+}
+
+// LeasesServiceMockRenewLeaseParamPtrs contains pointers to parameters of the LeasesService.RenewLease
+type LeasesServiceMockRenewLeaseParamPtrs struct {
+	ctx     *context.Context
+	id      *int
+	renewal **mm_leases.Renewal
+}
+
+// LeasesServiceMockRenewLeaseResults contains results of the LeasesService.RenewLease
+type LeasesServiceMockRenewLeaseResults struct {
+	lp1 *storage.Lease
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRenewLease *mLeasesServiceMockRenewLease) Optional() *mLeasesServiceMockRenewLease {
+	mmRenewLease.optional = true
+	return mmRenewLease
+}
+
+// Expect sets up expected params for LeasesService.RenewLease
+func (mmRenewLease *mLeasesServiceMockRenewLease) Expect(ctx context.Context, id int, renewal *mm_leases.Renewal) *mLeasesServiceMockRenewLease {
+	if mmRenewLease.mock.funcRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Set")
+	}
+
+	if mmRenewLease.defaultExpectation == nil {
+		mmRenewLease.defaultExpectation = &LeasesServiceMockRenewLeaseExpectation{}
+	}
+
+	if mmRenewLease.defaultExpectation.paramPtrs != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by ExpectParams functions")
+	}
+
+	mmRenewLease.defaultExpectation.params = &LeasesServiceMockRenewLeaseParams{ctx, id, renewal}
+	for _, e := range mmRenewLease.expectations {
+		if minimock.Equal(e.params, mmRenewLease.defaultExpectation.params) {
+			mmRenewLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenewLease.defaultExpectation.params)
+		}
+	}
+
+	return mmRenewLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.RenewLease
+func (mmRenewLease *mLeasesServiceMockRenewLease) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockRenewLease {
+	if mmRenewLease.mock.funcRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Set")
+	}
+
+	if mmRenewLease.defaultExpectation == nil {
+		mmRenewLease.defaultExpectation = &LeasesServiceMockRenewLeaseExpectation{}
+	}
+
+	if mmRenewLease.defaultExpectation.params != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Expect")
+	}
+
+	if mmRenewLease.defaultExpectation.paramPtrs == nil {
+		mmRenewLease.defaultExpectation.paramPtrs = &LeasesServiceMockRenewLeaseParamPtrs{}
+	}
+	mmRenewLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRenewLease
+}
+
+// ExpectIdParam2 sets up expected param id for LeasesService.RenewLease
+func (mmRenewLease *mLeasesServiceMockRenewLease) ExpectIdParam2(id int) *mLeasesServiceMockRenewLease {
+	if mmRenewLease.mock.funcRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Set")
+	}
+
+	if mmRenewLease.defaultExpectation == nil {
+		mmRenewLease.defaultExpectation = &LeasesServiceMockRenewLeaseExpectation{}
+	}
+
+	if mmRenewLease.defaultExpectation.params != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Expect")
+	}
+
+	if mmRenewLease.defaultExpectation.paramPtrs == nil {
+		mmRenewLease.defaultExpectation.paramPtrs = &LeasesServiceMockRenewLeaseParamPtrs{}
+	}
+	mmRenewLease.defaultExpectation.paramPtrs.id = &id
+
+	return mmRenewLease
+}
+
+// ExpectRenewalParam3 sets up expected param renewal for LeasesService.RenewLease
+func (mmRenewLease *mLeasesServiceMockRenewLease) ExpectRenewalParam3(renewal *mm_leases.Renewal) *mLeasesServiceMockRenewLease {
+	if mmRenewLease.mock.funcRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Set")
+	}
+
+	if mmRenewLease.defaultExpectation == nil {
+		mmRenewLease.defaultExpectation = &LeasesServiceMockRenewLeaseExpectation{}
+	}
+
+	if mmRenewLease.defaultExpectation.params != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Expect")
+	}
+
+	if mmRenewLease.defaultExpectation.paramPtrs == nil {
+		mmRenewLease.defaultExpectation.paramPtrs = &LeasesServiceMockRenewLeaseParamPtrs{}
+	}
+	mmRenewLease.defaultExpectation.paramPtrs.renewal = &renewal
+
+	return mmRenewLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.RenewLease
+func (mmRenewLease *mLeasesServiceMockRenewLease) Inspect(f func(ctx context.Context, id int, renewal *mm_leases.Renewal)) *mLeasesServiceMockRenewLease {
+	if mmRenewLease.mock.inspectFuncRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.RenewLease")
+	}
+
+	mmRenewLease.mock.inspectFuncRenewLease = f
+
+	return mmRenewLease
+}
+
+// Return sets up results that will be returned by LeasesService.RenewLease
+func (mmRenewLease *mLeasesServiceMockRenewLease) Return(lp1 *storage.Lease, err error) *LeasesServiceMock {
+	if mmRenewLease.mock.funcRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Set")
+	}
+
+	if mmRenewLease.defaultExpectation == nil {
+		mmRenewLease.defaultExpectation = &LeasesServiceMockRenewLeaseExpectation{mock: mmRenewLease.mock}
+	}
+	mmRenewLease.defaultExpectation.results = &LeasesServiceMockRenewLeaseResults{lp1, err}
+	return mmRenewLease.mock
+}
+
+// Set uses given function f to mock the LeasesService.RenewLease method
+func (mmRenewLease *mLeasesServiceMockRenewLease) Set(f func(ctx context.Context, id int, renewal *mm_leases.Renewal) (lp1 *storage.Lease, err error)) *LeasesServiceMock {
+	if mmRenewLease.defaultExpectation != nil {
+		mmRenewLease.mock.t.Fatalf("Default expectation is already set for the LeasesService.RenewLease method")
+	}
+
+	if len(mmRenewLease.expectations) > 0 {
+		mmRenewLease.mock.t.Fatalf("Some expectations are already set for the LeasesService.RenewLease method")
+	}
+
+	mmRenewLease.mock.funcRenewLease = f
+	return mmRenewLease.mock
+}
+
+// When sets expectation for the LeasesService.RenewLease which will trigger the result defined by the following
+// Then helper
+func (mmRenewLease *mLeasesServiceMockRenewLease) When(ctx context.Context, id int, renewal *mm_leases.Renewal) *LeasesServiceMockRenewLeaseExpectation {
+	if mmRenewLease.mock.funcRenewLease != nil {
+		mmRenewLease.mock.t.Fatalf("LeasesServiceMock.RenewLease mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockRenewLeaseExpectation{
+		mock:   mmRenewLease.mock,
+		params: &LeasesServiceMockRenewLeaseParams{ctx, id, renewal},
+	}
+	mmRenewLease.expectations = append(mmRenewLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.RenewLease return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockRenewLeaseExpectation) Then(lp1 *storage.Lease, err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockRenewLeaseResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.RenewLease should be invoked
+func (mmRenewLease *mLeasesServiceMockRenewLease) Times(n uint64) *mLeasesServiceMockRenewLease {
+	if n == 0 {
+		mmRenewLease.mock.t.Fatalf("Times of LeasesServiceMock.RenewLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRenewLease.expectedInvocations, n)
+	return mmRenewLease
+}
+
+func (mmRenewLease *mLeasesServiceMockRenewLease) invocationsDone() bool {
+	if len(mmRenewLease.expectations) == 0 && mmRenewLease.defaultExpectation == nil && mmRenewLease.mock.funcRenewLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRenewLease.mock.afterRenewLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRenewLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RenewLease implements leases.LeasesService
+func (mmRenewLease *LeasesServiceMock) RenewLease(ctx context.Context, id int, renewal *mm_leases.Renewal) (lp1 *storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmRenewLease.beforeRenewLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmRenewLease.afterRenewLeaseCounter, 1)
+
+	if mmRenewLease.inspectFuncRenewLease != nil {
+		mmRenewLease.inspectFuncRenewLease(ctx, id, renewal)
+	}
+
+	mm_params := LeasesServiceMockRenewLeaseParams{ctx, id, renewal}
+
+	// Record call args
+	mmRenewLease.RenewLeaseMock.mutex.Lock()
+	mmRenewLease.RenewLeaseMock.callArgs = append(mmRenewLease.RenewLeaseMock.callArgs, &mm_params)
+	mmRenewLease.RenewLeaseMock.mutex.Unlock()
+
+	for _, e := range mmRenewLease.RenewLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmRenewLease.RenewLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRenewLease.RenewLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmRenewLease.RenewLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmRenewLease.RenewLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockRenewLeaseParams{ctx, id, renewal}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRenewLease.t.Errorf("LeasesServiceMock.RenewLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRenewLease.t.Errorf("LeasesServiceMock.RenewLease got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.renewal != nil && !minimock.Equal(*mm_want_ptrs.renewal, mm_got.renewal) {
+				mmRenewLease.t.Errorf("LeasesServiceMock.RenewLease got unexpected parameter renewal, want: %#v, got: %#v%s\n", *mm_want_ptrs.renewal, mm_got.renewal, minimock.Diff(*mm_want_ptrs.renewal, mm_got.renewal))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRenewLease.t.Errorf("LeasesServiceMock.RenewLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRenewLease.RenewLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRenewLease.t.Fatal("No results are set for the LeasesServiceMock.RenewLease")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmRenewLease.funcRenewLease != nil {
+		return mmRenewLease.funcRenewLease(ctx, id, renewal)
+	}
+	mmRenewLease.t.Fatalf("Unexpected call to LeasesServiceMock.RenewLease. %v %v %v", ctx, id, renewal)
+	return
+}
+
+// RenewLeaseAfterCounter returns a count of finished LeasesServiceMock.RenewLease invocations
+func (mmRenewLease *LeasesServiceMock) RenewLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenewLease.afterRenewLeaseCounter)
+}
+
+// RenewLeaseBeforeCounter returns a count of LeasesServiceMock.RenewLease invocations
+func (mmRenewLease *LeasesServiceMock) RenewLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenewLease.beforeRenewLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.RenewLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRenewLease *mLeasesServiceMockRenewLease) Calls() []*LeasesServiceMockRenewLeaseParams {
+	mmRenewLease.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockRenewLeaseParams, len(mmRenewLease.callArgs))
+	copy(argCopy, mmRenewLease.callArgs)
+
+	mmRenewLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRenewLeaseDone returns true if the count of the RenewLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockRenewLeaseDone() bool {
+	if m.RenewLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RenewLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RenewLeaseMock.invocationsDone()
+}
+
+// MinimockRenewLeaseInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockRenewLeaseInspect() {
+	for _, e := range m.RenewLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.RenewLease with params: %#v", *e.params)
+		}
+	}
+
+	afterRenewLeaseCounter := mm_atomic.LoadUint64(&m.afterRenewLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenewLeaseMock.defaultExpectation != nil && afterRenewLeaseCounter < 1 {
+		if m.RenewLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.RenewLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.RenewLease with params: %#v", *m.RenewLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenewLease != nil && afterRenewLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.RenewLease")
+	}
+
+	if !m.RenewLeaseMock.invocationsDone() && afterRenewLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.RenewLease but found %d calls",
+			mm_atomic.LoadUint64(&m.RenewLeaseMock.expectedInvocations), afterRenewLeaseCounter)
+	}
+}
+
+type mLeasesServiceMockTerminateLease struct {
+	optional           bool
+	mock               *LeasesServiceMock
+	defaultExpectation *LeasesServiceMockTerminateLeaseExpectation
+	expectations       []*LeasesServiceMockTerminateLeaseExpectation
+
+	callArgs []*LeasesServiceMockTerminateLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesServiceMockTerminateLeaseExpectation specifies expectation struct of the LeasesService.TerminateLease
+type LeasesServiceMockTerminateLeaseExpectation struct {
+	mock      *LeasesServiceMock
+	params    *LeasesServiceMockTerminateLeaseParams
+	paramPtrs *LeasesServiceMockTerminateLeaseParamPtrs
+	results   *LeasesServiceMockTerminateLeaseResults
+	Counter   uint64
+}
+
+// LeasesServiceMockTerminateLeaseParams contains parameters of the LeasesService.TerminateLease
+type LeasesServiceMockTerminateLeaseParams struct {
+	ctx         context.Context
+	id          int
+	termination *mm_leases.Termination
+}
+
+// LeasesServiceMockTerminateLeaseParamPtrs contains pointers to parameters of the LeasesService.TerminateLease
+type LeasesServiceMockTerminateLeaseParamPtrs struct {
+	ctx         *context.Context
+	id          *int
+	termination **mm_leases.Termination
+}
+
+// LeasesServiceMockTerminateLeaseResults contains results of the LeasesService.TerminateLease
+type LeasesServiceMockTerminateLeaseResults struct {
+	lp1 *storage.Lease
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Optional() *mLeasesServiceMockTerminateLease {
+	mmTerminateLease.optional = true
+	return mmTerminateLease
+}
+
+// Expect sets up expected params for LeasesService.TerminateLease
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Expect(ctx context.Context, id int, termination *mm_leases.Termination) *mLeasesServiceMockTerminateLease {
+	if mmTerminateLease.mock.funcTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Set")
+	}
+
+	if mmTerminateLease.defaultExpectation == nil {
+		mmTerminateLease.defaultExpectation = &LeasesServiceMockTerminateLeaseExpectation{}
+	}
+
+	if mmTerminateLease.defaultExpectation.paramPtrs != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by ExpectParams functions")
+	}
+
+	mmTerminateLease.defaultExpectation.params = &LeasesServiceMockTerminateLeaseParams{ctx, id, termination}
+	for _, e := range mmTerminateLease.expectations {
+		if minimock.Equal(e.params, mmTerminateLease.defaultExpectation.params) {
+			mmTerminateLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTerminateLease.defaultExpectation.params)
+		}
+	}
+
+	return mmTerminateLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesService.TerminateLease
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) ExpectCtxParam1(ctx context.Context) *mLeasesServiceMockTerminateLease {
+	if mmTerminateLease.mock.funcTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Set")
+	}
+
+	if mmTerminateLease.defaultExpectation == nil {
+		mmTerminateLease.defaultExpectation = &LeasesServiceMockTerminateLeaseExpectation{}
+	}
+
+	if mmTerminateLease.defaultExpectation.params != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Expect")
+	}
+
+	if mmTerminateLease.defaultExpectation.paramPtrs == nil {
+		mmTerminateLease.defaultExpectation.paramPtrs = &LeasesServiceMockTerminateLeaseParamPtrs{}
+	}
+	mmTerminateLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTerminateLease
+}
+
+// ExpectIdParam2 sets up expected param id for LeasesService.TerminateLease
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) ExpectIdParam2(id int) *mLeasesServiceMockTerminateLease {
+	if mmTerminateLease.mock.funcTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Set")
+	}
+
+	if mmTerminateLease.defaultExpectation == nil {
+		mmTerminateLease.defaultExpectation = &LeasesServiceMockTerminateLeaseExpectation{}
+	}
+
+	if mmTerminateLease.defaultExpectation.params != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Expect")
+	}
+
+	if mmTerminateLease.defaultExpectation.paramPtrs == nil {
+		mmTerminateLease.defaultExpectation.paramPtrs = &LeasesServiceMockTerminateLeaseParamPtrs{}
+	}
+	mmTerminateLease.defaultExpectation.paramPtrs.id = &id
+
+	return mmTerminateLease
+}
+
+// ExpectTerminationParam3 sets up expected param termination for LeasesService.TerminateLease
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) ExpectTerminationParam3(termination *mm_leases.Termination) *mLeasesServiceMockTerminateLease {
+	if mmTerminateLease.mock.funcTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Set")
+	}
+
+	if mmTerminateLease.defaultExpectation == nil {
+		mmTerminateLease.defaultExpectation = &LeasesServiceMockTerminateLeaseExpectation{}
+	}
+
+	if mmTerminateLease.defaultExpectation.params != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Expect")
+	}
+
+	if mmTerminateLease.defaultExpectation.paramPtrs == nil {
+		mmTerminateLease.defaultExpectation.paramPtrs = &LeasesServiceMockTerminateLeaseParamPtrs{}
+	}
+	mmTerminateLease.defaultExpectation.paramPtrs.termination = &termination
+
+	return mmTerminateLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesService.TerminateLease
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Inspect(f func(ctx context.Context, id int, termination *mm_leases.Termination)) *mLeasesServiceMockTerminateLease {
+	if mmTerminateLease.mock.inspectFuncTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("Inspect function is already set for LeasesServiceMock.TerminateLease")
+	}
+
+	mmTerminateLease.mock.inspectFuncTerminateLease = f
+
+	return mmTerminateLease
+}
+
+// Return sets up results that will be returned by LeasesService.TerminateLease
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Return(lp1 *storage.Lease, err error) *LeasesServiceMock {
+	if mmTerminateLease.mock.funcTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Set")
+	}
+
+	if mmTerminateLease.defaultExpectation == nil {
+		mmTerminateLease.defaultExpectation = &LeasesServiceMockTerminateLeaseExpectation{mock: mmTerminateLease.mock}
+	}
+	mmTerminateLease.defaultExpectation.results = &LeasesServiceMockTerminateLeaseResults{lp1, err}
+	return mmTerminateLease.mock
+}
+
+// Set uses given function f to mock the LeasesService.TerminateLease method
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Set(f func(ctx context.Context, id int, termination *mm_leases.Termination) (lp1 *storage.Lease, err error)) *LeasesServiceMock {
+	if mmTerminateLease.defaultExpectation != nil {
+		mmTerminateLease.mock.t.Fatalf("Default expectation is already set for the LeasesService.TerminateLease method")
+	}
+
+	if len(mmTerminateLease.expectations) > 0 {
+		mmTerminateLease.mock.t.Fatalf("Some expectations are already set for the LeasesService.TerminateLease method")
+	}
+
+	mmTerminateLease.mock.funcTerminateLease = f
+	return mmTerminateLease.mock
+}
+
+// When sets expectation for the LeasesService.TerminateLease which will trigger the result defined by the following
+// Then helper
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) When(ctx context.Context, id int, termination *mm_leases.Termination) *LeasesServiceMockTerminateLeaseExpectation {
+	if mmTerminateLease.mock.funcTerminateLease != nil {
+		mmTerminateLease.mock.t.Fatalf("LeasesServiceMock.TerminateLease mock is already set by Set")
+	}
+
+	expectation := &LeasesServiceMockTerminateLeaseExpectation{
+		mock:   mmTerminateLease.mock,
+		params: &LeasesServiceMockTerminateLeaseParams{ctx, id, termination},
+	}
+	mmTerminateLease.expectations = append(mmTerminateLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesService.TerminateLease return parameters for the expectation previously defined by the When method
+func (e *LeasesServiceMockTerminateLeaseExpectation) Then(lp1 *storage.Lease, err error) *LeasesServiceMock {
+	e.results = &LeasesServiceMockTerminateLeaseResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesService.TerminateLease should be invoked
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Times(n uint64) *mLeasesServiceMockTerminateLease {
+	if n == 0 {
+		mmTerminateLease.mock.t.Fatalf("Times of LeasesServiceMock.TerminateLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTerminateLease.expectedInvocations, n)
+	return mmTerminateLease
+}
+
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) invocationsDone() bool {
+	if len(mmTerminateLease.expectations) == 0 && mmTerminateLease.defaultExpectation == nil && mmTerminateLease.mock.funcTerminateLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTerminateLease.mock.afterTerminateLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTerminateLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TerminateLease implements leases.LeasesService
+func (mmTerminateLease *LeasesServiceMock) TerminateLease(ctx context.Context, id int, termination *mm_leases.Termination) (lp1 *storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmTerminateLease.beforeTerminateLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmTerminateLease.afterTerminateLeaseCounter, 1)
+
+	if mmTerminateLease.inspectFuncTerminateLease != nil {
+		mmTerminateLease.inspectFuncTerminateLease(ctx, id, termination)
+	}
+
+	mm_params := LeasesServiceMockTerminateLeaseParams{ctx, id, termination}
+
+	// Record call args
+	mmTerminateLease.TerminateLeaseMock.mutex.Lock()
+	mmTerminateLease.TerminateLeaseMock.callArgs = append(mmTerminateLease.TerminateLeaseMock.callArgs, &mm_params)
+	mmTerminateLease.TerminateLeaseMock.mutex.Unlock()
+
+	for _, e := range mmTerminateLease.TerminateLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmTerminateLease.TerminateLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTerminateLease.TerminateLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmTerminateLease.TerminateLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmTerminateLease.TerminateLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesServiceMockTerminateLeaseParams{ctx, id, termination}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTerminateLease.t.Errorf("LeasesServiceMock.TerminateLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmTerminateLease.t.Errorf("LeasesServiceMock.TerminateLease got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.termination != nil && !minimock.Equal(*mm_want_ptrs.termination, mm_got.termination) {
+				mmTerminateLease.t.Errorf("LeasesServiceMock.TerminateLease got unexpected parameter termination, want: %#v, got: %#v%s\n", *mm_want_ptrs.termination, mm_got.termination, minimock.Diff(*mm_want_ptrs.termination, mm_got.termination))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTerminateLease.t.Errorf("LeasesServiceMock.TerminateLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTerminateLease.TerminateLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTerminateLease.t.Fatal("No results are set for the LeasesServiceMock.TerminateLease")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmTerminateLease.funcTerminateLease != nil {
+		return mmTerminateLease.funcTerminateLease(ctx, id, termination)
+	}
+	mmTerminateLease.t.Fatalf("Unexpected call to LeasesServiceMock.TerminateLease. %v %v %v", ctx, id, termination)
+	return
+}
+
+// TerminateLeaseAfterCounter returns a count of finished LeasesServiceMock.TerminateLease invocations
+func (mmTerminateLease *LeasesServiceMock) TerminateLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTerminateLease.afterTerminateLeaseCounter)
+}
+
+// TerminateLeaseBeforeCounter returns a count of LeasesServiceMock.TerminateLease invocations
+func (mmTerminateLease *LeasesServiceMock) TerminateLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTerminateLease.beforeTerminateLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesServiceMock.TerminateLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTerminateLease *mLeasesServiceMockTerminateLease) Calls() []*LeasesServiceMockTerminateLeaseParams {
+	mmTerminateLease.mutex.RLock()
+
+	argCopy := make([]*LeasesServiceMockTerminateLeaseParams, len(mmTerminateLease.callArgs))
+	copy(argCopy, mmTerminateLease.callArgs)
+
+	mmTerminateLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTerminateLeaseDone returns true if the count of the TerminateLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesServiceMock) MinimockTerminateLeaseDone() bool {
+	if m.TerminateLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TerminateLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TerminateLeaseMock.invocationsDone()
+}
+
+// MinimockTerminateLeaseInspect logs each unmet expectation
+func (m *LeasesServiceMock) MinimockTerminateLeaseInspect() {
+	for _, e := range m.TerminateLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesServiceMock.TerminateLease with params: %#v", *e.params)
+		}
+	}
+
+	afterTerminateLeaseCounter := mm_atomic.LoadUint64(&m.afterTerminateLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TerminateLeaseMock.defaultExpectation != nil && afterTerminateLeaseCounter < 1 {
+		if m.TerminateLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesServiceMock.TerminateLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesServiceMock.TerminateLease with params: %#v", *m.TerminateLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTerminateLease != nil && afterTerminateLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesServiceMock.TerminateLease")
+	}
+
+	if !m.TerminateLeaseMock.invocationsDone() && afterTerminateLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesServiceMock.TerminateLease but found %d calls",
+			mm_atomic.LoadUint64(&m.TerminateLeaseMock.expectedInvocations), afterTerminateLeaseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LeasesServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateLeaseInspect()
+
+			m.MinimockDeleteLeaseInspect()
+
+			m.MinimockGetApartmentLeasesInspect()
+
+			m.MinimockGetExpiringLeasesInspect()
+
+			m.MinimockGetLeaseInspect()
+
+			m.MinimockRenewLeaseInspect()
+
+			m.MinimockTerminateLeaseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LeasesServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LeasesServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateLeaseDone() &&
+		m.MinimockDeleteLeaseDone() &&
+		m.MinimockGetApartmentLeasesDone() &&
+		m.MinimockGetExpiringLeasesDone() &&
+		m.MinimockGetLeaseDone() &&
+		m.MinimockRenewLeaseDone() &&
+		m.MinimockTerminateLeaseDone()
+}
@@ -0,0 +1,1781 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/residents.ResidentsService -o residents_service_mock_test.go -n ResidentsServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// ResidentsServiceMock implements residents.ResidentsService
+type ResidentsServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateResident          func(ctx context.Context, resident *storage.Resident) (err error)
+	inspectFuncCreateResident   func(ctx context.Context, resident *storage.Resident)
+	afterCreateResidentCounter  uint64
+	beforeCreateResidentCounter uint64
+	CreateResidentMock          mResidentsServiceMockCreateResident
+
+	funcDeleteResident          func(ctx context.Context, id int) (err error)
+	inspectFuncDeleteResident   func(ctx context.Context, id int)
+	afterDeleteResidentCounter  uint64
+	beforeDeleteResidentCounter uint64
+	DeleteResidentMock          mResidentsServiceMockDeleteResident
+
+	funcGetApartmentResidents          func(ctx context.Context, apartmentId int, history bool) (rpa1 []*storage.Resident, err error)
+	inspectFuncGetApartmentResidents   func(ctx context.Context, apartmentId int, history bool)
+	afterGetApartmentResidentsCounter  uint64
+	beforeGetApartmentResidentsCounter uint64
+	GetApartmentResidentsMock          mResidentsServiceMockGetApartmentResidents
+
+	funcGetBuildingResidents          func(ctx context.Context, buildingId int, history bool) (rpa1 []*storage.Resident, err error)
+	inspectFuncGetBuildingResidents   func(ctx context.Context, buildingId int, history bool)
+	afterGetBuildingResidentsCounter  uint64
+	beforeGetBuildingResidentsCounter uint64
+	GetBuildingResidentsMock          mResidentsServiceMockGetBuildingResidents
+
+	funcGetResident          func(ctx context.Context, id int) (rp1 *storage.Resident, err error)
+	inspectFuncGetResident   func(ctx context.Context, id int)
+	afterGetResidentCounter  uint64
+	beforeGetResidentCounter uint64
+	GetResidentMock          mResidentsServiceMockGetResident
+}
+
+// NewResidentsServiceMock returns a mock for residents.ResidentsService
+func NewResidentsServiceMock(t minimock.Tester) *ResidentsServiceMock {
+	m := &ResidentsServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateResidentMock = mResidentsServiceMockCreateResident{mock: m}
+	m.CreateResidentMock.callArgs = []*ResidentsServiceMockCreateResidentParams{}
+
+	m.DeleteResidentMock = mResidentsServiceMockDeleteResident{mock: m}
+	m.DeleteResidentMock.callArgs = []*ResidentsServiceMockDeleteResidentParams{}
+
+	m.GetApartmentResidentsMock = mResidentsServiceMockGetApartmentResidents{mock: m}
+	m.GetApartmentResidentsMock.callArgs = []*ResidentsServiceMockGetApartmentResidentsParams{}
+
+	m.GetBuildingResidentsMock = mResidentsServiceMockGetBuildingResidents{mock: m}
+	m.GetBuildingResidentsMock.callArgs = []*ResidentsServiceMockGetBuildingResidentsParams{}
+
+	m.GetResidentMock = mResidentsServiceMockGetResident{mock: m}
+	m.GetResidentMock.callArgs = []*ResidentsServiceMockGetResidentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mResidentsServiceMockCreateResident struct {
+	optional           bool
+	mock               *ResidentsServiceMock
+	defaultExpectation *ResidentsServiceMockCreateResidentExpectation
+	expectations       []*ResidentsServiceMockCreateResidentExpectation
+
+	callArgs []*ResidentsServiceMockCreateResidentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsServiceMockCreateResidentExpectation specifies expectation struct of the ResidentsService.CreateResident
+type ResidentsServiceMockCreateResidentExpectation struct {
+	mock      *ResidentsServiceMock
+	params    *ResidentsServiceMockCreateResidentParams
+	paramPtrs *ResidentsServiceMockCreateResidentParamPtrs
+	results   *ResidentsServiceMockCreateResidentResults
+	Counter   uint64
+}
+
+// ResidentsServiceMockCreateResidentParams contains parameters of the ResidentsService.CreateResident
+type ResidentsServiceMockCreateResidentParams struct {
+	ctx      context.Context
+	resident *storage.Resident
+}
+
+// ResidentsServiceMockCreateResidentParamPtrs contains pointers to parameters of the ResidentsService.CreateResident
+type ResidentsServiceMockCreateResidentParamPtrs struct {
+	ctx      *context.Context
+	resident **storage.Resident
+}
+
+// ResidentsServiceMockCreateResidentResults contains results of the ResidentsService.CreateResident
+type ResidentsServiceMockCreateResidentResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateResident *mResidentsServiceMockCreateResident) Optional() *mResidentsServiceMockCreateResident {
+	mmCreateResident.optional = true
+	return mmCreateResident
+}
+
+// Expect sets up expected params for ResidentsService.CreateResident
+func (mmCreateResident *mResidentsServiceMockCreateResident) Expect(ctx context.Context, resident *storage.Resident) *mResidentsServiceMockCreateResident {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsServiceMockCreateResidentExpectation{}
+	}
+
+	if mmCreateResident.defaultExpectation.paramPtrs != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by ExpectParams functions")
+	}
+
+	mmCreateResident.defaultExpectation.params = &ResidentsServiceMockCreateResidentParams{ctx, resident}
+	for _, e := range mmCreateResident.expectations {
+		if minimock.Equal(e.params, mmCreateResident.defaultExpectation.params) {
+			mmCreateResident.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateResident.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateResident
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsService.CreateResident
+func (mmCreateResident *mResidentsServiceMockCreateResident) ExpectCtxParam1(ctx context.Context) *mResidentsServiceMockCreateResident {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsServiceMockCreateResidentExpectation{}
+	}
+
+	if mmCreateResident.defaultExpectation.params != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Expect")
+	}
+
+	if mmCreateResident.defaultExpectation.paramPtrs == nil {
+		mmCreateResident.defaultExpectation.paramPtrs = &ResidentsServiceMockCreateResidentParamPtrs{}
+	}
+	mmCreateResident.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateResident
+}
+
+// ExpectResidentParam2 sets up expected param resident for ResidentsService.CreateResident
+func (mmCreateResident *mResidentsServiceMockCreateResident) ExpectResidentParam2(resident *storage.Resident) *mResidentsServiceMockCreateResident {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsServiceMockCreateResidentExpectation{}
+	}
+
+	if mmCreateResident.defaultExpectation.params != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Expect")
+	}
+
+	if mmCreateResident.defaultExpectation.paramPtrs == nil {
+		mmCreateResident.defaultExpectation.paramPtrs = &ResidentsServiceMockCreateResidentParamPtrs{}
+	}
+	mmCreateResident.defaultExpectation.paramPtrs.resident = &resident
+
+	return mmCreateResident
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsService.CreateResident
+func (mmCreateResident *mResidentsServiceMockCreateResident) Inspect(f func(ctx context.Context, resident *storage.Resident)) *mResidentsServiceMockCreateResident {
+	if mmCreateResident.mock.inspectFuncCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("Inspect function is already set for ResidentsServiceMock.CreateResident")
+	}
+
+	mmCreateResident.mock.inspectFuncCreateResident = f
+
+	return mmCreateResident
+}
+
+// Return sets up results that will be returned by ResidentsService.CreateResident
+func (mmCreateResident *mResidentsServiceMockCreateResident) Return(err error) *ResidentsServiceMock {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsServiceMockCreateResidentExpectation{mock: mmCreateResident.mock}
+	}
+	mmCreateResident.defaultExpectation.results = &ResidentsServiceMockCreateResidentResults{err}
+	return mmCreateResident.mock
+}
+
+// Set uses given function f to mock the ResidentsService.CreateResident method
+func (mmCreateResident *mResidentsServiceMockCreateResident) Set(f func(ctx context.Context, resident *storage.Resident) (err error)) *ResidentsServiceMock {
+	if mmCreateResident.defaultExpectation != nil {
+		mmCreateResident.mock.t.Fatalf("Default expectation is already set for the ResidentsService.CreateResident method")
+	}
+
+	if len(mmCreateResident.expectations) > 0 {
+		mmCreateResident.mock.t.Fatalf("Some expectations are already set for the ResidentsService.CreateResident method")
+	}
+
+	mmCreateResident.mock.funcCreateResident = f
+	return mmCreateResident.mock
+}
+
+// When sets expectation for the ResidentsService.CreateResident which will trigger the result defined by the following
+// Then helper
+func (mmCreateResident *mResidentsServiceMockCreateResident) When(ctx context.Context, resident *storage.Resident) *ResidentsServiceMockCreateResidentExpectation {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsServiceMock.CreateResident mock is already set by Set")
+	}
+
+	expectation := &ResidentsServiceMockCreateResidentExpectation{
+		mock:   mmCreateResident.mock,
+		params: &ResidentsServiceMockCreateResidentParams{ctx, resident},
+	}
+	mmCreateResident.expectations = append(mmCreateResident.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsService.CreateResident return parameters for the expectation previously defined by the When method
+func (e *ResidentsServiceMockCreateResidentExpectation) Then(err error) *ResidentsServiceMock {
+	e.results = &ResidentsServiceMockCreateResidentResults{err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsService.CreateResident should be invoked
+func (mmCreateResident *mResidentsServiceMockCreateResident) Times(n uint64) *mResidentsServiceMockCreateResident {
+	if n == 0 {
+		mmCreateResident.mock.t.Fatalf("Times of ResidentsServiceMock.CreateResident mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateResident.expectedInvocations, n)
+	return mmCreateResident
+}
+
+func (mmCreateResident *mResidentsServiceMockCreateResident) invocationsDone() bool {
+	if len(mmCreateResident.expectations) == 0 && mmCreateResident.defaultExpectation == nil && mmCreateResident.mock.funcCreateResident == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateResident.mock.afterCreateResidentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateResident.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateResident implements residents.ResidentsService
+func (mmCreateResident *ResidentsServiceMock) CreateResident(ctx context.Context, resident *storage.Resident) (err error) {
+	mm_atomic.AddUint64(&mmCreateResident.beforeCreateResidentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateResident.afterCreateResidentCounter, 1)
+
+	if mmCreateResident.inspectFuncCreateResident != nil {
+		mmCreateResident.inspectFuncCreateResident(ctx, resident)
+	}
+
+	mm_params := ResidentsServiceMockCreateResidentParams{ctx, resident}
+
+	// Record call args
+	mmCreateResident.CreateResidentMock.mutex.Lock()
+	mmCreateResident.CreateResidentMock.callArgs = append(mmCreateResident.CreateResidentMock.callArgs, &mm_params)
+	mmCreateResident.CreateResidentMock.mutex.Unlock()
+
+	for _, e := range mmCreateResident.CreateResidentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateResident.CreateResidentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateResident.CreateResidentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateResident.CreateResidentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateResident.CreateResidentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsServiceMockCreateResidentParams{ctx, resident}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateResident.t.Errorf("ResidentsServiceMock.CreateResident got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.resident != nil && !minimock.Equal(*mm_want_ptrs.resident, mm_got.resident) {
+				mmCreateResident.t.Errorf("ResidentsServiceMock.CreateResident got unexpected parameter resident, want: %#v, got: %#v%s\n", *mm_want_ptrs.resident, mm_got.resident, minimock.Diff(*mm_want_ptrs.resident, mm_got.resident))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateResident.t.Errorf("ResidentsServiceMock.CreateResident got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateResident.CreateResidentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateResident.t.Fatal("No results are set for the ResidentsServiceMock.CreateResident")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateResident.funcCreateResident != nil {
+		return mmCreateResident.funcCreateResident(ctx, resident)
+	}
+	mmCreateResident.t.Fatalf("Unexpected call to ResidentsServiceMock.CreateResident. %v %v", ctx, resident)
+	return
+}
+
+// CreateResidentAfterCounter returns a count of finished ResidentsServiceMock.CreateResident invocations
+func (mmCreateResident *ResidentsServiceMock) CreateResidentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateResident.afterCreateResidentCounter)
+}
+
+// CreateResidentBeforeCounter returns a count of ResidentsServiceMock.CreateResident invocations
+func (mmCreateResident *ResidentsServiceMock) CreateResidentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateResident.beforeCreateResidentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsServiceMock.CreateResident.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateResident *mResidentsServiceMockCreateResident) Calls() []*ResidentsServiceMockCreateResidentParams {
+	mmCreateResident.mutex.RLock()
+
+	argCopy := make([]*ResidentsServiceMockCreateResidentParams, len(mmCreateResident.callArgs))
+	copy(argCopy, mmCreateResident.callArgs)
+
+	mmCreateResident.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateResidentDone returns true if the count of the CreateResident invocations corresponds
+// the number of defined expectations
+func (m *ResidentsServiceMock) MinimockCreateResidentDone() bool {
+	if m.CreateResidentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateResidentMock.invocationsDone()
+}
+
+// MinimockCreateResidentInspect logs each unmet expectation
+func (m *ResidentsServiceMock) MinimockCreateResidentInspect() {
+	for _, e := range m.CreateResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsServiceMock.CreateResident with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateResidentCounter := mm_atomic.LoadUint64(&m.afterCreateResidentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateResidentMock.defaultExpectation != nil && afterCreateResidentCounter < 1 {
+		if m.CreateResidentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsServiceMock.CreateResident")
+		} else {
+			m.t.Errorf("Expected call to ResidentsServiceMock.CreateResident with params: %#v", *m.CreateResidentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateResident != nil && afterCreateResidentCounter < 1 {
+		m.t.Error("Expected call to ResidentsServiceMock.CreateResident")
+	}
+
+	if !m.CreateResidentMock.invocationsDone() && afterCreateResidentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsServiceMock.CreateResident but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateResidentMock.expectedInvocations), afterCreateResidentCounter)
+	}
+}
+
+type mResidentsServiceMockDeleteResident struct {
+	optional           bool
+	mock               *ResidentsServiceMock
+	defaultExpectation *ResidentsServiceMockDeleteResidentExpectation
+	expectations       []*ResidentsServiceMockDeleteResidentExpectation
+
+	callArgs []*ResidentsServiceMockDeleteResidentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsServiceMockDeleteResidentExpectation specifies expectation struct of the ResidentsService.DeleteResident
+type ResidentsServiceMockDeleteResidentExpectation struct {
+	mock      *ResidentsServiceMock
+	params    *ResidentsServiceMockDeleteResidentParams
+	paramPtrs *ResidentsServiceMockDeleteResidentParamPtrs
+	results   *ResidentsServiceMockDeleteResidentResults
+	Counter   uint64
+}
+
+// ResidentsServiceMockDeleteResidentParams contains parameters of the ResidentsService.DeleteResident
+type ResidentsServiceMockDeleteResidentParams struct {
+	ctx context.Context
+	id  int
+}
+
+// ResidentsServiceMockDeleteResidentParamPtrs contains pointers to parameters of the ResidentsService.DeleteResident
+type ResidentsServiceMockDeleteResidentParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// ResidentsServiceMockDeleteResidentResults contains results of the ResidentsService.DeleteResident
+type ResidentsServiceMockDeleteResidentResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Optional() *mResidentsServiceMockDeleteResident {
+	mmDeleteResident.optional = true
+	return mmDeleteResident
+}
+
+// Expect sets up expected params for ResidentsService.DeleteResident
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Expect(ctx context.Context, id int) *mResidentsServiceMockDeleteResident {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsServiceMockDeleteResidentExpectation{}
+	}
+
+	if mmDeleteResident.defaultExpectation.paramPtrs != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteResident.defaultExpectation.params = &ResidentsServiceMockDeleteResidentParams{ctx, id}
+	for _, e := range mmDeleteResident.expectations {
+		if minimock.Equal(e.params, mmDeleteResident.defaultExpectation.params) {
+			mmDeleteResident.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteResident.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteResident
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsService.DeleteResident
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) ExpectCtxParam1(ctx context.Context) *mResidentsServiceMockDeleteResident {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsServiceMockDeleteResidentExpectation{}
+	}
+
+	if mmDeleteResident.defaultExpectation.params != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Expect")
+	}
+
+	if mmDeleteResident.defaultExpectation.paramPtrs == nil {
+		mmDeleteResident.defaultExpectation.paramPtrs = &ResidentsServiceMockDeleteResidentParamPtrs{}
+	}
+	mmDeleteResident.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteResident
+}
+
+// ExpectIdParam2 sets up expected param id for ResidentsService.DeleteResident
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) ExpectIdParam2(id int) *mResidentsServiceMockDeleteResident {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsServiceMockDeleteResidentExpectation{}
+	}
+
+	if mmDeleteResident.defaultExpectation.params != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Expect")
+	}
+
+	if mmDeleteResident.defaultExpectation.paramPtrs == nil {
+		mmDeleteResident.defaultExpectation.paramPtrs = &ResidentsServiceMockDeleteResidentParamPtrs{}
+	}
+	mmDeleteResident.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteResident
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsService.DeleteResident
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Inspect(f func(ctx context.Context, id int)) *mResidentsServiceMockDeleteResident {
+	if mmDeleteResident.mock.inspectFuncDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("Inspect function is already set for ResidentsServiceMock.DeleteResident")
+	}
+
+	mmDeleteResident.mock.inspectFuncDeleteResident = f
+
+	return mmDeleteResident
+}
+
+// Return sets up results that will be returned by ResidentsService.DeleteResident
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Return(err error) *ResidentsServiceMock {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsServiceMockDeleteResidentExpectation{mock: mmDeleteResident.mock}
+	}
+	mmDeleteResident.defaultExpectation.results = &ResidentsServiceMockDeleteResidentResults{err}
+	return mmDeleteResident.mock
+}
+
+// Set uses given function f to mock the ResidentsService.DeleteResident method
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Set(f func(ctx context.Context, id int) (err error)) *ResidentsServiceMock {
+	if mmDeleteResident.defaultExpectation != nil {
+		mmDeleteResident.mock.t.Fatalf("Default expectation is already set for the ResidentsService.DeleteResident method")
+	}
+
+	if len(mmDeleteResident.expectations) > 0 {
+		mmDeleteResident.mock.t.Fatalf("Some expectations are already set for the ResidentsService.DeleteResident method")
+	}
+
+	mmDeleteResident.mock.funcDeleteResident = f
+	return mmDeleteResident.mock
+}
+
+// When sets expectation for the ResidentsService.DeleteResident which will trigger the result defined by the following
+// Then helper
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) When(ctx context.Context, id int) *ResidentsServiceMockDeleteResidentExpectation {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsServiceMock.DeleteResident mock is already set by Set")
+	}
+
+	expectation := &ResidentsServiceMockDeleteResidentExpectation{
+		mock:   mmDeleteResident.mock,
+		params: &ResidentsServiceMockDeleteResidentParams{ctx, id},
+	}
+	mmDeleteResident.expectations = append(mmDeleteResident.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsService.DeleteResident return parameters for the expectation previously defined by the When method
+func (e *ResidentsServiceMockDeleteResidentExpectation) Then(err error) *ResidentsServiceMock {
+	e.results = &ResidentsServiceMockDeleteResidentResults{err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsService.DeleteResident should be invoked
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Times(n uint64) *mResidentsServiceMockDeleteResident {
+	if n == 0 {
+		mmDeleteResident.mock.t.Fatalf("Times of ResidentsServiceMock.DeleteResident mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteResident.expectedInvocations, n)
+	return mmDeleteResident
+}
+
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) invocationsDone() bool {
+	if len(mmDeleteResident.expectations) == 0 && mmDeleteResident.defaultExpectation == nil && mmDeleteResident.mock.funcDeleteResident == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteResident.mock.afterDeleteResidentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteResident.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteResident implements residents.ResidentsService
+func (mmDeleteResident *ResidentsServiceMock) DeleteResident(ctx context.Context, id int) (err error) {
+	mm_atomic.AddUint64(&mmDeleteResident.beforeDeleteResidentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteResident.afterDeleteResidentCounter, 1)
+
+	if mmDeleteResident.inspectFuncDeleteResident != nil {
+		mmDeleteResident.inspectFuncDeleteResident(ctx, id)
+	}
+
+	mm_params := ResidentsServiceMockDeleteResidentParams{ctx, id}
+
+	// Record call args
+	mmDeleteResident.DeleteResidentMock.mutex.Lock()
+	mmDeleteResident.DeleteResidentMock.callArgs = append(mmDeleteResident.DeleteResidentMock.callArgs, &mm_params)
+	mmDeleteResident.DeleteResidentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteResident.DeleteResidentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteResident.DeleteResidentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteResident.DeleteResidentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteResident.DeleteResidentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteResident.DeleteResidentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsServiceMockDeleteResidentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteResident.t.Errorf("ResidentsServiceMock.DeleteResident got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteResident.t.Errorf("ResidentsServiceMock.DeleteResident got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteResident.t.Errorf("ResidentsServiceMock.DeleteResident got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteResident.DeleteResidentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteResident.t.Fatal("No results are set for the ResidentsServiceMock.DeleteResident")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteResident.funcDeleteResident != nil {
+		return mmDeleteResident.funcDeleteResident(ctx, id)
+	}
+	mmDeleteResident.t.Fatalf("Unexpected call to ResidentsServiceMock.DeleteResident. %v %v", ctx, id)
+	return
+}
+
+// DeleteResidentAfterCounter returns a count of finished ResidentsServiceMock.DeleteResident invocations
+func (mmDeleteResident *ResidentsServiceMock) DeleteResidentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteResident.afterDeleteResidentCounter)
+}
+
+// DeleteResidentBeforeCounter returns a count of ResidentsServiceMock.DeleteResident invocations
+func (mmDeleteResident *ResidentsServiceMock) DeleteResidentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteResident.beforeDeleteResidentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsServiceMock.DeleteResident.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteResident *mResidentsServiceMockDeleteResident) Calls() []*ResidentsServiceMockDeleteResidentParams {
+	mmDeleteResident.mutex.RLock()
+
+	argCopy := make([]*ResidentsServiceMockDeleteResidentParams, len(mmDeleteResident.callArgs))
+	copy(argCopy, mmDeleteResident.callArgs)
+
+	mmDeleteResident.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteResidentDone returns true if the count of the DeleteResident invocations corresponds
+// the number of defined expectations
+func (m *ResidentsServiceMock) MinimockDeleteResidentDone() bool {
+	if m.DeleteResidentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteResidentMock.invocationsDone()
+}
+
+// MinimockDeleteResidentInspect logs each unmet expectation
+func (m *ResidentsServiceMock) MinimockDeleteResidentInspect() {
+	for _, e := range m.DeleteResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsServiceMock.DeleteResident with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteResidentCounter := mm_atomic.LoadUint64(&m.afterDeleteResidentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteResidentMock.defaultExpectation != nil && afterDeleteResidentCounter < 1 {
+		if m.DeleteResidentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsServiceMock.DeleteResident")
+		} else {
+			m.t.Errorf("Expected call to ResidentsServiceMock.DeleteResident with params: %#v", *m.DeleteResidentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteResident != nil && afterDeleteResidentCounter < 1 {
+		m.t.Error("Expected call to ResidentsServiceMock.DeleteResident")
+	}
+
+	if !m.DeleteResidentMock.invocationsDone() && afterDeleteResidentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsServiceMock.DeleteResident but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteResidentMock.expectedInvocations), afterDeleteResidentCounter)
+	}
+}
+
+type mResidentsServiceMockGetApartmentResidents struct {
+	optional           bool
+	mock               *ResidentsServiceMock
+	defaultExpectation *ResidentsServiceMockGetApartmentResidentsExpectation
+	expectations       []*ResidentsServiceMockGetApartmentResidentsExpectation
+
+	callArgs []*ResidentsServiceMockGetApartmentResidentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsServiceMockGetApartmentResidentsExpectation specifies expectation struct of the ResidentsService.GetApartmentResidents
+type ResidentsServiceMockGetApartmentResidentsExpectation struct {
+	mock      *ResidentsServiceMock
+	params    *ResidentsServiceMockGetApartmentResidentsParams
+	paramPtrs *ResidentsServiceMockGetApartmentResidentsParamPtrs
+	results   *ResidentsServiceMockGetApartmentResidentsResults
+	Counter   uint64
+}
+
+// ResidentsServiceMockGetApartmentResidentsParams contains parameters of the ResidentsService.GetApartmentResidents
+type ResidentsServiceMockGetApartmentResidentsParams struct {
+	ctx         context.Context
+	apartmentId int
+	history     bool
+}
+
+// ResidentsServiceMockGetApartmentResidentsParamPtrs contains pointers to parameters of the ResidentsService.GetApartmentResidents
+type ResidentsServiceMockGetApartmentResidentsParamPtrs struct {
+	ctx         *context.Context
+	apartmentId *int
+	history     *bool
+}
+
+// ResidentsServiceMockGetApartmentResidentsResults contains results of the ResidentsService.GetApartmentResidents
+type ResidentsServiceMockGetApartmentResidentsResults struct {
+	rpa1 []*storage.Resident
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Optional() *mResidentsServiceMockGetApartmentResidents {
+	mmGetApartmentResidents.optional = true
+	return mmGetApartmentResidents
+}
+
+// Expect sets up expected params for ResidentsService.GetApartmentResidents
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Expect(ctx context.Context, apartmentId int, history bool) *mResidentsServiceMockGetApartmentResidents {
+	if mmGetApartmentResidents.mock.funcGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Set")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation == nil {
+		mmGetApartmentResidents.defaultExpectation = &ResidentsServiceMockGetApartmentResidentsExpectation{}
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.paramPtrs != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by ExpectParams functions")
+	}
+
+	mmGetApartmentResidents.defaultExpectation.params = &ResidentsServiceMockGetApartmentResidentsParams{ctx, apartmentId, history}
+	for _, e := range mmGetApartmentResidents.expectations {
+		if minimock.Equal(e.params, mmGetApartmentResidents.defaultExpectation.params) {
+			mmGetApartmentResidents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetApartmentResidents.defaultExpectation.params)
+		}
+	}
+
+	return mmGetApartmentResidents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsService.GetApartmentResidents
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) ExpectCtxParam1(ctx context.Context) *mResidentsServiceMockGetApartmentResidents {
+	if mmGetApartmentResidents.mock.funcGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Set")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation == nil {
+		mmGetApartmentResidents.defaultExpectation = &ResidentsServiceMockGetApartmentResidentsExpectation{}
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.params != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Expect")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentResidents.defaultExpectation.paramPtrs = &ResidentsServiceMockGetApartmentResidentsParamPtrs{}
+	}
+	mmGetApartmentResidents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetApartmentResidents
+}
+
+// ExpectApartmentIdParam2 sets up expected param apartmentId for ResidentsService.GetApartmentResidents
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) ExpectApartmentIdParam2(apartmentId int) *mResidentsServiceMockGetApartmentResidents {
+	if mmGetApartmentResidents.mock.funcGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Set")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation == nil {
+		mmGetApartmentResidents.defaultExpectation = &ResidentsServiceMockGetApartmentResidentsExpectation{}
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.params != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Expect")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentResidents.defaultExpectation.paramPtrs = &ResidentsServiceMockGetApartmentResidentsParamPtrs{}
+	}
+	mmGetApartmentResidents.defaultExpectation.paramPtrs.apartmentId = &apartmentId
+
+	return mmGetApartmentResidents
+}
+
+// ExpectHistoryParam3 sets up expected param history for ResidentsService.GetApartmentResidents
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) ExpectHistoryParam3(history bool) *mResidentsServiceMockGetApartmentResidents {
+	if mmGetApartmentResidents.mock.funcGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Set")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation == nil {
+		mmGetApartmentResidents.defaultExpectation = &ResidentsServiceMockGetApartmentResidentsExpectation{}
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.params != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Expect")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentResidents.defaultExpectation.paramPtrs = &ResidentsServiceMockGetApartmentResidentsParamPtrs{}
+	}
+	mmGetApartmentResidents.defaultExpectation.paramPtrs.history = &history
+
+	return mmGetApartmentResidents
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsService.GetApartmentResidents
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Inspect(f func(ctx context.Context, apartmentId int, history bool)) *mResidentsServiceMockGetApartmentResidents {
+	if mmGetApartmentResidents.mock.inspectFuncGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("Inspect function is already set for ResidentsServiceMock.GetApartmentResidents")
+	}
+
+	mmGetApartmentResidents.mock.inspectFuncGetApartmentResidents = f
+
+	return mmGetApartmentResidents
+}
+
+// Return sets up results that will be returned by ResidentsService.GetApartmentResidents
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Return(rpa1 []*storage.Resident, err error) *ResidentsServiceMock {
+	if mmGetApartmentResidents.mock.funcGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Set")
+	}
+
+	if mmGetApartmentResidents.defaultExpectation == nil {
+		mmGetApartmentResidents.defaultExpectation = &ResidentsServiceMockGetApartmentResidentsExpectation{mock: mmGetApartmentResidents.mock}
+	}
+	mmGetApartmentResidents.defaultExpectation.results = &ResidentsServiceMockGetApartmentResidentsResults{rpa1, err}
+	return mmGetApartmentResidents.mock
+}
+
+// Set uses given function f to mock the ResidentsService.GetApartmentResidents method
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Set(f func(ctx context.Context, apartmentId int, history bool) (rpa1 []*storage.Resident, err error)) *ResidentsServiceMock {
+	if mmGetApartmentResidents.defaultExpectation != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("Default expectation is already set for the ResidentsService.GetApartmentResidents method")
+	}
+
+	if len(mmGetApartmentResidents.expectations) > 0 {
+		mmGetApartmentResidents.mock.t.Fatalf("Some expectations are already set for the ResidentsService.GetApartmentResidents method")
+	}
+
+	mmGetApartmentResidents.mock.funcGetApartmentResidents = f
+	return mmGetApartmentResidents.mock
+}
+
+// When sets expectation for the ResidentsService.GetApartmentResidents which will trigger the result defined by the following
+// Then helper
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) When(ctx context.Context, apartmentId int, history bool) *ResidentsServiceMockGetApartmentResidentsExpectation {
+	if mmGetApartmentResidents.mock.funcGetApartmentResidents != nil {
+		mmGetApartmentResidents.mock.t.Fatalf("ResidentsServiceMock.GetApartmentResidents mock is already set by Set")
+	}
+
+	expectation := &ResidentsServiceMockGetApartmentResidentsExpectation{
+		mock:   mmGetApartmentResidents.mock,
+		params: &ResidentsServiceMockGetApartmentResidentsParams{ctx, apartmentId, history},
+	}
+	mmGetApartmentResidents.expectations = append(mmGetApartmentResidents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsService.GetApartmentResidents return parameters for the expectation previously defined by the When method
+func (e *ResidentsServiceMockGetApartmentResidentsExpectation) Then(rpa1 []*storage.Resident, err error) *ResidentsServiceMock {
+	e.results = &ResidentsServiceMockGetApartmentResidentsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsService.GetApartmentResidents should be invoked
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Times(n uint64) *mResidentsServiceMockGetApartmentResidents {
+	if n == 0 {
+		mmGetApartmentResidents.mock.t.Fatalf("Times of ResidentsServiceMock.GetApartmentResidents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetApartmentResidents.expectedInvocations, n)
+	return mmGetApartmentResidents
+}
+
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) invocationsDone() bool {
+	if len(mmGetApartmentResidents.expectations) == 0 && mmGetApartmentResidents.defaultExpectation == nil && mmGetApartmentResidents.mock.funcGetApartmentResidents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetApartmentResidents.mock.afterGetApartmentResidentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetApartmentResidents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetApartmentResidents implements residents.ResidentsService
+func (mmGetApartmentResidents *ResidentsServiceMock) GetApartmentResidents(ctx context.Context, apartmentId int, history bool) (rpa1 []*storage.Resident, err error) {
+	mm_atomic.AddUint64(&mmGetApartmentResidents.beforeGetApartmentResidentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetApartmentResidents.afterGetApartmentResidentsCounter, 1)
+
+	if mmGetApartmentResidents.inspectFuncGetApartmentResidents != nil {
+		mmGetApartmentResidents.inspectFuncGetApartmentResidents(ctx, apartmentId, history)
+	}
+
+	mm_params := ResidentsServiceMockGetApartmentResidentsParams{ctx, apartmentId, history}
+
+	// Record call args
+	mmGetApartmentResidents.GetApartmentResidentsMock.mutex.Lock()
+	mmGetApartmentResidents.GetApartmentResidentsMock.callArgs = append(mmGetApartmentResidents.GetApartmentResidentsMock.callArgs, &mm_params)
+	mmGetApartmentResidents.GetApartmentResidentsMock.mutex.Unlock()
+
+	for _, e := range mmGetApartmentResidents.GetApartmentResidentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetApartmentResidents.GetApartmentResidentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetApartmentResidents.GetApartmentResidentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetApartmentResidents.GetApartmentResidentsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetApartmentResidents.GetApartmentResidentsMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsServiceMockGetApartmentResidentsParams{ctx, apartmentId, history}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetApartmentResidents.t.Errorf("ResidentsServiceMock.GetApartmentResidents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.apartmentId != nil && !minimock.Equal(*mm_want_ptrs.apartmentId, mm_got.apartmentId) {
+				mmGetApartmentResidents.t.Errorf("ResidentsServiceMock.GetApartmentResidents got unexpected parameter apartmentId, want: %#v, got: %#v%s\n", *mm_want_ptrs.apartmentId, mm_got.apartmentId, minimock.Diff(*mm_want_ptrs.apartmentId, mm_got.apartmentId))
+			}
+
+			if mm_want_ptrs.history != nil && !minimock.Equal(*mm_want_ptrs.history, mm_got.history) {
+				mmGetApartmentResidents.t.Errorf("ResidentsServiceMock.GetApartmentResidents got unexpected parameter history, want: %#v, got: %#v%s\n", *mm_want_ptrs.history, mm_got.history, minimock.Diff(*mm_want_ptrs.history, mm_got.history))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetApartmentResidents.t.Errorf("ResidentsServiceMock.GetApartmentResidents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetApartmentResidents.GetApartmentResidentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetApartmentResidents.t.Fatal("No results are set for the ResidentsServiceMock.GetApartmentResidents")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetApartmentResidents.funcGetApartmentResidents != nil {
+		return mmGetApartmentResidents.funcGetApartmentResidents(ctx, apartmentId, history)
+	}
+	mmGetApartmentResidents.t.Fatalf("Unexpected call to ResidentsServiceMock.GetApartmentResidents. %v %v %v", ctx, apartmentId, history)
+	return
+}
+
+// GetApartmentResidentsAfterCounter returns a count of finished ResidentsServiceMock.GetApartmentResidents invocations
+func (mmGetApartmentResidents *ResidentsServiceMock) GetApartmentResidentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentResidents.afterGetApartmentResidentsCounter)
+}
+
+// GetApartmentResidentsBeforeCounter returns a count of ResidentsServiceMock.GetApartmentResidents invocations
+func (mmGetApartmentResidents *ResidentsServiceMock) GetApartmentResidentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentResidents.beforeGetApartmentResidentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsServiceMock.GetApartmentResidents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetApartmentResidents *mResidentsServiceMockGetApartmentResidents) Calls() []*ResidentsServiceMockGetApartmentResidentsParams {
+	mmGetApartmentResidents.mutex.RLock()
+
+	argCopy := make([]*ResidentsServiceMockGetApartmentResidentsParams, len(mmGetApartmentResidents.callArgs))
+	copy(argCopy, mmGetApartmentResidents.callArgs)
+
+	mmGetApartmentResidents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetApartmentResidentsDone returns true if the count of the GetApartmentResidents invocations corresponds
+// the number of defined expectations
+func (m *ResidentsServiceMock) MinimockGetApartmentResidentsDone() bool {
+	if m.GetApartmentResidentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetApartmentResidentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetApartmentResidentsMock.invocationsDone()
+}
+
+// MinimockGetApartmentResidentsInspect logs each unmet expectation
+func (m *ResidentsServiceMock) MinimockGetApartmentResidentsInspect() {
+	for _, e := range m.GetApartmentResidentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsServiceMock.GetApartmentResidents with params: %#v", *e.params)
+		}
+	}
+
+	afterGetApartmentResidentsCounter := mm_atomic.LoadUint64(&m.afterGetApartmentResidentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetApartmentResidentsMock.defaultExpectation != nil && afterGetApartmentResidentsCounter < 1 {
+		if m.GetApartmentResidentsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsServiceMock.GetApartmentResidents")
+		} else {
+			m.t.Errorf("Expected call to ResidentsServiceMock.GetApartmentResidents with params: %#v", *m.GetApartmentResidentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetApartmentResidents != nil && afterGetApartmentResidentsCounter < 1 {
+		m.t.Error("Expected call to ResidentsServiceMock.GetApartmentResidents")
+	}
+
+	if !m.GetApartmentResidentsMock.invocationsDone() && afterGetApartmentResidentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsServiceMock.GetApartmentResidents but found %d calls",
+			mm_atomic.LoadUint64(&m.GetApartmentResidentsMock.expectedInvocations), afterGetApartmentResidentsCounter)
+	}
+}
+
+type mResidentsServiceMockGetBuildingResidents struct {
+	optional           bool
+	mock               *ResidentsServiceMock
+	defaultExpectation *ResidentsServiceMockGetBuildingResidentsExpectation
+	expectations       []*ResidentsServiceMockGetBuildingResidentsExpectation
+
+	callArgs []*ResidentsServiceMockGetBuildingResidentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsServiceMockGetBuildingResidentsExpectation specifies expectation struct of the ResidentsService.GetBuildingResidents
+type ResidentsServiceMockGetBuildingResidentsExpectation struct {
+	mock      *ResidentsServiceMock
+	params    *ResidentsServiceMockGetBuildingResidentsParams
+	paramPtrs *ResidentsServiceMockGetBuildingResidentsParamPtrs
+	results   *ResidentsServiceMockGetBuildingResidentsResults
+	Counter   uint64
+}
+
+// ResidentsServiceMockGetBuildingResidentsParams contains parameters of the ResidentsService.GetBuildingResidents
+type ResidentsServiceMockGetBuildingResidentsParams struct {
+	ctx        context.Context
+	buildingId int
+	history    bool
+}
+
+// ResidentsServiceMockGetBuildingResidentsParamPtrs contains pointers to parameters of the ResidentsService.GetBuildingResidents
+type ResidentsServiceMockGetBuildingResidentsParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	history    *bool
+}
+
+// ResidentsServiceMockGetBuildingResidentsResults contains results of the ResidentsService.GetBuildingResidents
+type ResidentsServiceMockGetBuildingResidentsResults struct {
+	rpa1 []*storage.Resident
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Optional() *mResidentsServiceMockGetBuildingResidents {
+	mmGetBuildingResidents.optional = true
+	return mmGetBuildingResidents
+}
+
+// Expect sets up expected params for ResidentsService.GetBuildingResidents
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Expect(ctx context.Context, buildingId int, history bool) *mResidentsServiceMockGetBuildingResidents {
+	if mmGetBuildingResidents.mock.funcGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Set")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation == nil {
+		mmGetBuildingResidents.defaultExpectation = &ResidentsServiceMockGetBuildingResidentsExpectation{}
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.paramPtrs != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by ExpectParams functions")
+	}
+
+	mmGetBuildingResidents.defaultExpectation.params = &ResidentsServiceMockGetBuildingResidentsParams{ctx, buildingId, history}
+	for _, e := range mmGetBuildingResidents.expectations {
+		if minimock.Equal(e.params, mmGetBuildingResidents.defaultExpectation.params) {
+			mmGetBuildingResidents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBuildingResidents.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBuildingResidents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsService.GetBuildingResidents
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) ExpectCtxParam1(ctx context.Context) *mResidentsServiceMockGetBuildingResidents {
+	if mmGetBuildingResidents.mock.funcGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Set")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation == nil {
+		mmGetBuildingResidents.defaultExpectation = &ResidentsServiceMockGetBuildingResidentsExpectation{}
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.params != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Expect")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingResidents.defaultExpectation.paramPtrs = &ResidentsServiceMockGetBuildingResidentsParamPtrs{}
+	}
+	mmGetBuildingResidents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBuildingResidents
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for ResidentsService.GetBuildingResidents
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) ExpectBuildingIdParam2(buildingId int) *mResidentsServiceMockGetBuildingResidents {
+	if mmGetBuildingResidents.mock.funcGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Set")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation == nil {
+		mmGetBuildingResidents.defaultExpectation = &ResidentsServiceMockGetBuildingResidentsExpectation{}
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.params != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Expect")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingResidents.defaultExpectation.paramPtrs = &ResidentsServiceMockGetBuildingResidentsParamPtrs{}
+	}
+	mmGetBuildingResidents.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetBuildingResidents
+}
+
+// ExpectHistoryParam3 sets up expected param history for ResidentsService.GetBuildingResidents
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) ExpectHistoryParam3(history bool) *mResidentsServiceMockGetBuildingResidents {
+	if mmGetBuildingResidents.mock.funcGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Set")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation == nil {
+		mmGetBuildingResidents.defaultExpectation = &ResidentsServiceMockGetBuildingResidentsExpectation{}
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.params != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Expect")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingResidents.defaultExpectation.paramPtrs = &ResidentsServiceMockGetBuildingResidentsParamPtrs{}
+	}
+	mmGetBuildingResidents.defaultExpectation.paramPtrs.history = &history
+
+	return mmGetBuildingResidents
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsService.GetBuildingResidents
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Inspect(f func(ctx context.Context, buildingId int, history bool)) *mResidentsServiceMockGetBuildingResidents {
+	if mmGetBuildingResidents.mock.inspectFuncGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("Inspect function is already set for ResidentsServiceMock.GetBuildingResidents")
+	}
+
+	mmGetBuildingResidents.mock.inspectFuncGetBuildingResidents = f
+
+	return mmGetBuildingResidents
+}
+
+// Return sets up results that will be returned by ResidentsService.GetBuildingResidents
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Return(rpa1 []*storage.Resident, err error) *ResidentsServiceMock {
+	if mmGetBuildingResidents.mock.funcGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Set")
+	}
+
+	if mmGetBuildingResidents.defaultExpectation == nil {
+		mmGetBuildingResidents.defaultExpectation = &ResidentsServiceMockGetBuildingResidentsExpectation{mock: mmGetBuildingResidents.mock}
+	}
+	mmGetBuildingResidents.defaultExpectation.results = &ResidentsServiceMockGetBuildingResidentsResults{rpa1, err}
+	return mmGetBuildingResidents.mock
+}
+
+// Set uses given function f to mock the ResidentsService.GetBuildingResidents method
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Set(f func(ctx context.Context, buildingId int, history bool) (rpa1 []*storage.Resident, err error)) *ResidentsServiceMock {
+	if mmGetBuildingResidents.defaultExpectation != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("Default expectation is already set for the ResidentsService.GetBuildingResidents method")
+	}
+
+	if len(mmGetBuildingResidents.expectations) > 0 {
+		mmGetBuildingResidents.mock.t.Fatalf("Some expectations are already set for the ResidentsService.GetBuildingResidents method")
+	}
+
+	mmGetBuildingResidents.mock.funcGetBuildingResidents = f
+	return mmGetBuildingResidents.mock
+}
+
+// When sets expectation for the ResidentsService.GetBuildingResidents which will trigger the result defined by the following
+// Then helper
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) When(ctx context.Context, buildingId int, history bool) *ResidentsServiceMockGetBuildingResidentsExpectation {
+	if mmGetBuildingResidents.mock.funcGetBuildingResidents != nil {
+		mmGetBuildingResidents.mock.t.Fatalf("ResidentsServiceMock.GetBuildingResidents mock is already set by Set")
+	}
+
+	expectation := &ResidentsServiceMockGetBuildingResidentsExpectation{
+		mock:   mmGetBuildingResidents.mock,
+		params: &ResidentsServiceMockGetBuildingResidentsParams{ctx, buildingId, history},
+	}
+	mmGetBuildingResidents.expectations = append(mmGetBuildingResidents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsService.GetBuildingResidents return parameters for the expectation previously defined by the When method
+func (e *ResidentsServiceMockGetBuildingResidentsExpectation) Then(rpa1 []*storage.Resident, err error) *ResidentsServiceMock {
+	e.results = &ResidentsServiceMockGetBuildingResidentsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsService.GetBuildingResidents should be invoked
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Times(n uint64) *mResidentsServiceMockGetBuildingResidents {
+	if n == 0 {
+		mmGetBuildingResidents.mock.t.Fatalf("Times of ResidentsServiceMock.GetBuildingResidents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBuildingResidents.expectedInvocations, n)
+	return mmGetBuildingResidents
+}
+
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) invocationsDone() bool {
+	if len(mmGetBuildingResidents.expectations) == 0 && mmGetBuildingResidents.defaultExpectation == nil && mmGetBuildingResidents.mock.funcGetBuildingResidents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBuildingResidents.mock.afterGetBuildingResidentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBuildingResidents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBuildingResidents implements residents.ResidentsService
+func (mmGetBuildingResidents *ResidentsServiceMock) GetBuildingResidents(ctx context.Context, buildingId int, history bool) (rpa1 []*storage.Resident, err error) {
+	mm_atomic.AddUint64(&mmGetBuildingResidents.beforeGetBuildingResidentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBuildingResidents.afterGetBuildingResidentsCounter, 1)
+
+	if mmGetBuildingResidents.inspectFuncGetBuildingResidents != nil {
+		mmGetBuildingResidents.inspectFuncGetBuildingResidents(ctx, buildingId, history)
+	}
+
+	mm_params := ResidentsServiceMockGetBuildingResidentsParams{ctx, buildingId, history}
+
+	// Record call args
+	mmGetBuildingResidents.GetBuildingResidentsMock.mutex.Lock()
+	mmGetBuildingResidents.GetBuildingResidentsMock.callArgs = append(mmGetBuildingResidents.GetBuildingResidentsMock.callArgs, &mm_params)
+	mmGetBuildingResidents.GetBuildingResidentsMock.mutex.Unlock()
+
+	for _, e := range mmGetBuildingResidents.GetBuildingResidentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetBuildingResidents.GetBuildingResidentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBuildingResidents.GetBuildingResidentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBuildingResidents.GetBuildingResidentsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBuildingResidents.GetBuildingResidentsMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsServiceMockGetBuildingResidentsParams{ctx, buildingId, history}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBuildingResidents.t.Errorf("ResidentsServiceMock.GetBuildingResidents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetBuildingResidents.t.Errorf("ResidentsServiceMock.GetBuildingResidents got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.history != nil && !minimock.Equal(*mm_want_ptrs.history, mm_got.history) {
+				mmGetBuildingResidents.t.Errorf("ResidentsServiceMock.GetBuildingResidents got unexpected parameter history, want: %#v, got: %#v%s\n", *mm_want_ptrs.history, mm_got.history, minimock.Diff(*mm_want_ptrs.history, mm_got.history))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBuildingResidents.t.Errorf("ResidentsServiceMock.GetBuildingResidents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBuildingResidents.GetBuildingResidentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBuildingResidents.t.Fatal("No results are set for the ResidentsServiceMock.GetBuildingResidents")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetBuildingResidents.funcGetBuildingResidents != nil {
+		return mmGetBuildingResidents.funcGetBuildingResidents(ctx, buildingId, history)
+	}
+	mmGetBuildingResidents.t.Fatalf("Unexpected call to ResidentsServiceMock.GetBuildingResidents. %v %v %v", ctx, buildingId, history)
+	return
+}
+
+// GetBuildingResidentsAfterCounter returns a count of finished ResidentsServiceMock.GetBuildingResidents invocations
+func (mmGetBuildingResidents *ResidentsServiceMock) GetBuildingResidentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingResidents.afterGetBuildingResidentsCounter)
+}
+
+// GetBuildingResidentsBeforeCounter returns a count of ResidentsServiceMock.GetBuildingResidents invocations
+func (mmGetBuildingResidents *ResidentsServiceMock) GetBuildingResidentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingResidents.beforeGetBuildingResidentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsServiceMock.GetBuildingResidents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBuildingResidents *mResidentsServiceMockGetBuildingResidents) Calls() []*ResidentsServiceMockGetBuildingResidentsParams {
+	mmGetBuildingResidents.mutex.RLock()
+
+	argCopy := make([]*ResidentsServiceMockGetBuildingResidentsParams, len(mmGetBuildingResidents.callArgs))
+	copy(argCopy, mmGetBuildingResidents.callArgs)
+
+	mmGetBuildingResidents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBuildingResidentsDone returns true if the count of the GetBuildingResidents invocations corresponds
+// the number of defined expectations
+func (m *ResidentsServiceMock) MinimockGetBuildingResidentsDone() bool {
+	if m.GetBuildingResidentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBuildingResidentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBuildingResidentsMock.invocationsDone()
+}
+
+// MinimockGetBuildingResidentsInspect logs each unmet expectation
+func (m *ResidentsServiceMock) MinimockGetBuildingResidentsInspect() {
+	for _, e := range m.GetBuildingResidentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsServiceMock.GetBuildingResidents with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBuildingResidentsCounter := mm_atomic.LoadUint64(&m.afterGetBuildingResidentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBuildingResidentsMock.defaultExpectation != nil && afterGetBuildingResidentsCounter < 1 {
+		if m.GetBuildingResidentsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsServiceMock.GetBuildingResidents")
+		} else {
+			m.t.Errorf("Expected call to ResidentsServiceMock.GetBuildingResidents with params: %#v", *m.GetBuildingResidentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBuildingResidents != nil && afterGetBuildingResidentsCounter < 1 {
+		m.t.Error("Expected call to ResidentsServiceMock.GetBuildingResidents")
+	}
+
+	if !m.GetBuildingResidentsMock.invocationsDone() && afterGetBuildingResidentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsServiceMock.GetBuildingResidents but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBuildingResidentsMock.expectedInvocations), afterGetBuildingResidentsCounter)
+	}
+}
+
+type mResidentsServiceMockGetResident struct {
+	optional           bool
+	mock               *ResidentsServiceMock
+	defaultExpectation *ResidentsServiceMockGetResidentExpectation
+	expectations       []*ResidentsServiceMockGetResidentExpectation
+
+	callArgs []*ResidentsServiceMockGetResidentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsServiceMockGetResidentExpectation specifies expectation struct of the ResidentsService.GetResident
+type ResidentsServiceMockGetResidentExpectation struct {
+	mock      *ResidentsServiceMock
+	params    *ResidentsServiceMockGetResidentParams
+	paramPtrs *ResidentsServiceMockGetResidentParamPtrs
+	results   *ResidentsServiceMockGetResidentResults
+	Counter   uint64
+}
+
+// ResidentsServiceMockGetResidentParams contains parameters of the ResidentsService.GetResident
+type ResidentsServiceMockGetResidentParams struct {
+	ctx context.Context
+	id  int
+}
+
+// ResidentsServiceMockGetResidentParamPtrs contains pointers to parameters of the ResidentsService.GetResident
+type ResidentsServiceMockGetResidentParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// ResidentsServiceMockGetResidentResults contains results of the ResidentsService.GetResident
+type ResidentsServiceMockGetResidentResults struct {
+	rp1 *storage.Resident
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetResident *mResidentsServiceMockGetResident) Optional() *mResidentsServiceMockGetResident {
+	mmGetResident.optional = true
+	return mmGetResident
+}
+
+// Expect sets up expected params for ResidentsService.GetResident
+func (mmGetResident *mResidentsServiceMockGetResident) Expect(ctx context.Context, id int) *mResidentsServiceMockGetResident {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsServiceMockGetResidentExpectation{}
+	}
+
+	if mmGetResident.defaultExpectation.paramPtrs != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by ExpectParams functions")
+	}
+
+	mmGetResident.defaultExpectation.params = &ResidentsServiceMockGetResidentParams{ctx, id}
+	for _, e := range mmGetResident.expectations {
+		if minimock.Equal(e.params, mmGetResident.defaultExpectation.params) {
+			mmGetResident.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResident.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResident
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsService.GetResident
+func (mmGetResident *mResidentsServiceMockGetResident) ExpectCtxParam1(ctx context.Context) *mResidentsServiceMockGetResident {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsServiceMockGetResidentExpectation{}
+	}
+
+	if mmGetResident.defaultExpectation.params != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Expect")
+	}
+
+	if mmGetResident.defaultExpectation.paramPtrs == nil {
+		mmGetResident.defaultExpectation.paramPtrs = &ResidentsServiceMockGetResidentParamPtrs{}
+	}
+	mmGetResident.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetResident
+}
+
+// ExpectIdParam2 sets up expected param id for ResidentsService.GetResident
+func (mmGetResident *mResidentsServiceMockGetResident) ExpectIdParam2(id int) *mResidentsServiceMockGetResident {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsServiceMockGetResidentExpectation{}
+	}
+
+	if mmGetResident.defaultExpectation.params != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Expect")
+	}
+
+	if mmGetResident.defaultExpectation.paramPtrs == nil {
+		mmGetResident.defaultExpectation.paramPtrs = &ResidentsServiceMockGetResidentParamPtrs{}
+	}
+	mmGetResident.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetResident
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsService.GetResident
+func (mmGetResident *mResidentsServiceMockGetResident) Inspect(f func(ctx context.Context, id int)) *mResidentsServiceMockGetResident {
+	if mmGetResident.mock.inspectFuncGetResident != nil {
+		mmGetResident.mock.t.Fatalf("Inspect function is already set for ResidentsServiceMock.GetResident")
+	}
+
+	mmGetResident.mock.inspectFuncGetResident = f
+
+	return mmGetResident
+}
+
+// Return sets up results that will be returned by ResidentsService.GetResident
+func (mmGetResident *mResidentsServiceMockGetResident) Return(rp1 *storage.Resident, err error) *ResidentsServiceMock {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsServiceMockGetResidentExpectation{mock: mmGetResident.mock}
+	}
+	mmGetResident.defaultExpectation.results = &ResidentsServiceMockGetResidentResults{rp1, err}
+	return mmGetResident.mock
+}
+
+// Set uses given function f to mock the ResidentsService.GetResident method
+func (mmGetResident *mResidentsServiceMockGetResident) Set(f func(ctx context.Context, id int) (rp1 *storage.Resident, err error)) *ResidentsServiceMock {
+	if mmGetResident.defaultExpectation != nil {
+		mmGetResident.mock.t.Fatalf("Default expectation is already set for the ResidentsService.GetResident method")
+	}
+
+	if len(mmGetResident.expectations) > 0 {
+		mmGetResident.mock.t.Fatalf("Some expectations are already set for the ResidentsService.GetResident method")
+	}
+
+	mmGetResident.mock.funcGetResident = f
+	return mmGetResident.mock
+}
+
+// When sets expectation for the ResidentsService.GetResident which will trigger the result defined by the following
+// Then helper
+func (mmGetResident *mResidentsServiceMockGetResident) When(ctx context.Context, id int) *ResidentsServiceMockGetResidentExpectation {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsServiceMock.GetResident mock is already set by Set")
+	}
+
+	expectation := &ResidentsServiceMockGetResidentExpectation{
+		mock:   mmGetResident.mock,
+		params: &ResidentsServiceMockGetResidentParams{ctx, id},
+	}
+	mmGetResident.expectations = append(mmGetResident.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsService.GetResident return parameters for the expectation previously defined by the When method
+func (e *ResidentsServiceMockGetResidentExpectation) Then(rp1 *storage.Resident, err error) *ResidentsServiceMock {
+	e.results = &ResidentsServiceMockGetResidentResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsService.GetResident should be invoked
+func (mmGetResident *mResidentsServiceMockGetResident) Times(n uint64) *mResidentsServiceMockGetResident {
+	if n == 0 {
+		mmGetResident.mock.t.Fatalf("Times of ResidentsServiceMock.GetResident mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetResident.expectedInvocations, n)
+	return mmGetResident
+}
+
+func (mmGetResident *mResidentsServiceMockGetResident) invocationsDone() bool {
+	if len(mmGetResident.expectations) == 0 && mmGetResident.defaultExpectation == nil && mmGetResident.mock.funcGetResident == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetResident.mock.afterGetResidentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetResident.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetResident implements residents.ResidentsService
+func (mmGetResident *ResidentsServiceMock) GetResident(ctx context.Context, id int) (rp1 *storage.Resident, err error) {
+	mm_atomic.AddUint64(&mmGetResident.beforeGetResidentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResident.afterGetResidentCounter, 1)
+
+	if mmGetResident.inspectFuncGetResident != nil {
+		mmGetResident.inspectFuncGetResident(ctx, id)
+	}
+
+	mm_params := ResidentsServiceMockGetResidentParams{ctx, id}
+
+	// Record call args
+	mmGetResident.GetResidentMock.mutex.Lock()
+	mmGetResident.GetResidentMock.callArgs = append(mmGetResident.GetResidentMock.callArgs, &mm_params)
+	mmGetResident.GetResidentMock.mutex.Unlock()
+
+	for _, e := range mmGetResident.GetResidentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetResident.GetResidentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResident.GetResidentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResident.GetResidentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetResident.GetResidentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsServiceMockGetResidentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetResident.t.Errorf("ResidentsServiceMock.GetResident got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetResident.t.Errorf("ResidentsServiceMock.GetResident got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResident.t.Errorf("ResidentsServiceMock.GetResident got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResident.GetResidentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResident.t.Fatal("No results are set for the ResidentsServiceMock.GetResident")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetResident.funcGetResident != nil {
+		return mmGetResident.funcGetResident(ctx, id)
+	}
+	mmGetResident.t.Fatalf("Unexpected call to ResidentsServiceMock.GetResident. %v %v", ctx, id)
+	return
+}
+
+// GetResidentAfterCounter returns a count of finished ResidentsServiceMock.GetResident invocations
+func (mmGetResident *ResidentsServiceMock) GetResidentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResident.afterGetResidentCounter)
+}
+
+// GetResidentBeforeCounter returns a count of ResidentsServiceMock.GetResident invocations
+func (mmGetResident *ResidentsServiceMock) GetResidentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResident.beforeGetResidentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsServiceMock.GetResident.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResident *mResidentsServiceMockGetResident) Calls() []*ResidentsServiceMockGetResidentParams {
+	mmGetResident.mutex.RLock()
+
+	argCopy := make([]*ResidentsServiceMockGetResidentParams, len(mmGetResident.callArgs))
+	copy(argCopy, mmGetResident.callArgs)
+
+	mmGetResident.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResidentDone returns true if the count of the GetResident invocations corresponds
+// the number of defined expectations
+func (m *ResidentsServiceMock) MinimockGetResidentDone() bool {
+	if m.GetResidentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetResidentMock.invocationsDone()
+}
+
+// MinimockGetResidentInspect logs each unmet expectation
+func (m *ResidentsServiceMock) MinimockGetResidentInspect() {
+	for _, e := range m.GetResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsServiceMock.GetResident with params: %#v", *e.params)
+		}
+	}
+
+	afterGetResidentCounter := mm_atomic.LoadUint64(&m.afterGetResidentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResidentMock.defaultExpectation != nil && afterGetResidentCounter < 1 {
+		if m.GetResidentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsServiceMock.GetResident")
+		} else {
+			m.t.Errorf("Expected call to ResidentsServiceMock.GetResident with params: %#v", *m.GetResidentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResident != nil && afterGetResidentCounter < 1 {
+		m.t.Error("Expected call to ResidentsServiceMock.GetResident")
+	}
+
+	if !m.GetResidentMock.invocationsDone() && afterGetResidentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsServiceMock.GetResident but found %d calls",
+			mm_atomic.LoadUint64(&m.GetResidentMock.expectedInvocations), afterGetResidentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ResidentsServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateResidentInspect()
+
+			m.MinimockDeleteResidentInspect()
+
+			m.MinimockGetApartmentResidentsInspect()
+
+			m.MinimockGetBuildingResidentsInspect()
+
+			m.MinimockGetResidentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ResidentsServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ResidentsServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateResidentDone() &&
+		m.MinimockDeleteResidentDone() &&
+		m.MinimockGetApartmentResidentsDone() &&
+		m.MinimockGetBuildingResidentsDone() &&
+		m.MinimockGetResidentDone()
+}
@@ -0,0 +1,423 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/search.SearchService -o search_service_mock_test.go -n SearchServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// SearchServiceMock implements search.SearchService
+type SearchServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSearch          func(ctx context.Context, query string, limit int) (spa1 []*storage.SearchResult, err error)
+	inspectFuncSearch   func(ctx context.Context, query string, limit int)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mSearchServiceMockSearch
+}
+
+// NewSearchServiceMock returns a mock for search.SearchService
+func NewSearchServiceMock(t minimock.Tester) *SearchServiceMock {
+	m := &SearchServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SearchMock = mSearchServiceMockSearch{mock: m}
+	m.SearchMock.callArgs = []*SearchServiceMockSearchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSearchServiceMockSearch struct {
+	optional           bool
+	mock               *SearchServiceMock
+	defaultExpectation *SearchServiceMockSearchExpectation
+	expectations       []*SearchServiceMockSearchExpectation
+
+	callArgs []*SearchServiceMockSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SearchServiceMockSearchExpectation specifies expectation struct of the SearchService.Search
+type SearchServiceMockSearchExpectation struct {
+	mock      *SearchServiceMock
+	params    *SearchServiceMockSearchParams
+	paramPtrs *SearchServiceMockSearchParamPtrs
+	results   *SearchServiceMockSearchResults
+	Counter   uint64
+}
+
+// SearchServiceMockSearchParams contains parameters of the SearchService.Search
+type SearchServiceMockSearchParams struct {
+	ctx   context.Context
+	query string
+	limit int
+}
+
+// SearchServiceMockSearchParamPtrs contains pointers to parameters of the SearchService.Search
+type SearchServiceMockSearchParamPtrs struct {
+	ctx   *context.Context
+	query *string
+	limit *int
+}
+
+// SearchServiceMockSearchResults contains results of the SearchService.Search
+type SearchServiceMockSearchResults struct {
+	spa1 []*storage.SearchResult
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearch *mSearchServiceMockSearch) Optional() *mSearchServiceMockSearch {
+	mmSearch.optional = true
+	return mmSearch
+}
+
+// Expect sets up expected params for SearchService.Search
+func (mmSearch *mSearchServiceMockSearch) Expect(ctx context.Context, query string, limit int) *mSearchServiceMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchServiceMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by ExpectParams functions")
+	}
+
+	mmSearch.defaultExpectation.params = &SearchServiceMockSearchParams{ctx, query, limit}
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SearchService.Search
+func (mmSearch *mSearchServiceMockSearch) ExpectCtxParam1(ctx context.Context) *mSearchServiceMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchServiceMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchServiceMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSearch
+}
+
+// ExpectQueryParam2 sets up expected param query for SearchService.Search
+func (mmSearch *mSearchServiceMockSearch) ExpectQueryParam2(query string) *mSearchServiceMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchServiceMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchServiceMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.query = &query
+
+	return mmSearch
+}
+
+// ExpectLimitParam3 sets up expected param limit for SearchService.Search
+func (mmSearch *mSearchServiceMockSearch) ExpectLimitParam3(limit int) *mSearchServiceMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchServiceMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchServiceMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the SearchService.Search
+func (mmSearch *mSearchServiceMockSearch) Inspect(f func(ctx context.Context, query string, limit int)) *mSearchServiceMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for SearchServiceMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by SearchService.Search
+func (mmSearch *mSearchServiceMockSearch) Return(spa1 []*storage.SearchResult, err error) *SearchServiceMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchServiceMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &SearchServiceMockSearchResults{spa1, err}
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the SearchService.Search method
+func (mmSearch *mSearchServiceMockSearch) Set(f func(ctx context.Context, query string, limit int) (spa1 []*storage.SearchResult, err error)) *SearchServiceMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the SearchService.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the SearchService.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	return mmSearch.mock
+}
+
+// When sets expectation for the SearchService.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mSearchServiceMockSearch) When(ctx context.Context, query string, limit int) *SearchServiceMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchServiceMock.Search mock is already set by Set")
+	}
+
+	expectation := &SearchServiceMockSearchExpectation{
+		mock:   mmSearch.mock,
+		params: &SearchServiceMockSearchParams{ctx, query, limit},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SearchService.Search return parameters for the expectation previously defined by the When method
+func (e *SearchServiceMockSearchExpectation) Then(spa1 []*storage.SearchResult, err error) *SearchServiceMock {
+	e.results = &SearchServiceMockSearchResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times SearchService.Search should be invoked
+func (mmSearch *mSearchServiceMockSearch) Times(n uint64) *mSearchServiceMockSearch {
+	if n == 0 {
+		mmSearch.mock.t.Fatalf("Times of SearchServiceMock.Search mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearch.expectedInvocations, n)
+	return mmSearch
+}
+
+func (mmSearch *mSearchServiceMockSearch) invocationsDone() bool {
+	if len(mmSearch.expectations) == 0 && mmSearch.defaultExpectation == nil && mmSearch.mock.funcSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearch.mock.afterSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Search implements search.SearchService
+func (mmSearch *SearchServiceMock) Search(ctx context.Context, query string, limit int) (spa1 []*storage.SearchResult, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
+
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, query, limit)
+	}
+
+	mm_params := SearchServiceMockSearchParams{ctx, query, limit}
+
+	// Record call args
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
+
+	for _, e := range mmSearch.SearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_want_ptrs := mmSearch.SearchMock.defaultExpectation.paramPtrs
+
+		mm_got := SearchServiceMockSearchParams{ctx, query, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearch.t.Errorf("SearchServiceMock.Search got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearch.t.Errorf("SearchServiceMock.Search got unexpected parameter query, want: %#v, got: %#v%s\n", *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSearch.t.Errorf("SearchServiceMock.Search got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearch.t.Errorf("SearchServiceMock.Search got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearch.t.Fatal("No results are set for the SearchServiceMock.Search")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, query, limit)
+	}
+	mmSearch.t.Fatalf("Unexpected call to SearchServiceMock.Search. %v %v %v", ctx, query, limit)
+	return
+}
+
+// SearchAfterCounter returns a count of finished SearchServiceMock.Search invocations
+func (mmSearch *SearchServiceMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
+}
+
+// SearchBeforeCounter returns a count of SearchServiceMock.Search invocations
+func (mmSearch *SearchServiceMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to SearchServiceMock.Search.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearch *mSearchServiceMockSearch) Calls() []*SearchServiceMockSearchParams {
+	mmSearch.mutex.RLock()
+
+	argCopy := make([]*SearchServiceMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
+
+	mmSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
+// the number of defined expectations
+func (m *SearchServiceMock) MinimockSearchDone() bool {
+	if m.SearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMock.invocationsDone()
+}
+
+// MinimockSearchInspect logs each unmet expectation
+func (m *SearchServiceMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SearchServiceMock.Search with params: %#v", *e.params)
+		}
+	}
+
+	afterSearchCounter := mm_atomic.LoadUint64(&m.afterSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && afterSearchCounter < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SearchServiceMock.Search")
+		} else {
+			m.t.Errorf("Expected call to SearchServiceMock.Search with params: %#v", *m.SearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && afterSearchCounter < 1 {
+		m.t.Error("Expected call to SearchServiceMock.Search")
+	}
+
+	if !m.SearchMock.invocationsDone() && afterSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to SearchServiceMock.Search but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMock.expectedInvocations), afterSearchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SearchServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSearchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SearchServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SearchServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSearchDone()
+}
@@ -0,0 +1,308 @@
+// Package residents manages the residents of the apartments, who lived or live there and who is
+// their primary contact. Their personal data isn't logged nor traced, and isn't repeated in the
+// error messages.
+package residents
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+)
+
+// MaxNameLength bounds the names of the residents, in characters.
+const MaxNameLength = 200
+
+// phonePattern accepts the phone numbers as they are written, e.g. "+972 4-123-4567" or
+// "(555) 123.4567". They must have from 6 to 15 digits, the maximum of E.164.
+var phonePattern = regexp.MustCompile(`^\+?[0-9()][0-9 ().\-]*$`)
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/residents.ResidentsService -o ../mocks/
+type ResidentsService interface {
+	GetApartmentResidents(ctx context.Context, apartmentId int, history bool) ([]*storage.Resident, error)
+	GetBuildingResidents(ctx context.Context, buildingId int, history bool) ([]*storage.Resident, error)
+	GetResident(ctx context.Context, id int) (*storage.Resident, error)
+	CreateResident(ctx context.Context, resident *storage.Resident) error
+	DeleteResident(ctx context.Context, id int) error
+}
+
+type Service struct {
+	residentsStorage  storage.ResidentsStorage
+	apartmentsStorage storage.ApartmentsStorage
+	scopes            access.Resolver
+}
+
+func NewService(residentsStorage storage.ResidentsStorage, apartmentsStorage storage.ApartmentsStorage, scopes access.Resolver) *Service {
+	return &Service{
+		residentsStorage:  residentsStorage,
+		apartmentsStorage: apartmentsStorage,
+		scopes:            scopes,
+	}
+}
+
+// GetApartmentResidents returns the current residents of the apartment, or all of them with
+// history, by move-in date.
+func (s *Service) GetApartmentResidents(ctx context.Context, apartmentId int, history bool) (_ []*storage.Resident, err error) {
+	ctx, span := tracing.Start(ctx, "residents.GetApartmentResidents",
+		attribute.Int("apartment.id", apartmentId), attribute.Bool("residents.history", history))
+	defer tracing.End(span, &err)
+
+	_, err = s.authorizeApartment(ctx, access.ActionRead, apartmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.residentsStorage.GetResidentsOfApartment(ctx, apartmentId, !history)
+}
+
+// GetBuildingResidents returns the current residents of the apartments of the building, or all of
+// them with history, by apartment and move-in date.
+func (s *Service) GetBuildingResidents(ctx context.Context, buildingId int, history bool) (_ []*storage.Resident, err error) {
+	ctx, span := tracing.Start(ctx, "residents.GetBuildingResidents",
+		attribute.Int("building.id", buildingId), attribute.Bool("residents.history", history))
+	defer tracing.End(span, &err)
+
+	if buildingId <= 0 {
+		return nil, fmt.Errorf("%w: building id less or equal 0", service.ErrInvalid)
+	}
+	err = s.authorize(ctx, access.ActionRead, buildingId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.residentsStorage.GetResidentsOfBuilding(ctx, buildingId, !history)
+}
+
+func (s *Service) GetResident(ctx context.Context, id int) (_ *storage.Resident, err error) {
+	ctx, span := tracing.Start(ctx, "residents.GetResident", attribute.Int("resident.id", id))
+	defer tracing.End(span, &err)
+
+	resident, err := s.getResident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorize(ctx, access.ActionRead, resident.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resident, nil
+}
+
+// CreateResident creates the resident of an apartment or, if its ID isn't 0, replaces the one with
+// this ID, possibly moving them to another apartment.
+func (s *Service) CreateResident(ctx context.Context, resident *storage.Resident) (err error) {
+	ctx, span := tracing.Start(ctx, "residents.CreateResident")
+	defer tracing.End(span, &err)
+
+	if resident == nil {
+		return fmt.Errorf("%w: resident is required", service.ErrInvalid)
+	}
+	err = normalize(resident)
+	if err != nil {
+		return err
+	}
+
+	apartment, err := s.authorizeApartment(ctx, access.ActionWrite, resident.ApartmentID)
+	if err != nil {
+		return err
+	}
+	resident.BuildingID = apartment.BuildingID
+
+	// An update may move the resident out of another building, which must be writable too.
+	if resident.ID > 0 {
+		existing, err := s.residentsStorage.GetResident(ctx, resident.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && existing.BuildingID != resident.BuildingID {
+			err = s.authorize(ctx, access.ActionWrite, existing.BuildingID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if resident.PrimaryContact {
+		err = s.validatePrimaryContact(ctx, resident)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.residentsStorage.CreateResident(ctx, resident)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: no resident with id [%v]", service.ErrNotFound, resident.ID)
+	}
+	if errors.Is(err, storage.ErrOverlap) {
+		return fmt.Errorf("%w: apartment [%v] has another primary contact from [%v]",
+			service.ErrInvalid, resident.ApartmentID, resident.MoveIn)
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteResident(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "residents.DeleteResident", attribute.Int("resident.id", id))
+	defer tracing.End(span, &err)
+
+	resident, err := s.getResident(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorize(ctx, access.ActionWrite, resident.BuildingID)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.residentsStorage.DeleteResident(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: no resident with id [%v]", service.ErrNotFound, id)
+	}
+
+	return nil
+}
+
+// normalize collapses the whitespace of the personal data, drops the empty contact details and
+// validates the resident.
+func normalize(r *storage.Resident) error {
+	if r.ApartmentID <= 0 {
+		return fmt.Errorf("%w: apartment id less or equal 0", service.ErrInvalid)
+	}
+
+	r.Name = strings.Join(strings.Fields(r.Name), " ")
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", service.ErrInvalid)
+	}
+	if utf8.RuneCountInString(r.Name) > MaxNameLength {
+		return fmt.Errorf("%w: name longer than %d characters", service.ErrInvalid, MaxNameLength)
+	}
+
+	r.Email = trimmed(r.Email)
+	if r.Email != nil {
+		address, err := mail.ParseAddress(*r.Email)
+		if err != nil || address.Address != *r.Email {
+			return fmt.Errorf("%w: email isn't an email address", service.ErrInvalid)
+		}
+	}
+
+	r.Phone = trimmed(r.Phone)
+	if r.Phone != nil {
+		digits := 0
+		for _, c := range *r.Phone {
+			if c >= '0' && c <= '9' {
+				digits++
+			}
+		}
+		if !phonePattern.MatchString(*r.Phone) || digits < 6 || digits > 15 {
+			return fmt.Errorf("%w: phone isn't a phone number", service.ErrInvalid)
+		}
+	}
+
+	if r.MoveIn.IsZero() {
+		return fmt.Errorf("%w: move_in is required", service.ErrInvalid)
+	}
+	if r.MoveOut != nil && r.MoveOut.Before(r.MoveIn) {
+		return fmt.Errorf("%w: move_out [%v] before move_in [%v]", service.ErrInvalid, r.MoveOut, r.MoveIn)
+	}
+
+	return nil
+}
+
+// trimmed returns nil for the blank strings.
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	if t == "" {
+		return nil
+	}
+	return &t
+}
+
+// validatePrimaryContact fails if another primary contact of the apartment lived there at the same
+// time as the resident.
+func (s *Service) validatePrimaryContact(ctx context.Context, resident *storage.Resident) error {
+	residents, err := s.residentsStorage.GetResidentsOfApartment(ctx, resident.ApartmentID, false)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range residents {
+		if other.ID != resident.ID && other.PrimaryContact && other.Overlaps(resident) {
+			return fmt.Errorf("%w: resident [%v] is the primary contact of apartment [%v] from [%v]",
+				service.ErrInvalid, other.ID, resident.ApartmentID, other.MoveIn)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getResident(ctx context.Context, id int) (*storage.Resident, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: id less or equal 0", service.ErrInvalid)
+	}
+
+	resident, err := s.residentsStorage.GetResident(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no resident with id [%v]", service.ErrNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resident, nil
+}
+
+// authorizeApartment returns the apartment if the principal may perform the action on its building.
+func (s *Service) authorizeApartment(ctx context.Context, a access.Action, apartmentId int) (*models.Apartment, error) {
+	if apartmentId <= 0 {
+		return nil, fmt.Errorf("%w: apartment id less or equal 0", service.ErrInvalid)
+	}
+
+	apartment, err := s.apartmentsStorage.GetApartment(ctx, apartmentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no apartment with id [%v]", service.ErrNotFound, apartmentId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorize(ctx, a, apartment.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return apartment, nil
+}
+
+func (s *Service) authorize(ctx context.Context, a access.Action, buildingID int) error {
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	return access.Authorize(scope, a, buildingID)
+}
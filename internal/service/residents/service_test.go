@@ -0,0 +1,375 @@
+package residents
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+)
+
+func scopeOf(grants ...*storage.Grant) access.Resolver {
+	return access.Fixed(access.NewScope(grants...))
+}
+
+func grantOf(role access.Role, buildingID int) *storage.Grant {
+	return &storage.Grant{Role: string(role), BuildingID: &buildingID}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func datePtr(d storage.Date) *storage.Date {
+	return &d
+}
+
+var (
+	jan1 = storage.NewDate(2026, time.January, 1)
+	jul1 = storage.NewDate(2026, time.July, 1)
+)
+
+// apartments returns apartment 7 of building 1 and apartment 8 of building 2.
+func apartments(mc *minimock.Controller) storage.ApartmentsStorage {
+	return storage_mocks.NewApartmentsStorageMock(mc).
+		GetApartmentMock.
+		Optional().
+		Set(func(_ context.Context, id int) (*models.Apartment, error) {
+			switch id {
+			case 7:
+				return &models.Apartment{ID: 7, BuildingID: 1}, nil
+			case 8:
+				return &models.Apartment{ID: 8, BuildingID: 2}, nil
+			default:
+				return nil, sql.ErrNoRows
+			}
+		})
+}
+
+func Test_GetApartmentResidents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("current", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		want := []*storage.Resident{{ID: 1, ApartmentID: 7, BuildingID: 1, Name: "Ada", MoveIn: jan1}}
+		residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+			GetResidentsOfApartmentMock.
+			Expect(minimock.AnyContext, 7, true).
+			Return(want, nil)
+		s := NewService(residentsStorage, apartments(mc), scopeOf(grantOf(access.RoleViewer, 1)))
+
+		got, err := s.GetApartmentResidents(context.Background(), 7, false)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("history", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+			GetResidentsOfApartmentMock.
+			Expect(minimock.AnyContext, 7, false).
+			Return([]*storage.Resident{}, nil)
+		s := NewService(residentsStorage, apartments(mc), access.Fixed(access.Unrestricted()))
+
+		got, err := s.GetApartmentResidents(context.Background(), 7, true)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("outOfScope", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, apartments(minimock.NewController(t)), scopeOf(grantOf(access.RoleViewer, 1)))
+
+		_, err := s.GetApartmentResidents(context.Background(), 8, false)
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("unknownApartment", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, apartments(minimock.NewController(t)), access.Fixed(access.Unrestricted()))
+
+		_, err := s.GetApartmentResidents(context.Background(), 9, false)
+		assert.ErrorIs(t, err, service.ErrNotFound)
+
+		_, err = s.GetApartmentResidents(context.Background(), 0, false)
+		assert.ErrorIs(t, err, service.ErrInvalid)
+	})
+}
+
+func Test_GetBuildingResidents(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+		GetResidentsOfBuildingMock.
+		Expect(minimock.AnyContext, 1, false).
+		Return([]*storage.Resident{}, nil)
+	s := NewService(residentsStorage, nil, scopeOf(grantOf(access.RoleViewer, 1)))
+
+	_, err := s.GetBuildingResidents(context.Background(), 1, true)
+	require.NoError(t, err)
+
+	_, err = s.GetBuildingResidents(context.Background(), 2, true)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+
+	_, err = s.GetBuildingResidents(context.Background(), 0, true)
+	assert.ErrorIs(t, err, service.ErrInvalid)
+}
+
+func Test_GetResident(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+		GetResidentMock.
+		When(minimock.AnyContext, 1).Then(&storage.Resident{ID: 1, ApartmentID: 7, BuildingID: 1, Name: "Ada", MoveIn: jan1}, nil).
+		GetResidentMock.
+		When(minimock.AnyContext, 2).Then(&storage.Resident{ID: 2, ApartmentID: 8, BuildingID: 2, Name: "Grace", MoveIn: jan1}, nil).
+		GetResidentMock.
+		When(minimock.AnyContext, 3).Then(nil, sql.ErrNoRows)
+	s := NewService(residentsStorage, nil, scopeOf(grantOf(access.RoleViewer, 1)))
+
+	got, err := s.GetResident(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", got.Name)
+
+	_, err = s.GetResident(context.Background(), 2)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+
+	_, err = s.GetResident(context.Background(), 3)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	assert.EqualError(t, err, "not found: no resident with id [3]")
+}
+
+func Test_CreateResident(t *testing.T) {
+	t.Parallel()
+
+	errStorage := errors.New("storage error")
+	existing := []*storage.Resident{
+		{ID: 1, ApartmentID: 7, BuildingID: 1, Name: "Ada", PrimaryContact: true, MoveIn: jan1, MoveOut: datePtr(jul1)},
+		{ID: 2, ApartmentID: 7, BuildingID: 1, Name: "Charles", MoveIn: jan1},
+	}
+
+	tests := []struct {
+		name                string
+		resident            *storage.Resident
+		scope               access.Resolver
+		getResidentsStorage func(mc *minimock.Controller) storage.ResidentsStorage
+		wantErr             error
+	}{
+		{
+			name: "valid",
+			resident: &storage.Resident{
+				ApartmentID: 7,
+				Name:        "  Grace   Hopper ",
+				Email:       strPtr(" grace@example.com "),
+				Phone:       strPtr("+972 4-123-4567"),
+				MoveIn:      jan1,
+			},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					CreateResidentMock.
+					Expect(minimock.AnyContext, &storage.Resident{
+						ApartmentID: 7,
+						BuildingID:  1,
+						Name:        "Grace Hopper",
+						Email:       strPtr("grace@example.com"),
+						Phone:       strPtr("+972 4-123-4567"),
+						MoveIn:      jan1,
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name:     "blankContacts",
+			resident: &storage.Resident{ApartmentID: 7, Name: "Grace", Email: strPtr(" "), Phone: strPtr(""), MoveIn: jan1},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					CreateResidentMock.
+					Expect(minimock.AnyContext, &storage.Resident{ApartmentID: 7, BuildingID: 1, Name: "Grace", MoveIn: jan1}).
+					Return(nil)
+			},
+		},
+		{
+			name:     "primaryContactAfterAnother",
+			resident: &storage.Resident{ApartmentID: 7, Name: "Grace", PrimaryContact: true, MoveIn: jul1},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					GetResidentsOfApartmentMock.
+					Expect(minimock.AnyContext, 7, false).
+					Return(existing, nil).
+					CreateResidentMock.
+					Return(nil)
+			},
+		},
+		{
+			name:     "primaryContactUpdated",
+			resident: &storage.Resident{ID: 1, ApartmentID: 7, Name: "Ada", PrimaryContact: true, MoveIn: jan1},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					GetResidentMock.
+					Expect(minimock.AnyContext, 1).
+					Return(existing[0], nil).
+					GetResidentsOfApartmentMock.
+					Return(existing, nil).
+					CreateResidentMock.
+					Return(nil)
+			},
+		},
+		{
+			name:     "twoPrimaryContacts",
+			resident: &storage.Resident{ApartmentID: 7, Name: "Grace", PrimaryContact: true, MoveIn: storage.NewDate(2026, time.June, 30)},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					GetResidentsOfApartmentMock.
+					Return(existing, nil)
+			},
+			wantErr: service.ErrInvalid,
+		},
+		{
+			name:     "movedFromUnwritableBuilding",
+			resident: &storage.Resident{ID: 5, ApartmentID: 7, Name: "Grace", MoveIn: jan1},
+			scope:    scopeOf(grantOf(access.RoleManager, 1), grantOf(access.RoleViewer, 2)),
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					GetResidentMock.
+					Return(&storage.Resident{ID: 5, ApartmentID: 8, BuildingID: 2}, nil)
+			},
+			wantErr: service.ErrForbidden,
+		},
+		{
+			name:     "residentOfAnotherTenant",
+			resident: &storage.Resident{ID: 5, ApartmentID: 7, Name: "Grace", MoveIn: jan1},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					GetResidentMock.
+					Return(nil, sql.ErrNoRows).
+					CreateResidentMock.
+					Return(sql.ErrNoRows)
+			},
+			wantErr: service.ErrNotFound,
+		},
+		{
+			name:     "storageError",
+			resident: &storage.Resident{ApartmentID: 7, Name: "Grace", MoveIn: jan1},
+			getResidentsStorage: func(mc *minimock.Controller) storage.ResidentsStorage {
+				return storage_mocks.NewResidentsStorageMock(mc).
+					CreateResidentMock.
+					Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{name: "nil", wantErr: service.ErrInvalid},
+		{name: "unknownApartment", resident: &storage.Resident{ApartmentID: 9, Name: "Grace", MoveIn: jan1}, wantErr: service.ErrNotFound},
+		{name: "outOfScope", resident: &storage.Resident{ApartmentID: 8, Name: "Grace", MoveIn: jan1}, wantErr: service.ErrForbidden},
+		{name: "noApartment", resident: &storage.Resident{Name: "Grace", MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "noName", resident: &storage.Resident{ApartmentID: 7, Name: " ", MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "longName", resident: &storage.Resident{ApartmentID: 7, Name: strings.Repeat("a", MaxNameLength+1), MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "badEmail", resident: &storage.Resident{ApartmentID: 7, Name: "Grace", Email: strPtr("grace"), MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "namedEmail", resident: &storage.Resident{ApartmentID: 7, Name: "Grace", Email: strPtr("Grace <grace@example.com>"), MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "badPhone", resident: &storage.Resident{ApartmentID: 7, Name: "Grace", Phone: strPtr("call me"), MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "shortPhone", resident: &storage.Resident{ApartmentID: 7, Name: "Grace", Phone: strPtr("123"), MoveIn: jan1}, wantErr: service.ErrInvalid},
+		{name: "noMoveIn", resident: &storage.Resident{ApartmentID: 7, Name: "Grace"}, wantErr: service.ErrInvalid},
+		{name: "moveOutBeforeMoveIn", resident: &storage.Resident{ApartmentID: 7, Name: "Grace", MoveIn: jul1, MoveOut: datePtr(jan1)}, wantErr: service.ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			var residentsStorage storage.ResidentsStorage
+			if tt.getResidentsStorage != nil {
+				residentsStorage = tt.getResidentsStorage(mc)
+			}
+			scope := tt.scope
+			if scope == nil {
+				scope = scopeOf(grantOf(access.RoleManager, 1))
+			}
+			s := NewService(residentsStorage, apartments(mc), scope)
+
+			err := s.CreateResident(context.Background(), tt.resident)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("noPersonalDataInErrors", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, apartments(minimock.NewController(t)), access.Fixed(access.Unrestricted()))
+
+		err := s.CreateResident(context.Background(), &storage.Resident{ApartmentID: 7, Name: "Grace", Email: strPtr("grace@"), MoveIn: jan1})
+		assert.EqualError(t, err, "invalid: email isn't an email address")
+	})
+
+	t.Run("twoPrimaryContactsMessage", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+			GetResidentsOfApartmentMock.
+			Return(existing, nil)
+		s := NewService(residentsStorage, apartments(mc), access.Fixed(access.Unrestricted()))
+
+		err := s.CreateResident(context.Background(), &storage.Resident{ApartmentID: 7, Name: "Grace", PrimaryContact: true, MoveIn: jan1})
+		assert.EqualError(t, err, "invalid: resident [1] is the primary contact of apartment [7] from [2026-01-01]")
+	})
+
+	t.Run("concurrentPrimaryContact", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+			GetResidentsOfApartmentMock.Return(nil, nil).
+			CreateResidentMock.Return(fmt.Errorf("%w: resident_one_primary_contact", storage.ErrOverlap))
+		s := NewService(residentsStorage, apartments(mc), access.Fixed(access.Unrestricted()))
+
+		err := s.CreateResident(context.Background(), &storage.Resident{ApartmentID: 7, Name: "Grace", PrimaryContact: true, MoveIn: jan1})
+		assert.ErrorIs(t, err, service.ErrInvalid)
+		assert.EqualError(t, err, "invalid: apartment [7] has another primary contact from [2026-01-01]")
+	})
+}
+
+func Test_DeleteResident(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	residentsStorage := storage_mocks.NewResidentsStorageMock(mc).
+		GetResidentMock.
+		When(minimock.AnyContext, 1).Then(&storage.Resident{ID: 1, ApartmentID: 7, BuildingID: 1}, nil).
+		GetResidentMock.
+		When(minimock.AnyContext, 2).Then(&storage.Resident{ID: 2, ApartmentID: 8, BuildingID: 2}, nil).
+		GetResidentMock.
+		When(minimock.AnyContext, 3).Then(nil, sql.ErrNoRows).
+		DeleteResidentMock.
+		Expect(minimock.AnyContext, 1).
+		Return(1, nil)
+	s := NewService(residentsStorage, nil, scopeOf(grantOf(access.RoleManager, 1), grantOf(access.RoleViewer, 2)))
+
+	require.NoError(t, s.DeleteResident(context.Background(), 1))
+	assert.ErrorIs(t, s.DeleteResident(context.Background(), 2), service.ErrForbidden)
+	assert.ErrorIs(t, s.DeleteResident(context.Background(), 3), service.ErrNotFound)
+	assert.ErrorIs(t, s.DeleteResident(context.Background(), 0), service.ErrInvalid)
+}
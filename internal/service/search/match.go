@@ -0,0 +1,193 @@
+package search
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+const (
+	// similarityThreshold is the default pg_trgm.word_similarity_threshold.
+	similarityThreshold = 0.6
+	// fullTextRank ranks the texts containing all the words of the query, close to the ts_rank
+	// of the short texts of the buildings.
+	fullTextRank = 0.1
+	// apartmentRank ranks an apartment whose number is in the query above its building.
+	apartmentRank = 1
+)
+
+// Match ranks the buildings and the apartments in memory the way the Postgres search does: a
+// building contains all the words of the query or, with typos, is similar enough to it. An
+// apartment must also have one of the words as number. The word similarity of pg_trgm is
+// approximated by the share of the trigrams of the query found in the text.
+func Match(query string, buildings models.BuildingSlice, apartments models.ApartmentSlice, limit int) []*storage.SearchResult {
+	q := newQuery(query)
+	results := []*storage.SearchResult{}
+
+	byID := make(map[int]*models.Building, len(buildings))
+	for _, b := range buildings {
+		byID[b.ID] = b
+		text := buildingText(b)
+		if rank, ok := q.rank(text); ok {
+			results = append(results, &storage.SearchResult{
+				Kind:       storage.SearchKindBuilding,
+				ID:         b.ID,
+				BuildingID: b.ID,
+				Title:      b.Name,
+				Snippet:    q.highlight(strings.TrimSpace(text)),
+				Rank:       rank,
+			})
+		}
+	}
+	for _, a := range apartments {
+		b, ok := byID[a.BuildingID]
+		if !ok || !a.Number.Valid || !slices.Contains(q.fields, strings.ToLower(a.Number.String)) {
+			continue
+		}
+		text := a.Number.String + " " + buildingText(b)
+		if rank, ok := q.rank(text); ok {
+			results = append(results, &storage.SearchResult{
+				Kind:       storage.SearchKindApartment,
+				ID:         a.ID,
+				BuildingID: a.BuildingID,
+				Title:      a.Number.String,
+				Snippet:    q.highlight(strings.TrimSpace(text)),
+				Rank:       apartmentRank + rank,
+			})
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b *storage.SearchResult) int {
+		switch {
+		case a.Rank != b.Rank:
+			if a.Rank > b.Rank {
+				return -1
+			}
+			return 1
+		case a.Kind != b.Kind:
+			return strings.Compare(a.Kind, b.Kind)
+		default:
+			return a.ID - b.ID
+		}
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+// buildingText is the searched text of a building, the snippets are trimmed of the trailing
+// space of the buildings without an address.
+func buildingText(b *models.Building) string {
+	return b.Name + " " + b.Address.String
+}
+
+// query is a search query split in its lowercase whitespace-separated fields, compared to the
+// apartment numbers, and in its words, compared to the words of the texts.
+type query struct {
+	fields   []string
+	words    []string
+	trigrams map[string]struct{}
+}
+
+func newQuery(s string) *query {
+	return &query{
+		fields:   strings.Fields(strings.ToLower(s)),
+		words:    words(s),
+		trigrams: trigrams(s),
+	}
+}
+
+// rank returns the rank of the text and whether it matches the query.
+func (q *query) rank(text string) (float64, bool) {
+	fullText := true
+	textWords := words(text)
+	for _, w := range q.words {
+		if !slices.Contains(textWords, w) {
+			fullText = false
+			break
+		}
+	}
+
+	similarity := q.wordSimilarity(text)
+	if !fullText && similarity < similarityThreshold {
+		return 0, false
+	}
+	if fullText {
+		return fullTextRank + similarity, true
+	}
+	return similarity, true
+}
+
+// wordSimilarity is the share of the trigrams of the query found in the text.
+func (q *query) wordSimilarity(text string) float64 {
+	if len(q.trigrams) == 0 {
+		return 0
+	}
+	found := 0
+	textTrigrams := trigrams(text)
+	for t := range q.trigrams {
+		if _, ok := textTrigrams[t]; ok {
+			found++
+		}
+	}
+	return float64(found) / float64(len(q.trigrams))
+}
+
+// highlight HTML-escapes the text and puts the words of the query between <mark> and </mark>.
+func (q *query) highlight(text string) string {
+	var b strings.Builder
+	start := -1
+	flush := func(end int) {
+		word := text[start:end]
+		if slices.Contains(q.words, strings.ToLower(word)) {
+			b.WriteString("<mark>" + html.EscapeString(word) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(word))
+		}
+		start = -1
+	}
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			flush(i)
+		}
+		b.WriteString(html.EscapeString(string(r)))
+	}
+	if start >= 0 {
+		flush(len(text))
+	}
+	return b.String()
+}
+
+// words splits the text in its lowercase words, the runs of letters and digits.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !isWordRune(r) })
+}
+
+// trigrams returns the trigrams of the words of the text, padded like pg_trgm does with two
+// spaces before and one after.
+func trigrams(text string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, w := range words(text) {
+		padded := []rune("  " + w + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
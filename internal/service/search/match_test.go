@@ -0,0 +1,92 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+func Test_Match(t *testing.T) {
+	t.Parallel()
+
+	buildings := models.BuildingSlice{
+		{ID: 1, Name: "Meridor", Address: null.StringFrom("1 Main St")},
+		{ID: 2, Name: "Harbor View", Address: null.StringFrom("79 Dock Rd")},
+		{ID: 3, Name: "Tom & Jerry <Lofts>"},
+	}
+	apartments := models.ApartmentSlice{
+		{ID: 10, BuildingID: 1, Number: null.StringFrom("79")},
+		{ID: 11, BuildingID: 1, Number: null.StringFrom("80")},
+		{ID: 12, BuildingID: 2, Number: null.StringFrom("79")},
+		{ID: 13, BuildingID: 1},
+	}
+
+	t.Run("buildingAndApartment", func(t *testing.T) {
+		t.Parallel()
+
+		got := Match("Meridor 79", buildings, apartments, 10)
+		require.Len(t, got, 2)
+		assert.Equal(t, storage.SearchKindApartment, got[0].Kind)
+		assert.Equal(t, 10, got[0].ID)
+		assert.Equal(t, 1, got[0].BuildingID)
+		assert.Equal(t, "79", got[0].Title)
+		assert.Equal(t, "<mark>79</mark> <mark>Meridor</mark> 1 Main St", got[0].Snippet)
+		assert.Equal(t, storage.SearchKindBuilding, got[1].Kind)
+		assert.Equal(t, 1, got[1].ID)
+		assert.Greater(t, got[0].Rank, got[1].Rank)
+	})
+
+	t.Run("typo", func(t *testing.T) {
+		t.Parallel()
+
+		got := Match("meridr", buildings, apartments, 10)
+		require.Len(t, got, 1)
+		assert.Equal(t, 1, got[0].ID)
+		assert.Equal(t, "Meridor 1 Main St", got[0].Snippet)
+	})
+
+	t.Run("address", func(t *testing.T) {
+		t.Parallel()
+
+		got := Match("dock", buildings, apartments, 10)
+		require.Len(t, got, 1)
+		assert.Equal(t, 2, got[0].ID)
+		assert.Equal(t, "Harbor View 79 <mark>Dock</mark> Rd", got[0].Snippet)
+	})
+
+	t.Run("apartmentNumberRanksFirst", func(t *testing.T) {
+		t.Parallel()
+
+		got := Match("79", buildings, apartments, 10)
+		require.Len(t, got, 3)
+		assert.Equal(t, []int{10, 12, 2}, []int{got[0].ID, got[1].ID, got[2].ID})
+		assert.Equal(t, storage.SearchKindBuilding, got[2].Kind)
+	})
+
+	t.Run("escaped", func(t *testing.T) {
+		t.Parallel()
+
+		got := Match("lofts", buildings, apartments, 10)
+		require.Len(t, got, 1)
+		assert.Equal(t, "Tom &amp; Jerry &lt;<mark>Lofts</mark>&gt;", got[0].Snippet)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		t.Parallel()
+
+		got := Match("79", buildings, apartments, 1)
+		require.Len(t, got, 1)
+		assert.Equal(t, 10, got[0].ID)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []*storage.SearchResult{}, Match("nowhere", buildings, apartments, 10))
+	})
+}
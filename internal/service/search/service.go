@@ -0,0 +1,89 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// DefaultLimit is the number of results when the limit isn't given.
+	DefaultLimit = 20
+	// MaxLimit bounds the number of results of a search.
+	MaxLimit = 100
+)
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/service/search.SearchService -o ../mocks/
+type SearchService interface {
+	Search(ctx context.Context, query string, limit int) ([]*storage.SearchResult, error)
+}
+
+type Service struct {
+	buildingsStorage  storage.BuildingsStorage
+	apartmentsStorage storage.ApartmentsStorage
+	scopes            access.Resolver
+}
+
+func NewService(buildingsStorage storage.BuildingsStorage, apartmentsStorage storage.ApartmentsStorage, scopes access.Resolver) *Service {
+	return &Service{
+		buildingsStorage:  buildingsStorage,
+		apartmentsStorage: apartmentsStorage,
+		scopes:            scopes,
+	}
+}
+
+// Search ranks the buildings the principal may read and their apartments by their match of the
+// query. The storages that aren't a storage.SearchStorage are searched in memory, see Match.
+func (s *Service) Search(ctx context.Context, query string, limit int) (_ []*storage.SearchResult, err error) {
+	ctx, span := tracing.Start(ctx, "search.Search", attribute.Int("search.limit", limit))
+	defer tracing.End(span, &err)
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("empty query")
+	}
+	if limit <= 0 || limit > MaxLimit {
+		return nil, errors.New("limit out of range")
+	}
+
+	scope, err := s.scopes.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buildingIds, all := scope.Buildings(access.ActionRead)
+	if all {
+		buildingIds = nil
+	} else if len(buildingIds) == 0 {
+		return []*storage.SearchResult{}, nil
+	}
+
+	if searcher, ok := s.buildingsStorage.(storage.SearchStorage); ok {
+		return searcher.Search(ctx, query, buildingIds, limit)
+	}
+
+	var buildings models.BuildingSlice
+	var apartments models.ApartmentSlice
+	if all {
+		buildings, err = s.buildingsStorage.GetBuildings(ctx)
+		if err == nil {
+			apartments, err = s.apartmentsStorage.GetApartments(ctx)
+		}
+	} else {
+		buildings, err = s.buildingsStorage.GetBuildingsByIDs(ctx, buildingIds)
+		if err == nil {
+			apartments, err = s.apartmentsStorage.GetApartmentsInBuildings(ctx, buildingIds)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return Match(query, buildings, apartments, limit), nil
+}
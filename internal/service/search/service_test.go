@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/service/access"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	storage_mocks "github.com/sotskov-do/oms-assignment/internal/storage/mocks"
+)
+
+// searchStorage is a buildings storage that searches itself.
+type searchStorage struct {
+	*storage_mocks.BuildingsStorageMock
+	search func(ctx context.Context, query string, buildingIds []int, limit int) ([]*storage.SearchResult, error)
+}
+
+func (s *searchStorage) Search(ctx context.Context, query string, buildingIds []int, limit int) ([]*storage.SearchResult, error) {
+	return s.search(ctx, query, buildingIds, limit)
+}
+
+func scopeOf(grants ...*storage.Grant) access.Resolver {
+	return access.Fixed(access.NewScope(grants...))
+}
+
+func grantOf(role access.Role, buildingID int) *storage.Grant {
+	return &storage.Grant{Role: string(role), BuildingID: &buildingID}
+}
+
+func Test_Search(t *testing.T) {
+	t.Parallel()
+
+	t.Run("storage", func(t *testing.T) {
+		t.Parallel()
+
+		want := []*storage.SearchResult{{Kind: storage.SearchKindBuilding, ID: 1, BuildingID: 1, Title: "Meridor"}}
+		buildingsStorage := &searchStorage{
+			BuildingsStorageMock: storage_mocks.NewBuildingsStorageMock(minimock.NewController(t)),
+			search: func(_ context.Context, query string, buildingIds []int, limit int) ([]*storage.SearchResult, error) {
+				assert.Equal(t, "Meridor 79", query)
+				assert.Equal(t, []int{1, 2}, buildingIds)
+				assert.Equal(t, 5, limit)
+				return want, nil
+			},
+		}
+		s := NewService(buildingsStorage, nil, scopeOf(grantOf(access.RoleViewer, 2), grantOf(access.RoleManager, 1)))
+
+		got, err := s.Search(context.Background(), " Meridor 79 ", 5)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsMock.
+			Return(models.BuildingSlice{{ID: 1, Name: "Meridor"}, {ID: 2, Name: "Harbor View"}}, nil)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsMock.
+			Return(models.ApartmentSlice{{ID: 10, BuildingID: 1, Number: null.StringFrom("79")}}, nil)
+		s := NewService(buildingsStorage, apartmentsStorage, access.Fixed(access.Unrestricted()))
+
+		got, err := s.Search(context.Background(), "meridor 79", DefaultLimit)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, storage.SearchKindApartment, got[0].Kind)
+		assert.Equal(t, storage.SearchKindBuilding, got[1].Kind)
+	})
+
+	t.Run("fallbackFiltered", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		buildingsStorage := storage_mocks.NewBuildingsStorageMock(mc).
+			GetBuildingsByIDsMock.
+			Expect(minimock.AnyContext, []int{2}).
+			Return(models.BuildingSlice{{ID: 2, Name: "Harbor View"}}, nil)
+		apartmentsStorage := storage_mocks.NewApartmentsStorageMock(mc).
+			GetApartmentsInBuildingsMock.
+			Expect(minimock.AnyContext, []int{2}).
+			Return(models.ApartmentSlice{}, nil)
+		s := NewService(buildingsStorage, apartmentsStorage, scopeOf(grantOf(access.RoleViewer, 2)))
+
+		got, err := s.Search(context.Background(), "harbor", DefaultLimit)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, 2, got[0].ID)
+	})
+
+	t.Run("withoutGrants", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, scopeOf())
+
+		got, err := s.Search(context.Background(), "meridor", DefaultLimit)
+		assert.NoError(t, err)
+		assert.Equal(t, []*storage.SearchResult{}, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewService(nil, nil, access.Fixed(access.Unrestricted()))
+
+		_, err := s.Search(context.Background(), "  ", DefaultLimit)
+		assert.Error(t, err)
+		_, err = s.Search(context.Background(), "meridor", MaxLimit+1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+const dateLayout = time.DateOnly
+
+// Date is a calendar day without a time zone, "2006-01-02" in JSON and a date in SQL.
+type Date struct {
+	t time.Time
+}
+
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{t: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the day of t in its location.
+func DateOf(t time.Time) Date {
+	return NewDate(t.Date())
+}
+
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("date [%v] isn't YYYY-MM-DD", s)
+	}
+	return Date{t: t}, nil
+}
+
+func (d Date) IsZero() bool {
+	return d.t.IsZero()
+}
+
+func (d Date) Before(other Date) bool {
+	return d.t.Before(other.t)
+}
+
+// AddDays returns the date n days later, earlier if n is negative.
+func (d Date) AddDays(n int) Date {
+	return Date{t: d.t.AddDate(0, 0, n)}
+}
+
+func (d Date) String() string {
+	return d.t.Format(dateLayout)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(b []byte) error {
+	parsed, err := ParseDate(string(b))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value sends the date as a date literal, the time zone of the session doesn't shift it.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d *Date) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		*d = DateOf(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("can't scan %T into a date", src)
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	mm_storage "github.com/sotskov-do/oms-assignment/internal/storage"
 )
 
 // ApartmentsStorageMock implements storage.ApartmentsStorage
@@ -48,6 +49,30 @@ type ApartmentsStorageMock struct {
 	afterGetApartmentsInBuildingCounter  uint64
 	beforeGetApartmentsInBuildingCounter uint64
 	GetApartmentsInBuildingMock          mApartmentsStorageMockGetApartmentsInBuilding
+
+	funcGetApartmentsInBuildings          func(ctx context.Context, buildingIds []int) (a1 models.ApartmentSlice, err error)
+	inspectFuncGetApartmentsInBuildings   func(ctx context.Context, buildingIds []int)
+	afterGetApartmentsInBuildingsCounter  uint64
+	beforeGetApartmentsInBuildingsCounter uint64
+	GetApartmentsInBuildingsMock          mApartmentsStorageMockGetApartmentsInBuildings
+
+	funcGetApartmentsOnFloor          func(ctx context.Context, buildingId int, floor int) (a1 models.ApartmentSlice, err error)
+	inspectFuncGetApartmentsOnFloor   func(ctx context.Context, buildingId int, floor int)
+	afterGetApartmentsOnFloorCounter  uint64
+	beforeGetApartmentsOnFloorCounter uint64
+	GetApartmentsOnFloorMock          mApartmentsStorageMockGetApartmentsOnFloor
+
+	funcGetStats          func(ctx context.Context, buildingIds []int) (sp1 *mm_storage.Stats, err error)
+	inspectFuncGetStats   func(ctx context.Context, buildingIds []int)
+	afterGetStatsCounter  uint64
+	beforeGetStatsCounter uint64
+	GetStatsMock          mApartmentsStorageMockGetStats
+
+	funcStreamApartments          func(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) (err error)
+	inspectFuncStreamApartments   func(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error)
+	afterStreamApartmentsCounter  uint64
+	beforeStreamApartmentsCounter uint64
+	StreamApartmentsMock          mApartmentsStorageMockStreamApartments
 }
 
 // NewApartmentsStorageMock returns a mock for storage.ApartmentsStorage
@@ -73,6 +98,18 @@ func NewApartmentsStorageMock(t minimock.Tester) *ApartmentsStorageMock {
 	m.GetApartmentsInBuildingMock = mApartmentsStorageMockGetApartmentsInBuilding{mock: m}
 	m.GetApartmentsInBuildingMock.callArgs = []*ApartmentsStorageMockGetApartmentsInBuildingParams{}
 
+	m.GetApartmentsInBuildingsMock = mApartmentsStorageMockGetApartmentsInBuildings{mock: m}
+	m.GetApartmentsInBuildingsMock.callArgs = []*ApartmentsStorageMockGetApartmentsInBuildingsParams{}
+
+	m.GetApartmentsOnFloorMock = mApartmentsStorageMockGetApartmentsOnFloor{mock: m}
+	m.GetApartmentsOnFloorMock.callArgs = []*ApartmentsStorageMockGetApartmentsOnFloorParams{}
+
+	m.GetStatsMock = mApartmentsStorageMockGetStats{mock: m}
+	m.GetStatsMock.callArgs = []*ApartmentsStorageMockGetStatsParams{}
+
+	m.StreamApartmentsMock = mApartmentsStorageMockStreamApartments{mock: m}
+	m.StreamApartmentsMock.callArgs = []*ApartmentsStorageMockStreamApartmentsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -1654,19 +1691,1366 @@ func (m *ApartmentsStorageMock) MinimockGetApartmentsInBuildingInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ApartmentsStorageMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateApartmentInspect()
+type mApartmentsStorageMockGetApartmentsInBuildings struct {
+	optional           bool
+	mock               *ApartmentsStorageMock
+	defaultExpectation *ApartmentsStorageMockGetApartmentsInBuildingsExpectation
+	expectations       []*ApartmentsStorageMockGetApartmentsInBuildingsExpectation
 
-			m.MinimockDeleteApartmentInspect()
+	callArgs []*ApartmentsStorageMockGetApartmentsInBuildingsParams
+	mutex    sync.RWMutex
 
-			m.MinimockGetApartmentInspect()
+	expectedInvocations uint64
+}
 
-			m.MinimockGetApartmentsInspect()
+// ApartmentsStorageMockGetApartmentsInBuildingsExpectation specifies expectation struct of the ApartmentsStorage.GetApartmentsInBuildings
+type ApartmentsStorageMockGetApartmentsInBuildingsExpectation struct {
+	mock      *ApartmentsStorageMock
+	params    *ApartmentsStorageMockGetApartmentsInBuildingsParams
+	paramPtrs *ApartmentsStorageMockGetApartmentsInBuildingsParamPtrs
+	results   *ApartmentsStorageMockGetApartmentsInBuildingsResults
+	Counter   uint64
+}
 
-			m.MinimockGetApartmentsInBuildingInspect()
+// ApartmentsStorageMockGetApartmentsInBuildingsParams contains parameters of the ApartmentsStorage.GetApartmentsInBuildings
+type ApartmentsStorageMockGetApartmentsInBuildingsParams struct {
+	ctx         context.Context
+	buildingIds []int
+}
+
+// ApartmentsStorageMockGetApartmentsInBuildingsParamPtrs contains pointers to parameters of the ApartmentsStorage.GetApartmentsInBuildings
+type ApartmentsStorageMockGetApartmentsInBuildingsParamPtrs struct {
+	ctx         *context.Context
+	buildingIds *[]int
+}
+
+// ApartmentsStorageMockGetApartmentsInBuildingsResults contains results of the ApartmentsStorage.GetApartmentsInBuildings
+type ApartmentsStorageMockGetApartmentsInBuildingsResults struct {
+	a1  models.ApartmentSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Optional() *mApartmentsStorageMockGetApartmentsInBuildings {
+	mmGetApartmentsInBuildings.optional = true
+	return mmGetApartmentsInBuildings
+}
+
+// Expect sets up expected params for ApartmentsStorage.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Expect(ctx context.Context, buildingIds []int) *mApartmentsStorageMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsStorageMockGetApartmentsInBuildingsExpectation{}
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.paramPtrs != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by ExpectParams functions")
+	}
+
+	mmGetApartmentsInBuildings.defaultExpectation.params = &ApartmentsStorageMockGetApartmentsInBuildingsParams{ctx, buildingIds}
+	for _, e := range mmGetApartmentsInBuildings.expectations {
+		if minimock.Equal(e.params, mmGetApartmentsInBuildings.defaultExpectation.params) {
+			mmGetApartmentsInBuildings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetApartmentsInBuildings.defaultExpectation.params)
+		}
+	}
+
+	return mmGetApartmentsInBuildings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsStorage.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) ExpectCtxParam1(ctx context.Context) *mApartmentsStorageMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsStorageMockGetApartmentsInBuildingsExpectation{}
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.params != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Expect")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsInBuildings.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetApartmentsInBuildingsParamPtrs{}
+	}
+	mmGetApartmentsInBuildings.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetApartmentsInBuildings
+}
+
+// ExpectBuildingIdsParam2 sets up expected param buildingIds for ApartmentsStorage.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) ExpectBuildingIdsParam2(buildingIds []int) *mApartmentsStorageMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsStorageMockGetApartmentsInBuildingsExpectation{}
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.params != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Expect")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsInBuildings.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetApartmentsInBuildingsParamPtrs{}
+	}
+	mmGetApartmentsInBuildings.defaultExpectation.paramPtrs.buildingIds = &buildingIds
+
+	return mmGetApartmentsInBuildings
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsStorage.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Inspect(f func(ctx context.Context, buildingIds []int)) *mApartmentsStorageMockGetApartmentsInBuildings {
+	if mmGetApartmentsInBuildings.mock.inspectFuncGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Inspect function is already set for ApartmentsStorageMock.GetApartmentsInBuildings")
+	}
+
+	mmGetApartmentsInBuildings.mock.inspectFuncGetApartmentsInBuildings = f
+
+	return mmGetApartmentsInBuildings
+}
+
+// Return sets up results that will be returned by ApartmentsStorage.GetApartmentsInBuildings
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Return(a1 models.ApartmentSlice, err error) *ApartmentsStorageMock {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	if mmGetApartmentsInBuildings.defaultExpectation == nil {
+		mmGetApartmentsInBuildings.defaultExpectation = &ApartmentsStorageMockGetApartmentsInBuildingsExpectation{mock: mmGetApartmentsInBuildings.mock}
+	}
+	mmGetApartmentsInBuildings.defaultExpectation.results = &ApartmentsStorageMockGetApartmentsInBuildingsResults{a1, err}
+	return mmGetApartmentsInBuildings.mock
+}
+
+// Set uses given function f to mock the ApartmentsStorage.GetApartmentsInBuildings method
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Set(f func(ctx context.Context, buildingIds []int) (a1 models.ApartmentSlice, err error)) *ApartmentsStorageMock {
+	if mmGetApartmentsInBuildings.defaultExpectation != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Default expectation is already set for the ApartmentsStorage.GetApartmentsInBuildings method")
+	}
+
+	if len(mmGetApartmentsInBuildings.expectations) > 0 {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Some expectations are already set for the ApartmentsStorage.GetApartmentsInBuildings method")
+	}
+
+	mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings = f
+	return mmGetApartmentsInBuildings.mock
+}
+
+// When sets expectation for the ApartmentsStorage.GetApartmentsInBuildings which will trigger the result defined by the following
+// Then helper
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) When(ctx context.Context, buildingIds []int) *ApartmentsStorageMockGetApartmentsInBuildingsExpectation {
+	if mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsInBuildings mock is already set by Set")
+	}
+
+	expectation := &ApartmentsStorageMockGetApartmentsInBuildingsExpectation{
+		mock:   mmGetApartmentsInBuildings.mock,
+		params: &ApartmentsStorageMockGetApartmentsInBuildingsParams{ctx, buildingIds},
+	}
+	mmGetApartmentsInBuildings.expectations = append(mmGetApartmentsInBuildings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsStorage.GetApartmentsInBuildings return parameters for the expectation previously defined by the When method
+func (e *ApartmentsStorageMockGetApartmentsInBuildingsExpectation) Then(a1 models.ApartmentSlice, err error) *ApartmentsStorageMock {
+	e.results = &ApartmentsStorageMockGetApartmentsInBuildingsResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsStorage.GetApartmentsInBuildings should be invoked
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Times(n uint64) *mApartmentsStorageMockGetApartmentsInBuildings {
+	if n == 0 {
+		mmGetApartmentsInBuildings.mock.t.Fatalf("Times of ApartmentsStorageMock.GetApartmentsInBuildings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetApartmentsInBuildings.expectedInvocations, n)
+	return mmGetApartmentsInBuildings
+}
+
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) invocationsDone() bool {
+	if len(mmGetApartmentsInBuildings.expectations) == 0 && mmGetApartmentsInBuildings.defaultExpectation == nil && mmGetApartmentsInBuildings.mock.funcGetApartmentsInBuildings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.mock.afterGetApartmentsInBuildingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetApartmentsInBuildings implements storage.ApartmentsStorage
+func (mmGetApartmentsInBuildings *ApartmentsStorageMock) GetApartmentsInBuildings(ctx context.Context, buildingIds []int) (a1 models.ApartmentSlice, err error) {
+	mm_atomic.AddUint64(&mmGetApartmentsInBuildings.beforeGetApartmentsInBuildingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetApartmentsInBuildings.afterGetApartmentsInBuildingsCounter, 1)
+
+	if mmGetApartmentsInBuildings.inspectFuncGetApartmentsInBuildings != nil {
+		mmGetApartmentsInBuildings.inspectFuncGetApartmentsInBuildings(ctx, buildingIds)
+	}
+
+	mm_params := ApartmentsStorageMockGetApartmentsInBuildingsParams{ctx, buildingIds}
+
+	// Record call args
+	mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.mutex.Lock()
+	mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.callArgs = append(mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.callArgs, &mm_params)
+	mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.mutex.Unlock()
+
+	for _, e := range mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsStorageMockGetApartmentsInBuildingsParams{ctx, buildingIds}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetApartmentsInBuildings.t.Errorf("ApartmentsStorageMock.GetApartmentsInBuildings got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingIds != nil && !minimock.Equal(*mm_want_ptrs.buildingIds, mm_got.buildingIds) {
+				mmGetApartmentsInBuildings.t.Errorf("ApartmentsStorageMock.GetApartmentsInBuildings got unexpected parameter buildingIds, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingIds, mm_got.buildingIds, minimock.Diff(*mm_want_ptrs.buildingIds, mm_got.buildingIds))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetApartmentsInBuildings.t.Errorf("ApartmentsStorageMock.GetApartmentsInBuildings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetApartmentsInBuildings.GetApartmentsInBuildingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetApartmentsInBuildings.t.Fatal("No results are set for the ApartmentsStorageMock.GetApartmentsInBuildings")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetApartmentsInBuildings.funcGetApartmentsInBuildings != nil {
+		return mmGetApartmentsInBuildings.funcGetApartmentsInBuildings(ctx, buildingIds)
+	}
+	mmGetApartmentsInBuildings.t.Fatalf("Unexpected call to ApartmentsStorageMock.GetApartmentsInBuildings. %v %v", ctx, buildingIds)
+	return
+}
+
+// GetApartmentsInBuildingsAfterCounter returns a count of finished ApartmentsStorageMock.GetApartmentsInBuildings invocations
+func (mmGetApartmentsInBuildings *ApartmentsStorageMock) GetApartmentsInBuildingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.afterGetApartmentsInBuildingsCounter)
+}
+
+// GetApartmentsInBuildingsBeforeCounter returns a count of ApartmentsStorageMock.GetApartmentsInBuildings invocations
+func (mmGetApartmentsInBuildings *ApartmentsStorageMock) GetApartmentsInBuildingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsInBuildings.beforeGetApartmentsInBuildingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsStorageMock.GetApartmentsInBuildings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetApartmentsInBuildings *mApartmentsStorageMockGetApartmentsInBuildings) Calls() []*ApartmentsStorageMockGetApartmentsInBuildingsParams {
+	mmGetApartmentsInBuildings.mutex.RLock()
+
+	argCopy := make([]*ApartmentsStorageMockGetApartmentsInBuildingsParams, len(mmGetApartmentsInBuildings.callArgs))
+	copy(argCopy, mmGetApartmentsInBuildings.callArgs)
+
+	mmGetApartmentsInBuildings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetApartmentsInBuildingsDone returns true if the count of the GetApartmentsInBuildings invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsStorageMock) MinimockGetApartmentsInBuildingsDone() bool {
+	if m.GetApartmentsInBuildingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetApartmentsInBuildingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetApartmentsInBuildingsMock.invocationsDone()
+}
+
+// MinimockGetApartmentsInBuildingsInspect logs each unmet expectation
+func (m *ApartmentsStorageMock) MinimockGetApartmentsInBuildingsInspect() {
+	for _, e := range m.GetApartmentsInBuildingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.GetApartmentsInBuildings with params: %#v", *e.params)
+		}
+	}
+
+	afterGetApartmentsInBuildingsCounter := mm_atomic.LoadUint64(&m.afterGetApartmentsInBuildingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetApartmentsInBuildingsMock.defaultExpectation != nil && afterGetApartmentsInBuildingsCounter < 1 {
+		if m.GetApartmentsInBuildingsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsStorageMock.GetApartmentsInBuildings")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.GetApartmentsInBuildings with params: %#v", *m.GetApartmentsInBuildingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetApartmentsInBuildings != nil && afterGetApartmentsInBuildingsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsStorageMock.GetApartmentsInBuildings")
+	}
+
+	if !m.GetApartmentsInBuildingsMock.invocationsDone() && afterGetApartmentsInBuildingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsStorageMock.GetApartmentsInBuildings but found %d calls",
+			mm_atomic.LoadUint64(&m.GetApartmentsInBuildingsMock.expectedInvocations), afterGetApartmentsInBuildingsCounter)
+	}
+}
+
+type mApartmentsStorageMockGetApartmentsOnFloor struct {
+	optional           bool
+	mock               *ApartmentsStorageMock
+	defaultExpectation *ApartmentsStorageMockGetApartmentsOnFloorExpectation
+	expectations       []*ApartmentsStorageMockGetApartmentsOnFloorExpectation
+
+	callArgs []*ApartmentsStorageMockGetApartmentsOnFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsStorageMockGetApartmentsOnFloorExpectation specifies expectation struct of the ApartmentsStorage.GetApartmentsOnFloor
+type ApartmentsStorageMockGetApartmentsOnFloorExpectation struct {
+	mock      *ApartmentsStorageMock
+	params    *ApartmentsStorageMockGetApartmentsOnFloorParams
+	paramPtrs *ApartmentsStorageMockGetApartmentsOnFloorParamPtrs
+	results   *ApartmentsStorageMockGetApartmentsOnFloorResults
+	Counter   uint64
+}
+
+// ApartmentsStorageMockGetApartmentsOnFloorParams contains parameters of the ApartmentsStorage.GetApartmentsOnFloor
+type ApartmentsStorageMockGetApartmentsOnFloorParams struct {
+	ctx        context.Context
+	buildingId int
+	floor      int
+}
+
+// ApartmentsStorageMockGetApartmentsOnFloorParamPtrs contains pointers to parameters of the ApartmentsStorage.GetApartmentsOnFloor
+type ApartmentsStorageMockGetApartmentsOnFloorParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	floor      *int
+}
+
+// ApartmentsStorageMockGetApartmentsOnFloorResults contains results of the ApartmentsStorage.GetApartmentsOnFloor
+type ApartmentsStorageMockGetApartmentsOnFloorResults struct {
+	a1  models.ApartmentSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Optional() *mApartmentsStorageMockGetApartmentsOnFloor {
+	mmGetApartmentsOnFloor.optional = true
+	return mmGetApartmentsOnFloor
+}
+
+// Expect sets up expected params for ApartmentsStorage.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Expect(ctx context.Context, buildingId int, floor int) *mApartmentsStorageMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsStorageMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by ExpectParams functions")
+	}
+
+	mmGetApartmentsOnFloor.defaultExpectation.params = &ApartmentsStorageMockGetApartmentsOnFloorParams{ctx, buildingId, floor}
+	for _, e := range mmGetApartmentsOnFloor.expectations {
+		if minimock.Equal(e.params, mmGetApartmentsOnFloor.defaultExpectation.params) {
+			mmGetApartmentsOnFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetApartmentsOnFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetApartmentsOnFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsStorage.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) ExpectCtxParam1(ctx context.Context) *mApartmentsStorageMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsStorageMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.params != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Expect")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsOnFloor.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetApartmentsOnFloorParamPtrs{}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetApartmentsOnFloor
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for ApartmentsStorage.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) ExpectBuildingIdParam2(buildingId int) *mApartmentsStorageMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsStorageMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.params != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Expect")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsOnFloor.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetApartmentsOnFloorParamPtrs{}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetApartmentsOnFloor
+}
+
+// ExpectFloorParam3 sets up expected param floor for ApartmentsStorage.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) ExpectFloorParam3(floor int) *mApartmentsStorageMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsStorageMockGetApartmentsOnFloorExpectation{}
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.params != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Expect")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation.paramPtrs == nil {
+		mmGetApartmentsOnFloor.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetApartmentsOnFloorParamPtrs{}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.paramPtrs.floor = &floor
+
+	return mmGetApartmentsOnFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsStorage.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Inspect(f func(ctx context.Context, buildingId int, floor int)) *mApartmentsStorageMockGetApartmentsOnFloor {
+	if mmGetApartmentsOnFloor.mock.inspectFuncGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Inspect function is already set for ApartmentsStorageMock.GetApartmentsOnFloor")
+	}
+
+	mmGetApartmentsOnFloor.mock.inspectFuncGetApartmentsOnFloor = f
+
+	return mmGetApartmentsOnFloor
+}
+
+// Return sets up results that will be returned by ApartmentsStorage.GetApartmentsOnFloor
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Return(a1 models.ApartmentSlice, err error) *ApartmentsStorageMock {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	if mmGetApartmentsOnFloor.defaultExpectation == nil {
+		mmGetApartmentsOnFloor.defaultExpectation = &ApartmentsStorageMockGetApartmentsOnFloorExpectation{mock: mmGetApartmentsOnFloor.mock}
+	}
+	mmGetApartmentsOnFloor.defaultExpectation.results = &ApartmentsStorageMockGetApartmentsOnFloorResults{a1, err}
+	return mmGetApartmentsOnFloor.mock
+}
+
+// Set uses given function f to mock the ApartmentsStorage.GetApartmentsOnFloor method
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Set(f func(ctx context.Context, buildingId int, floor int) (a1 models.ApartmentSlice, err error)) *ApartmentsStorageMock {
+	if mmGetApartmentsOnFloor.defaultExpectation != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Default expectation is already set for the ApartmentsStorage.GetApartmentsOnFloor method")
+	}
+
+	if len(mmGetApartmentsOnFloor.expectations) > 0 {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Some expectations are already set for the ApartmentsStorage.GetApartmentsOnFloor method")
+	}
+
+	mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor = f
+	return mmGetApartmentsOnFloor.mock
+}
+
+// When sets expectation for the ApartmentsStorage.GetApartmentsOnFloor which will trigger the result defined by the following
+// Then helper
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) When(ctx context.Context, buildingId int, floor int) *ApartmentsStorageMockGetApartmentsOnFloorExpectation {
+	if mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("ApartmentsStorageMock.GetApartmentsOnFloor mock is already set by Set")
+	}
+
+	expectation := &ApartmentsStorageMockGetApartmentsOnFloorExpectation{
+		mock:   mmGetApartmentsOnFloor.mock,
+		params: &ApartmentsStorageMockGetApartmentsOnFloorParams{ctx, buildingId, floor},
+	}
+	mmGetApartmentsOnFloor.expectations = append(mmGetApartmentsOnFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsStorage.GetApartmentsOnFloor return parameters for the expectation previously defined by the When method
+func (e *ApartmentsStorageMockGetApartmentsOnFloorExpectation) Then(a1 models.ApartmentSlice, err error) *ApartmentsStorageMock {
+	e.results = &ApartmentsStorageMockGetApartmentsOnFloorResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsStorage.GetApartmentsOnFloor should be invoked
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Times(n uint64) *mApartmentsStorageMockGetApartmentsOnFloor {
+	if n == 0 {
+		mmGetApartmentsOnFloor.mock.t.Fatalf("Times of ApartmentsStorageMock.GetApartmentsOnFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetApartmentsOnFloor.expectedInvocations, n)
+	return mmGetApartmentsOnFloor
+}
+
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) invocationsDone() bool {
+	if len(mmGetApartmentsOnFloor.expectations) == 0 && mmGetApartmentsOnFloor.defaultExpectation == nil && mmGetApartmentsOnFloor.mock.funcGetApartmentsOnFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.mock.afterGetApartmentsOnFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetApartmentsOnFloor implements storage.ApartmentsStorage
+func (mmGetApartmentsOnFloor *ApartmentsStorageMock) GetApartmentsOnFloor(ctx context.Context, buildingId int, floor int) (a1 models.ApartmentSlice, err error) {
+	mm_atomic.AddUint64(&mmGetApartmentsOnFloor.beforeGetApartmentsOnFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetApartmentsOnFloor.afterGetApartmentsOnFloorCounter, 1)
+
+	if mmGetApartmentsOnFloor.inspectFuncGetApartmentsOnFloor != nil {
+		mmGetApartmentsOnFloor.inspectFuncGetApartmentsOnFloor(ctx, buildingId, floor)
+	}
+
+	mm_params := ApartmentsStorageMockGetApartmentsOnFloorParams{ctx, buildingId, floor}
+
+	// Record call args
+	mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.mutex.Lock()
+	mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.callArgs = append(mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.callArgs, &mm_params)
+	mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.mutex.Unlock()
+
+	for _, e := range mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsStorageMockGetApartmentsOnFloorParams{ctx, buildingId, floor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetApartmentsOnFloor.t.Errorf("ApartmentsStorageMock.GetApartmentsOnFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetApartmentsOnFloor.t.Errorf("ApartmentsStorageMock.GetApartmentsOnFloor got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.floor != nil && !minimock.Equal(*mm_want_ptrs.floor, mm_got.floor) {
+				mmGetApartmentsOnFloor.t.Errorf("ApartmentsStorageMock.GetApartmentsOnFloor got unexpected parameter floor, want: %#v, got: %#v%s\n", *mm_want_ptrs.floor, mm_got.floor, minimock.Diff(*mm_want_ptrs.floor, mm_got.floor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetApartmentsOnFloor.t.Errorf("ApartmentsStorageMock.GetApartmentsOnFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetApartmentsOnFloor.GetApartmentsOnFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetApartmentsOnFloor.t.Fatal("No results are set for the ApartmentsStorageMock.GetApartmentsOnFloor")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetApartmentsOnFloor.funcGetApartmentsOnFloor != nil {
+		return mmGetApartmentsOnFloor.funcGetApartmentsOnFloor(ctx, buildingId, floor)
+	}
+	mmGetApartmentsOnFloor.t.Fatalf("Unexpected call to ApartmentsStorageMock.GetApartmentsOnFloor. %v %v %v", ctx, buildingId, floor)
+	return
+}
+
+// GetApartmentsOnFloorAfterCounter returns a count of finished ApartmentsStorageMock.GetApartmentsOnFloor invocations
+func (mmGetApartmentsOnFloor *ApartmentsStorageMock) GetApartmentsOnFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.afterGetApartmentsOnFloorCounter)
+}
+
+// GetApartmentsOnFloorBeforeCounter returns a count of ApartmentsStorageMock.GetApartmentsOnFloor invocations
+func (mmGetApartmentsOnFloor *ApartmentsStorageMock) GetApartmentsOnFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetApartmentsOnFloor.beforeGetApartmentsOnFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsStorageMock.GetApartmentsOnFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetApartmentsOnFloor *mApartmentsStorageMockGetApartmentsOnFloor) Calls() []*ApartmentsStorageMockGetApartmentsOnFloorParams {
+	mmGetApartmentsOnFloor.mutex.RLock()
+
+	argCopy := make([]*ApartmentsStorageMockGetApartmentsOnFloorParams, len(mmGetApartmentsOnFloor.callArgs))
+	copy(argCopy, mmGetApartmentsOnFloor.callArgs)
+
+	mmGetApartmentsOnFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetApartmentsOnFloorDone returns true if the count of the GetApartmentsOnFloor invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsStorageMock) MinimockGetApartmentsOnFloorDone() bool {
+	if m.GetApartmentsOnFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetApartmentsOnFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetApartmentsOnFloorMock.invocationsDone()
+}
+
+// MinimockGetApartmentsOnFloorInspect logs each unmet expectation
+func (m *ApartmentsStorageMock) MinimockGetApartmentsOnFloorInspect() {
+	for _, e := range m.GetApartmentsOnFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.GetApartmentsOnFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterGetApartmentsOnFloorCounter := mm_atomic.LoadUint64(&m.afterGetApartmentsOnFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetApartmentsOnFloorMock.defaultExpectation != nil && afterGetApartmentsOnFloorCounter < 1 {
+		if m.GetApartmentsOnFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsStorageMock.GetApartmentsOnFloor")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.GetApartmentsOnFloor with params: %#v", *m.GetApartmentsOnFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetApartmentsOnFloor != nil && afterGetApartmentsOnFloorCounter < 1 {
+		m.t.Error("Expected call to ApartmentsStorageMock.GetApartmentsOnFloor")
+	}
+
+	if !m.GetApartmentsOnFloorMock.invocationsDone() && afterGetApartmentsOnFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsStorageMock.GetApartmentsOnFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.GetApartmentsOnFloorMock.expectedInvocations), afterGetApartmentsOnFloorCounter)
+	}
+}
+
+type mApartmentsStorageMockGetStats struct {
+	optional           bool
+	mock               *ApartmentsStorageMock
+	defaultExpectation *ApartmentsStorageMockGetStatsExpectation
+	expectations       []*ApartmentsStorageMockGetStatsExpectation
+
+	callArgs []*ApartmentsStorageMockGetStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsStorageMockGetStatsExpectation specifies expectation struct of the ApartmentsStorage.GetStats
+type ApartmentsStorageMockGetStatsExpectation struct {
+	mock      *ApartmentsStorageMock
+	params    *ApartmentsStorageMockGetStatsParams
+	paramPtrs *ApartmentsStorageMockGetStatsParamPtrs
+	results   *ApartmentsStorageMockGetStatsResults
+	Counter   uint64
+}
+
+// ApartmentsStorageMockGetStatsParams contains parameters of the ApartmentsStorage.GetStats
+type ApartmentsStorageMockGetStatsParams struct {
+	ctx         context.Context
+	buildingIds []int
+}
+
+// ApartmentsStorageMockGetStatsParamPtrs contains pointers to parameters of the ApartmentsStorage.GetStats
+type ApartmentsStorageMockGetStatsParamPtrs struct {
+	ctx         *context.Context
+	buildingIds *[]int
+}
+
+// ApartmentsStorageMockGetStatsResults contains results of the ApartmentsStorage.GetStats
+type ApartmentsStorageMockGetStatsResults struct {
+	sp1 *mm_storage.Stats
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStats *mApartmentsStorageMockGetStats) Optional() *mApartmentsStorageMockGetStats {
+	mmGetStats.optional = true
+	return mmGetStats
+}
+
+// Expect sets up expected params for ApartmentsStorage.GetStats
+func (mmGetStats *mApartmentsStorageMockGetStats) Expect(ctx context.Context, buildingIds []int) *mApartmentsStorageMockGetStats {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsStorageMockGetStatsExpectation{}
+	}
+
+	if mmGetStats.defaultExpectation.paramPtrs != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by ExpectParams functions")
+	}
+
+	mmGetStats.defaultExpectation.params = &ApartmentsStorageMockGetStatsParams{ctx, buildingIds}
+	for _, e := range mmGetStats.expectations {
+		if minimock.Equal(e.params, mmGetStats.defaultExpectation.params) {
+			mmGetStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStats.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsStorage.GetStats
+func (mmGetStats *mApartmentsStorageMockGetStats) ExpectCtxParam1(ctx context.Context) *mApartmentsStorageMockGetStats {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsStorageMockGetStatsExpectation{}
+	}
+
+	if mmGetStats.defaultExpectation.params != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Expect")
+	}
+
+	if mmGetStats.defaultExpectation.paramPtrs == nil {
+		mmGetStats.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetStatsParamPtrs{}
+	}
+	mmGetStats.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetStats
+}
+
+// ExpectBuildingIdsParam2 sets up expected param buildingIds for ApartmentsStorage.GetStats
+func (mmGetStats *mApartmentsStorageMockGetStats) ExpectBuildingIdsParam2(buildingIds []int) *mApartmentsStorageMockGetStats {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsStorageMockGetStatsExpectation{}
+	}
+
+	if mmGetStats.defaultExpectation.params != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Expect")
+	}
+
+	if mmGetStats.defaultExpectation.paramPtrs == nil {
+		mmGetStats.defaultExpectation.paramPtrs = &ApartmentsStorageMockGetStatsParamPtrs{}
+	}
+	mmGetStats.defaultExpectation.paramPtrs.buildingIds = &buildingIds
+
+	return mmGetStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsStorage.GetStats
+func (mmGetStats *mApartmentsStorageMockGetStats) Inspect(f func(ctx context.Context, buildingIds []int)) *mApartmentsStorageMockGetStats {
+	if mmGetStats.mock.inspectFuncGetStats != nil {
+		mmGetStats.mock.t.Fatalf("Inspect function is already set for ApartmentsStorageMock.GetStats")
+	}
+
+	mmGetStats.mock.inspectFuncGetStats = f
+
+	return mmGetStats
+}
+
+// Return sets up results that will be returned by ApartmentsStorage.GetStats
+func (mmGetStats *mApartmentsStorageMockGetStats) Return(sp1 *mm_storage.Stats, err error) *ApartmentsStorageMock {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Set")
+	}
+
+	if mmGetStats.defaultExpectation == nil {
+		mmGetStats.defaultExpectation = &ApartmentsStorageMockGetStatsExpectation{mock: mmGetStats.mock}
+	}
+	mmGetStats.defaultExpectation.results = &ApartmentsStorageMockGetStatsResults{sp1, err}
+	return mmGetStats.mock
+}
+
+// Set uses given function f to mock the ApartmentsStorage.GetStats method
+func (mmGetStats *mApartmentsStorageMockGetStats) Set(f func(ctx context.Context, buildingIds []int) (sp1 *mm_storage.Stats, err error)) *ApartmentsStorageMock {
+	if mmGetStats.defaultExpectation != nil {
+		mmGetStats.mock.t.Fatalf("Default expectation is already set for the ApartmentsStorage.GetStats method")
+	}
+
+	if len(mmGetStats.expectations) > 0 {
+		mmGetStats.mock.t.Fatalf("Some expectations are already set for the ApartmentsStorage.GetStats method")
+	}
+
+	mmGetStats.mock.funcGetStats = f
+	return mmGetStats.mock
+}
+
+// When sets expectation for the ApartmentsStorage.GetStats which will trigger the result defined by the following
+// Then helper
+func (mmGetStats *mApartmentsStorageMockGetStats) When(ctx context.Context, buildingIds []int) *ApartmentsStorageMockGetStatsExpectation {
+	if mmGetStats.mock.funcGetStats != nil {
+		mmGetStats.mock.t.Fatalf("ApartmentsStorageMock.GetStats mock is already set by Set")
+	}
+
+	expectation := &ApartmentsStorageMockGetStatsExpectation{
+		mock:   mmGetStats.mock,
+		params: &ApartmentsStorageMockGetStatsParams{ctx, buildingIds},
+	}
+	mmGetStats.expectations = append(mmGetStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsStorage.GetStats return parameters for the expectation previously defined by the When method
+func (e *ApartmentsStorageMockGetStatsExpectation) Then(sp1 *mm_storage.Stats, err error) *ApartmentsStorageMock {
+	e.results = &ApartmentsStorageMockGetStatsResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsStorage.GetStats should be invoked
+func (mmGetStats *mApartmentsStorageMockGetStats) Times(n uint64) *mApartmentsStorageMockGetStats {
+	if n == 0 {
+		mmGetStats.mock.t.Fatalf("Times of ApartmentsStorageMock.GetStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStats.expectedInvocations, n)
+	return mmGetStats
+}
+
+func (mmGetStats *mApartmentsStorageMockGetStats) invocationsDone() bool {
+	if len(mmGetStats.expectations) == 0 && mmGetStats.defaultExpectation == nil && mmGetStats.mock.funcGetStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStats.mock.afterGetStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStats implements storage.ApartmentsStorage
+func (mmGetStats *ApartmentsStorageMock) GetStats(ctx context.Context, buildingIds []int) (sp1 *mm_storage.Stats, err error) {
+	mm_atomic.AddUint64(&mmGetStats.beforeGetStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStats.afterGetStatsCounter, 1)
+
+	if mmGetStats.inspectFuncGetStats != nil {
+		mmGetStats.inspectFuncGetStats(ctx, buildingIds)
+	}
+
+	mm_params := ApartmentsStorageMockGetStatsParams{ctx, buildingIds}
+
+	// Record call args
+	mmGetStats.GetStatsMock.mutex.Lock()
+	mmGetStats.GetStatsMock.callArgs = append(mmGetStats.GetStatsMock.callArgs, &mm_params)
+	mmGetStats.GetStatsMock.mutex.Unlock()
+
+	for _, e := range mmGetStats.GetStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetStats.GetStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStats.GetStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStats.GetStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStats.GetStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsStorageMockGetStatsParams{ctx, buildingIds}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStats.t.Errorf("ApartmentsStorageMock.GetStats got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingIds != nil && !minimock.Equal(*mm_want_ptrs.buildingIds, mm_got.buildingIds) {
+				mmGetStats.t.Errorf("ApartmentsStorageMock.GetStats got unexpected parameter buildingIds, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingIds, mm_got.buildingIds, minimock.Diff(*mm_want_ptrs.buildingIds, mm_got.buildingIds))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStats.t.Errorf("ApartmentsStorageMock.GetStats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStats.GetStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStats.t.Fatal("No results are set for the ApartmentsStorageMock.GetStats")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetStats.funcGetStats != nil {
+		return mmGetStats.funcGetStats(ctx, buildingIds)
+	}
+	mmGetStats.t.Fatalf("Unexpected call to ApartmentsStorageMock.GetStats. %v %v", ctx, buildingIds)
+	return
+}
+
+// GetStatsAfterCounter returns a count of finished ApartmentsStorageMock.GetStats invocations
+func (mmGetStats *ApartmentsStorageMock) GetStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStats.afterGetStatsCounter)
+}
+
+// GetStatsBeforeCounter returns a count of ApartmentsStorageMock.GetStats invocations
+func (mmGetStats *ApartmentsStorageMock) GetStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStats.beforeGetStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsStorageMock.GetStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStats *mApartmentsStorageMockGetStats) Calls() []*ApartmentsStorageMockGetStatsParams {
+	mmGetStats.mutex.RLock()
+
+	argCopy := make([]*ApartmentsStorageMockGetStatsParams, len(mmGetStats.callArgs))
+	copy(argCopy, mmGetStats.callArgs)
+
+	mmGetStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStatsDone returns true if the count of the GetStats invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsStorageMock) MinimockGetStatsDone() bool {
+	if m.GetStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStatsMock.invocationsDone()
+}
+
+// MinimockGetStatsInspect logs each unmet expectation
+func (m *ApartmentsStorageMock) MinimockGetStatsInspect() {
+	for _, e := range m.GetStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.GetStats with params: %#v", *e.params)
+		}
+	}
+
+	afterGetStatsCounter := mm_atomic.LoadUint64(&m.afterGetStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStatsMock.defaultExpectation != nil && afterGetStatsCounter < 1 {
+		if m.GetStatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsStorageMock.GetStats")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.GetStats with params: %#v", *m.GetStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStats != nil && afterGetStatsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsStorageMock.GetStats")
+	}
+
+	if !m.GetStatsMock.invocationsDone() && afterGetStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsStorageMock.GetStats but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStatsMock.expectedInvocations), afterGetStatsCounter)
+	}
+}
+
+type mApartmentsStorageMockStreamApartments struct {
+	optional           bool
+	mock               *ApartmentsStorageMock
+	defaultExpectation *ApartmentsStorageMockStreamApartmentsExpectation
+	expectations       []*ApartmentsStorageMockStreamApartmentsExpectation
+
+	callArgs []*ApartmentsStorageMockStreamApartmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ApartmentsStorageMockStreamApartmentsExpectation specifies expectation struct of the ApartmentsStorage.StreamApartments
+type ApartmentsStorageMockStreamApartmentsExpectation struct {
+	mock      *ApartmentsStorageMock
+	params    *ApartmentsStorageMockStreamApartmentsParams
+	paramPtrs *ApartmentsStorageMockStreamApartmentsParamPtrs
+	results   *ApartmentsStorageMockStreamApartmentsResults
+	Counter   uint64
+}
+
+// ApartmentsStorageMockStreamApartmentsParams contains parameters of the ApartmentsStorage.StreamApartments
+type ApartmentsStorageMockStreamApartmentsParams struct {
+	ctx         context.Context
+	buildingIds []int
+	send        func(a *models.Apartment) error
+}
+
+// ApartmentsStorageMockStreamApartmentsParamPtrs contains pointers to parameters of the ApartmentsStorage.StreamApartments
+type ApartmentsStorageMockStreamApartmentsParamPtrs struct {
+	ctx         *context.Context
+	buildingIds *[]int
+	send        *func(a *models.Apartment) error
+}
+
+// ApartmentsStorageMockStreamApartmentsResults contains results of the ApartmentsStorage.StreamApartments
+type ApartmentsStorageMockStreamApartmentsResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Optional() *mApartmentsStorageMockStreamApartments {
+	mmStreamApartments.optional = true
+	return mmStreamApartments
+}
+
+// Expect sets up expected params for ApartmentsStorage.StreamApartments
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Expect(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) *mApartmentsStorageMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsStorageMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by ExpectParams functions")
+	}
+
+	mmStreamApartments.defaultExpectation.params = &ApartmentsStorageMockStreamApartmentsParams{ctx, buildingIds, send}
+	for _, e := range mmStreamApartments.expectations {
+		if minimock.Equal(e.params, mmStreamApartments.defaultExpectation.params) {
+			mmStreamApartments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStreamApartments.defaultExpectation.params)
+		}
+	}
+
+	return mmStreamApartments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApartmentsStorage.StreamApartments
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) ExpectCtxParam1(ctx context.Context) *mApartmentsStorageMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsStorageMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.params != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Expect")
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs == nil {
+		mmStreamApartments.defaultExpectation.paramPtrs = &ApartmentsStorageMockStreamApartmentsParamPtrs{}
+	}
+	mmStreamApartments.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmStreamApartments
+}
+
+// ExpectBuildingIdsParam2 sets up expected param buildingIds for ApartmentsStorage.StreamApartments
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) ExpectBuildingIdsParam2(buildingIds []int) *mApartmentsStorageMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsStorageMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.params != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Expect")
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs == nil {
+		mmStreamApartments.defaultExpectation.paramPtrs = &ApartmentsStorageMockStreamApartmentsParamPtrs{}
+	}
+	mmStreamApartments.defaultExpectation.paramPtrs.buildingIds = &buildingIds
+
+	return mmStreamApartments
+}
+
+// ExpectSendParam3 sets up expected param send for ApartmentsStorage.StreamApartments
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) ExpectSendParam3(send func(a *models.Apartment) error) *mApartmentsStorageMockStreamApartments {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsStorageMockStreamApartmentsExpectation{}
+	}
+
+	if mmStreamApartments.defaultExpectation.params != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Expect")
+	}
+
+	if mmStreamApartments.defaultExpectation.paramPtrs == nil {
+		mmStreamApartments.defaultExpectation.paramPtrs = &ApartmentsStorageMockStreamApartmentsParamPtrs{}
+	}
+	mmStreamApartments.defaultExpectation.paramPtrs.send = &send
+
+	return mmStreamApartments
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApartmentsStorage.StreamApartments
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Inspect(f func(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error)) *mApartmentsStorageMockStreamApartments {
+	if mmStreamApartments.mock.inspectFuncStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("Inspect function is already set for ApartmentsStorageMock.StreamApartments")
+	}
+
+	mmStreamApartments.mock.inspectFuncStreamApartments = f
+
+	return mmStreamApartments
+}
+
+// Return sets up results that will be returned by ApartmentsStorage.StreamApartments
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Return(err error) *ApartmentsStorageMock {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Set")
+	}
+
+	if mmStreamApartments.defaultExpectation == nil {
+		mmStreamApartments.defaultExpectation = &ApartmentsStorageMockStreamApartmentsExpectation{mock: mmStreamApartments.mock}
+	}
+	mmStreamApartments.defaultExpectation.results = &ApartmentsStorageMockStreamApartmentsResults{err}
+	return mmStreamApartments.mock
+}
+
+// Set uses given function f to mock the ApartmentsStorage.StreamApartments method
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Set(f func(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) (err error)) *ApartmentsStorageMock {
+	if mmStreamApartments.defaultExpectation != nil {
+		mmStreamApartments.mock.t.Fatalf("Default expectation is already set for the ApartmentsStorage.StreamApartments method")
+	}
+
+	if len(mmStreamApartments.expectations) > 0 {
+		mmStreamApartments.mock.t.Fatalf("Some expectations are already set for the ApartmentsStorage.StreamApartments method")
+	}
+
+	mmStreamApartments.mock.funcStreamApartments = f
+	return mmStreamApartments.mock
+}
+
+// When sets expectation for the ApartmentsStorage.StreamApartments which will trigger the result defined by the following
+// Then helper
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) When(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) *ApartmentsStorageMockStreamApartmentsExpectation {
+	if mmStreamApartments.mock.funcStreamApartments != nil {
+		mmStreamApartments.mock.t.Fatalf("ApartmentsStorageMock.StreamApartments mock is already set by Set")
+	}
+
+	expectation := &ApartmentsStorageMockStreamApartmentsExpectation{
+		mock:   mmStreamApartments.mock,
+		params: &ApartmentsStorageMockStreamApartmentsParams{ctx, buildingIds, send},
+	}
+	mmStreamApartments.expectations = append(mmStreamApartments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApartmentsStorage.StreamApartments return parameters for the expectation previously defined by the When method
+func (e *ApartmentsStorageMockStreamApartmentsExpectation) Then(err error) *ApartmentsStorageMock {
+	e.results = &ApartmentsStorageMockStreamApartmentsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ApartmentsStorage.StreamApartments should be invoked
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Times(n uint64) *mApartmentsStorageMockStreamApartments {
+	if n == 0 {
+		mmStreamApartments.mock.t.Fatalf("Times of ApartmentsStorageMock.StreamApartments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStreamApartments.expectedInvocations, n)
+	return mmStreamApartments
+}
+
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) invocationsDone() bool {
+	if len(mmStreamApartments.expectations) == 0 && mmStreamApartments.defaultExpectation == nil && mmStreamApartments.mock.funcStreamApartments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStreamApartments.mock.afterStreamApartmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStreamApartments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StreamApartments implements storage.ApartmentsStorage
+func (mmStreamApartments *ApartmentsStorageMock) StreamApartments(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) (err error) {
+	mm_atomic.AddUint64(&mmStreamApartments.beforeStreamApartmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmStreamApartments.afterStreamApartmentsCounter, 1)
+
+	if mmStreamApartments.inspectFuncStreamApartments != nil {
+		mmStreamApartments.inspectFuncStreamApartments(ctx, buildingIds, send)
+	}
+
+	mm_params := ApartmentsStorageMockStreamApartmentsParams{ctx, buildingIds, send}
+
+	// Record call args
+	mmStreamApartments.StreamApartmentsMock.mutex.Lock()
+	mmStreamApartments.StreamApartmentsMock.callArgs = append(mmStreamApartments.StreamApartmentsMock.callArgs, &mm_params)
+	mmStreamApartments.StreamApartmentsMock.mutex.Unlock()
+
+	for _, e := range mmStreamApartments.StreamApartmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStreamApartments.StreamApartmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStreamApartments.StreamApartmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmStreamApartments.StreamApartmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmStreamApartments.StreamApartmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := ApartmentsStorageMockStreamApartmentsParams{ctx, buildingIds, send}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStreamApartments.t.Errorf("ApartmentsStorageMock.StreamApartments got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingIds != nil && !minimock.Equal(*mm_want_ptrs.buildingIds, mm_got.buildingIds) {
+				mmStreamApartments.t.Errorf("ApartmentsStorageMock.StreamApartments got unexpected parameter buildingIds, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingIds, mm_got.buildingIds, minimock.Diff(*mm_want_ptrs.buildingIds, mm_got.buildingIds))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmStreamApartments.t.Errorf("ApartmentsStorageMock.StreamApartments got unexpected parameter send, want: %#v, got: %#v%s\n", *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStreamApartments.t.Errorf("ApartmentsStorageMock.StreamApartments got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStreamApartments.StreamApartmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStreamApartments.t.Fatal("No results are set for the ApartmentsStorageMock.StreamApartments")
+		}
+		return (*mm_results).err
+	}
+	if mmStreamApartments.funcStreamApartments != nil {
+		return mmStreamApartments.funcStreamApartments(ctx, buildingIds, send)
+	}
+	mmStreamApartments.t.Fatalf("Unexpected call to ApartmentsStorageMock.StreamApartments. %v %v %v", ctx, buildingIds, send)
+	return
+}
+
+// StreamApartmentsAfterCounter returns a count of finished ApartmentsStorageMock.StreamApartments invocations
+func (mmStreamApartments *ApartmentsStorageMock) StreamApartmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamApartments.afterStreamApartmentsCounter)
+}
+
+// StreamApartmentsBeforeCounter returns a count of ApartmentsStorageMock.StreamApartments invocations
+func (mmStreamApartments *ApartmentsStorageMock) StreamApartmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamApartments.beforeStreamApartmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApartmentsStorageMock.StreamApartments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStreamApartments *mApartmentsStorageMockStreamApartments) Calls() []*ApartmentsStorageMockStreamApartmentsParams {
+	mmStreamApartments.mutex.RLock()
+
+	argCopy := make([]*ApartmentsStorageMockStreamApartmentsParams, len(mmStreamApartments.callArgs))
+	copy(argCopy, mmStreamApartments.callArgs)
+
+	mmStreamApartments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStreamApartmentsDone returns true if the count of the StreamApartments invocations corresponds
+// the number of defined expectations
+func (m *ApartmentsStorageMock) MinimockStreamApartmentsDone() bool {
+	if m.StreamApartmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StreamApartmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StreamApartmentsMock.invocationsDone()
+}
+
+// MinimockStreamApartmentsInspect logs each unmet expectation
+func (m *ApartmentsStorageMock) MinimockStreamApartmentsInspect() {
+	for _, e := range m.StreamApartmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.StreamApartments with params: %#v", *e.params)
+		}
+	}
+
+	afterStreamApartmentsCounter := mm_atomic.LoadUint64(&m.afterStreamApartmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StreamApartmentsMock.defaultExpectation != nil && afterStreamApartmentsCounter < 1 {
+		if m.StreamApartmentsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ApartmentsStorageMock.StreamApartments")
+		} else {
+			m.t.Errorf("Expected call to ApartmentsStorageMock.StreamApartments with params: %#v", *m.StreamApartmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStreamApartments != nil && afterStreamApartmentsCounter < 1 {
+		m.t.Error("Expected call to ApartmentsStorageMock.StreamApartments")
+	}
+
+	if !m.StreamApartmentsMock.invocationsDone() && afterStreamApartmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApartmentsStorageMock.StreamApartments but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamApartmentsMock.expectedInvocations), afterStreamApartmentsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ApartmentsStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateApartmentInspect()
+
+			m.MinimockDeleteApartmentInspect()
+
+			m.MinimockGetApartmentInspect()
+
+			m.MinimockGetApartmentsInspect()
+
+			m.MinimockGetApartmentsInBuildingInspect()
+
+			m.MinimockGetApartmentsInBuildingsInspect()
+
+			m.MinimockGetApartmentsOnFloorInspect()
+
+			m.MinimockGetStatsInspect()
+
+			m.MinimockStreamApartmentsInspect()
 		}
 	})
 }
@@ -1694,5 +3078,9 @@ func (m *ApartmentsStorageMock) minimockDone() bool {
 		m.MinimockDeleteApartmentDone() &&
 		m.MinimockGetApartmentDone() &&
 		m.MinimockGetApartmentsDone() &&
-		m.MinimockGetApartmentsInBuildingDone()
+		m.MinimockGetApartmentsInBuildingDone() &&
+		m.MinimockGetApartmentsInBuildingsDone() &&
+		m.MinimockGetApartmentsOnFloorDone() &&
+		m.MinimockGetStatsDone() &&
+		m.MinimockStreamApartmentsDone()
 }
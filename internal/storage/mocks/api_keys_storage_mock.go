@@ -0,0 +1,1365 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.APIKeysStorage -o api_keys_storage_mock_test.go -n APIKeysStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_storage "github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// APIKeysStorageMock implements storage.APIKeysStorage
+type APIKeysStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateAPIKey          func(ctx context.Context, apiKey *mm_storage.APIKey) (err error)
+	inspectFuncCreateAPIKey   func(ctx context.Context, apiKey *mm_storage.APIKey)
+	afterCreateAPIKeyCounter  uint64
+	beforeCreateAPIKeyCounter uint64
+	CreateAPIKeyMock          mAPIKeysStorageMockCreateAPIKey
+
+	funcGetAPIKeyByHash          func(ctx context.Context, hash string) (ap1 *mm_storage.APIKey, err error)
+	inspectFuncGetAPIKeyByHash   func(ctx context.Context, hash string)
+	afterGetAPIKeyByHashCounter  uint64
+	beforeGetAPIKeyByHashCounter uint64
+	GetAPIKeyByHashMock          mAPIKeysStorageMockGetAPIKeyByHash
+
+	funcGetAPIKeys          func(ctx context.Context) (apa1 []*mm_storage.APIKey, err error)
+	inspectFuncGetAPIKeys   func(ctx context.Context)
+	afterGetAPIKeysCounter  uint64
+	beforeGetAPIKeysCounter uint64
+	GetAPIKeysMock          mAPIKeysStorageMockGetAPIKeys
+
+	funcRevokeAPIKey          func(ctx context.Context, id int) (i1 int64, err error)
+	inspectFuncRevokeAPIKey   func(ctx context.Context, id int)
+	afterRevokeAPIKeyCounter  uint64
+	beforeRevokeAPIKeyCounter uint64
+	RevokeAPIKeyMock          mAPIKeysStorageMockRevokeAPIKey
+}
+
+// NewAPIKeysStorageMock returns a mock for storage.APIKeysStorage
+func NewAPIKeysStorageMock(t minimock.Tester) *APIKeysStorageMock {
+	m := &APIKeysStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateAPIKeyMock = mAPIKeysStorageMockCreateAPIKey{mock: m}
+	m.CreateAPIKeyMock.callArgs = []*APIKeysStorageMockCreateAPIKeyParams{}
+
+	m.GetAPIKeyByHashMock = mAPIKeysStorageMockGetAPIKeyByHash{mock: m}
+	m.GetAPIKeyByHashMock.callArgs = []*APIKeysStorageMockGetAPIKeyByHashParams{}
+
+	m.GetAPIKeysMock = mAPIKeysStorageMockGetAPIKeys{mock: m}
+	m.GetAPIKeysMock.callArgs = []*APIKeysStorageMockGetAPIKeysParams{}
+
+	m.RevokeAPIKeyMock = mAPIKeysStorageMockRevokeAPIKey{mock: m}
+	m.RevokeAPIKeyMock.callArgs = []*APIKeysStorageMockRevokeAPIKeyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAPIKeysStorageMockCreateAPIKey struct {
+	optional           bool
+	mock               *APIKeysStorageMock
+	defaultExpectation *APIKeysStorageMockCreateAPIKeyExpectation
+	expectations       []*APIKeysStorageMockCreateAPIKeyExpectation
+
+	callArgs []*APIKeysStorageMockCreateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeysStorageMockCreateAPIKeyExpectation specifies expectation struct of the APIKeysStorage.CreateAPIKey
+type APIKeysStorageMockCreateAPIKeyExpectation struct {
+	mock      *APIKeysStorageMock
+	params    *APIKeysStorageMockCreateAPIKeyParams
+	paramPtrs *APIKeysStorageMockCreateAPIKeyParamPtrs
+	results   *APIKeysStorageMockCreateAPIKeyResults
+	Counter   uint64
+}
+
+// APIKeysStorageMockCreateAPIKeyParams contains parameters of the APIKeysStorage.CreateAPIKey
+type APIKeysStorageMockCreateAPIKeyParams struct {
+	ctx    context.Context
+	apiKey *mm_storage.APIKey
+}
+
+// APIKeysStorageMockCreateAPIKeyParamPtrs contains pointers to parameters of the APIKeysStorage.CreateAPIKey
+type APIKeysStorageMockCreateAPIKeyParamPtrs struct {
+	ctx    *context.Context
+	apiKey **mm_storage.APIKey
+}
+
+// APIKeysStorageMockCreateAPIKeyResults contains results of the APIKeysStorage.CreateAPIKey
+type APIKeysStorageMockCreateAPIKeyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Optional() *mAPIKeysStorageMockCreateAPIKey {
+	mmCreateAPIKey.optional = true
+	return mmCreateAPIKey
+}
+
+// Expect sets up expected params for APIKeysStorage.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Expect(ctx context.Context, apiKey *mm_storage.APIKey) *mAPIKeysStorageMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeysStorageMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPIKey.defaultExpectation.params = &APIKeysStorageMockCreateAPIKeyParams{ctx, apiKey}
+	for _, e := range mmCreateAPIKey.expectations {
+		if minimock.Equal(e.params, mmCreateAPIKey.defaultExpectation.params) {
+			mmCreateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeysStorage.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) ExpectCtxParam1(ctx context.Context) *mAPIKeysStorageMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeysStorageMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &APIKeysStorageMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAPIKey
+}
+
+// ExpectApiKeyParam2 sets up expected param apiKey for APIKeysStorage.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) ExpectApiKeyParam2(apiKey *mm_storage.APIKey) *mAPIKeysStorageMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeysStorageMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &APIKeysStorageMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.apiKey = &apiKey
+
+	return mmCreateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeysStorage.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Inspect(f func(ctx context.Context, apiKey *mm_storage.APIKey)) *mAPIKeysStorageMockCreateAPIKey {
+	if mmCreateAPIKey.mock.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Inspect function is already set for APIKeysStorageMock.CreateAPIKey")
+	}
+
+	mmCreateAPIKey.mock.inspectFuncCreateAPIKey = f
+
+	return mmCreateAPIKey
+}
+
+// Return sets up results that will be returned by APIKeysStorage.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Return(err error) *APIKeysStorageMock {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeysStorageMockCreateAPIKeyExpectation{mock: mmCreateAPIKey.mock}
+	}
+	mmCreateAPIKey.defaultExpectation.results = &APIKeysStorageMockCreateAPIKeyResults{err}
+	return mmCreateAPIKey.mock
+}
+
+// Set uses given function f to mock the APIKeysStorage.CreateAPIKey method
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Set(f func(ctx context.Context, apiKey *mm_storage.APIKey) (err error)) *APIKeysStorageMock {
+	if mmCreateAPIKey.defaultExpectation != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Default expectation is already set for the APIKeysStorage.CreateAPIKey method")
+	}
+
+	if len(mmCreateAPIKey.expectations) > 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Some expectations are already set for the APIKeysStorage.CreateAPIKey method")
+	}
+
+	mmCreateAPIKey.mock.funcCreateAPIKey = f
+	return mmCreateAPIKey.mock
+}
+
+// When sets expectation for the APIKeysStorage.CreateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) When(ctx context.Context, apiKey *mm_storage.APIKey) *APIKeysStorageMockCreateAPIKeyExpectation {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeysStorageMock.CreateAPIKey mock is already set by Set")
+	}
+
+	expectation := &APIKeysStorageMockCreateAPIKeyExpectation{
+		mock:   mmCreateAPIKey.mock,
+		params: &APIKeysStorageMockCreateAPIKeyParams{ctx, apiKey},
+	}
+	mmCreateAPIKey.expectations = append(mmCreateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeysStorage.CreateAPIKey return parameters for the expectation previously defined by the When method
+func (e *APIKeysStorageMockCreateAPIKeyExpectation) Then(err error) *APIKeysStorageMock {
+	e.results = &APIKeysStorageMockCreateAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times APIKeysStorage.CreateAPIKey should be invoked
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Times(n uint64) *mAPIKeysStorageMockCreateAPIKey {
+	if n == 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Times of APIKeysStorageMock.CreateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPIKey.expectedInvocations, n)
+	return mmCreateAPIKey
+}
+
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) invocationsDone() bool {
+	if len(mmCreateAPIKey.expectations) == 0 && mmCreateAPIKey.defaultExpectation == nil && mmCreateAPIKey.mock.funcCreateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.mock.afterCreateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPIKey implements storage.APIKeysStorage
+func (mmCreateAPIKey *APIKeysStorageMock) CreateAPIKey(ctx context.Context, apiKey *mm_storage.APIKey) (err error) {
+	mm_atomic.AddUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter, 1)
+
+	if mmCreateAPIKey.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.inspectFuncCreateAPIKey(ctx, apiKey)
+	}
+
+	mm_params := APIKeysStorageMockCreateAPIKeyParams{ctx, apiKey}
+
+	// Record call args
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Lock()
+	mmCreateAPIKey.CreateAPIKeyMock.callArgs = append(mmCreateAPIKey.CreateAPIKeyMock.callArgs, &mm_params)
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPIKey.CreateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeysStorageMockCreateAPIKeyParams{ctx, apiKey}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPIKey.t.Errorf("APIKeysStorageMock.CreateAPIKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.apiKey != nil && !minimock.Equal(*mm_want_ptrs.apiKey, mm_got.apiKey) {
+				mmCreateAPIKey.t.Errorf("APIKeysStorageMock.CreateAPIKey got unexpected parameter apiKey, want: %#v, got: %#v%s\n", *mm_want_ptrs.apiKey, mm_got.apiKey, minimock.Diff(*mm_want_ptrs.apiKey, mm_got.apiKey))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPIKey.t.Errorf("APIKeysStorageMock.CreateAPIKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPIKey.t.Fatal("No results are set for the APIKeysStorageMock.CreateAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAPIKey.funcCreateAPIKey != nil {
+		return mmCreateAPIKey.funcCreateAPIKey(ctx, apiKey)
+	}
+	mmCreateAPIKey.t.Fatalf("Unexpected call to APIKeysStorageMock.CreateAPIKey. %v %v", ctx, apiKey)
+	return
+}
+
+// CreateAPIKeyAfterCounter returns a count of finished APIKeysStorageMock.CreateAPIKey invocations
+func (mmCreateAPIKey *APIKeysStorageMock) CreateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter)
+}
+
+// CreateAPIKeyBeforeCounter returns a count of APIKeysStorageMock.CreateAPIKey invocations
+func (mmCreateAPIKey *APIKeysStorageMock) CreateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeysStorageMock.CreateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPIKey *mAPIKeysStorageMockCreateAPIKey) Calls() []*APIKeysStorageMockCreateAPIKeyParams {
+	mmCreateAPIKey.mutex.RLock()
+
+	argCopy := make([]*APIKeysStorageMockCreateAPIKeyParams, len(mmCreateAPIKey.callArgs))
+	copy(argCopy, mmCreateAPIKey.callArgs)
+
+	mmCreateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPIKeyDone returns true if the count of the CreateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *APIKeysStorageMock) MinimockCreateAPIKeyDone() bool {
+	if m.CreateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPIKeyMock.invocationsDone()
+}
+
+// MinimockCreateAPIKeyInspect logs each unmet expectation
+func (m *APIKeysStorageMock) MinimockCreateAPIKeyInspect() {
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeysStorageMock.CreateAPIKey with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterCreateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPIKeyMock.defaultExpectation != nil && afterCreateAPIKeyCounter < 1 {
+		if m.CreateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeysStorageMock.CreateAPIKey")
+		} else {
+			m.t.Errorf("Expected call to APIKeysStorageMock.CreateAPIKey with params: %#v", *m.CreateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPIKey != nil && afterCreateAPIKeyCounter < 1 {
+		m.t.Error("Expected call to APIKeysStorageMock.CreateAPIKey")
+	}
+
+	if !m.CreateAPIKeyMock.invocationsDone() && afterCreateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeysStorageMock.CreateAPIKey but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPIKeyMock.expectedInvocations), afterCreateAPIKeyCounter)
+	}
+}
+
+type mAPIKeysStorageMockGetAPIKeyByHash struct {
+	optional           bool
+	mock               *APIKeysStorageMock
+	defaultExpectation *APIKeysStorageMockGetAPIKeyByHashExpectation
+	expectations       []*APIKeysStorageMockGetAPIKeyByHashExpectation
+
+	callArgs []*APIKeysStorageMockGetAPIKeyByHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeysStorageMockGetAPIKeyByHashExpectation specifies expectation struct of the APIKeysStorage.GetAPIKeyByHash
+type APIKeysStorageMockGetAPIKeyByHashExpectation struct {
+	mock      *APIKeysStorageMock
+	params    *APIKeysStorageMockGetAPIKeyByHashParams
+	paramPtrs *APIKeysStorageMockGetAPIKeyByHashParamPtrs
+	results   *APIKeysStorageMockGetAPIKeyByHashResults
+	Counter   uint64
+}
+
+// APIKeysStorageMockGetAPIKeyByHashParams contains parameters of the APIKeysStorage.GetAPIKeyByHash
+type APIKeysStorageMockGetAPIKeyByHashParams struct {
+	ctx  context.Context
+	hash string
+}
+
+// APIKeysStorageMockGetAPIKeyByHashParamPtrs contains pointers to parameters of the APIKeysStorage.GetAPIKeyByHash
+type APIKeysStorageMockGetAPIKeyByHashParamPtrs struct {
+	ctx  *context.Context
+	hash *string
+}
+
+// APIKeysStorageMockGetAPIKeyByHashResults contains results of the APIKeysStorage.GetAPIKeyByHash
+type APIKeysStorageMockGetAPIKeyByHashResults struct {
+	ap1 *mm_storage.APIKey
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Optional() *mAPIKeysStorageMockGetAPIKeyByHash {
+	mmGetAPIKeyByHash.optional = true
+	return mmGetAPIKeyByHash
+}
+
+// Expect sets up expected params for APIKeysStorage.GetAPIKeyByHash
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Expect(ctx context.Context, hash string) *mAPIKeysStorageMockGetAPIKeyByHash {
+	if mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Set")
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation == nil {
+		mmGetAPIKeyByHash.defaultExpectation = &APIKeysStorageMockGetAPIKeyByHashExpectation{}
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation.paramPtrs != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetAPIKeyByHash.defaultExpectation.params = &APIKeysStorageMockGetAPIKeyByHashParams{ctx, hash}
+	for _, e := range mmGetAPIKeyByHash.expectations {
+		if minimock.Equal(e.params, mmGetAPIKeyByHash.defaultExpectation.params) {
+			mmGetAPIKeyByHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAPIKeyByHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAPIKeyByHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeysStorage.GetAPIKeyByHash
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) ExpectCtxParam1(ctx context.Context) *mAPIKeysStorageMockGetAPIKeyByHash {
+	if mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Set")
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation == nil {
+		mmGetAPIKeyByHash.defaultExpectation = &APIKeysStorageMockGetAPIKeyByHashExpectation{}
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation.params != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Expect")
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation.paramPtrs == nil {
+		mmGetAPIKeyByHash.defaultExpectation.paramPtrs = &APIKeysStorageMockGetAPIKeyByHashParamPtrs{}
+	}
+	mmGetAPIKeyByHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetAPIKeyByHash
+}
+
+// ExpectHashParam2 sets up expected param hash for APIKeysStorage.GetAPIKeyByHash
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) ExpectHashParam2(hash string) *mAPIKeysStorageMockGetAPIKeyByHash {
+	if mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Set")
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation == nil {
+		mmGetAPIKeyByHash.defaultExpectation = &APIKeysStorageMockGetAPIKeyByHashExpectation{}
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation.params != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Expect")
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation.paramPtrs == nil {
+		mmGetAPIKeyByHash.defaultExpectation.paramPtrs = &APIKeysStorageMockGetAPIKeyByHashParamPtrs{}
+	}
+	mmGetAPIKeyByHash.defaultExpectation.paramPtrs.hash = &hash
+
+	return mmGetAPIKeyByHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeysStorage.GetAPIKeyByHash
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Inspect(f func(ctx context.Context, hash string)) *mAPIKeysStorageMockGetAPIKeyByHash {
+	if mmGetAPIKeyByHash.mock.inspectFuncGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("Inspect function is already set for APIKeysStorageMock.GetAPIKeyByHash")
+	}
+
+	mmGetAPIKeyByHash.mock.inspectFuncGetAPIKeyByHash = f
+
+	return mmGetAPIKeyByHash
+}
+
+// Return sets up results that will be returned by APIKeysStorage.GetAPIKeyByHash
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Return(ap1 *mm_storage.APIKey, err error) *APIKeysStorageMock {
+	if mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Set")
+	}
+
+	if mmGetAPIKeyByHash.defaultExpectation == nil {
+		mmGetAPIKeyByHash.defaultExpectation = &APIKeysStorageMockGetAPIKeyByHashExpectation{mock: mmGetAPIKeyByHash.mock}
+	}
+	mmGetAPIKeyByHash.defaultExpectation.results = &APIKeysStorageMockGetAPIKeyByHashResults{ap1, err}
+	return mmGetAPIKeyByHash.mock
+}
+
+// Set uses given function f to mock the APIKeysStorage.GetAPIKeyByHash method
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Set(f func(ctx context.Context, hash string) (ap1 *mm_storage.APIKey, err error)) *APIKeysStorageMock {
+	if mmGetAPIKeyByHash.defaultExpectation != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("Default expectation is already set for the APIKeysStorage.GetAPIKeyByHash method")
+	}
+
+	if len(mmGetAPIKeyByHash.expectations) > 0 {
+		mmGetAPIKeyByHash.mock.t.Fatalf("Some expectations are already set for the APIKeysStorage.GetAPIKeyByHash method")
+	}
+
+	mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash = f
+	return mmGetAPIKeyByHash.mock
+}
+
+// When sets expectation for the APIKeysStorage.GetAPIKeyByHash which will trigger the result defined by the following
+// Then helper
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) When(ctx context.Context, hash string) *APIKeysStorageMockGetAPIKeyByHashExpectation {
+	if mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeyByHash mock is already set by Set")
+	}
+
+	expectation := &APIKeysStorageMockGetAPIKeyByHashExpectation{
+		mock:   mmGetAPIKeyByHash.mock,
+		params: &APIKeysStorageMockGetAPIKeyByHashParams{ctx, hash},
+	}
+	mmGetAPIKeyByHash.expectations = append(mmGetAPIKeyByHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeysStorage.GetAPIKeyByHash return parameters for the expectation previously defined by the When method
+func (e *APIKeysStorageMockGetAPIKeyByHashExpectation) Then(ap1 *mm_storage.APIKey, err error) *APIKeysStorageMock {
+	e.results = &APIKeysStorageMockGetAPIKeyByHashResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeysStorage.GetAPIKeyByHash should be invoked
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Times(n uint64) *mAPIKeysStorageMockGetAPIKeyByHash {
+	if n == 0 {
+		mmGetAPIKeyByHash.mock.t.Fatalf("Times of APIKeysStorageMock.GetAPIKeyByHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAPIKeyByHash.expectedInvocations, n)
+	return mmGetAPIKeyByHash
+}
+
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) invocationsDone() bool {
+	if len(mmGetAPIKeyByHash.expectations) == 0 && mmGetAPIKeyByHash.defaultExpectation == nil && mmGetAPIKeyByHash.mock.funcGetAPIKeyByHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAPIKeyByHash.mock.afterGetAPIKeyByHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAPIKeyByHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAPIKeyByHash implements storage.APIKeysStorage
+func (mmGetAPIKeyByHash *APIKeysStorageMock) GetAPIKeyByHash(ctx context.Context, hash string) (ap1 *mm_storage.APIKey, err error) {
+	mm_atomic.AddUint64(&mmGetAPIKeyByHash.beforeGetAPIKeyByHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAPIKeyByHash.afterGetAPIKeyByHashCounter, 1)
+
+	if mmGetAPIKeyByHash.inspectFuncGetAPIKeyByHash != nil {
+		mmGetAPIKeyByHash.inspectFuncGetAPIKeyByHash(ctx, hash)
+	}
+
+	mm_params := APIKeysStorageMockGetAPIKeyByHashParams{ctx, hash}
+
+	// Record call args
+	mmGetAPIKeyByHash.GetAPIKeyByHashMock.mutex.Lock()
+	mmGetAPIKeyByHash.GetAPIKeyByHashMock.callArgs = append(mmGetAPIKeyByHash.GetAPIKeyByHashMock.callArgs, &mm_params)
+	mmGetAPIKeyByHash.GetAPIKeyByHashMock.mutex.Unlock()
+
+	for _, e := range mmGetAPIKeyByHash.GetAPIKeyByHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetAPIKeyByHash.GetAPIKeyByHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAPIKeyByHash.GetAPIKeyByHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAPIKeyByHash.GetAPIKeyByHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAPIKeyByHash.GetAPIKeyByHashMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeysStorageMockGetAPIKeyByHashParams{ctx, hash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAPIKeyByHash.t.Errorf("APIKeysStorageMock.GetAPIKeyByHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmGetAPIKeyByHash.t.Errorf("APIKeysStorageMock.GetAPIKeyByHash got unexpected parameter hash, want: %#v, got: %#v%s\n", *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAPIKeyByHash.t.Errorf("APIKeysStorageMock.GetAPIKeyByHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAPIKeyByHash.GetAPIKeyByHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAPIKeyByHash.t.Fatal("No results are set for the APIKeysStorageMock.GetAPIKeyByHash")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetAPIKeyByHash.funcGetAPIKeyByHash != nil {
+		return mmGetAPIKeyByHash.funcGetAPIKeyByHash(ctx, hash)
+	}
+	mmGetAPIKeyByHash.t.Fatalf("Unexpected call to APIKeysStorageMock.GetAPIKeyByHash. %v %v", ctx, hash)
+	return
+}
+
+// GetAPIKeyByHashAfterCounter returns a count of finished APIKeysStorageMock.GetAPIKeyByHash invocations
+func (mmGetAPIKeyByHash *APIKeysStorageMock) GetAPIKeyByHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAPIKeyByHash.afterGetAPIKeyByHashCounter)
+}
+
+// GetAPIKeyByHashBeforeCounter returns a count of APIKeysStorageMock.GetAPIKeyByHash invocations
+func (mmGetAPIKeyByHash *APIKeysStorageMock) GetAPIKeyByHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAPIKeyByHash.beforeGetAPIKeyByHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeysStorageMock.GetAPIKeyByHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAPIKeyByHash *mAPIKeysStorageMockGetAPIKeyByHash) Calls() []*APIKeysStorageMockGetAPIKeyByHashParams {
+	mmGetAPIKeyByHash.mutex.RLock()
+
+	argCopy := make([]*APIKeysStorageMockGetAPIKeyByHashParams, len(mmGetAPIKeyByHash.callArgs))
+	copy(argCopy, mmGetAPIKeyByHash.callArgs)
+
+	mmGetAPIKeyByHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAPIKeyByHashDone returns true if the count of the GetAPIKeyByHash invocations corresponds
+// the number of defined expectations
+func (m *APIKeysStorageMock) MinimockGetAPIKeyByHashDone() bool {
+	if m.GetAPIKeyByHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAPIKeyByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAPIKeyByHashMock.invocationsDone()
+}
+
+// MinimockGetAPIKeyByHashInspect logs each unmet expectation
+func (m *APIKeysStorageMock) MinimockGetAPIKeyByHashInspect() {
+	for _, e := range m.GetAPIKeyByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeysStorageMock.GetAPIKeyByHash with params: %#v", *e.params)
+		}
+	}
+
+	afterGetAPIKeyByHashCounter := mm_atomic.LoadUint64(&m.afterGetAPIKeyByHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAPIKeyByHashMock.defaultExpectation != nil && afterGetAPIKeyByHashCounter < 1 {
+		if m.GetAPIKeyByHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeysStorageMock.GetAPIKeyByHash")
+		} else {
+			m.t.Errorf("Expected call to APIKeysStorageMock.GetAPIKeyByHash with params: %#v", *m.GetAPIKeyByHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAPIKeyByHash != nil && afterGetAPIKeyByHashCounter < 1 {
+		m.t.Error("Expected call to APIKeysStorageMock.GetAPIKeyByHash")
+	}
+
+	if !m.GetAPIKeyByHashMock.invocationsDone() && afterGetAPIKeyByHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeysStorageMock.GetAPIKeyByHash but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAPIKeyByHashMock.expectedInvocations), afterGetAPIKeyByHashCounter)
+	}
+}
+
+type mAPIKeysStorageMockGetAPIKeys struct {
+	optional           bool
+	mock               *APIKeysStorageMock
+	defaultExpectation *APIKeysStorageMockGetAPIKeysExpectation
+	expectations       []*APIKeysStorageMockGetAPIKeysExpectation
+
+	callArgs []*APIKeysStorageMockGetAPIKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeysStorageMockGetAPIKeysExpectation specifies expectation struct of the APIKeysStorage.GetAPIKeys
+type APIKeysStorageMockGetAPIKeysExpectation struct {
+	mock      *APIKeysStorageMock
+	params    *APIKeysStorageMockGetAPIKeysParams
+	paramPtrs *APIKeysStorageMockGetAPIKeysParamPtrs
+	results   *APIKeysStorageMockGetAPIKeysResults
+	Counter   uint64
+}
+
+// APIKeysStorageMockGetAPIKeysParams contains parameters of the APIKeysStorage.GetAPIKeys
+type APIKeysStorageMockGetAPIKeysParams struct {
+	ctx context.Context
+}
+
+// APIKeysStorageMockGetAPIKeysParamPtrs contains pointers to parameters of the APIKeysStorage.GetAPIKeys
+type APIKeysStorageMockGetAPIKeysParamPtrs struct {
+	ctx *context.Context
+}
+
+// APIKeysStorageMockGetAPIKeysResults contains results of the APIKeysStorage.GetAPIKeys
+type APIKeysStorageMockGetAPIKeysResults struct {
+	apa1 []*mm_storage.APIKey
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Optional() *mAPIKeysStorageMockGetAPIKeys {
+	mmGetAPIKeys.optional = true
+	return mmGetAPIKeys
+}
+
+// Expect sets up expected params for APIKeysStorage.GetAPIKeys
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Expect(ctx context.Context) *mAPIKeysStorageMockGetAPIKeys {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &APIKeysStorageMockGetAPIKeysExpectation{}
+	}
+
+	if mmGetAPIKeys.defaultExpectation.paramPtrs != nil {
+		mmGetAPIKeys.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeys mock is already set by ExpectParams functions")
+	}
+
+	mmGetAPIKeys.defaultExpectation.params = &APIKeysStorageMockGetAPIKeysParams{ctx}
+	for _, e := range mmGetAPIKeys.expectations {
+		if minimock.Equal(e.params, mmGetAPIKeys.defaultExpectation.params) {
+			mmGetAPIKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAPIKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAPIKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeysStorage.GetAPIKeys
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) ExpectCtxParam1(ctx context.Context) *mAPIKeysStorageMockGetAPIKeys {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &APIKeysStorageMockGetAPIKeysExpectation{}
+	}
+
+	if mmGetAPIKeys.defaultExpectation.params != nil {
+		mmGetAPIKeys.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeys mock is already set by Expect")
+	}
+
+	if mmGetAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmGetAPIKeys.defaultExpectation.paramPtrs = &APIKeysStorageMockGetAPIKeysParamPtrs{}
+	}
+	mmGetAPIKeys.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetAPIKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeysStorage.GetAPIKeys
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Inspect(f func(ctx context.Context)) *mAPIKeysStorageMockGetAPIKeys {
+	if mmGetAPIKeys.mock.inspectFuncGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("Inspect function is already set for APIKeysStorageMock.GetAPIKeys")
+	}
+
+	mmGetAPIKeys.mock.inspectFuncGetAPIKeys = f
+
+	return mmGetAPIKeys
+}
+
+// Return sets up results that will be returned by APIKeysStorage.GetAPIKeys
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Return(apa1 []*mm_storage.APIKey, err error) *APIKeysStorageMock {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &APIKeysStorageMockGetAPIKeysExpectation{mock: mmGetAPIKeys.mock}
+	}
+	mmGetAPIKeys.defaultExpectation.results = &APIKeysStorageMockGetAPIKeysResults{apa1, err}
+	return mmGetAPIKeys.mock
+}
+
+// Set uses given function f to mock the APIKeysStorage.GetAPIKeys method
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Set(f func(ctx context.Context) (apa1 []*mm_storage.APIKey, err error)) *APIKeysStorageMock {
+	if mmGetAPIKeys.defaultExpectation != nil {
+		mmGetAPIKeys.mock.t.Fatalf("Default expectation is already set for the APIKeysStorage.GetAPIKeys method")
+	}
+
+	if len(mmGetAPIKeys.expectations) > 0 {
+		mmGetAPIKeys.mock.t.Fatalf("Some expectations are already set for the APIKeysStorage.GetAPIKeys method")
+	}
+
+	mmGetAPIKeys.mock.funcGetAPIKeys = f
+	return mmGetAPIKeys.mock
+}
+
+// When sets expectation for the APIKeysStorage.GetAPIKeys which will trigger the result defined by the following
+// Then helper
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) When(ctx context.Context) *APIKeysStorageMockGetAPIKeysExpectation {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("APIKeysStorageMock.GetAPIKeys mock is already set by Set")
+	}
+
+	expectation := &APIKeysStorageMockGetAPIKeysExpectation{
+		mock:   mmGetAPIKeys.mock,
+		params: &APIKeysStorageMockGetAPIKeysParams{ctx},
+	}
+	mmGetAPIKeys.expectations = append(mmGetAPIKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeysStorage.GetAPIKeys return parameters for the expectation previously defined by the When method
+func (e *APIKeysStorageMockGetAPIKeysExpectation) Then(apa1 []*mm_storage.APIKey, err error) *APIKeysStorageMock {
+	e.results = &APIKeysStorageMockGetAPIKeysResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeysStorage.GetAPIKeys should be invoked
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Times(n uint64) *mAPIKeysStorageMockGetAPIKeys {
+	if n == 0 {
+		mmGetAPIKeys.mock.t.Fatalf("Times of APIKeysStorageMock.GetAPIKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAPIKeys.expectedInvocations, n)
+	return mmGetAPIKeys
+}
+
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) invocationsDone() bool {
+	if len(mmGetAPIKeys.expectations) == 0 && mmGetAPIKeys.defaultExpectation == nil && mmGetAPIKeys.mock.funcGetAPIKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAPIKeys.mock.afterGetAPIKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAPIKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAPIKeys implements storage.APIKeysStorage
+func (mmGetAPIKeys *APIKeysStorageMock) GetAPIKeys(ctx context.Context) (apa1 []*mm_storage.APIKey, err error) {
+	mm_atomic.AddUint64(&mmGetAPIKeys.beforeGetAPIKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAPIKeys.afterGetAPIKeysCounter, 1)
+
+	if mmGetAPIKeys.inspectFuncGetAPIKeys != nil {
+		mmGetAPIKeys.inspectFuncGetAPIKeys(ctx)
+	}
+
+	mm_params := APIKeysStorageMockGetAPIKeysParams{ctx}
+
+	// Record call args
+	mmGetAPIKeys.GetAPIKeysMock.mutex.Lock()
+	mmGetAPIKeys.GetAPIKeysMock.callArgs = append(mmGetAPIKeys.GetAPIKeysMock.callArgs, &mm_params)
+	mmGetAPIKeys.GetAPIKeysMock.mutex.Unlock()
+
+	for _, e := range mmGetAPIKeys.GetAPIKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmGetAPIKeys.GetAPIKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeysStorageMockGetAPIKeysParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAPIKeys.t.Errorf("APIKeysStorageMock.GetAPIKeys got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAPIKeys.t.Errorf("APIKeysStorageMock.GetAPIKeys got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAPIKeys.t.Fatal("No results are set for the APIKeysStorageMock.GetAPIKeys")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmGetAPIKeys.funcGetAPIKeys != nil {
+		return mmGetAPIKeys.funcGetAPIKeys(ctx)
+	}
+	mmGetAPIKeys.t.Fatalf("Unexpected call to APIKeysStorageMock.GetAPIKeys. %v", ctx)
+	return
+}
+
+// GetAPIKeysAfterCounter returns a count of finished APIKeysStorageMock.GetAPIKeys invocations
+func (mmGetAPIKeys *APIKeysStorageMock) GetAPIKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAPIKeys.afterGetAPIKeysCounter)
+}
+
+// GetAPIKeysBeforeCounter returns a count of APIKeysStorageMock.GetAPIKeys invocations
+func (mmGetAPIKeys *APIKeysStorageMock) GetAPIKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAPIKeys.beforeGetAPIKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeysStorageMock.GetAPIKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAPIKeys *mAPIKeysStorageMockGetAPIKeys) Calls() []*APIKeysStorageMockGetAPIKeysParams {
+	mmGetAPIKeys.mutex.RLock()
+
+	argCopy := make([]*APIKeysStorageMockGetAPIKeysParams, len(mmGetAPIKeys.callArgs))
+	copy(argCopy, mmGetAPIKeys.callArgs)
+
+	mmGetAPIKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAPIKeysDone returns true if the count of the GetAPIKeys invocations corresponds
+// the number of defined expectations
+func (m *APIKeysStorageMock) MinimockGetAPIKeysDone() bool {
+	if m.GetAPIKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAPIKeysMock.invocationsDone()
+}
+
+// MinimockGetAPIKeysInspect logs each unmet expectation
+func (m *APIKeysStorageMock) MinimockGetAPIKeysInspect() {
+	for _, e := range m.GetAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeysStorageMock.GetAPIKeys with params: %#v", *e.params)
+		}
+	}
+
+	afterGetAPIKeysCounter := mm_atomic.LoadUint64(&m.afterGetAPIKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAPIKeysMock.defaultExpectation != nil && afterGetAPIKeysCounter < 1 {
+		if m.GetAPIKeysMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeysStorageMock.GetAPIKeys")
+		} else {
+			m.t.Errorf("Expected call to APIKeysStorageMock.GetAPIKeys with params: %#v", *m.GetAPIKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAPIKeys != nil && afterGetAPIKeysCounter < 1 {
+		m.t.Error("Expected call to APIKeysStorageMock.GetAPIKeys")
+	}
+
+	if !m.GetAPIKeysMock.invocationsDone() && afterGetAPIKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeysStorageMock.GetAPIKeys but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAPIKeysMock.expectedInvocations), afterGetAPIKeysCounter)
+	}
+}
+
+type mAPIKeysStorageMockRevokeAPIKey struct {
+	optional           bool
+	mock               *APIKeysStorageMock
+	defaultExpectation *APIKeysStorageMockRevokeAPIKeyExpectation
+	expectations       []*APIKeysStorageMockRevokeAPIKeyExpectation
+
+	callArgs []*APIKeysStorageMockRevokeAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeysStorageMockRevokeAPIKeyExpectation specifies expectation struct of the APIKeysStorage.RevokeAPIKey
+type APIKeysStorageMockRevokeAPIKeyExpectation struct {
+	mock      *APIKeysStorageMock
+	params    *APIKeysStorageMockRevokeAPIKeyParams
+	paramPtrs *APIKeysStorageMockRevokeAPIKeyParamPtrs
+	results   *APIKeysStorageMockRevokeAPIKeyResults
+	Counter   uint64
+}
+
+// APIKeysStorageMockRevokeAPIKeyParams contains parameters of the APIKeysStorage.RevokeAPIKey
+type APIKeysStorageMockRevokeAPIKeyParams struct {
+	ctx context.Context
+	id  int
+}
+
+// APIKeysStorageMockRevokeAPIKeyParamPtrs contains pointers to parameters of the APIKeysStorage.RevokeAPIKey
+type APIKeysStorageMockRevokeAPIKeyParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// APIKeysStorageMockRevokeAPIKeyResults contains results of the APIKeysStorage.RevokeAPIKey
+type APIKeysStorageMockRevokeAPIKeyResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Optional() *mAPIKeysStorageMockRevokeAPIKey {
+	mmRevokeAPIKey.optional = true
+	return mmRevokeAPIKey
+}
+
+// Expect sets up expected params for APIKeysStorage.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Expect(ctx context.Context, id int) *mAPIKeysStorageMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeysStorageMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeAPIKey.defaultExpectation.params = &APIKeysStorageMockRevokeAPIKeyParams{ctx, id}
+	for _, e := range mmRevokeAPIKey.expectations {
+		if minimock.Equal(e.params, mmRevokeAPIKey.defaultExpectation.params) {
+			mmRevokeAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeysStorage.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) ExpectCtxParam1(ctx context.Context) *mAPIKeysStorageMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeysStorageMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &APIKeysStorageMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeAPIKey
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeysStorage.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) ExpectIdParam2(id int) *mAPIKeysStorageMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeysStorageMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &APIKeysStorageMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevokeAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeysStorage.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Inspect(f func(ctx context.Context, id int)) *mAPIKeysStorageMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.inspectFuncRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("Inspect function is already set for APIKeysStorageMock.RevokeAPIKey")
+	}
+
+	mmRevokeAPIKey.mock.inspectFuncRevokeAPIKey = f
+
+	return mmRevokeAPIKey
+}
+
+// Return sets up results that will be returned by APIKeysStorage.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Return(i1 int64, err error) *APIKeysStorageMock {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeysStorageMockRevokeAPIKeyExpectation{mock: mmRevokeAPIKey.mock}
+	}
+	mmRevokeAPIKey.defaultExpectation.results = &APIKeysStorageMockRevokeAPIKeyResults{i1, err}
+	return mmRevokeAPIKey.mock
+}
+
+// Set uses given function f to mock the APIKeysStorage.RevokeAPIKey method
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Set(f func(ctx context.Context, id int) (i1 int64, err error)) *APIKeysStorageMock {
+	if mmRevokeAPIKey.defaultExpectation != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("Default expectation is already set for the APIKeysStorage.RevokeAPIKey method")
+	}
+
+	if len(mmRevokeAPIKey.expectations) > 0 {
+		mmRevokeAPIKey.mock.t.Fatalf("Some expectations are already set for the APIKeysStorage.RevokeAPIKey method")
+	}
+
+	mmRevokeAPIKey.mock.funcRevokeAPIKey = f
+	return mmRevokeAPIKey.mock
+}
+
+// When sets expectation for the APIKeysStorage.RevokeAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) When(ctx context.Context, id int) *APIKeysStorageMockRevokeAPIKeyExpectation {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeysStorageMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	expectation := &APIKeysStorageMockRevokeAPIKeyExpectation{
+		mock:   mmRevokeAPIKey.mock,
+		params: &APIKeysStorageMockRevokeAPIKeyParams{ctx, id},
+	}
+	mmRevokeAPIKey.expectations = append(mmRevokeAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeysStorage.RevokeAPIKey return parameters for the expectation previously defined by the When method
+func (e *APIKeysStorageMockRevokeAPIKeyExpectation) Then(i1 int64, err error) *APIKeysStorageMock {
+	e.results = &APIKeysStorageMockRevokeAPIKeyResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeysStorage.RevokeAPIKey should be invoked
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Times(n uint64) *mAPIKeysStorageMockRevokeAPIKey {
+	if n == 0 {
+		mmRevokeAPIKey.mock.t.Fatalf("Times of APIKeysStorageMock.RevokeAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeAPIKey.expectedInvocations, n)
+	return mmRevokeAPIKey
+}
+
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) invocationsDone() bool {
+	if len(mmRevokeAPIKey.expectations) == 0 && mmRevokeAPIKey.defaultExpectation == nil && mmRevokeAPIKey.mock.funcRevokeAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeAPIKey.mock.afterRevokeAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeAPIKey implements storage.APIKeysStorage
+func (mmRevokeAPIKey *APIKeysStorageMock) RevokeAPIKey(ctx context.Context, id int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRevokeAPIKey.beforeRevokeAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeAPIKey.afterRevokeAPIKeyCounter, 1)
+
+	if mmRevokeAPIKey.inspectFuncRevokeAPIKey != nil {
+		mmRevokeAPIKey.inspectFuncRevokeAPIKey(ctx, id)
+	}
+
+	mm_params := APIKeysStorageMockRevokeAPIKeyParams{ctx, id}
+
+	// Record call args
+	mmRevokeAPIKey.RevokeAPIKeyMock.mutex.Lock()
+	mmRevokeAPIKey.RevokeAPIKeyMock.callArgs = append(mmRevokeAPIKey.RevokeAPIKeyMock.callArgs, &mm_params)
+	mmRevokeAPIKey.RevokeAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeAPIKey.RevokeAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeysStorageMockRevokeAPIKeyParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeAPIKey.t.Errorf("APIKeysStorageMock.RevokeAPIKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeAPIKey.t.Errorf("APIKeysStorageMock.RevokeAPIKey got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeAPIKey.t.Errorf("APIKeysStorageMock.RevokeAPIKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeAPIKey.t.Fatal("No results are set for the APIKeysStorageMock.RevokeAPIKey")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRevokeAPIKey.funcRevokeAPIKey != nil {
+		return mmRevokeAPIKey.funcRevokeAPIKey(ctx, id)
+	}
+	mmRevokeAPIKey.t.Fatalf("Unexpected call to APIKeysStorageMock.RevokeAPIKey. %v %v", ctx, id)
+	return
+}
+
+// RevokeAPIKeyAfterCounter returns a count of finished APIKeysStorageMock.RevokeAPIKey invocations
+func (mmRevokeAPIKey *APIKeysStorageMock) RevokeAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAPIKey.afterRevokeAPIKeyCounter)
+}
+
+// RevokeAPIKeyBeforeCounter returns a count of APIKeysStorageMock.RevokeAPIKey invocations
+func (mmRevokeAPIKey *APIKeysStorageMock) RevokeAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAPIKey.beforeRevokeAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeysStorageMock.RevokeAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeAPIKey *mAPIKeysStorageMockRevokeAPIKey) Calls() []*APIKeysStorageMockRevokeAPIKeyParams {
+	mmRevokeAPIKey.mutex.RLock()
+
+	argCopy := make([]*APIKeysStorageMockRevokeAPIKeyParams, len(mmRevokeAPIKey.callArgs))
+	copy(argCopy, mmRevokeAPIKey.callArgs)
+
+	mmRevokeAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeAPIKeyDone returns true if the count of the RevokeAPIKey invocations corresponds
+// the number of defined expectations
+func (m *APIKeysStorageMock) MinimockRevokeAPIKeyDone() bool {
+	if m.RevokeAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeAPIKeyMock.invocationsDone()
+}
+
+// MinimockRevokeAPIKeyInspect logs each unmet expectation
+func (m *APIKeysStorageMock) MinimockRevokeAPIKeyInspect() {
+	for _, e := range m.RevokeAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeysStorageMock.RevokeAPIKey with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeAPIKeyCounter := mm_atomic.LoadUint64(&m.afterRevokeAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeAPIKeyMock.defaultExpectation != nil && afterRevokeAPIKeyCounter < 1 {
+		if m.RevokeAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeysStorageMock.RevokeAPIKey")
+		} else {
+			m.t.Errorf("Expected call to APIKeysStorageMock.RevokeAPIKey with params: %#v", *m.RevokeAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeAPIKey != nil && afterRevokeAPIKeyCounter < 1 {
+		m.t.Error("Expected call to APIKeysStorageMock.RevokeAPIKey")
+	}
+
+	if !m.RevokeAPIKeyMock.invocationsDone() && afterRevokeAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeysStorageMock.RevokeAPIKey but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeAPIKeyMock.expectedInvocations), afterRevokeAPIKeyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *APIKeysStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAPIKeyInspect()
+
+			m.MinimockGetAPIKeyByHashInspect()
+
+			m.MinimockGetAPIKeysInspect()
+
+			m.MinimockRevokeAPIKeyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *APIKeysStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *APIKeysStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateAPIKeyDone() &&
+		m.MinimockGetAPIKeyByHashDone() &&
+		m.MinimockGetAPIKeysDone() &&
+		m.MinimockRevokeAPIKeyDone()
+}
@@ -42,6 +42,12 @@ type BuildingsStorageMock struct {
 	afterGetBuildingsCounter  uint64
 	beforeGetBuildingsCounter uint64
 	GetBuildingsMock          mBuildingsStorageMockGetBuildings
+
+	funcGetBuildingsByIDs          func(ctx context.Context, ids []int) (b1 models.BuildingSlice, err error)
+	inspectFuncGetBuildingsByIDs   func(ctx context.Context, ids []int)
+	afterGetBuildingsByIDsCounter  uint64
+	beforeGetBuildingsByIDsCounter uint64
+	GetBuildingsByIDsMock          mBuildingsStorageMockGetBuildingsByIDs
 }
 
 // NewBuildingsStorageMock returns a mock for storage.BuildingsStorage
@@ -64,6 +70,9 @@ func NewBuildingsStorageMock(t minimock.Tester) *BuildingsStorageMock {
 	m.GetBuildingsMock = mBuildingsStorageMockGetBuildings{mock: m}
 	m.GetBuildingsMock.callArgs = []*BuildingsStorageMockGetBuildingsParams{}
 
+	m.GetBuildingsByIDsMock = mBuildingsStorageMockGetBuildingsByIDs{mock: m}
+	m.GetBuildingsByIDsMock.callArgs = []*BuildingsStorageMockGetBuildingsByIDsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -1324,6 +1333,327 @@ func (m *BuildingsStorageMock) MinimockGetBuildingsInspect() {
 	}
 }
 
+type mBuildingsStorageMockGetBuildingsByIDs struct {
+	optional           bool
+	mock               *BuildingsStorageMock
+	defaultExpectation *BuildingsStorageMockGetBuildingsByIDsExpectation
+	expectations       []*BuildingsStorageMockGetBuildingsByIDsExpectation
+
+	callArgs []*BuildingsStorageMockGetBuildingsByIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BuildingsStorageMockGetBuildingsByIDsExpectation specifies expectation struct of the BuildingsStorage.GetBuildingsByIDs
+type BuildingsStorageMockGetBuildingsByIDsExpectation struct {
+	mock      *BuildingsStorageMock
+	params    *BuildingsStorageMockGetBuildingsByIDsParams
+	paramPtrs *BuildingsStorageMockGetBuildingsByIDsParamPtrs
+	results   *BuildingsStorageMockGetBuildingsByIDsResults
+	Counter   uint64
+}
+
+// BuildingsStorageMockGetBuildingsByIDsParams contains parameters of the BuildingsStorage.GetBuildingsByIDs
+type BuildingsStorageMockGetBuildingsByIDsParams struct {
+	ctx context.Context
+	ids []int
+}
+
+// BuildingsStorageMockGetBuildingsByIDsParamPtrs contains pointers to parameters of the BuildingsStorage.GetBuildingsByIDs
+type BuildingsStorageMockGetBuildingsByIDsParamPtrs struct {
+	ctx *context.Context
+	ids *[]int
+}
+
+// BuildingsStorageMockGetBuildingsByIDsResults contains results of the BuildingsStorage.GetBuildingsByIDs
+type BuildingsStorageMockGetBuildingsByIDsResults struct {
+	b1  models.BuildingSlice
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Optional() *mBuildingsStorageMockGetBuildingsByIDs {
+	mmGetBuildingsByIDs.optional = true
+	return mmGetBuildingsByIDs
+}
+
+// Expect sets up expected params for BuildingsStorage.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Expect(ctx context.Context, ids []int) *mBuildingsStorageMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsStorageMockGetBuildingsByIDsExpectation{}
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.paramPtrs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by ExpectParams functions")
+	}
+
+	mmGetBuildingsByIDs.defaultExpectation.params = &BuildingsStorageMockGetBuildingsByIDsParams{ctx, ids}
+	for _, e := range mmGetBuildingsByIDs.expectations {
+		if minimock.Equal(e.params, mmGetBuildingsByIDs.defaultExpectation.params) {
+			mmGetBuildingsByIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBuildingsByIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBuildingsByIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BuildingsStorage.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) ExpectCtxParam1(ctx context.Context) *mBuildingsStorageMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsStorageMockGetBuildingsByIDsExpectation{}
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.params != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Expect")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsByIDs.defaultExpectation.paramPtrs = &BuildingsStorageMockGetBuildingsByIDsParamPtrs{}
+	}
+	mmGetBuildingsByIDs.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBuildingsByIDs
+}
+
+// ExpectIdsParam2 sets up expected param ids for BuildingsStorage.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) ExpectIdsParam2(ids []int) *mBuildingsStorageMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsStorageMockGetBuildingsByIDsExpectation{}
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.params != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Expect")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation.paramPtrs == nil {
+		mmGetBuildingsByIDs.defaultExpectation.paramPtrs = &BuildingsStorageMockGetBuildingsByIDsParamPtrs{}
+	}
+	mmGetBuildingsByIDs.defaultExpectation.paramPtrs.ids = &ids
+
+	return mmGetBuildingsByIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the BuildingsStorage.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Inspect(f func(ctx context.Context, ids []int)) *mBuildingsStorageMockGetBuildingsByIDs {
+	if mmGetBuildingsByIDs.mock.inspectFuncGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Inspect function is already set for BuildingsStorageMock.GetBuildingsByIDs")
+	}
+
+	mmGetBuildingsByIDs.mock.inspectFuncGetBuildingsByIDs = f
+
+	return mmGetBuildingsByIDs
+}
+
+// Return sets up results that will be returned by BuildingsStorage.GetBuildingsByIDs
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Return(b1 models.BuildingSlice, err error) *BuildingsStorageMock {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	if mmGetBuildingsByIDs.defaultExpectation == nil {
+		mmGetBuildingsByIDs.defaultExpectation = &BuildingsStorageMockGetBuildingsByIDsExpectation{mock: mmGetBuildingsByIDs.mock}
+	}
+	mmGetBuildingsByIDs.defaultExpectation.results = &BuildingsStorageMockGetBuildingsByIDsResults{b1, err}
+	return mmGetBuildingsByIDs.mock
+}
+
+// Set uses given function f to mock the BuildingsStorage.GetBuildingsByIDs method
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Set(f func(ctx context.Context, ids []int) (b1 models.BuildingSlice, err error)) *BuildingsStorageMock {
+	if mmGetBuildingsByIDs.defaultExpectation != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Default expectation is already set for the BuildingsStorage.GetBuildingsByIDs method")
+	}
+
+	if len(mmGetBuildingsByIDs.expectations) > 0 {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Some expectations are already set for the BuildingsStorage.GetBuildingsByIDs method")
+	}
+
+	mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs = f
+	return mmGetBuildingsByIDs.mock
+}
+
+// When sets expectation for the BuildingsStorage.GetBuildingsByIDs which will trigger the result defined by the following
+// Then helper
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) When(ctx context.Context, ids []int) *BuildingsStorageMockGetBuildingsByIDsExpectation {
+	if mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.mock.t.Fatalf("BuildingsStorageMock.GetBuildingsByIDs mock is already set by Set")
+	}
+
+	expectation := &BuildingsStorageMockGetBuildingsByIDsExpectation{
+		mock:   mmGetBuildingsByIDs.mock,
+		params: &BuildingsStorageMockGetBuildingsByIDsParams{ctx, ids},
+	}
+	mmGetBuildingsByIDs.expectations = append(mmGetBuildingsByIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BuildingsStorage.GetBuildingsByIDs return parameters for the expectation previously defined by the When method
+func (e *BuildingsStorageMockGetBuildingsByIDsExpectation) Then(b1 models.BuildingSlice, err error) *BuildingsStorageMock {
+	e.results = &BuildingsStorageMockGetBuildingsByIDsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times BuildingsStorage.GetBuildingsByIDs should be invoked
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Times(n uint64) *mBuildingsStorageMockGetBuildingsByIDs {
+	if n == 0 {
+		mmGetBuildingsByIDs.mock.t.Fatalf("Times of BuildingsStorageMock.GetBuildingsByIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBuildingsByIDs.expectedInvocations, n)
+	return mmGetBuildingsByIDs
+}
+
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) invocationsDone() bool {
+	if len(mmGetBuildingsByIDs.expectations) == 0 && mmGetBuildingsByIDs.defaultExpectation == nil && mmGetBuildingsByIDs.mock.funcGetBuildingsByIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBuildingsByIDs.mock.afterGetBuildingsByIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBuildingsByIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBuildingsByIDs implements storage.BuildingsStorage
+func (mmGetBuildingsByIDs *BuildingsStorageMock) GetBuildingsByIDs(ctx context.Context, ids []int) (b1 models.BuildingSlice, err error) {
+	mm_atomic.AddUint64(&mmGetBuildingsByIDs.beforeGetBuildingsByIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBuildingsByIDs.afterGetBuildingsByIDsCounter, 1)
+
+	if mmGetBuildingsByIDs.inspectFuncGetBuildingsByIDs != nil {
+		mmGetBuildingsByIDs.inspectFuncGetBuildingsByIDs(ctx, ids)
+	}
+
+	mm_params := BuildingsStorageMockGetBuildingsByIDsParams{ctx, ids}
+
+	// Record call args
+	mmGetBuildingsByIDs.GetBuildingsByIDsMock.mutex.Lock()
+	mmGetBuildingsByIDs.GetBuildingsByIDsMock.callArgs = append(mmGetBuildingsByIDs.GetBuildingsByIDsMock.callArgs, &mm_params)
+	mmGetBuildingsByIDs.GetBuildingsByIDsMock.mutex.Unlock()
+
+	for _, e := range mmGetBuildingsByIDs.GetBuildingsByIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := BuildingsStorageMockGetBuildingsByIDsParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBuildingsByIDs.t.Errorf("BuildingsStorageMock.GetBuildingsByIDs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmGetBuildingsByIDs.t.Errorf("BuildingsStorageMock.GetBuildingsByIDs got unexpected parameter ids, want: %#v, got: %#v%s\n", *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBuildingsByIDs.t.Errorf("BuildingsStorageMock.GetBuildingsByIDs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBuildingsByIDs.GetBuildingsByIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBuildingsByIDs.t.Fatal("No results are set for the BuildingsStorageMock.GetBuildingsByIDs")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmGetBuildingsByIDs.funcGetBuildingsByIDs != nil {
+		return mmGetBuildingsByIDs.funcGetBuildingsByIDs(ctx, ids)
+	}
+	mmGetBuildingsByIDs.t.Fatalf("Unexpected call to BuildingsStorageMock.GetBuildingsByIDs. %v %v", ctx, ids)
+	return
+}
+
+// GetBuildingsByIDsAfterCounter returns a count of finished BuildingsStorageMock.GetBuildingsByIDs invocations
+func (mmGetBuildingsByIDs *BuildingsStorageMock) GetBuildingsByIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsByIDs.afterGetBuildingsByIDsCounter)
+}
+
+// GetBuildingsByIDsBeforeCounter returns a count of BuildingsStorageMock.GetBuildingsByIDs invocations
+func (mmGetBuildingsByIDs *BuildingsStorageMock) GetBuildingsByIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBuildingsByIDs.beforeGetBuildingsByIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BuildingsStorageMock.GetBuildingsByIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBuildingsByIDs *mBuildingsStorageMockGetBuildingsByIDs) Calls() []*BuildingsStorageMockGetBuildingsByIDsParams {
+	mmGetBuildingsByIDs.mutex.RLock()
+
+	argCopy := make([]*BuildingsStorageMockGetBuildingsByIDsParams, len(mmGetBuildingsByIDs.callArgs))
+	copy(argCopy, mmGetBuildingsByIDs.callArgs)
+
+	mmGetBuildingsByIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBuildingsByIDsDone returns true if the count of the GetBuildingsByIDs invocations corresponds
+// the number of defined expectations
+func (m *BuildingsStorageMock) MinimockGetBuildingsByIDsDone() bool {
+	if m.GetBuildingsByIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBuildingsByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBuildingsByIDsMock.invocationsDone()
+}
+
+// MinimockGetBuildingsByIDsInspect logs each unmet expectation
+func (m *BuildingsStorageMock) MinimockGetBuildingsByIDsInspect() {
+	for _, e := range m.GetBuildingsByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BuildingsStorageMock.GetBuildingsByIDs with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBuildingsByIDsCounter := mm_atomic.LoadUint64(&m.afterGetBuildingsByIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBuildingsByIDsMock.defaultExpectation != nil && afterGetBuildingsByIDsCounter < 1 {
+		if m.GetBuildingsByIDsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BuildingsStorageMock.GetBuildingsByIDs")
+		} else {
+			m.t.Errorf("Expected call to BuildingsStorageMock.GetBuildingsByIDs with params: %#v", *m.GetBuildingsByIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBuildingsByIDs != nil && afterGetBuildingsByIDsCounter < 1 {
+		m.t.Error("Expected call to BuildingsStorageMock.GetBuildingsByIDs")
+	}
+
+	if !m.GetBuildingsByIDsMock.invocationsDone() && afterGetBuildingsByIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BuildingsStorageMock.GetBuildingsByIDs but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBuildingsByIDsMock.expectedInvocations), afterGetBuildingsByIDsCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *BuildingsStorageMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -1335,6 +1665,8 @@ func (m *BuildingsStorageMock) MinimockFinish() {
 			m.MinimockGetBuildingInspect()
 
 			m.MinimockGetBuildingsInspect()
+
+			m.MinimockGetBuildingsByIDsInspect()
 		}
 	})
 }
@@ -1361,5 +1693,6 @@ func (m *BuildingsStorageMock) minimockDone() bool {
 		m.MinimockCreateBuildingDone() &&
 		m.MinimockDeleteBuildingDone() &&
 		m.MinimockGetBuildingDone() &&
-		m.MinimockGetBuildingsDone()
+		m.MinimockGetBuildingsDone() &&
+		m.MinimockGetBuildingsByIDsDone()
 }
@@ -0,0 +1,1449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.FloorsStorage -o floors_storage_mock_test.go -n FloorsStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_storage "github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// FloorsStorageMock implements storage.FloorsStorage
+type FloorsStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateFloor          func(ctx context.Context, floor *mm_storage.Floor) (err error)
+	inspectFuncCreateFloor   func(ctx context.Context, floor *mm_storage.Floor)
+	afterCreateFloorCounter  uint64
+	beforeCreateFloorCounter uint64
+	CreateFloorMock          mFloorsStorageMockCreateFloor
+
+	funcDeleteFloor          func(ctx context.Context, buildingId int, number int) (i1 int64, err error)
+	inspectFuncDeleteFloor   func(ctx context.Context, buildingId int, number int)
+	afterDeleteFloorCounter  uint64
+	beforeDeleteFloorCounter uint64
+	DeleteFloorMock          mFloorsStorageMockDeleteFloor
+
+	funcGetFloor          func(ctx context.Context, buildingId int, number int) (fp1 *mm_storage.Floor, err error)
+	inspectFuncGetFloor   func(ctx context.Context, buildingId int, number int)
+	afterGetFloorCounter  uint64
+	beforeGetFloorCounter uint64
+	GetFloorMock          mFloorsStorageMockGetFloor
+
+	funcGetFloors          func(ctx context.Context, buildingId int) (fpa1 []*mm_storage.Floor, err error)
+	inspectFuncGetFloors   func(ctx context.Context, buildingId int)
+	afterGetFloorsCounter  uint64
+	beforeGetFloorsCounter uint64
+	GetFloorsMock          mFloorsStorageMockGetFloors
+}
+
+// NewFloorsStorageMock returns a mock for storage.FloorsStorage
+func NewFloorsStorageMock(t minimock.Tester) *FloorsStorageMock {
+	m := &FloorsStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateFloorMock = mFloorsStorageMockCreateFloor{mock: m}
+	m.CreateFloorMock.callArgs = []*FloorsStorageMockCreateFloorParams{}
+
+	m.DeleteFloorMock = mFloorsStorageMockDeleteFloor{mock: m}
+	m.DeleteFloorMock.callArgs = []*FloorsStorageMockDeleteFloorParams{}
+
+	m.GetFloorMock = mFloorsStorageMockGetFloor{mock: m}
+	m.GetFloorMock.callArgs = []*FloorsStorageMockGetFloorParams{}
+
+	m.GetFloorsMock = mFloorsStorageMockGetFloors{mock: m}
+	m.GetFloorsMock.callArgs = []*FloorsStorageMockGetFloorsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFloorsStorageMockCreateFloor struct {
+	optional           bool
+	mock               *FloorsStorageMock
+	defaultExpectation *FloorsStorageMockCreateFloorExpectation
+	expectations       []*FloorsStorageMockCreateFloorExpectation
+
+	callArgs []*FloorsStorageMockCreateFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsStorageMockCreateFloorExpectation specifies expectation struct of the FloorsStorage.CreateFloor
+type FloorsStorageMockCreateFloorExpectation struct {
+	mock      *FloorsStorageMock
+	params    *FloorsStorageMockCreateFloorParams
+	paramPtrs *FloorsStorageMockCreateFloorParamPtrs
+	results   *FloorsStorageMockCreateFloorResults
+	Counter   uint64
+}
+
+// FloorsStorageMockCreateFloorParams contains parameters of the FloorsStorage.CreateFloor
+type FloorsStorageMockCreateFloorParams struct {
+	ctx   context.Context
+	floor *mm_storage.Floor
+}
+
+// FloorsStorageMockCreateFloorParamPtrs contains pointers to parameters of the FloorsStorage.CreateFloor
+type FloorsStorageMockCreateFloorParamPtrs struct {
+	ctx   *context.Context
+	floor **mm_storage.Floor
+}
+
+// FloorsStorageMockCreateFloorResults contains results of the FloorsStorage.CreateFloor
+type FloorsStorageMockCreateFloorResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Optional() *mFloorsStorageMockCreateFloor {
+	mmCreateFloor.optional = true
+	return mmCreateFloor
+}
+
+// Expect sets up expected params for FloorsStorage.CreateFloor
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Expect(ctx context.Context, floor *mm_storage.Floor) *mFloorsStorageMockCreateFloor {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsStorageMockCreateFloorExpectation{}
+	}
+
+	if mmCreateFloor.defaultExpectation.paramPtrs != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by ExpectParams functions")
+	}
+
+	mmCreateFloor.defaultExpectation.params = &FloorsStorageMockCreateFloorParams{ctx, floor}
+	for _, e := range mmCreateFloor.expectations {
+		if minimock.Equal(e.params, mmCreateFloor.defaultExpectation.params) {
+			mmCreateFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsStorage.CreateFloor
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) ExpectCtxParam1(ctx context.Context) *mFloorsStorageMockCreateFloor {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsStorageMockCreateFloorExpectation{}
+	}
+
+	if mmCreateFloor.defaultExpectation.params != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Expect")
+	}
+
+	if mmCreateFloor.defaultExpectation.paramPtrs == nil {
+		mmCreateFloor.defaultExpectation.paramPtrs = &FloorsStorageMockCreateFloorParamPtrs{}
+	}
+	mmCreateFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateFloor
+}
+
+// ExpectFloorParam2 sets up expected param floor for FloorsStorage.CreateFloor
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) ExpectFloorParam2(floor *mm_storage.Floor) *mFloorsStorageMockCreateFloor {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsStorageMockCreateFloorExpectation{}
+	}
+
+	if mmCreateFloor.defaultExpectation.params != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Expect")
+	}
+
+	if mmCreateFloor.defaultExpectation.paramPtrs == nil {
+		mmCreateFloor.defaultExpectation.paramPtrs = &FloorsStorageMockCreateFloorParamPtrs{}
+	}
+	mmCreateFloor.defaultExpectation.paramPtrs.floor = &floor
+
+	return mmCreateFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsStorage.CreateFloor
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Inspect(f func(ctx context.Context, floor *mm_storage.Floor)) *mFloorsStorageMockCreateFloor {
+	if mmCreateFloor.mock.inspectFuncCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("Inspect function is already set for FloorsStorageMock.CreateFloor")
+	}
+
+	mmCreateFloor.mock.inspectFuncCreateFloor = f
+
+	return mmCreateFloor
+}
+
+// Return sets up results that will be returned by FloorsStorage.CreateFloor
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Return(err error) *FloorsStorageMock {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Set")
+	}
+
+	if mmCreateFloor.defaultExpectation == nil {
+		mmCreateFloor.defaultExpectation = &FloorsStorageMockCreateFloorExpectation{mock: mmCreateFloor.mock}
+	}
+	mmCreateFloor.defaultExpectation.results = &FloorsStorageMockCreateFloorResults{err}
+	return mmCreateFloor.mock
+}
+
+// Set uses given function f to mock the FloorsStorage.CreateFloor method
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Set(f func(ctx context.Context, floor *mm_storage.Floor) (err error)) *FloorsStorageMock {
+	if mmCreateFloor.defaultExpectation != nil {
+		mmCreateFloor.mock.t.Fatalf("Default expectation is already set for the FloorsStorage.CreateFloor method")
+	}
+
+	if len(mmCreateFloor.expectations) > 0 {
+		mmCreateFloor.mock.t.Fatalf("Some expectations are already set for the FloorsStorage.CreateFloor method")
+	}
+
+	mmCreateFloor.mock.funcCreateFloor = f
+	return mmCreateFloor.mock
+}
+
+// When sets expectation for the FloorsStorage.CreateFloor which will trigger the result defined by the following
+// Then helper
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) When(ctx context.Context, floor *mm_storage.Floor) *FloorsStorageMockCreateFloorExpectation {
+	if mmCreateFloor.mock.funcCreateFloor != nil {
+		mmCreateFloor.mock.t.Fatalf("FloorsStorageMock.CreateFloor mock is already set by Set")
+	}
+
+	expectation := &FloorsStorageMockCreateFloorExpectation{
+		mock:   mmCreateFloor.mock,
+		params: &FloorsStorageMockCreateFloorParams{ctx, floor},
+	}
+	mmCreateFloor.expectations = append(mmCreateFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsStorage.CreateFloor return parameters for the expectation previously defined by the When method
+func (e *FloorsStorageMockCreateFloorExpectation) Then(err error) *FloorsStorageMock {
+	e.results = &FloorsStorageMockCreateFloorResults{err}
+	return e.mock
+}
+
+// Times sets number of times FloorsStorage.CreateFloor should be invoked
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Times(n uint64) *mFloorsStorageMockCreateFloor {
+	if n == 0 {
+		mmCreateFloor.mock.t.Fatalf("Times of FloorsStorageMock.CreateFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateFloor.expectedInvocations, n)
+	return mmCreateFloor
+}
+
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) invocationsDone() bool {
+	if len(mmCreateFloor.expectations) == 0 && mmCreateFloor.defaultExpectation == nil && mmCreateFloor.mock.funcCreateFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateFloor.mock.afterCreateFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateFloor implements storage.FloorsStorage
+func (mmCreateFloor *FloorsStorageMock) CreateFloor(ctx context.Context, floor *mm_storage.Floor) (err error) {
+	mm_atomic.AddUint64(&mmCreateFloor.beforeCreateFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateFloor.afterCreateFloorCounter, 1)
+
+	if mmCreateFloor.inspectFuncCreateFloor != nil {
+		mmCreateFloor.inspectFuncCreateFloor(ctx, floor)
+	}
+
+	mm_params := FloorsStorageMockCreateFloorParams{ctx, floor}
+
+	// Record call args
+	mmCreateFloor.CreateFloorMock.mutex.Lock()
+	mmCreateFloor.CreateFloorMock.callArgs = append(mmCreateFloor.CreateFloorMock.callArgs, &mm_params)
+	mmCreateFloor.CreateFloorMock.mutex.Unlock()
+
+	for _, e := range mmCreateFloor.CreateFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateFloor.CreateFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateFloor.CreateFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateFloor.CreateFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateFloor.CreateFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsStorageMockCreateFloorParams{ctx, floor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateFloor.t.Errorf("FloorsStorageMock.CreateFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.floor != nil && !minimock.Equal(*mm_want_ptrs.floor, mm_got.floor) {
+				mmCreateFloor.t.Errorf("FloorsStorageMock.CreateFloor got unexpected parameter floor, want: %#v, got: %#v%s\n", *mm_want_ptrs.floor, mm_got.floor, minimock.Diff(*mm_want_ptrs.floor, mm_got.floor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateFloor.t.Errorf("FloorsStorageMock.CreateFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateFloor.CreateFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateFloor.t.Fatal("No results are set for the FloorsStorageMock.CreateFloor")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateFloor.funcCreateFloor != nil {
+		return mmCreateFloor.funcCreateFloor(ctx, floor)
+	}
+	mmCreateFloor.t.Fatalf("Unexpected call to FloorsStorageMock.CreateFloor. %v %v", ctx, floor)
+	return
+}
+
+// CreateFloorAfterCounter returns a count of finished FloorsStorageMock.CreateFloor invocations
+func (mmCreateFloor *FloorsStorageMock) CreateFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFloor.afterCreateFloorCounter)
+}
+
+// CreateFloorBeforeCounter returns a count of FloorsStorageMock.CreateFloor invocations
+func (mmCreateFloor *FloorsStorageMock) CreateFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFloor.beforeCreateFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsStorageMock.CreateFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateFloor *mFloorsStorageMockCreateFloor) Calls() []*FloorsStorageMockCreateFloorParams {
+	mmCreateFloor.mutex.RLock()
+
+	argCopy := make([]*FloorsStorageMockCreateFloorParams, len(mmCreateFloor.callArgs))
+	copy(argCopy, mmCreateFloor.callArgs)
+
+	mmCreateFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateFloorDone returns true if the count of the CreateFloor invocations corresponds
+// the number of defined expectations
+func (m *FloorsStorageMock) MinimockCreateFloorDone() bool {
+	if m.CreateFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateFloorMock.invocationsDone()
+}
+
+// MinimockCreateFloorInspect logs each unmet expectation
+func (m *FloorsStorageMock) MinimockCreateFloorInspect() {
+	for _, e := range m.CreateFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsStorageMock.CreateFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateFloorCounter := mm_atomic.LoadUint64(&m.afterCreateFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateFloorMock.defaultExpectation != nil && afterCreateFloorCounter < 1 {
+		if m.CreateFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsStorageMock.CreateFloor")
+		} else {
+			m.t.Errorf("Expected call to FloorsStorageMock.CreateFloor with params: %#v", *m.CreateFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateFloor != nil && afterCreateFloorCounter < 1 {
+		m.t.Error("Expected call to FloorsStorageMock.CreateFloor")
+	}
+
+	if !m.CreateFloorMock.invocationsDone() && afterCreateFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsStorageMock.CreateFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateFloorMock.expectedInvocations), afterCreateFloorCounter)
+	}
+}
+
+type mFloorsStorageMockDeleteFloor struct {
+	optional           bool
+	mock               *FloorsStorageMock
+	defaultExpectation *FloorsStorageMockDeleteFloorExpectation
+	expectations       []*FloorsStorageMockDeleteFloorExpectation
+
+	callArgs []*FloorsStorageMockDeleteFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsStorageMockDeleteFloorExpectation specifies expectation struct of the FloorsStorage.DeleteFloor
+type FloorsStorageMockDeleteFloorExpectation struct {
+	mock      *FloorsStorageMock
+	params    *FloorsStorageMockDeleteFloorParams
+	paramPtrs *FloorsStorageMockDeleteFloorParamPtrs
+	results   *FloorsStorageMockDeleteFloorResults
+	Counter   uint64
+}
+
+// FloorsStorageMockDeleteFloorParams contains parameters of the FloorsStorage.DeleteFloor
+type FloorsStorageMockDeleteFloorParams struct {
+	ctx        context.Context
+	buildingId int
+	number     int
+}
+
+// FloorsStorageMockDeleteFloorParamPtrs contains pointers to parameters of the FloorsStorage.DeleteFloor
+type FloorsStorageMockDeleteFloorParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	number     *int
+}
+
+// FloorsStorageMockDeleteFloorResults contains results of the FloorsStorage.DeleteFloor
+type FloorsStorageMockDeleteFloorResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Optional() *mFloorsStorageMockDeleteFloor {
+	mmDeleteFloor.optional = true
+	return mmDeleteFloor
+}
+
+// Expect sets up expected params for FloorsStorage.DeleteFloor
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Expect(ctx context.Context, buildingId int, number int) *mFloorsStorageMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsStorageMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFloor.defaultExpectation.params = &FloorsStorageMockDeleteFloorParams{ctx, buildingId, number}
+	for _, e := range mmDeleteFloor.expectations {
+		if minimock.Equal(e.params, mmDeleteFloor.defaultExpectation.params) {
+			mmDeleteFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsStorage.DeleteFloor
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) ExpectCtxParam1(ctx context.Context) *mFloorsStorageMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsStorageMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.params != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Expect")
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs == nil {
+		mmDeleteFloor.defaultExpectation.paramPtrs = &FloorsStorageMockDeleteFloorParamPtrs{}
+	}
+	mmDeleteFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteFloor
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for FloorsStorage.DeleteFloor
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) ExpectBuildingIdParam2(buildingId int) *mFloorsStorageMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsStorageMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.params != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Expect")
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs == nil {
+		mmDeleteFloor.defaultExpectation.paramPtrs = &FloorsStorageMockDeleteFloorParamPtrs{}
+	}
+	mmDeleteFloor.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmDeleteFloor
+}
+
+// ExpectNumberParam3 sets up expected param number for FloorsStorage.DeleteFloor
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) ExpectNumberParam3(number int) *mFloorsStorageMockDeleteFloor {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsStorageMockDeleteFloorExpectation{}
+	}
+
+	if mmDeleteFloor.defaultExpectation.params != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Expect")
+	}
+
+	if mmDeleteFloor.defaultExpectation.paramPtrs == nil {
+		mmDeleteFloor.defaultExpectation.paramPtrs = &FloorsStorageMockDeleteFloorParamPtrs{}
+	}
+	mmDeleteFloor.defaultExpectation.paramPtrs.number = &number
+
+	return mmDeleteFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsStorage.DeleteFloor
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Inspect(f func(ctx context.Context, buildingId int, number int)) *mFloorsStorageMockDeleteFloor {
+	if mmDeleteFloor.mock.inspectFuncDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("Inspect function is already set for FloorsStorageMock.DeleteFloor")
+	}
+
+	mmDeleteFloor.mock.inspectFuncDeleteFloor = f
+
+	return mmDeleteFloor
+}
+
+// Return sets up results that will be returned by FloorsStorage.DeleteFloor
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Return(i1 int64, err error) *FloorsStorageMock {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Set")
+	}
+
+	if mmDeleteFloor.defaultExpectation == nil {
+		mmDeleteFloor.defaultExpectation = &FloorsStorageMockDeleteFloorExpectation{mock: mmDeleteFloor.mock}
+	}
+	mmDeleteFloor.defaultExpectation.results = &FloorsStorageMockDeleteFloorResults{i1, err}
+	return mmDeleteFloor.mock
+}
+
+// Set uses given function f to mock the FloorsStorage.DeleteFloor method
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Set(f func(ctx context.Context, buildingId int, number int) (i1 int64, err error)) *FloorsStorageMock {
+	if mmDeleteFloor.defaultExpectation != nil {
+		mmDeleteFloor.mock.t.Fatalf("Default expectation is already set for the FloorsStorage.DeleteFloor method")
+	}
+
+	if len(mmDeleteFloor.expectations) > 0 {
+		mmDeleteFloor.mock.t.Fatalf("Some expectations are already set for the FloorsStorage.DeleteFloor method")
+	}
+
+	mmDeleteFloor.mock.funcDeleteFloor = f
+	return mmDeleteFloor.mock
+}
+
+// When sets expectation for the FloorsStorage.DeleteFloor which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) When(ctx context.Context, buildingId int, number int) *FloorsStorageMockDeleteFloorExpectation {
+	if mmDeleteFloor.mock.funcDeleteFloor != nil {
+		mmDeleteFloor.mock.t.Fatalf("FloorsStorageMock.DeleteFloor mock is already set by Set")
+	}
+
+	expectation := &FloorsStorageMockDeleteFloorExpectation{
+		mock:   mmDeleteFloor.mock,
+		params: &FloorsStorageMockDeleteFloorParams{ctx, buildingId, number},
+	}
+	mmDeleteFloor.expectations = append(mmDeleteFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsStorage.DeleteFloor return parameters for the expectation previously defined by the When method
+func (e *FloorsStorageMockDeleteFloorExpectation) Then(i1 int64, err error) *FloorsStorageMock {
+	e.results = &FloorsStorageMockDeleteFloorResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times FloorsStorage.DeleteFloor should be invoked
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Times(n uint64) *mFloorsStorageMockDeleteFloor {
+	if n == 0 {
+		mmDeleteFloor.mock.t.Fatalf("Times of FloorsStorageMock.DeleteFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFloor.expectedInvocations, n)
+	return mmDeleteFloor
+}
+
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) invocationsDone() bool {
+	if len(mmDeleteFloor.expectations) == 0 && mmDeleteFloor.defaultExpectation == nil && mmDeleteFloor.mock.funcDeleteFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFloor.mock.afterDeleteFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFloor implements storage.FloorsStorage
+func (mmDeleteFloor *FloorsStorageMock) DeleteFloor(ctx context.Context, buildingId int, number int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteFloor.beforeDeleteFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFloor.afterDeleteFloorCounter, 1)
+
+	if mmDeleteFloor.inspectFuncDeleteFloor != nil {
+		mmDeleteFloor.inspectFuncDeleteFloor(ctx, buildingId, number)
+	}
+
+	mm_params := FloorsStorageMockDeleteFloorParams{ctx, buildingId, number}
+
+	// Record call args
+	mmDeleteFloor.DeleteFloorMock.mutex.Lock()
+	mmDeleteFloor.DeleteFloorMock.callArgs = append(mmDeleteFloor.DeleteFloorMock.callArgs, &mm_params)
+	mmDeleteFloor.DeleteFloorMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFloor.DeleteFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteFloor.DeleteFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFloor.DeleteFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFloor.DeleteFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFloor.DeleteFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsStorageMockDeleteFloorParams{ctx, buildingId, number}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFloor.t.Errorf("FloorsStorageMock.DeleteFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmDeleteFloor.t.Errorf("FloorsStorageMock.DeleteFloor got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.number != nil && !minimock.Equal(*mm_want_ptrs.number, mm_got.number) {
+				mmDeleteFloor.t.Errorf("FloorsStorageMock.DeleteFloor got unexpected parameter number, want: %#v, got: %#v%s\n", *mm_want_ptrs.number, mm_got.number, minimock.Diff(*mm_want_ptrs.number, mm_got.number))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFloor.t.Errorf("FloorsStorageMock.DeleteFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFloor.DeleteFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFloor.t.Fatal("No results are set for the FloorsStorageMock.DeleteFloor")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteFloor.funcDeleteFloor != nil {
+		return mmDeleteFloor.funcDeleteFloor(ctx, buildingId, number)
+	}
+	mmDeleteFloor.t.Fatalf("Unexpected call to FloorsStorageMock.DeleteFloor. %v %v %v", ctx, buildingId, number)
+	return
+}
+
+// DeleteFloorAfterCounter returns a count of finished FloorsStorageMock.DeleteFloor invocations
+func (mmDeleteFloor *FloorsStorageMock) DeleteFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFloor.afterDeleteFloorCounter)
+}
+
+// DeleteFloorBeforeCounter returns a count of FloorsStorageMock.DeleteFloor invocations
+func (mmDeleteFloor *FloorsStorageMock) DeleteFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFloor.beforeDeleteFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsStorageMock.DeleteFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFloor *mFloorsStorageMockDeleteFloor) Calls() []*FloorsStorageMockDeleteFloorParams {
+	mmDeleteFloor.mutex.RLock()
+
+	argCopy := make([]*FloorsStorageMockDeleteFloorParams, len(mmDeleteFloor.callArgs))
+	copy(argCopy, mmDeleteFloor.callArgs)
+
+	mmDeleteFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFloorDone returns true if the count of the DeleteFloor invocations corresponds
+// the number of defined expectations
+func (m *FloorsStorageMock) MinimockDeleteFloorDone() bool {
+	if m.DeleteFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFloorMock.invocationsDone()
+}
+
+// MinimockDeleteFloorInspect logs each unmet expectation
+func (m *FloorsStorageMock) MinimockDeleteFloorInspect() {
+	for _, e := range m.DeleteFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsStorageMock.DeleteFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteFloorCounter := mm_atomic.LoadUint64(&m.afterDeleteFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFloorMock.defaultExpectation != nil && afterDeleteFloorCounter < 1 {
+		if m.DeleteFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsStorageMock.DeleteFloor")
+		} else {
+			m.t.Errorf("Expected call to FloorsStorageMock.DeleteFloor with params: %#v", *m.DeleteFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFloor != nil && afterDeleteFloorCounter < 1 {
+		m.t.Error("Expected call to FloorsStorageMock.DeleteFloor")
+	}
+
+	if !m.DeleteFloorMock.invocationsDone() && afterDeleteFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsStorageMock.DeleteFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFloorMock.expectedInvocations), afterDeleteFloorCounter)
+	}
+}
+
+type mFloorsStorageMockGetFloor struct {
+	optional           bool
+	mock               *FloorsStorageMock
+	defaultExpectation *FloorsStorageMockGetFloorExpectation
+	expectations       []*FloorsStorageMockGetFloorExpectation
+
+	callArgs []*FloorsStorageMockGetFloorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsStorageMockGetFloorExpectation specifies expectation struct of the FloorsStorage.GetFloor
+type FloorsStorageMockGetFloorExpectation struct {
+	mock      *FloorsStorageMock
+	params    *FloorsStorageMockGetFloorParams
+	paramPtrs *FloorsStorageMockGetFloorParamPtrs
+	results   *FloorsStorageMockGetFloorResults
+	Counter   uint64
+}
+
+// FloorsStorageMockGetFloorParams contains parameters of the FloorsStorage.GetFloor
+type FloorsStorageMockGetFloorParams struct {
+	ctx        context.Context
+	buildingId int
+	number     int
+}
+
+// FloorsStorageMockGetFloorParamPtrs contains pointers to parameters of the FloorsStorage.GetFloor
+type FloorsStorageMockGetFloorParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	number     *int
+}
+
+// FloorsStorageMockGetFloorResults contains results of the FloorsStorage.GetFloor
+type FloorsStorageMockGetFloorResults struct {
+	fp1 *mm_storage.Floor
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFloor *mFloorsStorageMockGetFloor) Optional() *mFloorsStorageMockGetFloor {
+	mmGetFloor.optional = true
+	return mmGetFloor
+}
+
+// Expect sets up expected params for FloorsStorage.GetFloor
+func (mmGetFloor *mFloorsStorageMockGetFloor) Expect(ctx context.Context, buildingId int, number int) *mFloorsStorageMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsStorageMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by ExpectParams functions")
+	}
+
+	mmGetFloor.defaultExpectation.params = &FloorsStorageMockGetFloorParams{ctx, buildingId, number}
+	for _, e := range mmGetFloor.expectations {
+		if minimock.Equal(e.params, mmGetFloor.defaultExpectation.params) {
+			mmGetFloor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFloor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFloor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsStorage.GetFloor
+func (mmGetFloor *mFloorsStorageMockGetFloor) ExpectCtxParam1(ctx context.Context) *mFloorsStorageMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsStorageMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.params != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Expect")
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs == nil {
+		mmGetFloor.defaultExpectation.paramPtrs = &FloorsStorageMockGetFloorParamPtrs{}
+	}
+	mmGetFloor.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetFloor
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for FloorsStorage.GetFloor
+func (mmGetFloor *mFloorsStorageMockGetFloor) ExpectBuildingIdParam2(buildingId int) *mFloorsStorageMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsStorageMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.params != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Expect")
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs == nil {
+		mmGetFloor.defaultExpectation.paramPtrs = &FloorsStorageMockGetFloorParamPtrs{}
+	}
+	mmGetFloor.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetFloor
+}
+
+// ExpectNumberParam3 sets up expected param number for FloorsStorage.GetFloor
+func (mmGetFloor *mFloorsStorageMockGetFloor) ExpectNumberParam3(number int) *mFloorsStorageMockGetFloor {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsStorageMockGetFloorExpectation{}
+	}
+
+	if mmGetFloor.defaultExpectation.params != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Expect")
+	}
+
+	if mmGetFloor.defaultExpectation.paramPtrs == nil {
+		mmGetFloor.defaultExpectation.paramPtrs = &FloorsStorageMockGetFloorParamPtrs{}
+	}
+	mmGetFloor.defaultExpectation.paramPtrs.number = &number
+
+	return mmGetFloor
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsStorage.GetFloor
+func (mmGetFloor *mFloorsStorageMockGetFloor) Inspect(f func(ctx context.Context, buildingId int, number int)) *mFloorsStorageMockGetFloor {
+	if mmGetFloor.mock.inspectFuncGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("Inspect function is already set for FloorsStorageMock.GetFloor")
+	}
+
+	mmGetFloor.mock.inspectFuncGetFloor = f
+
+	return mmGetFloor
+}
+
+// Return sets up results that will be returned by FloorsStorage.GetFloor
+func (mmGetFloor *mFloorsStorageMockGetFloor) Return(fp1 *mm_storage.Floor, err error) *FloorsStorageMock {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Set")
+	}
+
+	if mmGetFloor.defaultExpectation == nil {
+		mmGetFloor.defaultExpectation = &FloorsStorageMockGetFloorExpectation{mock: mmGetFloor.mock}
+	}
+	mmGetFloor.defaultExpectation.results = &FloorsStorageMockGetFloorResults{fp1, err}
+	return mmGetFloor.mock
+}
+
+// Set uses given function f to mock the FloorsStorage.GetFloor method
+func (mmGetFloor *mFloorsStorageMockGetFloor) Set(f func(ctx context.Context, buildingId int, number int) (fp1 *mm_storage.Floor, err error)) *FloorsStorageMock {
+	if mmGetFloor.defaultExpectation != nil {
+		mmGetFloor.mock.t.Fatalf("Default expectation is already set for the FloorsStorage.GetFloor method")
+	}
+
+	if len(mmGetFloor.expectations) > 0 {
+		mmGetFloor.mock.t.Fatalf("Some expectations are already set for the FloorsStorage.GetFloor method")
+	}
+
+	mmGetFloor.mock.funcGetFloor = f
+	return mmGetFloor.mock
+}
+
+// When sets expectation for the FloorsStorage.GetFloor which will trigger the result defined by the following
+// Then helper
+func (mmGetFloor *mFloorsStorageMockGetFloor) When(ctx context.Context, buildingId int, number int) *FloorsStorageMockGetFloorExpectation {
+	if mmGetFloor.mock.funcGetFloor != nil {
+		mmGetFloor.mock.t.Fatalf("FloorsStorageMock.GetFloor mock is already set by Set")
+	}
+
+	expectation := &FloorsStorageMockGetFloorExpectation{
+		mock:   mmGetFloor.mock,
+		params: &FloorsStorageMockGetFloorParams{ctx, buildingId, number},
+	}
+	mmGetFloor.expectations = append(mmGetFloor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsStorage.GetFloor return parameters for the expectation previously defined by the When method
+func (e *FloorsStorageMockGetFloorExpectation) Then(fp1 *mm_storage.Floor, err error) *FloorsStorageMock {
+	e.results = &FloorsStorageMockGetFloorResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times FloorsStorage.GetFloor should be invoked
+func (mmGetFloor *mFloorsStorageMockGetFloor) Times(n uint64) *mFloorsStorageMockGetFloor {
+	if n == 0 {
+		mmGetFloor.mock.t.Fatalf("Times of FloorsStorageMock.GetFloor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFloor.expectedInvocations, n)
+	return mmGetFloor
+}
+
+func (mmGetFloor *mFloorsStorageMockGetFloor) invocationsDone() bool {
+	if len(mmGetFloor.expectations) == 0 && mmGetFloor.defaultExpectation == nil && mmGetFloor.mock.funcGetFloor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFloor.mock.afterGetFloorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFloor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFloor implements storage.FloorsStorage
+func (mmGetFloor *FloorsStorageMock) GetFloor(ctx context.Context, buildingId int, number int) (fp1 *mm_storage.Floor, err error) {
+	mm_atomic.AddUint64(&mmGetFloor.beforeGetFloorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFloor.afterGetFloorCounter, 1)
+
+	if mmGetFloor.inspectFuncGetFloor != nil {
+		mmGetFloor.inspectFuncGetFloor(ctx, buildingId, number)
+	}
+
+	mm_params := FloorsStorageMockGetFloorParams{ctx, buildingId, number}
+
+	// Record call args
+	mmGetFloor.GetFloorMock.mutex.Lock()
+	mmGetFloor.GetFloorMock.callArgs = append(mmGetFloor.GetFloorMock.callArgs, &mm_params)
+	mmGetFloor.GetFloorMock.mutex.Unlock()
+
+	for _, e := range mmGetFloor.GetFloorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmGetFloor.GetFloorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFloor.GetFloorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFloor.GetFloorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFloor.GetFloorMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsStorageMockGetFloorParams{ctx, buildingId, number}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFloor.t.Errorf("FloorsStorageMock.GetFloor got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetFloor.t.Errorf("FloorsStorageMock.GetFloor got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.number != nil && !minimock.Equal(*mm_want_ptrs.number, mm_got.number) {
+				mmGetFloor.t.Errorf("FloorsStorageMock.GetFloor got unexpected parameter number, want: %#v, got: %#v%s\n", *mm_want_ptrs.number, mm_got.number, minimock.Diff(*mm_want_ptrs.number, mm_got.number))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFloor.t.Errorf("FloorsStorageMock.GetFloor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFloor.GetFloorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFloor.t.Fatal("No results are set for the FloorsStorageMock.GetFloor")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmGetFloor.funcGetFloor != nil {
+		return mmGetFloor.funcGetFloor(ctx, buildingId, number)
+	}
+	mmGetFloor.t.Fatalf("Unexpected call to FloorsStorageMock.GetFloor. %v %v %v", ctx, buildingId, number)
+	return
+}
+
+// GetFloorAfterCounter returns a count of finished FloorsStorageMock.GetFloor invocations
+func (mmGetFloor *FloorsStorageMock) GetFloorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloor.afterGetFloorCounter)
+}
+
+// GetFloorBeforeCounter returns a count of FloorsStorageMock.GetFloor invocations
+func (mmGetFloor *FloorsStorageMock) GetFloorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloor.beforeGetFloorCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsStorageMock.GetFloor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFloor *mFloorsStorageMockGetFloor) Calls() []*FloorsStorageMockGetFloorParams {
+	mmGetFloor.mutex.RLock()
+
+	argCopy := make([]*FloorsStorageMockGetFloorParams, len(mmGetFloor.callArgs))
+	copy(argCopy, mmGetFloor.callArgs)
+
+	mmGetFloor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFloorDone returns true if the count of the GetFloor invocations corresponds
+// the number of defined expectations
+func (m *FloorsStorageMock) MinimockGetFloorDone() bool {
+	if m.GetFloorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFloorMock.invocationsDone()
+}
+
+// MinimockGetFloorInspect logs each unmet expectation
+func (m *FloorsStorageMock) MinimockGetFloorInspect() {
+	for _, e := range m.GetFloorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsStorageMock.GetFloor with params: %#v", *e.params)
+		}
+	}
+
+	afterGetFloorCounter := mm_atomic.LoadUint64(&m.afterGetFloorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFloorMock.defaultExpectation != nil && afterGetFloorCounter < 1 {
+		if m.GetFloorMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsStorageMock.GetFloor")
+		} else {
+			m.t.Errorf("Expected call to FloorsStorageMock.GetFloor with params: %#v", *m.GetFloorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFloor != nil && afterGetFloorCounter < 1 {
+		m.t.Error("Expected call to FloorsStorageMock.GetFloor")
+	}
+
+	if !m.GetFloorMock.invocationsDone() && afterGetFloorCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsStorageMock.GetFloor but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFloorMock.expectedInvocations), afterGetFloorCounter)
+	}
+}
+
+type mFloorsStorageMockGetFloors struct {
+	optional           bool
+	mock               *FloorsStorageMock
+	defaultExpectation *FloorsStorageMockGetFloorsExpectation
+	expectations       []*FloorsStorageMockGetFloorsExpectation
+
+	callArgs []*FloorsStorageMockGetFloorsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// FloorsStorageMockGetFloorsExpectation specifies expectation struct of the FloorsStorage.GetFloors
+type FloorsStorageMockGetFloorsExpectation struct {
+	mock      *FloorsStorageMock
+	params    *FloorsStorageMockGetFloorsParams
+	paramPtrs *FloorsStorageMockGetFloorsParamPtrs
+	results   *FloorsStorageMockGetFloorsResults
+	Counter   uint64
+}
+
+// FloorsStorageMockGetFloorsParams contains parameters of the FloorsStorage.GetFloors
+type FloorsStorageMockGetFloorsParams struct {
+	ctx        context.Context
+	buildingId int
+}
+
+// FloorsStorageMockGetFloorsParamPtrs contains pointers to parameters of the FloorsStorage.GetFloors
+type FloorsStorageMockGetFloorsParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+}
+
+// FloorsStorageMockGetFloorsResults contains results of the FloorsStorage.GetFloors
+type FloorsStorageMockGetFloorsResults struct {
+	fpa1 []*mm_storage.Floor
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFloors *mFloorsStorageMockGetFloors) Optional() *mFloorsStorageMockGetFloors {
+	mmGetFloors.optional = true
+	return mmGetFloors
+}
+
+// Expect sets up expected params for FloorsStorage.GetFloors
+func (mmGetFloors *mFloorsStorageMockGetFloors) Expect(ctx context.Context, buildingId int) *mFloorsStorageMockGetFloors {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsStorageMockGetFloorsExpectation{}
+	}
+
+	if mmGetFloors.defaultExpectation.paramPtrs != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by ExpectParams functions")
+	}
+
+	mmGetFloors.defaultExpectation.params = &FloorsStorageMockGetFloorsParams{ctx, buildingId}
+	for _, e := range mmGetFloors.expectations {
+		if minimock.Equal(e.params, mmGetFloors.defaultExpectation.params) {
+			mmGetFloors.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFloors.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFloors
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FloorsStorage.GetFloors
+func (mmGetFloors *mFloorsStorageMockGetFloors) ExpectCtxParam1(ctx context.Context) *mFloorsStorageMockGetFloors {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsStorageMockGetFloorsExpectation{}
+	}
+
+	if mmGetFloors.defaultExpectation.params != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Expect")
+	}
+
+	if mmGetFloors.defaultExpectation.paramPtrs == nil {
+		mmGetFloors.defaultExpectation.paramPtrs = &FloorsStorageMockGetFloorsParamPtrs{}
+	}
+	mmGetFloors.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetFloors
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for FloorsStorage.GetFloors
+func (mmGetFloors *mFloorsStorageMockGetFloors) ExpectBuildingIdParam2(buildingId int) *mFloorsStorageMockGetFloors {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsStorageMockGetFloorsExpectation{}
+	}
+
+	if mmGetFloors.defaultExpectation.params != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Expect")
+	}
+
+	if mmGetFloors.defaultExpectation.paramPtrs == nil {
+		mmGetFloors.defaultExpectation.paramPtrs = &FloorsStorageMockGetFloorsParamPtrs{}
+	}
+	mmGetFloors.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetFloors
+}
+
+// Inspect accepts an inspector function that has same arguments as the FloorsStorage.GetFloors
+func (mmGetFloors *mFloorsStorageMockGetFloors) Inspect(f func(ctx context.Context, buildingId int)) *mFloorsStorageMockGetFloors {
+	if mmGetFloors.mock.inspectFuncGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("Inspect function is already set for FloorsStorageMock.GetFloors")
+	}
+
+	mmGetFloors.mock.inspectFuncGetFloors = f
+
+	return mmGetFloors
+}
+
+// Return sets up results that will be returned by FloorsStorage.GetFloors
+func (mmGetFloors *mFloorsStorageMockGetFloors) Return(fpa1 []*mm_storage.Floor, err error) *FloorsStorageMock {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Set")
+	}
+
+	if mmGetFloors.defaultExpectation == nil {
+		mmGetFloors.defaultExpectation = &FloorsStorageMockGetFloorsExpectation{mock: mmGetFloors.mock}
+	}
+	mmGetFloors.defaultExpectation.results = &FloorsStorageMockGetFloorsResults{fpa1, err}
+	return mmGetFloors.mock
+}
+
+// Set uses given function f to mock the FloorsStorage.GetFloors method
+func (mmGetFloors *mFloorsStorageMockGetFloors) Set(f func(ctx context.Context, buildingId int) (fpa1 []*mm_storage.Floor, err error)) *FloorsStorageMock {
+	if mmGetFloors.defaultExpectation != nil {
+		mmGetFloors.mock.t.Fatalf("Default expectation is already set for the FloorsStorage.GetFloors method")
+	}
+
+	if len(mmGetFloors.expectations) > 0 {
+		mmGetFloors.mock.t.Fatalf("Some expectations are already set for the FloorsStorage.GetFloors method")
+	}
+
+	mmGetFloors.mock.funcGetFloors = f
+	return mmGetFloors.mock
+}
+
+// When sets expectation for the FloorsStorage.GetFloors which will trigger the result defined by the following
+// Then helper
+func (mmGetFloors *mFloorsStorageMockGetFloors) When(ctx context.Context, buildingId int) *FloorsStorageMockGetFloorsExpectation {
+	if mmGetFloors.mock.funcGetFloors != nil {
+		mmGetFloors.mock.t.Fatalf("FloorsStorageMock.GetFloors mock is already set by Set")
+	}
+
+	expectation := &FloorsStorageMockGetFloorsExpectation{
+		mock:   mmGetFloors.mock,
+		params: &FloorsStorageMockGetFloorsParams{ctx, buildingId},
+	}
+	mmGetFloors.expectations = append(mmGetFloors.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FloorsStorage.GetFloors return parameters for the expectation previously defined by the When method
+func (e *FloorsStorageMockGetFloorsExpectation) Then(fpa1 []*mm_storage.Floor, err error) *FloorsStorageMock {
+	e.results = &FloorsStorageMockGetFloorsResults{fpa1, err}
+	return e.mock
+}
+
+// Times sets number of times FloorsStorage.GetFloors should be invoked
+func (mmGetFloors *mFloorsStorageMockGetFloors) Times(n uint64) *mFloorsStorageMockGetFloors {
+	if n == 0 {
+		mmGetFloors.mock.t.Fatalf("Times of FloorsStorageMock.GetFloors mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFloors.expectedInvocations, n)
+	return mmGetFloors
+}
+
+func (mmGetFloors *mFloorsStorageMockGetFloors) invocationsDone() bool {
+	if len(mmGetFloors.expectations) == 0 && mmGetFloors.defaultExpectation == nil && mmGetFloors.mock.funcGetFloors == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFloors.mock.afterGetFloorsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFloors.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFloors implements storage.FloorsStorage
+func (mmGetFloors *FloorsStorageMock) GetFloors(ctx context.Context, buildingId int) (fpa1 []*mm_storage.Floor, err error) {
+	mm_atomic.AddUint64(&mmGetFloors.beforeGetFloorsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFloors.afterGetFloorsCounter, 1)
+
+	if mmGetFloors.inspectFuncGetFloors != nil {
+		mmGetFloors.inspectFuncGetFloors(ctx, buildingId)
+	}
+
+	mm_params := FloorsStorageMockGetFloorsParams{ctx, buildingId}
+
+	// Record call args
+	mmGetFloors.GetFloorsMock.mutex.Lock()
+	mmGetFloors.GetFloorsMock.callArgs = append(mmGetFloors.GetFloorsMock.callArgs, &mm_params)
+	mmGetFloors.GetFloorsMock.mutex.Unlock()
+
+	for _, e := range mmGetFloors.GetFloorsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fpa1, e.results.err
+		}
+	}
+
+	if mmGetFloors.GetFloorsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFloors.GetFloorsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFloors.GetFloorsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFloors.GetFloorsMock.defaultExpectation.paramPtrs
+
+		mm_got := FloorsStorageMockGetFloorsParams{ctx, buildingId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFloors.t.Errorf("FloorsStorageMock.GetFloors got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetFloors.t.Errorf("FloorsStorageMock.GetFloors got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFloors.t.Errorf("FloorsStorageMock.GetFloors got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFloors.GetFloorsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFloors.t.Fatal("No results are set for the FloorsStorageMock.GetFloors")
+		}
+		return (*mm_results).fpa1, (*mm_results).err
+	}
+	if mmGetFloors.funcGetFloors != nil {
+		return mmGetFloors.funcGetFloors(ctx, buildingId)
+	}
+	mmGetFloors.t.Fatalf("Unexpected call to FloorsStorageMock.GetFloors. %v %v", ctx, buildingId)
+	return
+}
+
+// GetFloorsAfterCounter returns a count of finished FloorsStorageMock.GetFloors invocations
+func (mmGetFloors *FloorsStorageMock) GetFloorsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloors.afterGetFloorsCounter)
+}
+
+// GetFloorsBeforeCounter returns a count of FloorsStorageMock.GetFloors invocations
+func (mmGetFloors *FloorsStorageMock) GetFloorsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFloors.beforeGetFloorsCounter)
+}
+
+// Calls returns a list of arguments used in each call to FloorsStorageMock.GetFloors.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFloors *mFloorsStorageMockGetFloors) Calls() []*FloorsStorageMockGetFloorsParams {
+	mmGetFloors.mutex.RLock()
+
+	argCopy := make([]*FloorsStorageMockGetFloorsParams, len(mmGetFloors.callArgs))
+	copy(argCopy, mmGetFloors.callArgs)
+
+	mmGetFloors.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFloorsDone returns true if the count of the GetFloors invocations corresponds
+// the number of defined expectations
+func (m *FloorsStorageMock) MinimockGetFloorsDone() bool {
+	if m.GetFloorsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFloorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFloorsMock.invocationsDone()
+}
+
+// MinimockGetFloorsInspect logs each unmet expectation
+func (m *FloorsStorageMock) MinimockGetFloorsInspect() {
+	for _, e := range m.GetFloorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FloorsStorageMock.GetFloors with params: %#v", *e.params)
+		}
+	}
+
+	afterGetFloorsCounter := mm_atomic.LoadUint64(&m.afterGetFloorsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFloorsMock.defaultExpectation != nil && afterGetFloorsCounter < 1 {
+		if m.GetFloorsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FloorsStorageMock.GetFloors")
+		} else {
+			m.t.Errorf("Expected call to FloorsStorageMock.GetFloors with params: %#v", *m.GetFloorsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFloors != nil && afterGetFloorsCounter < 1 {
+		m.t.Error("Expected call to FloorsStorageMock.GetFloors")
+	}
+
+	if !m.GetFloorsMock.invocationsDone() && afterGetFloorsCounter > 0 {
+		m.t.Errorf("Expected %d calls to FloorsStorageMock.GetFloors but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFloorsMock.expectedInvocations), afterGetFloorsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FloorsStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateFloorInspect()
+
+			m.MinimockDeleteFloorInspect()
+
+			m.MinimockGetFloorInspect()
+
+			m.MinimockGetFloorsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FloorsStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FloorsStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateFloorDone() &&
+		m.MinimockDeleteFloorDone() &&
+		m.MinimockGetFloorDone() &&
+		m.MinimockGetFloorsDone()
+}
@@ -0,0 +1,1365 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.GrantsStorage -o grants_storage_mock_test.go -n GrantsStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_storage "github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// GrantsStorageMock implements storage.GrantsStorage
+type GrantsStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateGrant          func(ctx context.Context, grant *mm_storage.Grant) (err error)
+	inspectFuncCreateGrant   func(ctx context.Context, grant *mm_storage.Grant)
+	afterCreateGrantCounter  uint64
+	beforeCreateGrantCounter uint64
+	CreateGrantMock          mGrantsStorageMockCreateGrant
+
+	funcDeleteGrant          func(ctx context.Context, id int) (i1 int64, err error)
+	inspectFuncDeleteGrant   func(ctx context.Context, id int)
+	afterDeleteGrantCounter  uint64
+	beforeDeleteGrantCounter uint64
+	DeleteGrantMock          mGrantsStorageMockDeleteGrant
+
+	funcGetGrants          func(ctx context.Context) (gpa1 []*mm_storage.Grant, err error)
+	inspectFuncGetGrants   func(ctx context.Context)
+	afterGetGrantsCounter  uint64
+	beforeGetGrantsCounter uint64
+	GetGrantsMock          mGrantsStorageMockGetGrants
+
+	funcGetGrantsBySubject          func(ctx context.Context, subject string) (gpa1 []*mm_storage.Grant, err error)
+	inspectFuncGetGrantsBySubject   func(ctx context.Context, subject string)
+	afterGetGrantsBySubjectCounter  uint64
+	beforeGetGrantsBySubjectCounter uint64
+	GetGrantsBySubjectMock          mGrantsStorageMockGetGrantsBySubject
+}
+
+// NewGrantsStorageMock returns a mock for storage.GrantsStorage
+func NewGrantsStorageMock(t minimock.Tester) *GrantsStorageMock {
+	m := &GrantsStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateGrantMock = mGrantsStorageMockCreateGrant{mock: m}
+	m.CreateGrantMock.callArgs = []*GrantsStorageMockCreateGrantParams{}
+
+	m.DeleteGrantMock = mGrantsStorageMockDeleteGrant{mock: m}
+	m.DeleteGrantMock.callArgs = []*GrantsStorageMockDeleteGrantParams{}
+
+	m.GetGrantsMock = mGrantsStorageMockGetGrants{mock: m}
+	m.GetGrantsMock.callArgs = []*GrantsStorageMockGetGrantsParams{}
+
+	m.GetGrantsBySubjectMock = mGrantsStorageMockGetGrantsBySubject{mock: m}
+	m.GetGrantsBySubjectMock.callArgs = []*GrantsStorageMockGetGrantsBySubjectParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGrantsStorageMockCreateGrant struct {
+	optional           bool
+	mock               *GrantsStorageMock
+	defaultExpectation *GrantsStorageMockCreateGrantExpectation
+	expectations       []*GrantsStorageMockCreateGrantExpectation
+
+	callArgs []*GrantsStorageMockCreateGrantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GrantsStorageMockCreateGrantExpectation specifies expectation struct of the GrantsStorage.CreateGrant
+type GrantsStorageMockCreateGrantExpectation struct {
+	mock      *GrantsStorageMock
+	params    *GrantsStorageMockCreateGrantParams
+	paramPtrs *GrantsStorageMockCreateGrantParamPtrs
+	results   *GrantsStorageMockCreateGrantResults
+	Counter   uint64
+}
+
+// GrantsStorageMockCreateGrantParams contains parameters of the GrantsStorage.CreateGrant
+type GrantsStorageMockCreateGrantParams struct {
+	ctx   context.Context
+	grant *mm_storage.Grant
+}
+
+// GrantsStorageMockCreateGrantParamPtrs contains pointers to parameters of the GrantsStorage.CreateGrant
+type GrantsStorageMockCreateGrantParamPtrs struct {
+	ctx   *context.Context
+	grant **mm_storage.Grant
+}
+
+// GrantsStorageMockCreateGrantResults contains results of the GrantsStorage.CreateGrant
+type GrantsStorageMockCreateGrantResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Optional() *mGrantsStorageMockCreateGrant {
+	mmCreateGrant.optional = true
+	return mmCreateGrant
+}
+
+// Expect sets up expected params for GrantsStorage.CreateGrant
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Expect(ctx context.Context, grant *mm_storage.Grant) *mGrantsStorageMockCreateGrant {
+	if mmCreateGrant.mock.funcCreateGrant != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Set")
+	}
+
+	if mmCreateGrant.defaultExpectation == nil {
+		mmCreateGrant.defaultExpectation = &GrantsStorageMockCreateGrantExpectation{}
+	}
+
+	if mmCreateGrant.defaultExpectation.paramPtrs != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by ExpectParams functions")
+	}
+
+	mmCreateGrant.defaultExpectation.params = &GrantsStorageMockCreateGrantParams{ctx, grant}
+	for _, e := range mmCreateGrant.expectations {
+		if minimock.Equal(e.params, mmCreateGrant.defaultExpectation.params) {
+			mmCreateGrant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateGrant.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateGrant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GrantsStorage.CreateGrant
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) ExpectCtxParam1(ctx context.Context) *mGrantsStorageMockCreateGrant {
+	if mmCreateGrant.mock.funcCreateGrant != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Set")
+	}
+
+	if mmCreateGrant.defaultExpectation == nil {
+		mmCreateGrant.defaultExpectation = &GrantsStorageMockCreateGrantExpectation{}
+	}
+
+	if mmCreateGrant.defaultExpectation.params != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Expect")
+	}
+
+	if mmCreateGrant.defaultExpectation.paramPtrs == nil {
+		mmCreateGrant.defaultExpectation.paramPtrs = &GrantsStorageMockCreateGrantParamPtrs{}
+	}
+	mmCreateGrant.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateGrant
+}
+
+// ExpectGrantParam2 sets up expected param grant for GrantsStorage.CreateGrant
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) ExpectGrantParam2(grant *mm_storage.Grant) *mGrantsStorageMockCreateGrant {
+	if mmCreateGrant.mock.funcCreateGrant != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Set")
+	}
+
+	if mmCreateGrant.defaultExpectation == nil {
+		mmCreateGrant.defaultExpectation = &GrantsStorageMockCreateGrantExpectation{}
+	}
+
+	if mmCreateGrant.defaultExpectation.params != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Expect")
+	}
+
+	if mmCreateGrant.defaultExpectation.paramPtrs == nil {
+		mmCreateGrant.defaultExpectation.paramPtrs = &GrantsStorageMockCreateGrantParamPtrs{}
+	}
+	mmCreateGrant.defaultExpectation.paramPtrs.grant = &grant
+
+	return mmCreateGrant
+}
+
+// Inspect accepts an inspector function that has same arguments as the GrantsStorage.CreateGrant
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Inspect(f func(ctx context.Context, grant *mm_storage.Grant)) *mGrantsStorageMockCreateGrant {
+	if mmCreateGrant.mock.inspectFuncCreateGrant != nil {
+		mmCreateGrant.mock.t.Fatalf("Inspect function is already set for GrantsStorageMock.CreateGrant")
+	}
+
+	mmCreateGrant.mock.inspectFuncCreateGrant = f
+
+	return mmCreateGrant
+}
+
+// Return sets up results that will be returned by GrantsStorage.CreateGrant
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Return(err error) *GrantsStorageMock {
+	if mmCreateGrant.mock.funcCreateGrant != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Set")
+	}
+
+	if mmCreateGrant.defaultExpectation == nil {
+		mmCreateGrant.defaultExpectation = &GrantsStorageMockCreateGrantExpectation{mock: mmCreateGrant.mock}
+	}
+	mmCreateGrant.defaultExpectation.results = &GrantsStorageMockCreateGrantResults{err}
+	return mmCreateGrant.mock
+}
+
+// Set uses given function f to mock the GrantsStorage.CreateGrant method
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Set(f func(ctx context.Context, grant *mm_storage.Grant) (err error)) *GrantsStorageMock {
+	if mmCreateGrant.defaultExpectation != nil {
+		mmCreateGrant.mock.t.Fatalf("Default expectation is already set for the GrantsStorage.CreateGrant method")
+	}
+
+	if len(mmCreateGrant.expectations) > 0 {
+		mmCreateGrant.mock.t.Fatalf("Some expectations are already set for the GrantsStorage.CreateGrant method")
+	}
+
+	mmCreateGrant.mock.funcCreateGrant = f
+	return mmCreateGrant.mock
+}
+
+// When sets expectation for the GrantsStorage.CreateGrant which will trigger the result defined by the following
+// Then helper
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) When(ctx context.Context, grant *mm_storage.Grant) *GrantsStorageMockCreateGrantExpectation {
+	if mmCreateGrant.mock.funcCreateGrant != nil {
+		mmCreateGrant.mock.t.Fatalf("GrantsStorageMock.CreateGrant mock is already set by Set")
+	}
+
+	expectation := &GrantsStorageMockCreateGrantExpectation{
+		mock:   mmCreateGrant.mock,
+		params: &GrantsStorageMockCreateGrantParams{ctx, grant},
+	}
+	mmCreateGrant.expectations = append(mmCreateGrant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GrantsStorage.CreateGrant return parameters for the expectation previously defined by the When method
+func (e *GrantsStorageMockCreateGrantExpectation) Then(err error) *GrantsStorageMock {
+	e.results = &GrantsStorageMockCreateGrantResults{err}
+	return e.mock
+}
+
+// Times sets number of times GrantsStorage.CreateGrant should be invoked
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Times(n uint64) *mGrantsStorageMockCreateGrant {
+	if n == 0 {
+		mmCreateGrant.mock.t.Fatalf("Times of GrantsStorageMock.CreateGrant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateGrant.expectedInvocations, n)
+	return mmCreateGrant
+}
+
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) invocationsDone() bool {
+	if len(mmCreateGrant.expectations) == 0 && mmCreateGrant.defaultExpectation == nil && mmCreateGrant.mock.funcCreateGrant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateGrant.mock.afterCreateGrantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateGrant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateGrant implements storage.GrantsStorage
+func (mmCreateGrant *GrantsStorageMock) CreateGrant(ctx context.Context, grant *mm_storage.Grant) (err error) {
+	mm_atomic.AddUint64(&mmCreateGrant.beforeCreateGrantCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateGrant.afterCreateGrantCounter, 1)
+
+	if mmCreateGrant.inspectFuncCreateGrant != nil {
+		mmCreateGrant.inspectFuncCreateGrant(ctx, grant)
+	}
+
+	mm_params := GrantsStorageMockCreateGrantParams{ctx, grant}
+
+	// Record call args
+	mmCreateGrant.CreateGrantMock.mutex.Lock()
+	mmCreateGrant.CreateGrantMock.callArgs = append(mmCreateGrant.CreateGrantMock.callArgs, &mm_params)
+	mmCreateGrant.CreateGrantMock.mutex.Unlock()
+
+	for _, e := range mmCreateGrant.CreateGrantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateGrant.CreateGrantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateGrant.CreateGrantMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateGrant.CreateGrantMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateGrant.CreateGrantMock.defaultExpectation.paramPtrs
+
+		mm_got := GrantsStorageMockCreateGrantParams{ctx, grant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateGrant.t.Errorf("GrantsStorageMock.CreateGrant got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grant != nil && !minimock.Equal(*mm_want_ptrs.grant, mm_got.grant) {
+				mmCreateGrant.t.Errorf("GrantsStorageMock.CreateGrant got unexpected parameter grant, want: %#v, got: %#v%s\n", *mm_want_ptrs.grant, mm_got.grant, minimock.Diff(*mm_want_ptrs.grant, mm_got.grant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateGrant.t.Errorf("GrantsStorageMock.CreateGrant got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateGrant.CreateGrantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateGrant.t.Fatal("No results are set for the GrantsStorageMock.CreateGrant")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateGrant.funcCreateGrant != nil {
+		return mmCreateGrant.funcCreateGrant(ctx, grant)
+	}
+	mmCreateGrant.t.Fatalf("Unexpected call to GrantsStorageMock.CreateGrant. %v %v", ctx, grant)
+	return
+}
+
+// CreateGrantAfterCounter returns a count of finished GrantsStorageMock.CreateGrant invocations
+func (mmCreateGrant *GrantsStorageMock) CreateGrantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGrant.afterCreateGrantCounter)
+}
+
+// CreateGrantBeforeCounter returns a count of GrantsStorageMock.CreateGrant invocations
+func (mmCreateGrant *GrantsStorageMock) CreateGrantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGrant.beforeCreateGrantCounter)
+}
+
+// Calls returns a list of arguments used in each call to GrantsStorageMock.CreateGrant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateGrant *mGrantsStorageMockCreateGrant) Calls() []*GrantsStorageMockCreateGrantParams {
+	mmCreateGrant.mutex.RLock()
+
+	argCopy := make([]*GrantsStorageMockCreateGrantParams, len(mmCreateGrant.callArgs))
+	copy(argCopy, mmCreateGrant.callArgs)
+
+	mmCreateGrant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateGrantDone returns true if the count of the CreateGrant invocations corresponds
+// the number of defined expectations
+func (m *GrantsStorageMock) MinimockCreateGrantDone() bool {
+	if m.CreateGrantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateGrantMock.invocationsDone()
+}
+
+// MinimockCreateGrantInspect logs each unmet expectation
+func (m *GrantsStorageMock) MinimockCreateGrantInspect() {
+	for _, e := range m.CreateGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GrantsStorageMock.CreateGrant with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateGrantCounter := mm_atomic.LoadUint64(&m.afterCreateGrantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateGrantMock.defaultExpectation != nil && afterCreateGrantCounter < 1 {
+		if m.CreateGrantMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GrantsStorageMock.CreateGrant")
+		} else {
+			m.t.Errorf("Expected call to GrantsStorageMock.CreateGrant with params: %#v", *m.CreateGrantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateGrant != nil && afterCreateGrantCounter < 1 {
+		m.t.Error("Expected call to GrantsStorageMock.CreateGrant")
+	}
+
+	if !m.CreateGrantMock.invocationsDone() && afterCreateGrantCounter > 0 {
+		m.t.Errorf("Expected %d calls to GrantsStorageMock.CreateGrant but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateGrantMock.expectedInvocations), afterCreateGrantCounter)
+	}
+}
+
+type mGrantsStorageMockDeleteGrant struct {
+	optional           bool
+	mock               *GrantsStorageMock
+	defaultExpectation *GrantsStorageMockDeleteGrantExpectation
+	expectations       []*GrantsStorageMockDeleteGrantExpectation
+
+	callArgs []*GrantsStorageMockDeleteGrantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GrantsStorageMockDeleteGrantExpectation specifies expectation struct of the GrantsStorage.DeleteGrant
+type GrantsStorageMockDeleteGrantExpectation struct {
+	mock      *GrantsStorageMock
+	params    *GrantsStorageMockDeleteGrantParams
+	paramPtrs *GrantsStorageMockDeleteGrantParamPtrs
+	results   *GrantsStorageMockDeleteGrantResults
+	Counter   uint64
+}
+
+// GrantsStorageMockDeleteGrantParams contains parameters of the GrantsStorage.DeleteGrant
+type GrantsStorageMockDeleteGrantParams struct {
+	ctx context.Context
+	id  int
+}
+
+// GrantsStorageMockDeleteGrantParamPtrs contains pointers to parameters of the GrantsStorage.DeleteGrant
+type GrantsStorageMockDeleteGrantParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// GrantsStorageMockDeleteGrantResults contains results of the GrantsStorage.DeleteGrant
+type GrantsStorageMockDeleteGrantResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Optional() *mGrantsStorageMockDeleteGrant {
+	mmDeleteGrant.optional = true
+	return mmDeleteGrant
+}
+
+// Expect sets up expected params for GrantsStorage.DeleteGrant
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Expect(ctx context.Context, id int) *mGrantsStorageMockDeleteGrant {
+	if mmDeleteGrant.mock.funcDeleteGrant != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Set")
+	}
+
+	if mmDeleteGrant.defaultExpectation == nil {
+		mmDeleteGrant.defaultExpectation = &GrantsStorageMockDeleteGrantExpectation{}
+	}
+
+	if mmDeleteGrant.defaultExpectation.paramPtrs != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteGrant.defaultExpectation.params = &GrantsStorageMockDeleteGrantParams{ctx, id}
+	for _, e := range mmDeleteGrant.expectations {
+		if minimock.Equal(e.params, mmDeleteGrant.defaultExpectation.params) {
+			mmDeleteGrant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteGrant.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteGrant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GrantsStorage.DeleteGrant
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) ExpectCtxParam1(ctx context.Context) *mGrantsStorageMockDeleteGrant {
+	if mmDeleteGrant.mock.funcDeleteGrant != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Set")
+	}
+
+	if mmDeleteGrant.defaultExpectation == nil {
+		mmDeleteGrant.defaultExpectation = &GrantsStorageMockDeleteGrantExpectation{}
+	}
+
+	if mmDeleteGrant.defaultExpectation.params != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Expect")
+	}
+
+	if mmDeleteGrant.defaultExpectation.paramPtrs == nil {
+		mmDeleteGrant.defaultExpectation.paramPtrs = &GrantsStorageMockDeleteGrantParamPtrs{}
+	}
+	mmDeleteGrant.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteGrant
+}
+
+// ExpectIdParam2 sets up expected param id for GrantsStorage.DeleteGrant
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) ExpectIdParam2(id int) *mGrantsStorageMockDeleteGrant {
+	if mmDeleteGrant.mock.funcDeleteGrant != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Set")
+	}
+
+	if mmDeleteGrant.defaultExpectation == nil {
+		mmDeleteGrant.defaultExpectation = &GrantsStorageMockDeleteGrantExpectation{}
+	}
+
+	if mmDeleteGrant.defaultExpectation.params != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Expect")
+	}
+
+	if mmDeleteGrant.defaultExpectation.paramPtrs == nil {
+		mmDeleteGrant.defaultExpectation.paramPtrs = &GrantsStorageMockDeleteGrantParamPtrs{}
+	}
+	mmDeleteGrant.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteGrant
+}
+
+// Inspect accepts an inspector function that has same arguments as the GrantsStorage.DeleteGrant
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Inspect(f func(ctx context.Context, id int)) *mGrantsStorageMockDeleteGrant {
+	if mmDeleteGrant.mock.inspectFuncDeleteGrant != nil {
+		mmDeleteGrant.mock.t.Fatalf("Inspect function is already set for GrantsStorageMock.DeleteGrant")
+	}
+
+	mmDeleteGrant.mock.inspectFuncDeleteGrant = f
+
+	return mmDeleteGrant
+}
+
+// Return sets up results that will be returned by GrantsStorage.DeleteGrant
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Return(i1 int64, err error) *GrantsStorageMock {
+	if mmDeleteGrant.mock.funcDeleteGrant != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Set")
+	}
+
+	if mmDeleteGrant.defaultExpectation == nil {
+		mmDeleteGrant.defaultExpectation = &GrantsStorageMockDeleteGrantExpectation{mock: mmDeleteGrant.mock}
+	}
+	mmDeleteGrant.defaultExpectation.results = &GrantsStorageMockDeleteGrantResults{i1, err}
+	return mmDeleteGrant.mock
+}
+
+// Set uses given function f to mock the GrantsStorage.DeleteGrant method
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Set(f func(ctx context.Context, id int) (i1 int64, err error)) *GrantsStorageMock {
+	if mmDeleteGrant.defaultExpectation != nil {
+		mmDeleteGrant.mock.t.Fatalf("Default expectation is already set for the GrantsStorage.DeleteGrant method")
+	}
+
+	if len(mmDeleteGrant.expectations) > 0 {
+		mmDeleteGrant.mock.t.Fatalf("Some expectations are already set for the GrantsStorage.DeleteGrant method")
+	}
+
+	mmDeleteGrant.mock.funcDeleteGrant = f
+	return mmDeleteGrant.mock
+}
+
+// When sets expectation for the GrantsStorage.DeleteGrant which will trigger the result defined by the following
+// Then helper
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) When(ctx context.Context, id int) *GrantsStorageMockDeleteGrantExpectation {
+	if mmDeleteGrant.mock.funcDeleteGrant != nil {
+		mmDeleteGrant.mock.t.Fatalf("GrantsStorageMock.DeleteGrant mock is already set by Set")
+	}
+
+	expectation := &GrantsStorageMockDeleteGrantExpectation{
+		mock:   mmDeleteGrant.mock,
+		params: &GrantsStorageMockDeleteGrantParams{ctx, id},
+	}
+	mmDeleteGrant.expectations = append(mmDeleteGrant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GrantsStorage.DeleteGrant return parameters for the expectation previously defined by the When method
+func (e *GrantsStorageMockDeleteGrantExpectation) Then(i1 int64, err error) *GrantsStorageMock {
+	e.results = &GrantsStorageMockDeleteGrantResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times GrantsStorage.DeleteGrant should be invoked
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Times(n uint64) *mGrantsStorageMockDeleteGrant {
+	if n == 0 {
+		mmDeleteGrant.mock.t.Fatalf("Times of GrantsStorageMock.DeleteGrant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteGrant.expectedInvocations, n)
+	return mmDeleteGrant
+}
+
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) invocationsDone() bool {
+	if len(mmDeleteGrant.expectations) == 0 && mmDeleteGrant.defaultExpectation == nil && mmDeleteGrant.mock.funcDeleteGrant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteGrant.mock.afterDeleteGrantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteGrant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteGrant implements storage.GrantsStorage
+func (mmDeleteGrant *GrantsStorageMock) DeleteGrant(ctx context.Context, id int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteGrant.beforeDeleteGrantCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteGrant.afterDeleteGrantCounter, 1)
+
+	if mmDeleteGrant.inspectFuncDeleteGrant != nil {
+		mmDeleteGrant.inspectFuncDeleteGrant(ctx, id)
+	}
+
+	mm_params := GrantsStorageMockDeleteGrantParams{ctx, id}
+
+	// Record call args
+	mmDeleteGrant.DeleteGrantMock.mutex.Lock()
+	mmDeleteGrant.DeleteGrantMock.callArgs = append(mmDeleteGrant.DeleteGrantMock.callArgs, &mm_params)
+	mmDeleteGrant.DeleteGrantMock.mutex.Unlock()
+
+	for _, e := range mmDeleteGrant.DeleteGrantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteGrant.DeleteGrantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteGrant.DeleteGrantMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteGrant.DeleteGrantMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteGrant.DeleteGrantMock.defaultExpectation.paramPtrs
+
+		mm_got := GrantsStorageMockDeleteGrantParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteGrant.t.Errorf("GrantsStorageMock.DeleteGrant got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteGrant.t.Errorf("GrantsStorageMock.DeleteGrant got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteGrant.t.Errorf("GrantsStorageMock.DeleteGrant got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteGrant.DeleteGrantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteGrant.t.Fatal("No results are set for the GrantsStorageMock.DeleteGrant")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteGrant.funcDeleteGrant != nil {
+		return mmDeleteGrant.funcDeleteGrant(ctx, id)
+	}
+	mmDeleteGrant.t.Fatalf("Unexpected call to GrantsStorageMock.DeleteGrant. %v %v", ctx, id)
+	return
+}
+
+// DeleteGrantAfterCounter returns a count of finished GrantsStorageMock.DeleteGrant invocations
+func (mmDeleteGrant *GrantsStorageMock) DeleteGrantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGrant.afterDeleteGrantCounter)
+}
+
+// DeleteGrantBeforeCounter returns a count of GrantsStorageMock.DeleteGrant invocations
+func (mmDeleteGrant *GrantsStorageMock) DeleteGrantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGrant.beforeDeleteGrantCounter)
+}
+
+// Calls returns a list of arguments used in each call to GrantsStorageMock.DeleteGrant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteGrant *mGrantsStorageMockDeleteGrant) Calls() []*GrantsStorageMockDeleteGrantParams {
+	mmDeleteGrant.mutex.RLock()
+
+	argCopy := make([]*GrantsStorageMockDeleteGrantParams, len(mmDeleteGrant.callArgs))
+	copy(argCopy, mmDeleteGrant.callArgs)
+
+	mmDeleteGrant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteGrantDone returns true if the count of the DeleteGrant invocations corresponds
+// the number of defined expectations
+func (m *GrantsStorageMock) MinimockDeleteGrantDone() bool {
+	if m.DeleteGrantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteGrantMock.invocationsDone()
+}
+
+// MinimockDeleteGrantInspect logs each unmet expectation
+func (m *GrantsStorageMock) MinimockDeleteGrantInspect() {
+	for _, e := range m.DeleteGrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GrantsStorageMock.DeleteGrant with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteGrantCounter := mm_atomic.LoadUint64(&m.afterDeleteGrantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteGrantMock.defaultExpectation != nil && afterDeleteGrantCounter < 1 {
+		if m.DeleteGrantMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GrantsStorageMock.DeleteGrant")
+		} else {
+			m.t.Errorf("Expected call to GrantsStorageMock.DeleteGrant with params: %#v", *m.DeleteGrantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteGrant != nil && afterDeleteGrantCounter < 1 {
+		m.t.Error("Expected call to GrantsStorageMock.DeleteGrant")
+	}
+
+	if !m.DeleteGrantMock.invocationsDone() && afterDeleteGrantCounter > 0 {
+		m.t.Errorf("Expected %d calls to GrantsStorageMock.DeleteGrant but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteGrantMock.expectedInvocations), afterDeleteGrantCounter)
+	}
+}
+
+type mGrantsStorageMockGetGrants struct {
+	optional           bool
+	mock               *GrantsStorageMock
+	defaultExpectation *GrantsStorageMockGetGrantsExpectation
+	expectations       []*GrantsStorageMockGetGrantsExpectation
+
+	callArgs []*GrantsStorageMockGetGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GrantsStorageMockGetGrantsExpectation specifies expectation struct of the GrantsStorage.GetGrants
+type GrantsStorageMockGetGrantsExpectation struct {
+	mock      *GrantsStorageMock
+	params    *GrantsStorageMockGetGrantsParams
+	paramPtrs *GrantsStorageMockGetGrantsParamPtrs
+	results   *GrantsStorageMockGetGrantsResults
+	Counter   uint64
+}
+
+// GrantsStorageMockGetGrantsParams contains parameters of the GrantsStorage.GetGrants
+type GrantsStorageMockGetGrantsParams struct {
+	ctx context.Context
+}
+
+// GrantsStorageMockGetGrantsParamPtrs contains pointers to parameters of the GrantsStorage.GetGrants
+type GrantsStorageMockGetGrantsParamPtrs struct {
+	ctx *context.Context
+}
+
+// GrantsStorageMockGetGrantsResults contains results of the GrantsStorage.GetGrants
+type GrantsStorageMockGetGrantsResults struct {
+	gpa1 []*mm_storage.Grant
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetGrants *mGrantsStorageMockGetGrants) Optional() *mGrantsStorageMockGetGrants {
+	mmGetGrants.optional = true
+	return mmGetGrants
+}
+
+// Expect sets up expected params for GrantsStorage.GetGrants
+func (mmGetGrants *mGrantsStorageMockGetGrants) Expect(ctx context.Context) *mGrantsStorageMockGetGrants {
+	if mmGetGrants.mock.funcGetGrants != nil {
+		mmGetGrants.mock.t.Fatalf("GrantsStorageMock.GetGrants mock is already set by Set")
+	}
+
+	if mmGetGrants.defaultExpectation == nil {
+		mmGetGrants.defaultExpectation = &GrantsStorageMockGetGrantsExpectation{}
+	}
+
+	if mmGetGrants.defaultExpectation.paramPtrs != nil {
+		mmGetGrants.mock.t.Fatalf("GrantsStorageMock.GetGrants mock is already set by ExpectParams functions")
+	}
+
+	mmGetGrants.defaultExpectation.params = &GrantsStorageMockGetGrantsParams{ctx}
+	for _, e := range mmGetGrants.expectations {
+		if minimock.Equal(e.params, mmGetGrants.defaultExpectation.params) {
+			mmGetGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmGetGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GrantsStorage.GetGrants
+func (mmGetGrants *mGrantsStorageMockGetGrants) ExpectCtxParam1(ctx context.Context) *mGrantsStorageMockGetGrants {
+	if mmGetGrants.mock.funcGetGrants != nil {
+		mmGetGrants.mock.t.Fatalf("GrantsStorageMock.GetGrants mock is already set by Set")
+	}
+
+	if mmGetGrants.defaultExpectation == nil {
+		mmGetGrants.defaultExpectation = &GrantsStorageMockGetGrantsExpectation{}
+	}
+
+	if mmGetGrants.defaultExpectation.params != nil {
+		mmGetGrants.mock.t.Fatalf("GrantsStorageMock.GetGrants mock is already set by Expect")
+	}
+
+	if mmGetGrants.defaultExpectation.paramPtrs == nil {
+		mmGetGrants.defaultExpectation.paramPtrs = &GrantsStorageMockGetGrantsParamPtrs{}
+	}
+	mmGetGrants.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the GrantsStorage.GetGrants
+func (mmGetGrants *mGrantsStorageMockGetGrants) Inspect(f func(ctx context.Context)) *mGrantsStorageMockGetGrants {
+	if mmGetGrants.mock.inspectFuncGetGrants != nil {
+		mmGetGrants.mock.t.Fatalf("Inspect function is already set for GrantsStorageMock.GetGrants")
+	}
+
+	mmGetGrants.mock.inspectFuncGetGrants = f
+
+	return mmGetGrants
+}
+
+// Return sets up results that will be returned by GrantsStorage.GetGrants
+func (mmGetGrants *mGrantsStorageMockGetGrants) Return(gpa1 []*mm_storage.Grant, err error) *GrantsStorageMock {
+	if mmGetGrants.mock.funcGetGrants != nil {
+		mmGetGrants.mock.t.Fatalf("GrantsStorageMock.GetGrants mock is already set by Set")
+	}
+
+	if mmGetGrants.defaultExpectation == nil {
+		mmGetGrants.defaultExpectation = &GrantsStorageMockGetGrantsExpectation{mock: mmGetGrants.mock}
+	}
+	mmGetGrants.defaultExpectation.results = &GrantsStorageMockGetGrantsResults{gpa1, err}
+	return mmGetGrants.mock
+}
+
+// Set uses given function f to mock the GrantsStorage.GetGrants method
+func (mmGetGrants *mGrantsStorageMockGetGrants) Set(f func(ctx context.Context) (gpa1 []*mm_storage.Grant, err error)) *GrantsStorageMock {
+	if mmGetGrants.defaultExpectation != nil {
+		mmGetGrants.mock.t.Fatalf("Default expectation is already set for the GrantsStorage.GetGrants method")
+	}
+
+	if len(mmGetGrants.expectations) > 0 {
+		mmGetGrants.mock.t.Fatalf("Some expectations are already set for the GrantsStorage.GetGrants method")
+	}
+
+	mmGetGrants.mock.funcGetGrants = f
+	return mmGetGrants.mock
+}
+
+// When sets expectation for the GrantsStorage.GetGrants which will trigger the result defined by the following
+// Then helper
+func (mmGetGrants *mGrantsStorageMockGetGrants) When(ctx context.Context) *GrantsStorageMockGetGrantsExpectation {
+	if mmGetGrants.mock.funcGetGrants != nil {
+		mmGetGrants.mock.t.Fatalf("GrantsStorageMock.GetGrants mock is already set by Set")
+	}
+
+	expectation := &GrantsStorageMockGetGrantsExpectation{
+		mock:   mmGetGrants.mock,
+		params: &GrantsStorageMockGetGrantsParams{ctx},
+	}
+	mmGetGrants.expectations = append(mmGetGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GrantsStorage.GetGrants return parameters for the expectation previously defined by the When method
+func (e *GrantsStorageMockGetGrantsExpectation) Then(gpa1 []*mm_storage.Grant, err error) *GrantsStorageMock {
+	e.results = &GrantsStorageMockGetGrantsResults{gpa1, err}
+	return e.mock
+}
+
+// Times sets number of times GrantsStorage.GetGrants should be invoked
+func (mmGetGrants *mGrantsStorageMockGetGrants) Times(n uint64) *mGrantsStorageMockGetGrants {
+	if n == 0 {
+		mmGetGrants.mock.t.Fatalf("Times of GrantsStorageMock.GetGrants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetGrants.expectedInvocations, n)
+	return mmGetGrants
+}
+
+func (mmGetGrants *mGrantsStorageMockGetGrants) invocationsDone() bool {
+	if len(mmGetGrants.expectations) == 0 && mmGetGrants.defaultExpectation == nil && mmGetGrants.mock.funcGetGrants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetGrants.mock.afterGetGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetGrants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetGrants implements storage.GrantsStorage
+func (mmGetGrants *GrantsStorageMock) GetGrants(ctx context.Context) (gpa1 []*mm_storage.Grant, err error) {
+	mm_atomic.AddUint64(&mmGetGrants.beforeGetGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetGrants.afterGetGrantsCounter, 1)
+
+	if mmGetGrants.inspectFuncGetGrants != nil {
+		mmGetGrants.inspectFuncGetGrants(ctx)
+	}
+
+	mm_params := GrantsStorageMockGetGrantsParams{ctx}
+
+	// Record call args
+	mmGetGrants.GetGrantsMock.mutex.Lock()
+	mmGetGrants.GetGrantsMock.callArgs = append(mmGetGrants.GetGrantsMock.callArgs, &mm_params)
+	mmGetGrants.GetGrantsMock.mutex.Unlock()
+
+	for _, e := range mmGetGrants.GetGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gpa1, e.results.err
+		}
+	}
+
+	if mmGetGrants.GetGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetGrants.GetGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetGrants.GetGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetGrants.GetGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := GrantsStorageMockGetGrantsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetGrants.t.Errorf("GrantsStorageMock.GetGrants got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetGrants.t.Errorf("GrantsStorageMock.GetGrants got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetGrants.GetGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetGrants.t.Fatal("No results are set for the GrantsStorageMock.GetGrants")
+		}
+		return (*mm_results).gpa1, (*mm_results).err
+	}
+	if mmGetGrants.funcGetGrants != nil {
+		return mmGetGrants.funcGetGrants(ctx)
+	}
+	mmGetGrants.t.Fatalf("Unexpected call to GrantsStorageMock.GetGrants. %v", ctx)
+	return
+}
+
+// GetGrantsAfterCounter returns a count of finished GrantsStorageMock.GetGrants invocations
+func (mmGetGrants *GrantsStorageMock) GetGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGrants.afterGetGrantsCounter)
+}
+
+// GetGrantsBeforeCounter returns a count of GrantsStorageMock.GetGrants invocations
+func (mmGetGrants *GrantsStorageMock) GetGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGrants.beforeGetGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to GrantsStorageMock.GetGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetGrants *mGrantsStorageMockGetGrants) Calls() []*GrantsStorageMockGetGrantsParams {
+	mmGetGrants.mutex.RLock()
+
+	argCopy := make([]*GrantsStorageMockGetGrantsParams, len(mmGetGrants.callArgs))
+	copy(argCopy, mmGetGrants.callArgs)
+
+	mmGetGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetGrantsDone returns true if the count of the GetGrants invocations corresponds
+// the number of defined expectations
+func (m *GrantsStorageMock) MinimockGetGrantsDone() bool {
+	if m.GetGrantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetGrantsMock.invocationsDone()
+}
+
+// MinimockGetGrantsInspect logs each unmet expectation
+func (m *GrantsStorageMock) MinimockGetGrantsInspect() {
+	for _, e := range m.GetGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GrantsStorageMock.GetGrants with params: %#v", *e.params)
+		}
+	}
+
+	afterGetGrantsCounter := mm_atomic.LoadUint64(&m.afterGetGrantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetGrantsMock.defaultExpectation != nil && afterGetGrantsCounter < 1 {
+		if m.GetGrantsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GrantsStorageMock.GetGrants")
+		} else {
+			m.t.Errorf("Expected call to GrantsStorageMock.GetGrants with params: %#v", *m.GetGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetGrants != nil && afterGetGrantsCounter < 1 {
+		m.t.Error("Expected call to GrantsStorageMock.GetGrants")
+	}
+
+	if !m.GetGrantsMock.invocationsDone() && afterGetGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to GrantsStorageMock.GetGrants but found %d calls",
+			mm_atomic.LoadUint64(&m.GetGrantsMock.expectedInvocations), afterGetGrantsCounter)
+	}
+}
+
+type mGrantsStorageMockGetGrantsBySubject struct {
+	optional           bool
+	mock               *GrantsStorageMock
+	defaultExpectation *GrantsStorageMockGetGrantsBySubjectExpectation
+	expectations       []*GrantsStorageMockGetGrantsBySubjectExpectation
+
+	callArgs []*GrantsStorageMockGetGrantsBySubjectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GrantsStorageMockGetGrantsBySubjectExpectation specifies expectation struct of the GrantsStorage.GetGrantsBySubject
+type GrantsStorageMockGetGrantsBySubjectExpectation struct {
+	mock      *GrantsStorageMock
+	params    *GrantsStorageMockGetGrantsBySubjectParams
+	paramPtrs *GrantsStorageMockGetGrantsBySubjectParamPtrs
+	results   *GrantsStorageMockGetGrantsBySubjectResults
+	Counter   uint64
+}
+
+// GrantsStorageMockGetGrantsBySubjectParams contains parameters of the GrantsStorage.GetGrantsBySubject
+type GrantsStorageMockGetGrantsBySubjectParams struct {
+	ctx     context.Context
+	subject string
+}
+
+// GrantsStorageMockGetGrantsBySubjectParamPtrs contains pointers to parameters of the GrantsStorage.GetGrantsBySubject
+type GrantsStorageMockGetGrantsBySubjectParamPtrs struct {
+	ctx     *context.Context
+	subject *string
+}
+
+// GrantsStorageMockGetGrantsBySubjectResults contains results of the GrantsStorage.GetGrantsBySubject
+type GrantsStorageMockGetGrantsBySubjectResults struct {
+	gpa1 []*mm_storage.Grant
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Optional() *mGrantsStorageMockGetGrantsBySubject {
+	mmGetGrantsBySubject.optional = true
+	return mmGetGrantsBySubject
+}
+
+// Expect sets up expected params for GrantsStorage.GetGrantsBySubject
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Expect(ctx context.Context, subject string) *mGrantsStorageMockGetGrantsBySubject {
+	if mmGetGrantsBySubject.mock.funcGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Set")
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation == nil {
+		mmGetGrantsBySubject.defaultExpectation = &GrantsStorageMockGetGrantsBySubjectExpectation{}
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation.paramPtrs != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by ExpectParams functions")
+	}
+
+	mmGetGrantsBySubject.defaultExpectation.params = &GrantsStorageMockGetGrantsBySubjectParams{ctx, subject}
+	for _, e := range mmGetGrantsBySubject.expectations {
+		if minimock.Equal(e.params, mmGetGrantsBySubject.defaultExpectation.params) {
+			mmGetGrantsBySubject.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetGrantsBySubject.defaultExpectation.params)
+		}
+	}
+
+	return mmGetGrantsBySubject
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GrantsStorage.GetGrantsBySubject
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) ExpectCtxParam1(ctx context.Context) *mGrantsStorageMockGetGrantsBySubject {
+	if mmGetGrantsBySubject.mock.funcGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Set")
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation == nil {
+		mmGetGrantsBySubject.defaultExpectation = &GrantsStorageMockGetGrantsBySubjectExpectation{}
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation.params != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Expect")
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation.paramPtrs == nil {
+		mmGetGrantsBySubject.defaultExpectation.paramPtrs = &GrantsStorageMockGetGrantsBySubjectParamPtrs{}
+	}
+	mmGetGrantsBySubject.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetGrantsBySubject
+}
+
+// ExpectSubjectParam2 sets up expected param subject for GrantsStorage.GetGrantsBySubject
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) ExpectSubjectParam2(subject string) *mGrantsStorageMockGetGrantsBySubject {
+	if mmGetGrantsBySubject.mock.funcGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Set")
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation == nil {
+		mmGetGrantsBySubject.defaultExpectation = &GrantsStorageMockGetGrantsBySubjectExpectation{}
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation.params != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Expect")
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation.paramPtrs == nil {
+		mmGetGrantsBySubject.defaultExpectation.paramPtrs = &GrantsStorageMockGetGrantsBySubjectParamPtrs{}
+	}
+	mmGetGrantsBySubject.defaultExpectation.paramPtrs.subject = &subject
+
+	return mmGetGrantsBySubject
+}
+
+// Inspect accepts an inspector function that has same arguments as the GrantsStorage.GetGrantsBySubject
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Inspect(f func(ctx context.Context, subject string)) *mGrantsStorageMockGetGrantsBySubject {
+	if mmGetGrantsBySubject.mock.inspectFuncGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("Inspect function is already set for GrantsStorageMock.GetGrantsBySubject")
+	}
+
+	mmGetGrantsBySubject.mock.inspectFuncGetGrantsBySubject = f
+
+	return mmGetGrantsBySubject
+}
+
+// Return sets up results that will be returned by GrantsStorage.GetGrantsBySubject
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Return(gpa1 []*mm_storage.Grant, err error) *GrantsStorageMock {
+	if mmGetGrantsBySubject.mock.funcGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Set")
+	}
+
+	if mmGetGrantsBySubject.defaultExpectation == nil {
+		mmGetGrantsBySubject.defaultExpectation = &GrantsStorageMockGetGrantsBySubjectExpectation{mock: mmGetGrantsBySubject.mock}
+	}
+	mmGetGrantsBySubject.defaultExpectation.results = &GrantsStorageMockGetGrantsBySubjectResults{gpa1, err}
+	return mmGetGrantsBySubject.mock
+}
+
+// Set uses given function f to mock the GrantsStorage.GetGrantsBySubject method
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Set(f func(ctx context.Context, subject string) (gpa1 []*mm_storage.Grant, err error)) *GrantsStorageMock {
+	if mmGetGrantsBySubject.defaultExpectation != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("Default expectation is already set for the GrantsStorage.GetGrantsBySubject method")
+	}
+
+	if len(mmGetGrantsBySubject.expectations) > 0 {
+		mmGetGrantsBySubject.mock.t.Fatalf("Some expectations are already set for the GrantsStorage.GetGrantsBySubject method")
+	}
+
+	mmGetGrantsBySubject.mock.funcGetGrantsBySubject = f
+	return mmGetGrantsBySubject.mock
+}
+
+// When sets expectation for the GrantsStorage.GetGrantsBySubject which will trigger the result defined by the following
+// Then helper
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) When(ctx context.Context, subject string) *GrantsStorageMockGetGrantsBySubjectExpectation {
+	if mmGetGrantsBySubject.mock.funcGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.mock.t.Fatalf("GrantsStorageMock.GetGrantsBySubject mock is already set by Set")
+	}
+
+	expectation := &GrantsStorageMockGetGrantsBySubjectExpectation{
+		mock:   mmGetGrantsBySubject.mock,
+		params: &GrantsStorageMockGetGrantsBySubjectParams{ctx, subject},
+	}
+	mmGetGrantsBySubject.expectations = append(mmGetGrantsBySubject.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GrantsStorage.GetGrantsBySubject return parameters for the expectation previously defined by the When method
+func (e *GrantsStorageMockGetGrantsBySubjectExpectation) Then(gpa1 []*mm_storage.Grant, err error) *GrantsStorageMock {
+	e.results = &GrantsStorageMockGetGrantsBySubjectResults{gpa1, err}
+	return e.mock
+}
+
+// Times sets number of times GrantsStorage.GetGrantsBySubject should be invoked
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Times(n uint64) *mGrantsStorageMockGetGrantsBySubject {
+	if n == 0 {
+		mmGetGrantsBySubject.mock.t.Fatalf("Times of GrantsStorageMock.GetGrantsBySubject mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetGrantsBySubject.expectedInvocations, n)
+	return mmGetGrantsBySubject
+}
+
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) invocationsDone() bool {
+	if len(mmGetGrantsBySubject.expectations) == 0 && mmGetGrantsBySubject.defaultExpectation == nil && mmGetGrantsBySubject.mock.funcGetGrantsBySubject == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetGrantsBySubject.mock.afterGetGrantsBySubjectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetGrantsBySubject.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetGrantsBySubject implements storage.GrantsStorage
+func (mmGetGrantsBySubject *GrantsStorageMock) GetGrantsBySubject(ctx context.Context, subject string) (gpa1 []*mm_storage.Grant, err error) {
+	mm_atomic.AddUint64(&mmGetGrantsBySubject.beforeGetGrantsBySubjectCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetGrantsBySubject.afterGetGrantsBySubjectCounter, 1)
+
+	if mmGetGrantsBySubject.inspectFuncGetGrantsBySubject != nil {
+		mmGetGrantsBySubject.inspectFuncGetGrantsBySubject(ctx, subject)
+	}
+
+	mm_params := GrantsStorageMockGetGrantsBySubjectParams{ctx, subject}
+
+	// Record call args
+	mmGetGrantsBySubject.GetGrantsBySubjectMock.mutex.Lock()
+	mmGetGrantsBySubject.GetGrantsBySubjectMock.callArgs = append(mmGetGrantsBySubject.GetGrantsBySubjectMock.callArgs, &mm_params)
+	mmGetGrantsBySubject.GetGrantsBySubjectMock.mutex.Unlock()
+
+	for _, e := range mmGetGrantsBySubject.GetGrantsBySubjectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gpa1, e.results.err
+		}
+	}
+
+	if mmGetGrantsBySubject.GetGrantsBySubjectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetGrantsBySubject.GetGrantsBySubjectMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetGrantsBySubject.GetGrantsBySubjectMock.defaultExpectation.params
+		mm_want_ptrs := mmGetGrantsBySubject.GetGrantsBySubjectMock.defaultExpectation.paramPtrs
+
+		mm_got := GrantsStorageMockGetGrantsBySubjectParams{ctx, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetGrantsBySubject.t.Errorf("GrantsStorageMock.GetGrantsBySubject got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGetGrantsBySubject.t.Errorf("GrantsStorageMock.GetGrantsBySubject got unexpected parameter subject, want: %#v, got: %#v%s\n", *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetGrantsBySubject.t.Errorf("GrantsStorageMock.GetGrantsBySubject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetGrantsBySubject.GetGrantsBySubjectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetGrantsBySubject.t.Fatal("No results are set for the GrantsStorageMock.GetGrantsBySubject")
+		}
+		return (*mm_results).gpa1, (*mm_results).err
+	}
+	if mmGetGrantsBySubject.funcGetGrantsBySubject != nil {
+		return mmGetGrantsBySubject.funcGetGrantsBySubject(ctx, subject)
+	}
+	mmGetGrantsBySubject.t.Fatalf("Unexpected call to GrantsStorageMock.GetGrantsBySubject. %v %v", ctx, subject)
+	return
+}
+
+// GetGrantsBySubjectAfterCounter returns a count of finished GrantsStorageMock.GetGrantsBySubject invocations
+func (mmGetGrantsBySubject *GrantsStorageMock) GetGrantsBySubjectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGrantsBySubject.afterGetGrantsBySubjectCounter)
+}
+
+// GetGrantsBySubjectBeforeCounter returns a count of GrantsStorageMock.GetGrantsBySubject invocations
+func (mmGetGrantsBySubject *GrantsStorageMock) GetGrantsBySubjectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGrantsBySubject.beforeGetGrantsBySubjectCounter)
+}
+
+// Calls returns a list of arguments used in each call to GrantsStorageMock.GetGrantsBySubject.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetGrantsBySubject *mGrantsStorageMockGetGrantsBySubject) Calls() []*GrantsStorageMockGetGrantsBySubjectParams {
+	mmGetGrantsBySubject.mutex.RLock()
+
+	argCopy := make([]*GrantsStorageMockGetGrantsBySubjectParams, len(mmGetGrantsBySubject.callArgs))
+	copy(argCopy, mmGetGrantsBySubject.callArgs)
+
+	mmGetGrantsBySubject.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetGrantsBySubjectDone returns true if the count of the GetGrantsBySubject invocations corresponds
+// the number of defined expectations
+func (m *GrantsStorageMock) MinimockGetGrantsBySubjectDone() bool {
+	if m.GetGrantsBySubjectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetGrantsBySubjectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetGrantsBySubjectMock.invocationsDone()
+}
+
+// MinimockGetGrantsBySubjectInspect logs each unmet expectation
+func (m *GrantsStorageMock) MinimockGetGrantsBySubjectInspect() {
+	for _, e := range m.GetGrantsBySubjectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GrantsStorageMock.GetGrantsBySubject with params: %#v", *e.params)
+		}
+	}
+
+	afterGetGrantsBySubjectCounter := mm_atomic.LoadUint64(&m.afterGetGrantsBySubjectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetGrantsBySubjectMock.defaultExpectation != nil && afterGetGrantsBySubjectCounter < 1 {
+		if m.GetGrantsBySubjectMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GrantsStorageMock.GetGrantsBySubject")
+		} else {
+			m.t.Errorf("Expected call to GrantsStorageMock.GetGrantsBySubject with params: %#v", *m.GetGrantsBySubjectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetGrantsBySubject != nil && afterGetGrantsBySubjectCounter < 1 {
+		m.t.Error("Expected call to GrantsStorageMock.GetGrantsBySubject")
+	}
+
+	if !m.GetGrantsBySubjectMock.invocationsDone() && afterGetGrantsBySubjectCounter > 0 {
+		m.t.Errorf("Expected %d calls to GrantsStorageMock.GetGrantsBySubject but found %d calls",
+			mm_atomic.LoadUint64(&m.GetGrantsBySubjectMock.expectedInvocations), afterGetGrantsBySubjectCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GrantsStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateGrantInspect()
+
+			m.MinimockDeleteGrantInspect()
+
+			m.MinimockGetGrantsInspect()
+
+			m.MinimockGetGrantsBySubjectInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GrantsStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GrantsStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateGrantDone() &&
+		m.MinimockDeleteGrantDone() &&
+		m.MinimockGetGrantsDone() &&
+		m.MinimockGetGrantsBySubjectDone()
+}
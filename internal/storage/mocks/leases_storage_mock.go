@@ -0,0 +1,1754 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.LeasesStorage -o leases_storage_mock_test.go -n LeasesStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_storage "github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// LeasesStorageMock implements storage.LeasesStorage
+type LeasesStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateLease          func(ctx context.Context, lease *mm_storage.Lease) (err error)
+	inspectFuncCreateLease   func(ctx context.Context, lease *mm_storage.Lease)
+	afterCreateLeaseCounter  uint64
+	beforeCreateLeaseCounter uint64
+	CreateLeaseMock          mLeasesStorageMockCreateLease
+
+	funcDeleteLease          func(ctx context.Context, id int) (i1 int64, err error)
+	inspectFuncDeleteLease   func(ctx context.Context, id int)
+	afterDeleteLeaseCounter  uint64
+	beforeDeleteLeaseCounter uint64
+	DeleteLeaseMock          mLeasesStorageMockDeleteLease
+
+	funcGetExpiringLeases          func(ctx context.Context, buildingIds []int, days int) (lpa1 []*mm_storage.Lease, err error)
+	inspectFuncGetExpiringLeases   func(ctx context.Context, buildingIds []int, days int)
+	afterGetExpiringLeasesCounter  uint64
+	beforeGetExpiringLeasesCounter uint64
+	GetExpiringLeasesMock          mLeasesStorageMockGetExpiringLeases
+
+	funcGetLease          func(ctx context.Context, id int) (lp1 *mm_storage.Lease, err error)
+	inspectFuncGetLease   func(ctx context.Context, id int)
+	afterGetLeaseCounter  uint64
+	beforeGetLeaseCounter uint64
+	GetLeaseMock          mLeasesStorageMockGetLease
+
+	funcGetLeasesOfApartment          func(ctx context.Context, apartmentId int) (lpa1 []*mm_storage.Lease, err error)
+	inspectFuncGetLeasesOfApartment   func(ctx context.Context, apartmentId int)
+	afterGetLeasesOfApartmentCounter  uint64
+	beforeGetLeasesOfApartmentCounter uint64
+	GetLeasesOfApartmentMock          mLeasesStorageMockGetLeasesOfApartment
+}
+
+// NewLeasesStorageMock returns a mock for storage.LeasesStorage
+func NewLeasesStorageMock(t minimock.Tester) *LeasesStorageMock {
+	m := &LeasesStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateLeaseMock = mLeasesStorageMockCreateLease{mock: m}
+	m.CreateLeaseMock.callArgs = []*LeasesStorageMockCreateLeaseParams{}
+
+	m.DeleteLeaseMock = mLeasesStorageMockDeleteLease{mock: m}
+	m.DeleteLeaseMock.callArgs = []*LeasesStorageMockDeleteLeaseParams{}
+
+	m.GetExpiringLeasesMock = mLeasesStorageMockGetExpiringLeases{mock: m}
+	m.GetExpiringLeasesMock.callArgs = []*LeasesStorageMockGetExpiringLeasesParams{}
+
+	m.GetLeaseMock = mLeasesStorageMockGetLease{mock: m}
+	m.GetLeaseMock.callArgs = []*LeasesStorageMockGetLeaseParams{}
+
+	m.GetLeasesOfApartmentMock = mLeasesStorageMockGetLeasesOfApartment{mock: m}
+	m.GetLeasesOfApartmentMock.callArgs = []*LeasesStorageMockGetLeasesOfApartmentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLeasesStorageMockCreateLease struct {
+	optional           bool
+	mock               *LeasesStorageMock
+	defaultExpectation *LeasesStorageMockCreateLeaseExpectation
+	expectations       []*LeasesStorageMockCreateLeaseExpectation
+
+	callArgs []*LeasesStorageMockCreateLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesStorageMockCreateLeaseExpectation specifies expectation struct of the LeasesStorage.CreateLease
+type LeasesStorageMockCreateLeaseExpectation struct {
+	mock      *LeasesStorageMock
+	params    *LeasesStorageMockCreateLeaseParams
+	paramPtrs *LeasesStorageMockCreateLeaseParamPtrs
+	results   *LeasesStorageMockCreateLeaseResults
+	Counter   uint64
+}
+
+// LeasesStorageMockCreateLeaseParams contains parameters of the LeasesStorage.CreateLease
+type LeasesStorageMockCreateLeaseParams struct {
+	ctx   context.Context
+	lease *mm_storage.Lease
+}
+
+// LeasesStorageMockCreateLeaseParamPtrs contains pointers to parameters of the LeasesStorage.CreateLease
+type LeasesStorageMockCreateLeaseParamPtrs struct {
+	ctx   *context.Context
+	lease **mm_storage.Lease
+}
+
+// LeasesStorageMockCreateLeaseResults contains results of the LeasesStorage.CreateLease
+type LeasesStorageMockCreateLeaseResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateLease *mLeasesStorageMockCreateLease) Optional() *mLeasesStorageMockCreateLease {
+	mmCreateLease.optional = true
+	return mmCreateLease
+}
+
+// Expect sets up expected params for LeasesStorage.CreateLease
+func (mmCreateLease *mLeasesStorageMockCreateLease) Expect(ctx context.Context, lease *mm_storage.Lease) *mLeasesStorageMockCreateLease {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesStorageMockCreateLeaseExpectation{}
+	}
+
+	if mmCreateLease.defaultExpectation.paramPtrs != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by ExpectParams functions")
+	}
+
+	mmCreateLease.defaultExpectation.params = &LeasesStorageMockCreateLeaseParams{ctx, lease}
+	for _, e := range mmCreateLease.expectations {
+		if minimock.Equal(e.params, mmCreateLease.defaultExpectation.params) {
+			mmCreateLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateLease.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesStorage.CreateLease
+func (mmCreateLease *mLeasesStorageMockCreateLease) ExpectCtxParam1(ctx context.Context) *mLeasesStorageMockCreateLease {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesStorageMockCreateLeaseExpectation{}
+	}
+
+	if mmCreateLease.defaultExpectation.params != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Expect")
+	}
+
+	if mmCreateLease.defaultExpectation.paramPtrs == nil {
+		mmCreateLease.defaultExpectation.paramPtrs = &LeasesStorageMockCreateLeaseParamPtrs{}
+	}
+	mmCreateLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateLease
+}
+
+// ExpectLeaseParam2 sets up expected param lease for LeasesStorage.CreateLease
+func (mmCreateLease *mLeasesStorageMockCreateLease) ExpectLeaseParam2(lease *mm_storage.Lease) *mLeasesStorageMockCreateLease {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesStorageMockCreateLeaseExpectation{}
+	}
+
+	if mmCreateLease.defaultExpectation.params != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Expect")
+	}
+
+	if mmCreateLease.defaultExpectation.paramPtrs == nil {
+		mmCreateLease.defaultExpectation.paramPtrs = &LeasesStorageMockCreateLeaseParamPtrs{}
+	}
+	mmCreateLease.defaultExpectation.paramPtrs.lease = &lease
+
+	return mmCreateLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesStorage.CreateLease
+func (mmCreateLease *mLeasesStorageMockCreateLease) Inspect(f func(ctx context.Context, lease *mm_storage.Lease)) *mLeasesStorageMockCreateLease {
+	if mmCreateLease.mock.inspectFuncCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("Inspect function is already set for LeasesStorageMock.CreateLease")
+	}
+
+	mmCreateLease.mock.inspectFuncCreateLease = f
+
+	return mmCreateLease
+}
+
+// Return sets up results that will be returned by LeasesStorage.CreateLease
+func (mmCreateLease *mLeasesStorageMockCreateLease) Return(err error) *LeasesStorageMock {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Set")
+	}
+
+	if mmCreateLease.defaultExpectation == nil {
+		mmCreateLease.defaultExpectation = &LeasesStorageMockCreateLeaseExpectation{mock: mmCreateLease.mock}
+	}
+	mmCreateLease.defaultExpectation.results = &LeasesStorageMockCreateLeaseResults{err}
+	return mmCreateLease.mock
+}
+
+// Set uses given function f to mock the LeasesStorage.CreateLease method
+func (mmCreateLease *mLeasesStorageMockCreateLease) Set(f func(ctx context.Context, lease *mm_storage.Lease) (err error)) *LeasesStorageMock {
+	if mmCreateLease.defaultExpectation != nil {
+		mmCreateLease.mock.t.Fatalf("Default expectation is already set for the LeasesStorage.CreateLease method")
+	}
+
+	if len(mmCreateLease.expectations) > 0 {
+		mmCreateLease.mock.t.Fatalf("Some expectations are already set for the LeasesStorage.CreateLease method")
+	}
+
+	mmCreateLease.mock.funcCreateLease = f
+	return mmCreateLease.mock
+}
+
+// When sets expectation for the LeasesStorage.CreateLease which will trigger the result defined by the following
+// Then helper
+func (mmCreateLease *mLeasesStorageMockCreateLease) When(ctx context.Context, lease *mm_storage.Lease) *LeasesStorageMockCreateLeaseExpectation {
+	if mmCreateLease.mock.funcCreateLease != nil {
+		mmCreateLease.mock.t.Fatalf("LeasesStorageMock.CreateLease mock is already set by Set")
+	}
+
+	expectation := &LeasesStorageMockCreateLeaseExpectation{
+		mock:   mmCreateLease.mock,
+		params: &LeasesStorageMockCreateLeaseParams{ctx, lease},
+	}
+	mmCreateLease.expectations = append(mmCreateLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesStorage.CreateLease return parameters for the expectation previously defined by the When method
+func (e *LeasesStorageMockCreateLeaseExpectation) Then(err error) *LeasesStorageMock {
+	e.results = &LeasesStorageMockCreateLeaseResults{err}
+	return e.mock
+}
+
+// Times sets number of times LeasesStorage.CreateLease should be invoked
+func (mmCreateLease *mLeasesStorageMockCreateLease) Times(n uint64) *mLeasesStorageMockCreateLease {
+	if n == 0 {
+		mmCreateLease.mock.t.Fatalf("Times of LeasesStorageMock.CreateLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateLease.expectedInvocations, n)
+	return mmCreateLease
+}
+
+func (mmCreateLease *mLeasesStorageMockCreateLease) invocationsDone() bool {
+	if len(mmCreateLease.expectations) == 0 && mmCreateLease.defaultExpectation == nil && mmCreateLease.mock.funcCreateLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateLease.mock.afterCreateLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateLease implements storage.LeasesStorage
+func (mmCreateLease *LeasesStorageMock) CreateLease(ctx context.Context, lease *mm_storage.Lease) (err error) {
+	mm_atomic.AddUint64(&mmCreateLease.beforeCreateLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateLease.afterCreateLeaseCounter, 1)
+
+	if mmCreateLease.inspectFuncCreateLease != nil {
+		mmCreateLease.inspectFuncCreateLease(ctx, lease)
+	}
+
+	mm_params := LeasesStorageMockCreateLeaseParams{ctx, lease}
+
+	// Record call args
+	mmCreateLease.CreateLeaseMock.mutex.Lock()
+	mmCreateLease.CreateLeaseMock.callArgs = append(mmCreateLease.CreateLeaseMock.callArgs, &mm_params)
+	mmCreateLease.CreateLeaseMock.mutex.Unlock()
+
+	for _, e := range mmCreateLease.CreateLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateLease.CreateLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateLease.CreateLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateLease.CreateLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateLease.CreateLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesStorageMockCreateLeaseParams{ctx, lease}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateLease.t.Errorf("LeasesStorageMock.CreateLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.lease != nil && !minimock.Equal(*mm_want_ptrs.lease, mm_got.lease) {
+				mmCreateLease.t.Errorf("LeasesStorageMock.CreateLease got unexpected parameter lease, want: %#v, got: %#v%s\n", *mm_want_ptrs.lease, mm_got.lease, minimock.Diff(*mm_want_ptrs.lease, mm_got.lease))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateLease.t.Errorf("LeasesStorageMock.CreateLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateLease.CreateLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateLease.t.Fatal("No results are set for the LeasesStorageMock.CreateLease")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateLease.funcCreateLease != nil {
+		return mmCreateLease.funcCreateLease(ctx, lease)
+	}
+	mmCreateLease.t.Fatalf("Unexpected call to LeasesStorageMock.CreateLease. %v %v", ctx, lease)
+	return
+}
+
+// CreateLeaseAfterCounter returns a count of finished LeasesStorageMock.CreateLease invocations
+func (mmCreateLease *LeasesStorageMock) CreateLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLease.afterCreateLeaseCounter)
+}
+
+// CreateLeaseBeforeCounter returns a count of LeasesStorageMock.CreateLease invocations
+func (mmCreateLease *LeasesStorageMock) CreateLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLease.beforeCreateLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesStorageMock.CreateLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateLease *mLeasesStorageMockCreateLease) Calls() []*LeasesStorageMockCreateLeaseParams {
+	mmCreateLease.mutex.RLock()
+
+	argCopy := make([]*LeasesStorageMockCreateLeaseParams, len(mmCreateLease.callArgs))
+	copy(argCopy, mmCreateLease.callArgs)
+
+	mmCreateLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateLeaseDone returns true if the count of the CreateLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesStorageMock) MinimockCreateLeaseDone() bool {
+	if m.CreateLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateLeaseMock.invocationsDone()
+}
+
+// MinimockCreateLeaseInspect logs each unmet expectation
+func (m *LeasesStorageMock) MinimockCreateLeaseInspect() {
+	for _, e := range m.CreateLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesStorageMock.CreateLease with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateLeaseCounter := mm_atomic.LoadUint64(&m.afterCreateLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateLeaseMock.defaultExpectation != nil && afterCreateLeaseCounter < 1 {
+		if m.CreateLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesStorageMock.CreateLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesStorageMock.CreateLease with params: %#v", *m.CreateLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateLease != nil && afterCreateLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesStorageMock.CreateLease")
+	}
+
+	if !m.CreateLeaseMock.invocationsDone() && afterCreateLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesStorageMock.CreateLease but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateLeaseMock.expectedInvocations), afterCreateLeaseCounter)
+	}
+}
+
+type mLeasesStorageMockDeleteLease struct {
+	optional           bool
+	mock               *LeasesStorageMock
+	defaultExpectation *LeasesStorageMockDeleteLeaseExpectation
+	expectations       []*LeasesStorageMockDeleteLeaseExpectation
+
+	callArgs []*LeasesStorageMockDeleteLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesStorageMockDeleteLeaseExpectation specifies expectation struct of the LeasesStorage.DeleteLease
+type LeasesStorageMockDeleteLeaseExpectation struct {
+	mock      *LeasesStorageMock
+	params    *LeasesStorageMockDeleteLeaseParams
+	paramPtrs *LeasesStorageMockDeleteLeaseParamPtrs
+	results   *LeasesStorageMockDeleteLeaseResults
+	Counter   uint64
+}
+
+// LeasesStorageMockDeleteLeaseParams contains parameters of the LeasesStorage.DeleteLease
+type LeasesStorageMockDeleteLeaseParams struct {
+	ctx context.Context
+	id  int
+}
+
+// LeasesStorageMockDeleteLeaseParamPtrs contains pointers to parameters of the LeasesStorage.DeleteLease
+type LeasesStorageMockDeleteLeaseParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// LeasesStorageMockDeleteLeaseResults contains results of the LeasesStorage.DeleteLease
+type LeasesStorageMockDeleteLeaseResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Optional() *mLeasesStorageMockDeleteLease {
+	mmDeleteLease.optional = true
+	return mmDeleteLease
+}
+
+// Expect sets up expected params for LeasesStorage.DeleteLease
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Expect(ctx context.Context, id int) *mLeasesStorageMockDeleteLease {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesStorageMockDeleteLeaseExpectation{}
+	}
+
+	if mmDeleteLease.defaultExpectation.paramPtrs != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteLease.defaultExpectation.params = &LeasesStorageMockDeleteLeaseParams{ctx, id}
+	for _, e := range mmDeleteLease.expectations {
+		if minimock.Equal(e.params, mmDeleteLease.defaultExpectation.params) {
+			mmDeleteLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteLease.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesStorage.DeleteLease
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) ExpectCtxParam1(ctx context.Context) *mLeasesStorageMockDeleteLease {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesStorageMockDeleteLeaseExpectation{}
+	}
+
+	if mmDeleteLease.defaultExpectation.params != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Expect")
+	}
+
+	if mmDeleteLease.defaultExpectation.paramPtrs == nil {
+		mmDeleteLease.defaultExpectation.paramPtrs = &LeasesStorageMockDeleteLeaseParamPtrs{}
+	}
+	mmDeleteLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteLease
+}
+
+// ExpectIdParam2 sets up expected param id for LeasesStorage.DeleteLease
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) ExpectIdParam2(id int) *mLeasesStorageMockDeleteLease {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesStorageMockDeleteLeaseExpectation{}
+	}
+
+	if mmDeleteLease.defaultExpectation.params != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Expect")
+	}
+
+	if mmDeleteLease.defaultExpectation.paramPtrs == nil {
+		mmDeleteLease.defaultExpectation.paramPtrs = &LeasesStorageMockDeleteLeaseParamPtrs{}
+	}
+	mmDeleteLease.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesStorage.DeleteLease
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Inspect(f func(ctx context.Context, id int)) *mLeasesStorageMockDeleteLease {
+	if mmDeleteLease.mock.inspectFuncDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("Inspect function is already set for LeasesStorageMock.DeleteLease")
+	}
+
+	mmDeleteLease.mock.inspectFuncDeleteLease = f
+
+	return mmDeleteLease
+}
+
+// Return sets up results that will be returned by LeasesStorage.DeleteLease
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Return(i1 int64, err error) *LeasesStorageMock {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Set")
+	}
+
+	if mmDeleteLease.defaultExpectation == nil {
+		mmDeleteLease.defaultExpectation = &LeasesStorageMockDeleteLeaseExpectation{mock: mmDeleteLease.mock}
+	}
+	mmDeleteLease.defaultExpectation.results = &LeasesStorageMockDeleteLeaseResults{i1, err}
+	return mmDeleteLease.mock
+}
+
+// Set uses given function f to mock the LeasesStorage.DeleteLease method
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Set(f func(ctx context.Context, id int) (i1 int64, err error)) *LeasesStorageMock {
+	if mmDeleteLease.defaultExpectation != nil {
+		mmDeleteLease.mock.t.Fatalf("Default expectation is already set for the LeasesStorage.DeleteLease method")
+	}
+
+	if len(mmDeleteLease.expectations) > 0 {
+		mmDeleteLease.mock.t.Fatalf("Some expectations are already set for the LeasesStorage.DeleteLease method")
+	}
+
+	mmDeleteLease.mock.funcDeleteLease = f
+	return mmDeleteLease.mock
+}
+
+// When sets expectation for the LeasesStorage.DeleteLease which will trigger the result defined by the following
+// Then helper
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) When(ctx context.Context, id int) *LeasesStorageMockDeleteLeaseExpectation {
+	if mmDeleteLease.mock.funcDeleteLease != nil {
+		mmDeleteLease.mock.t.Fatalf("LeasesStorageMock.DeleteLease mock is already set by Set")
+	}
+
+	expectation := &LeasesStorageMockDeleteLeaseExpectation{
+		mock:   mmDeleteLease.mock,
+		params: &LeasesStorageMockDeleteLeaseParams{ctx, id},
+	}
+	mmDeleteLease.expectations = append(mmDeleteLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesStorage.DeleteLease return parameters for the expectation previously defined by the When method
+func (e *LeasesStorageMockDeleteLeaseExpectation) Then(i1 int64, err error) *LeasesStorageMock {
+	e.results = &LeasesStorageMockDeleteLeaseResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesStorage.DeleteLease should be invoked
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Times(n uint64) *mLeasesStorageMockDeleteLease {
+	if n == 0 {
+		mmDeleteLease.mock.t.Fatalf("Times of LeasesStorageMock.DeleteLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteLease.expectedInvocations, n)
+	return mmDeleteLease
+}
+
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) invocationsDone() bool {
+	if len(mmDeleteLease.expectations) == 0 && mmDeleteLease.defaultExpectation == nil && mmDeleteLease.mock.funcDeleteLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteLease.mock.afterDeleteLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteLease implements storage.LeasesStorage
+func (mmDeleteLease *LeasesStorageMock) DeleteLease(ctx context.Context, id int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteLease.beforeDeleteLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteLease.afterDeleteLeaseCounter, 1)
+
+	if mmDeleteLease.inspectFuncDeleteLease != nil {
+		mmDeleteLease.inspectFuncDeleteLease(ctx, id)
+	}
+
+	mm_params := LeasesStorageMockDeleteLeaseParams{ctx, id}
+
+	// Record call args
+	mmDeleteLease.DeleteLeaseMock.mutex.Lock()
+	mmDeleteLease.DeleteLeaseMock.callArgs = append(mmDeleteLease.DeleteLeaseMock.callArgs, &mm_params)
+	mmDeleteLease.DeleteLeaseMock.mutex.Unlock()
+
+	for _, e := range mmDeleteLease.DeleteLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteLease.DeleteLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteLease.DeleteLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteLease.DeleteLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteLease.DeleteLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesStorageMockDeleteLeaseParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteLease.t.Errorf("LeasesStorageMock.DeleteLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteLease.t.Errorf("LeasesStorageMock.DeleteLease got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteLease.t.Errorf("LeasesStorageMock.DeleteLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteLease.DeleteLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteLease.t.Fatal("No results are set for the LeasesStorageMock.DeleteLease")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteLease.funcDeleteLease != nil {
+		return mmDeleteLease.funcDeleteLease(ctx, id)
+	}
+	mmDeleteLease.t.Fatalf("Unexpected call to LeasesStorageMock.DeleteLease. %v %v", ctx, id)
+	return
+}
+
+// DeleteLeaseAfterCounter returns a count of finished LeasesStorageMock.DeleteLease invocations
+func (mmDeleteLease *LeasesStorageMock) DeleteLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteLease.afterDeleteLeaseCounter)
+}
+
+// DeleteLeaseBeforeCounter returns a count of LeasesStorageMock.DeleteLease invocations
+func (mmDeleteLease *LeasesStorageMock) DeleteLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteLease.beforeDeleteLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesStorageMock.DeleteLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteLease *mLeasesStorageMockDeleteLease) Calls() []*LeasesStorageMockDeleteLeaseParams {
+	mmDeleteLease.mutex.RLock()
+
+	argCopy := make([]*LeasesStorageMockDeleteLeaseParams, len(mmDeleteLease.callArgs))
+	copy(argCopy, mmDeleteLease.callArgs)
+
+	mmDeleteLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteLeaseDone returns true if the count of the DeleteLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesStorageMock) MinimockDeleteLeaseDone() bool {
+	if m.DeleteLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteLeaseMock.invocationsDone()
+}
+
+// MinimockDeleteLeaseInspect logs each unmet expectation
+func (m *LeasesStorageMock) MinimockDeleteLeaseInspect() {
+	for _, e := range m.DeleteLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesStorageMock.DeleteLease with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteLeaseCounter := mm_atomic.LoadUint64(&m.afterDeleteLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteLeaseMock.defaultExpectation != nil && afterDeleteLeaseCounter < 1 {
+		if m.DeleteLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesStorageMock.DeleteLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesStorageMock.DeleteLease with params: %#v", *m.DeleteLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteLease != nil && afterDeleteLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesStorageMock.DeleteLease")
+	}
+
+	if !m.DeleteLeaseMock.invocationsDone() && afterDeleteLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesStorageMock.DeleteLease but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteLeaseMock.expectedInvocations), afterDeleteLeaseCounter)
+	}
+}
+
+type mLeasesStorageMockGetExpiringLeases struct {
+	optional           bool
+	mock               *LeasesStorageMock
+	defaultExpectation *LeasesStorageMockGetExpiringLeasesExpectation
+	expectations       []*LeasesStorageMockGetExpiringLeasesExpectation
+
+	callArgs []*LeasesStorageMockGetExpiringLeasesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesStorageMockGetExpiringLeasesExpectation specifies expectation struct of the LeasesStorage.GetExpiringLeases
+type LeasesStorageMockGetExpiringLeasesExpectation struct {
+	mock      *LeasesStorageMock
+	params    *LeasesStorageMockGetExpiringLeasesParams
+	paramPtrs *LeasesStorageMockGetExpiringLeasesParamPtrs
+	results   *LeasesStorageMockGetExpiringLeasesResults
+	Counter   uint64
+}
+
+// LeasesStorageMockGetExpiringLeasesParams contains parameters of the LeasesStorage.GetExpiringLeases
+type LeasesStorageMockGetExpiringLeasesParams struct {
+	ctx         context.Context
+	buildingIds []int
+	days        int
+}
+
+// LeasesStorageMockGetExpiringLeasesParamPtrs contains pointers to parameters of the LeasesStorage.GetExpiringLeases
+type LeasesStorageMockGetExpiringLeasesParamPtrs struct {
+	ctx         *context.Context
+	buildingIds *[]int
+	days        *int
+}
+
+// LeasesStorageMockGetExpiringLeasesResults contains results of the LeasesStorage.GetExpiringLeases
+type LeasesStorageMockGetExpiringLeasesResults struct {
+	lpa1 []*mm_storage.Lease
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Optional() *mLeasesStorageMockGetExpiringLeases {
+	mmGetExpiringLeases.optional = true
+	return mmGetExpiringLeases
+}
+
+// Expect sets up expected params for LeasesStorage.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Expect(ctx context.Context, buildingIds []int, days int) *mLeasesStorageMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesStorageMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by ExpectParams functions")
+	}
+
+	mmGetExpiringLeases.defaultExpectation.params = &LeasesStorageMockGetExpiringLeasesParams{ctx, buildingIds, days}
+	for _, e := range mmGetExpiringLeases.expectations {
+		if minimock.Equal(e.params, mmGetExpiringLeases.defaultExpectation.params) {
+			mmGetExpiringLeases.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetExpiringLeases.defaultExpectation.params)
+		}
+	}
+
+	return mmGetExpiringLeases
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesStorage.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) ExpectCtxParam1(ctx context.Context) *mLeasesStorageMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesStorageMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.params != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Expect")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs == nil {
+		mmGetExpiringLeases.defaultExpectation.paramPtrs = &LeasesStorageMockGetExpiringLeasesParamPtrs{}
+	}
+	mmGetExpiringLeases.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetExpiringLeases
+}
+
+// ExpectBuildingIdsParam2 sets up expected param buildingIds for LeasesStorage.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) ExpectBuildingIdsParam2(buildingIds []int) *mLeasesStorageMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesStorageMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.params != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Expect")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs == nil {
+		mmGetExpiringLeases.defaultExpectation.paramPtrs = &LeasesStorageMockGetExpiringLeasesParamPtrs{}
+	}
+	mmGetExpiringLeases.defaultExpectation.paramPtrs.buildingIds = &buildingIds
+
+	return mmGetExpiringLeases
+}
+
+// ExpectDaysParam3 sets up expected param days for LeasesStorage.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) ExpectDaysParam3(days int) *mLeasesStorageMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesStorageMockGetExpiringLeasesExpectation{}
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.params != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Expect")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation.paramPtrs == nil {
+		mmGetExpiringLeases.defaultExpectation.paramPtrs = &LeasesStorageMockGetExpiringLeasesParamPtrs{}
+	}
+	mmGetExpiringLeases.defaultExpectation.paramPtrs.days = &days
+
+	return mmGetExpiringLeases
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesStorage.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Inspect(f func(ctx context.Context, buildingIds []int, days int)) *mLeasesStorageMockGetExpiringLeases {
+	if mmGetExpiringLeases.mock.inspectFuncGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("Inspect function is already set for LeasesStorageMock.GetExpiringLeases")
+	}
+
+	mmGetExpiringLeases.mock.inspectFuncGetExpiringLeases = f
+
+	return mmGetExpiringLeases
+}
+
+// Return sets up results that will be returned by LeasesStorage.GetExpiringLeases
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Return(lpa1 []*mm_storage.Lease, err error) *LeasesStorageMock {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	if mmGetExpiringLeases.defaultExpectation == nil {
+		mmGetExpiringLeases.defaultExpectation = &LeasesStorageMockGetExpiringLeasesExpectation{mock: mmGetExpiringLeases.mock}
+	}
+	mmGetExpiringLeases.defaultExpectation.results = &LeasesStorageMockGetExpiringLeasesResults{lpa1, err}
+	return mmGetExpiringLeases.mock
+}
+
+// Set uses given function f to mock the LeasesStorage.GetExpiringLeases method
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Set(f func(ctx context.Context, buildingIds []int, days int) (lpa1 []*mm_storage.Lease, err error)) *LeasesStorageMock {
+	if mmGetExpiringLeases.defaultExpectation != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("Default expectation is already set for the LeasesStorage.GetExpiringLeases method")
+	}
+
+	if len(mmGetExpiringLeases.expectations) > 0 {
+		mmGetExpiringLeases.mock.t.Fatalf("Some expectations are already set for the LeasesStorage.GetExpiringLeases method")
+	}
+
+	mmGetExpiringLeases.mock.funcGetExpiringLeases = f
+	return mmGetExpiringLeases.mock
+}
+
+// When sets expectation for the LeasesStorage.GetExpiringLeases which will trigger the result defined by the following
+// Then helper
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) When(ctx context.Context, buildingIds []int, days int) *LeasesStorageMockGetExpiringLeasesExpectation {
+	if mmGetExpiringLeases.mock.funcGetExpiringLeases != nil {
+		mmGetExpiringLeases.mock.t.Fatalf("LeasesStorageMock.GetExpiringLeases mock is already set by Set")
+	}
+
+	expectation := &LeasesStorageMockGetExpiringLeasesExpectation{
+		mock:   mmGetExpiringLeases.mock,
+		params: &LeasesStorageMockGetExpiringLeasesParams{ctx, buildingIds, days},
+	}
+	mmGetExpiringLeases.expectations = append(mmGetExpiringLeases.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesStorage.GetExpiringLeases return parameters for the expectation previously defined by the When method
+func (e *LeasesStorageMockGetExpiringLeasesExpectation) Then(lpa1 []*mm_storage.Lease, err error) *LeasesStorageMock {
+	e.results = &LeasesStorageMockGetExpiringLeasesResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesStorage.GetExpiringLeases should be invoked
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Times(n uint64) *mLeasesStorageMockGetExpiringLeases {
+	if n == 0 {
+		mmGetExpiringLeases.mock.t.Fatalf("Times of LeasesStorageMock.GetExpiringLeases mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetExpiringLeases.expectedInvocations, n)
+	return mmGetExpiringLeases
+}
+
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) invocationsDone() bool {
+	if len(mmGetExpiringLeases.expectations) == 0 && mmGetExpiringLeases.defaultExpectation == nil && mmGetExpiringLeases.mock.funcGetExpiringLeases == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetExpiringLeases.mock.afterGetExpiringLeasesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetExpiringLeases.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetExpiringLeases implements storage.LeasesStorage
+func (mmGetExpiringLeases *LeasesStorageMock) GetExpiringLeases(ctx context.Context, buildingIds []int, days int) (lpa1 []*mm_storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmGetExpiringLeases.beforeGetExpiringLeasesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetExpiringLeases.afterGetExpiringLeasesCounter, 1)
+
+	if mmGetExpiringLeases.inspectFuncGetExpiringLeases != nil {
+		mmGetExpiringLeases.inspectFuncGetExpiringLeases(ctx, buildingIds, days)
+	}
+
+	mm_params := LeasesStorageMockGetExpiringLeasesParams{ctx, buildingIds, days}
+
+	// Record call args
+	mmGetExpiringLeases.GetExpiringLeasesMock.mutex.Lock()
+	mmGetExpiringLeases.GetExpiringLeasesMock.callArgs = append(mmGetExpiringLeases.GetExpiringLeasesMock.callArgs, &mm_params)
+	mmGetExpiringLeases.GetExpiringLeasesMock.mutex.Unlock()
+
+	for _, e := range mmGetExpiringLeases.GetExpiringLeasesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesStorageMockGetExpiringLeasesParams{ctx, buildingIds, days}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetExpiringLeases.t.Errorf("LeasesStorageMock.GetExpiringLeases got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingIds != nil && !minimock.Equal(*mm_want_ptrs.buildingIds, mm_got.buildingIds) {
+				mmGetExpiringLeases.t.Errorf("LeasesStorageMock.GetExpiringLeases got unexpected parameter buildingIds, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingIds, mm_got.buildingIds, minimock.Diff(*mm_want_ptrs.buildingIds, mm_got.buildingIds))
+			}
+
+			if mm_want_ptrs.days != nil && !minimock.Equal(*mm_want_ptrs.days, mm_got.days) {
+				mmGetExpiringLeases.t.Errorf("LeasesStorageMock.GetExpiringLeases got unexpected parameter days, want: %#v, got: %#v%s\n", *mm_want_ptrs.days, mm_got.days, minimock.Diff(*mm_want_ptrs.days, mm_got.days))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetExpiringLeases.t.Errorf("LeasesStorageMock.GetExpiringLeases got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetExpiringLeases.GetExpiringLeasesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetExpiringLeases.t.Fatal("No results are set for the LeasesStorageMock.GetExpiringLeases")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmGetExpiringLeases.funcGetExpiringLeases != nil {
+		return mmGetExpiringLeases.funcGetExpiringLeases(ctx, buildingIds, days)
+	}
+	mmGetExpiringLeases.t.Fatalf("Unexpected call to LeasesStorageMock.GetExpiringLeases. %v %v %v", ctx, buildingIds, days)
+	return
+}
+
+// GetExpiringLeasesAfterCounter returns a count of finished LeasesStorageMock.GetExpiringLeases invocations
+func (mmGetExpiringLeases *LeasesStorageMock) GetExpiringLeasesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiringLeases.afterGetExpiringLeasesCounter)
+}
+
+// GetExpiringLeasesBeforeCounter returns a count of LeasesStorageMock.GetExpiringLeases invocations
+func (mmGetExpiringLeases *LeasesStorageMock) GetExpiringLeasesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiringLeases.beforeGetExpiringLeasesCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesStorageMock.GetExpiringLeases.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetExpiringLeases *mLeasesStorageMockGetExpiringLeases) Calls() []*LeasesStorageMockGetExpiringLeasesParams {
+	mmGetExpiringLeases.mutex.RLock()
+
+	argCopy := make([]*LeasesStorageMockGetExpiringLeasesParams, len(mmGetExpiringLeases.callArgs))
+	copy(argCopy, mmGetExpiringLeases.callArgs)
+
+	mmGetExpiringLeases.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetExpiringLeasesDone returns true if the count of the GetExpiringLeases invocations corresponds
+// the number of defined expectations
+func (m *LeasesStorageMock) MinimockGetExpiringLeasesDone() bool {
+	if m.GetExpiringLeasesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetExpiringLeasesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetExpiringLeasesMock.invocationsDone()
+}
+
+// MinimockGetExpiringLeasesInspect logs each unmet expectation
+func (m *LeasesStorageMock) MinimockGetExpiringLeasesInspect() {
+	for _, e := range m.GetExpiringLeasesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesStorageMock.GetExpiringLeases with params: %#v", *e.params)
+		}
+	}
+
+	afterGetExpiringLeasesCounter := mm_atomic.LoadUint64(&m.afterGetExpiringLeasesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExpiringLeasesMock.defaultExpectation != nil && afterGetExpiringLeasesCounter < 1 {
+		if m.GetExpiringLeasesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesStorageMock.GetExpiringLeases")
+		} else {
+			m.t.Errorf("Expected call to LeasesStorageMock.GetExpiringLeases with params: %#v", *m.GetExpiringLeasesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExpiringLeases != nil && afterGetExpiringLeasesCounter < 1 {
+		m.t.Error("Expected call to LeasesStorageMock.GetExpiringLeases")
+	}
+
+	if !m.GetExpiringLeasesMock.invocationsDone() && afterGetExpiringLeasesCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesStorageMock.GetExpiringLeases but found %d calls",
+			mm_atomic.LoadUint64(&m.GetExpiringLeasesMock.expectedInvocations), afterGetExpiringLeasesCounter)
+	}
+}
+
+type mLeasesStorageMockGetLease struct {
+	optional           bool
+	mock               *LeasesStorageMock
+	defaultExpectation *LeasesStorageMockGetLeaseExpectation
+	expectations       []*LeasesStorageMockGetLeaseExpectation
+
+	callArgs []*LeasesStorageMockGetLeaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesStorageMockGetLeaseExpectation specifies expectation struct of the LeasesStorage.GetLease
+type LeasesStorageMockGetLeaseExpectation struct {
+	mock      *LeasesStorageMock
+	params    *LeasesStorageMockGetLeaseParams
+	paramPtrs *LeasesStorageMockGetLeaseParamPtrs
+	results   *LeasesStorageMockGetLeaseResults
+	Counter   uint64
+}
+
+// LeasesStorageMockGetLeaseParams contains parameters of the LeasesStorage.GetLease
+type LeasesStorageMockGetLeaseParams struct {
+	ctx context.Context
+	id  int
+}
+
+// LeasesStorageMockGetLeaseParamPtrs contains pointers to parameters of the LeasesStorage.GetLease
+type LeasesStorageMockGetLeaseParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// LeasesStorageMockGetLeaseResults contains results of the LeasesStorage.GetLease
+type LeasesStorageMockGetLeaseResults struct {
+	lp1 *mm_storage.Lease
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLease *mLeasesStorageMockGetLease) Optional() *mLeasesStorageMockGetLease {
+	mmGetLease.optional = true
+	return mmGetLease
+}
+
+// Expect sets up expected params for LeasesStorage.GetLease
+func (mmGetLease *mLeasesStorageMockGetLease) Expect(ctx context.Context, id int) *mLeasesStorageMockGetLease {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesStorageMockGetLeaseExpectation{}
+	}
+
+	if mmGetLease.defaultExpectation.paramPtrs != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by ExpectParams functions")
+	}
+
+	mmGetLease.defaultExpectation.params = &LeasesStorageMockGetLeaseParams{ctx, id}
+	for _, e := range mmGetLease.expectations {
+		if minimock.Equal(e.params, mmGetLease.defaultExpectation.params) {
+			mmGetLease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLease.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesStorage.GetLease
+func (mmGetLease *mLeasesStorageMockGetLease) ExpectCtxParam1(ctx context.Context) *mLeasesStorageMockGetLease {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesStorageMockGetLeaseExpectation{}
+	}
+
+	if mmGetLease.defaultExpectation.params != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Expect")
+	}
+
+	if mmGetLease.defaultExpectation.paramPtrs == nil {
+		mmGetLease.defaultExpectation.paramPtrs = &LeasesStorageMockGetLeaseParamPtrs{}
+	}
+	mmGetLease.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetLease
+}
+
+// ExpectIdParam2 sets up expected param id for LeasesStorage.GetLease
+func (mmGetLease *mLeasesStorageMockGetLease) ExpectIdParam2(id int) *mLeasesStorageMockGetLease {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesStorageMockGetLeaseExpectation{}
+	}
+
+	if mmGetLease.defaultExpectation.params != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Expect")
+	}
+
+	if mmGetLease.defaultExpectation.paramPtrs == nil {
+		mmGetLease.defaultExpectation.paramPtrs = &LeasesStorageMockGetLeaseParamPtrs{}
+	}
+	mmGetLease.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetLease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesStorage.GetLease
+func (mmGetLease *mLeasesStorageMockGetLease) Inspect(f func(ctx context.Context, id int)) *mLeasesStorageMockGetLease {
+	if mmGetLease.mock.inspectFuncGetLease != nil {
+		mmGetLease.mock.t.Fatalf("Inspect function is already set for LeasesStorageMock.GetLease")
+	}
+
+	mmGetLease.mock.inspectFuncGetLease = f
+
+	return mmGetLease
+}
+
+// Return sets up results that will be returned by LeasesStorage.GetLease
+func (mmGetLease *mLeasesStorageMockGetLease) Return(lp1 *mm_storage.Lease, err error) *LeasesStorageMock {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Set")
+	}
+
+	if mmGetLease.defaultExpectation == nil {
+		mmGetLease.defaultExpectation = &LeasesStorageMockGetLeaseExpectation{mock: mmGetLease.mock}
+	}
+	mmGetLease.defaultExpectation.results = &LeasesStorageMockGetLeaseResults{lp1, err}
+	return mmGetLease.mock
+}
+
+// Set uses given function f to mock the LeasesStorage.GetLease method
+func (mmGetLease *mLeasesStorageMockGetLease) Set(f func(ctx context.Context, id int) (lp1 *mm_storage.Lease, err error)) *LeasesStorageMock {
+	if mmGetLease.defaultExpectation != nil {
+		mmGetLease.mock.t.Fatalf("Default expectation is already set for the LeasesStorage.GetLease method")
+	}
+
+	if len(mmGetLease.expectations) > 0 {
+		mmGetLease.mock.t.Fatalf("Some expectations are already set for the LeasesStorage.GetLease method")
+	}
+
+	mmGetLease.mock.funcGetLease = f
+	return mmGetLease.mock
+}
+
+// When sets expectation for the LeasesStorage.GetLease which will trigger the result defined by the following
+// Then helper
+func (mmGetLease *mLeasesStorageMockGetLease) When(ctx context.Context, id int) *LeasesStorageMockGetLeaseExpectation {
+	if mmGetLease.mock.funcGetLease != nil {
+		mmGetLease.mock.t.Fatalf("LeasesStorageMock.GetLease mock is already set by Set")
+	}
+
+	expectation := &LeasesStorageMockGetLeaseExpectation{
+		mock:   mmGetLease.mock,
+		params: &LeasesStorageMockGetLeaseParams{ctx, id},
+	}
+	mmGetLease.expectations = append(mmGetLease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesStorage.GetLease return parameters for the expectation previously defined by the When method
+func (e *LeasesStorageMockGetLeaseExpectation) Then(lp1 *mm_storage.Lease, err error) *LeasesStorageMock {
+	e.results = &LeasesStorageMockGetLeaseResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesStorage.GetLease should be invoked
+func (mmGetLease *mLeasesStorageMockGetLease) Times(n uint64) *mLeasesStorageMockGetLease {
+	if n == 0 {
+		mmGetLease.mock.t.Fatalf("Times of LeasesStorageMock.GetLease mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLease.expectedInvocations, n)
+	return mmGetLease
+}
+
+func (mmGetLease *mLeasesStorageMockGetLease) invocationsDone() bool {
+	if len(mmGetLease.expectations) == 0 && mmGetLease.defaultExpectation == nil && mmGetLease.mock.funcGetLease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLease.mock.afterGetLeaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLease implements storage.LeasesStorage
+func (mmGetLease *LeasesStorageMock) GetLease(ctx context.Context, id int) (lp1 *mm_storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmGetLease.beforeGetLeaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLease.afterGetLeaseCounter, 1)
+
+	if mmGetLease.inspectFuncGetLease != nil {
+		mmGetLease.inspectFuncGetLease(ctx, id)
+	}
+
+	mm_params := LeasesStorageMockGetLeaseParams{ctx, id}
+
+	// Record call args
+	mmGetLease.GetLeaseMock.mutex.Lock()
+	mmGetLease.GetLeaseMock.callArgs = append(mmGetLease.GetLeaseMock.callArgs, &mm_params)
+	mmGetLease.GetLeaseMock.mutex.Unlock()
+
+	for _, e := range mmGetLease.GetLeaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetLease.GetLeaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLease.GetLeaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLease.GetLeaseMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLease.GetLeaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesStorageMockGetLeaseParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLease.t.Errorf("LeasesStorageMock.GetLease got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetLease.t.Errorf("LeasesStorageMock.GetLease got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLease.t.Errorf("LeasesStorageMock.GetLease got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLease.GetLeaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLease.t.Fatal("No results are set for the LeasesStorageMock.GetLease")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetLease.funcGetLease != nil {
+		return mmGetLease.funcGetLease(ctx, id)
+	}
+	mmGetLease.t.Fatalf("Unexpected call to LeasesStorageMock.GetLease. %v %v", ctx, id)
+	return
+}
+
+// GetLeaseAfterCounter returns a count of finished LeasesStorageMock.GetLease invocations
+func (mmGetLease *LeasesStorageMock) GetLeaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLease.afterGetLeaseCounter)
+}
+
+// GetLeaseBeforeCounter returns a count of LeasesStorageMock.GetLease invocations
+func (mmGetLease *LeasesStorageMock) GetLeaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLease.beforeGetLeaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesStorageMock.GetLease.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLease *mLeasesStorageMockGetLease) Calls() []*LeasesStorageMockGetLeaseParams {
+	mmGetLease.mutex.RLock()
+
+	argCopy := make([]*LeasesStorageMockGetLeaseParams, len(mmGetLease.callArgs))
+	copy(argCopy, mmGetLease.callArgs)
+
+	mmGetLease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLeaseDone returns true if the count of the GetLease invocations corresponds
+// the number of defined expectations
+func (m *LeasesStorageMock) MinimockGetLeaseDone() bool {
+	if m.GetLeaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLeaseMock.invocationsDone()
+}
+
+// MinimockGetLeaseInspect logs each unmet expectation
+func (m *LeasesStorageMock) MinimockGetLeaseInspect() {
+	for _, e := range m.GetLeaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesStorageMock.GetLease with params: %#v", *e.params)
+		}
+	}
+
+	afterGetLeaseCounter := mm_atomic.LoadUint64(&m.afterGetLeaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLeaseMock.defaultExpectation != nil && afterGetLeaseCounter < 1 {
+		if m.GetLeaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesStorageMock.GetLease")
+		} else {
+			m.t.Errorf("Expected call to LeasesStorageMock.GetLease with params: %#v", *m.GetLeaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLease != nil && afterGetLeaseCounter < 1 {
+		m.t.Error("Expected call to LeasesStorageMock.GetLease")
+	}
+
+	if !m.GetLeaseMock.invocationsDone() && afterGetLeaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesStorageMock.GetLease but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLeaseMock.expectedInvocations), afterGetLeaseCounter)
+	}
+}
+
+type mLeasesStorageMockGetLeasesOfApartment struct {
+	optional           bool
+	mock               *LeasesStorageMock
+	defaultExpectation *LeasesStorageMockGetLeasesOfApartmentExpectation
+	expectations       []*LeasesStorageMockGetLeasesOfApartmentExpectation
+
+	callArgs []*LeasesStorageMockGetLeasesOfApartmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LeasesStorageMockGetLeasesOfApartmentExpectation specifies expectation struct of the LeasesStorage.GetLeasesOfApartment
+type LeasesStorageMockGetLeasesOfApartmentExpectation struct {
+	mock      *LeasesStorageMock
+	params    *LeasesStorageMockGetLeasesOfApartmentParams
+	paramPtrs *LeasesStorageMockGetLeasesOfApartmentParamPtrs
+	results   *LeasesStorageMockGetLeasesOfApartmentResults
+	Counter   uint64
+}
+
+// LeasesStorageMockGetLeasesOfApartmentParams contains parameters of the LeasesStorage.GetLeasesOfApartment
+type LeasesStorageMockGetLeasesOfApartmentParams struct {
+	ctx         context.Context
+	apartmentId int
+}
+
+// LeasesStorageMockGetLeasesOfApartmentParamPtrs contains pointers to parameters of the LeasesStorage.GetLeasesOfApartment
+type LeasesStorageMockGetLeasesOfApartmentParamPtrs struct {
+	ctx         *context.Context
+	apartmentId *int
+}
+
+// LeasesStorageMockGetLeasesOfApartmentResults contains results of the LeasesStorage.GetLeasesOfApartment
+type LeasesStorageMockGetLeasesOfApartmentResults struct {
+	lpa1 []*mm_storage.Lease
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Optional() *mLeasesStorageMockGetLeasesOfApartment {
+	mmGetLeasesOfApartment.optional = true
+	return mmGetLeasesOfApartment
+}
+
+// Expect sets up expected params for LeasesStorage.GetLeasesOfApartment
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Expect(ctx context.Context, apartmentId int) *mLeasesStorageMockGetLeasesOfApartment {
+	if mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Set")
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation == nil {
+		mmGetLeasesOfApartment.defaultExpectation = &LeasesStorageMockGetLeasesOfApartmentExpectation{}
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation.paramPtrs != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by ExpectParams functions")
+	}
+
+	mmGetLeasesOfApartment.defaultExpectation.params = &LeasesStorageMockGetLeasesOfApartmentParams{ctx, apartmentId}
+	for _, e := range mmGetLeasesOfApartment.expectations {
+		if minimock.Equal(e.params, mmGetLeasesOfApartment.defaultExpectation.params) {
+			mmGetLeasesOfApartment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLeasesOfApartment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLeasesOfApartment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LeasesStorage.GetLeasesOfApartment
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) ExpectCtxParam1(ctx context.Context) *mLeasesStorageMockGetLeasesOfApartment {
+	if mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Set")
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation == nil {
+		mmGetLeasesOfApartment.defaultExpectation = &LeasesStorageMockGetLeasesOfApartmentExpectation{}
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation.params != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Expect")
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation.paramPtrs == nil {
+		mmGetLeasesOfApartment.defaultExpectation.paramPtrs = &LeasesStorageMockGetLeasesOfApartmentParamPtrs{}
+	}
+	mmGetLeasesOfApartment.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetLeasesOfApartment
+}
+
+// ExpectApartmentIdParam2 sets up expected param apartmentId for LeasesStorage.GetLeasesOfApartment
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) ExpectApartmentIdParam2(apartmentId int) *mLeasesStorageMockGetLeasesOfApartment {
+	if mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Set")
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation == nil {
+		mmGetLeasesOfApartment.defaultExpectation = &LeasesStorageMockGetLeasesOfApartmentExpectation{}
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation.params != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Expect")
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation.paramPtrs == nil {
+		mmGetLeasesOfApartment.defaultExpectation.paramPtrs = &LeasesStorageMockGetLeasesOfApartmentParamPtrs{}
+	}
+	mmGetLeasesOfApartment.defaultExpectation.paramPtrs.apartmentId = &apartmentId
+
+	return mmGetLeasesOfApartment
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeasesStorage.GetLeasesOfApartment
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Inspect(f func(ctx context.Context, apartmentId int)) *mLeasesStorageMockGetLeasesOfApartment {
+	if mmGetLeasesOfApartment.mock.inspectFuncGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("Inspect function is already set for LeasesStorageMock.GetLeasesOfApartment")
+	}
+
+	mmGetLeasesOfApartment.mock.inspectFuncGetLeasesOfApartment = f
+
+	return mmGetLeasesOfApartment
+}
+
+// Return sets up results that will be returned by LeasesStorage.GetLeasesOfApartment
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Return(lpa1 []*mm_storage.Lease, err error) *LeasesStorageMock {
+	if mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Set")
+	}
+
+	if mmGetLeasesOfApartment.defaultExpectation == nil {
+		mmGetLeasesOfApartment.defaultExpectation = &LeasesStorageMockGetLeasesOfApartmentExpectation{mock: mmGetLeasesOfApartment.mock}
+	}
+	mmGetLeasesOfApartment.defaultExpectation.results = &LeasesStorageMockGetLeasesOfApartmentResults{lpa1, err}
+	return mmGetLeasesOfApartment.mock
+}
+
+// Set uses given function f to mock the LeasesStorage.GetLeasesOfApartment method
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Set(f func(ctx context.Context, apartmentId int) (lpa1 []*mm_storage.Lease, err error)) *LeasesStorageMock {
+	if mmGetLeasesOfApartment.defaultExpectation != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("Default expectation is already set for the LeasesStorage.GetLeasesOfApartment method")
+	}
+
+	if len(mmGetLeasesOfApartment.expectations) > 0 {
+		mmGetLeasesOfApartment.mock.t.Fatalf("Some expectations are already set for the LeasesStorage.GetLeasesOfApartment method")
+	}
+
+	mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment = f
+	return mmGetLeasesOfApartment.mock
+}
+
+// When sets expectation for the LeasesStorage.GetLeasesOfApartment which will trigger the result defined by the following
+// Then helper
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) When(ctx context.Context, apartmentId int) *LeasesStorageMockGetLeasesOfApartmentExpectation {
+	if mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.mock.t.Fatalf("LeasesStorageMock.GetLeasesOfApartment mock is already set by Set")
+	}
+
+	expectation := &LeasesStorageMockGetLeasesOfApartmentExpectation{
+		mock:   mmGetLeasesOfApartment.mock,
+		params: &LeasesStorageMockGetLeasesOfApartmentParams{ctx, apartmentId},
+	}
+	mmGetLeasesOfApartment.expectations = append(mmGetLeasesOfApartment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeasesStorage.GetLeasesOfApartment return parameters for the expectation previously defined by the When method
+func (e *LeasesStorageMockGetLeasesOfApartmentExpectation) Then(lpa1 []*mm_storage.Lease, err error) *LeasesStorageMock {
+	e.results = &LeasesStorageMockGetLeasesOfApartmentResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LeasesStorage.GetLeasesOfApartment should be invoked
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Times(n uint64) *mLeasesStorageMockGetLeasesOfApartment {
+	if n == 0 {
+		mmGetLeasesOfApartment.mock.t.Fatalf("Times of LeasesStorageMock.GetLeasesOfApartment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLeasesOfApartment.expectedInvocations, n)
+	return mmGetLeasesOfApartment
+}
+
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) invocationsDone() bool {
+	if len(mmGetLeasesOfApartment.expectations) == 0 && mmGetLeasesOfApartment.defaultExpectation == nil && mmGetLeasesOfApartment.mock.funcGetLeasesOfApartment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLeasesOfApartment.mock.afterGetLeasesOfApartmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLeasesOfApartment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLeasesOfApartment implements storage.LeasesStorage
+func (mmGetLeasesOfApartment *LeasesStorageMock) GetLeasesOfApartment(ctx context.Context, apartmentId int) (lpa1 []*mm_storage.Lease, err error) {
+	mm_atomic.AddUint64(&mmGetLeasesOfApartment.beforeGetLeasesOfApartmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLeasesOfApartment.afterGetLeasesOfApartmentCounter, 1)
+
+	if mmGetLeasesOfApartment.inspectFuncGetLeasesOfApartment != nil {
+		mmGetLeasesOfApartment.inspectFuncGetLeasesOfApartment(ctx, apartmentId)
+	}
+
+	mm_params := LeasesStorageMockGetLeasesOfApartmentParams{ctx, apartmentId}
+
+	// Record call args
+	mmGetLeasesOfApartment.GetLeasesOfApartmentMock.mutex.Lock()
+	mmGetLeasesOfApartment.GetLeasesOfApartmentMock.callArgs = append(mmGetLeasesOfApartment.GetLeasesOfApartmentMock.callArgs, &mm_params)
+	mmGetLeasesOfApartment.GetLeasesOfApartmentMock.mutex.Unlock()
+
+	for _, e := range mmGetLeasesOfApartment.GetLeasesOfApartmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmGetLeasesOfApartment.GetLeasesOfApartmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLeasesOfApartment.GetLeasesOfApartmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLeasesOfApartment.GetLeasesOfApartmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLeasesOfApartment.GetLeasesOfApartmentMock.defaultExpectation.paramPtrs
+
+		mm_got := LeasesStorageMockGetLeasesOfApartmentParams{ctx, apartmentId}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLeasesOfApartment.t.Errorf("LeasesStorageMock.GetLeasesOfApartment got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.apartmentId != nil && !minimock.Equal(*mm_want_ptrs.apartmentId, mm_got.apartmentId) {
+				mmGetLeasesOfApartment.t.Errorf("LeasesStorageMock.GetLeasesOfApartment got unexpected parameter apartmentId, want: %#v, got: %#v%s\n", *mm_want_ptrs.apartmentId, mm_got.apartmentId, minimock.Diff(*mm_want_ptrs.apartmentId, mm_got.apartmentId))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLeasesOfApartment.t.Errorf("LeasesStorageMock.GetLeasesOfApartment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLeasesOfApartment.GetLeasesOfApartmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLeasesOfApartment.t.Fatal("No results are set for the LeasesStorageMock.GetLeasesOfApartment")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmGetLeasesOfApartment.funcGetLeasesOfApartment != nil {
+		return mmGetLeasesOfApartment.funcGetLeasesOfApartment(ctx, apartmentId)
+	}
+	mmGetLeasesOfApartment.t.Fatalf("Unexpected call to LeasesStorageMock.GetLeasesOfApartment. %v %v", ctx, apartmentId)
+	return
+}
+
+// GetLeasesOfApartmentAfterCounter returns a count of finished LeasesStorageMock.GetLeasesOfApartment invocations
+func (mmGetLeasesOfApartment *LeasesStorageMock) GetLeasesOfApartmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLeasesOfApartment.afterGetLeasesOfApartmentCounter)
+}
+
+// GetLeasesOfApartmentBeforeCounter returns a count of LeasesStorageMock.GetLeasesOfApartment invocations
+func (mmGetLeasesOfApartment *LeasesStorageMock) GetLeasesOfApartmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLeasesOfApartment.beforeGetLeasesOfApartmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeasesStorageMock.GetLeasesOfApartment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLeasesOfApartment *mLeasesStorageMockGetLeasesOfApartment) Calls() []*LeasesStorageMockGetLeasesOfApartmentParams {
+	mmGetLeasesOfApartment.mutex.RLock()
+
+	argCopy := make([]*LeasesStorageMockGetLeasesOfApartmentParams, len(mmGetLeasesOfApartment.callArgs))
+	copy(argCopy, mmGetLeasesOfApartment.callArgs)
+
+	mmGetLeasesOfApartment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLeasesOfApartmentDone returns true if the count of the GetLeasesOfApartment invocations corresponds
+// the number of defined expectations
+func (m *LeasesStorageMock) MinimockGetLeasesOfApartmentDone() bool {
+	if m.GetLeasesOfApartmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLeasesOfApartmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLeasesOfApartmentMock.invocationsDone()
+}
+
+// MinimockGetLeasesOfApartmentInspect logs each unmet expectation
+func (m *LeasesStorageMock) MinimockGetLeasesOfApartmentInspect() {
+	for _, e := range m.GetLeasesOfApartmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeasesStorageMock.GetLeasesOfApartment with params: %#v", *e.params)
+		}
+	}
+
+	afterGetLeasesOfApartmentCounter := mm_atomic.LoadUint64(&m.afterGetLeasesOfApartmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLeasesOfApartmentMock.defaultExpectation != nil && afterGetLeasesOfApartmentCounter < 1 {
+		if m.GetLeasesOfApartmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeasesStorageMock.GetLeasesOfApartment")
+		} else {
+			m.t.Errorf("Expected call to LeasesStorageMock.GetLeasesOfApartment with params: %#v", *m.GetLeasesOfApartmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLeasesOfApartment != nil && afterGetLeasesOfApartmentCounter < 1 {
+		m.t.Error("Expected call to LeasesStorageMock.GetLeasesOfApartment")
+	}
+
+	if !m.GetLeasesOfApartmentMock.invocationsDone() && afterGetLeasesOfApartmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to LeasesStorageMock.GetLeasesOfApartment but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLeasesOfApartmentMock.expectedInvocations), afterGetLeasesOfApartmentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LeasesStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateLeaseInspect()
+
+			m.MinimockDeleteLeaseInspect()
+
+			m.MinimockGetExpiringLeasesInspect()
+
+			m.MinimockGetLeaseInspect()
+
+			m.MinimockGetLeasesOfApartmentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LeasesStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LeasesStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateLeaseDone() &&
+		m.MinimockDeleteLeaseDone() &&
+		m.MinimockGetExpiringLeasesDone() &&
+		m.MinimockGetLeaseDone() &&
+		m.MinimockGetLeasesOfApartmentDone()
+}
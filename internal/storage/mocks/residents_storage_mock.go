@@ -0,0 +1,1782 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.ResidentsStorage -o residents_storage_mock_test.go -n ResidentsStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_storage "github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// ResidentsStorageMock implements storage.ResidentsStorage
+type ResidentsStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateResident          func(ctx context.Context, resident *mm_storage.Resident) (err error)
+	inspectFuncCreateResident   func(ctx context.Context, resident *mm_storage.Resident)
+	afterCreateResidentCounter  uint64
+	beforeCreateResidentCounter uint64
+	CreateResidentMock          mResidentsStorageMockCreateResident
+
+	funcDeleteResident          func(ctx context.Context, id int) (i1 int64, err error)
+	inspectFuncDeleteResident   func(ctx context.Context, id int)
+	afterDeleteResidentCounter  uint64
+	beforeDeleteResidentCounter uint64
+	DeleteResidentMock          mResidentsStorageMockDeleteResident
+
+	funcGetResident          func(ctx context.Context, id int) (rp1 *mm_storage.Resident, err error)
+	inspectFuncGetResident   func(ctx context.Context, id int)
+	afterGetResidentCounter  uint64
+	beforeGetResidentCounter uint64
+	GetResidentMock          mResidentsStorageMockGetResident
+
+	funcGetResidentsOfApartment          func(ctx context.Context, apartmentId int, current bool) (rpa1 []*mm_storage.Resident, err error)
+	inspectFuncGetResidentsOfApartment   func(ctx context.Context, apartmentId int, current bool)
+	afterGetResidentsOfApartmentCounter  uint64
+	beforeGetResidentsOfApartmentCounter uint64
+	GetResidentsOfApartmentMock          mResidentsStorageMockGetResidentsOfApartment
+
+	funcGetResidentsOfBuilding          func(ctx context.Context, buildingId int, current bool) (rpa1 []*mm_storage.Resident, err error)
+	inspectFuncGetResidentsOfBuilding   func(ctx context.Context, buildingId int, current bool)
+	afterGetResidentsOfBuildingCounter  uint64
+	beforeGetResidentsOfBuildingCounter uint64
+	GetResidentsOfBuildingMock          mResidentsStorageMockGetResidentsOfBuilding
+}
+
+// NewResidentsStorageMock returns a mock for storage.ResidentsStorage
+func NewResidentsStorageMock(t minimock.Tester) *ResidentsStorageMock {
+	m := &ResidentsStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateResidentMock = mResidentsStorageMockCreateResident{mock: m}
+	m.CreateResidentMock.callArgs = []*ResidentsStorageMockCreateResidentParams{}
+
+	m.DeleteResidentMock = mResidentsStorageMockDeleteResident{mock: m}
+	m.DeleteResidentMock.callArgs = []*ResidentsStorageMockDeleteResidentParams{}
+
+	m.GetResidentMock = mResidentsStorageMockGetResident{mock: m}
+	m.GetResidentMock.callArgs = []*ResidentsStorageMockGetResidentParams{}
+
+	m.GetResidentsOfApartmentMock = mResidentsStorageMockGetResidentsOfApartment{mock: m}
+	m.GetResidentsOfApartmentMock.callArgs = []*ResidentsStorageMockGetResidentsOfApartmentParams{}
+
+	m.GetResidentsOfBuildingMock = mResidentsStorageMockGetResidentsOfBuilding{mock: m}
+	m.GetResidentsOfBuildingMock.callArgs = []*ResidentsStorageMockGetResidentsOfBuildingParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mResidentsStorageMockCreateResident struct {
+	optional           bool
+	mock               *ResidentsStorageMock
+	defaultExpectation *ResidentsStorageMockCreateResidentExpectation
+	expectations       []*ResidentsStorageMockCreateResidentExpectation
+
+	callArgs []*ResidentsStorageMockCreateResidentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsStorageMockCreateResidentExpectation specifies expectation struct of the ResidentsStorage.CreateResident
+type ResidentsStorageMockCreateResidentExpectation struct {
+	mock      *ResidentsStorageMock
+	params    *ResidentsStorageMockCreateResidentParams
+	paramPtrs *ResidentsStorageMockCreateResidentParamPtrs
+	results   *ResidentsStorageMockCreateResidentResults
+	Counter   uint64
+}
+
+// ResidentsStorageMockCreateResidentParams contains parameters of the ResidentsStorage.CreateResident
+type ResidentsStorageMockCreateResidentParams struct {
+	ctx      context.Context
+	resident *mm_storage.Resident
+}
+
+// ResidentsStorageMockCreateResidentParamPtrs contains pointers to parameters of the ResidentsStorage.CreateResident
+type ResidentsStorageMockCreateResidentParamPtrs struct {
+	ctx      *context.Context
+	resident **mm_storage.Resident
+}
+
+// ResidentsStorageMockCreateResidentResults contains results of the ResidentsStorage.CreateResident
+type ResidentsStorageMockCreateResidentResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateResident *mResidentsStorageMockCreateResident) Optional() *mResidentsStorageMockCreateResident {
+	mmCreateResident.optional = true
+	return mmCreateResident
+}
+
+// Expect sets up expected params for ResidentsStorage.CreateResident
+func (mmCreateResident *mResidentsStorageMockCreateResident) Expect(ctx context.Context, resident *mm_storage.Resident) *mResidentsStorageMockCreateResident {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsStorageMockCreateResidentExpectation{}
+	}
+
+	if mmCreateResident.defaultExpectation.paramPtrs != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by ExpectParams functions")
+	}
+
+	mmCreateResident.defaultExpectation.params = &ResidentsStorageMockCreateResidentParams{ctx, resident}
+	for _, e := range mmCreateResident.expectations {
+		if minimock.Equal(e.params, mmCreateResident.defaultExpectation.params) {
+			mmCreateResident.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateResident.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateResident
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsStorage.CreateResident
+func (mmCreateResident *mResidentsStorageMockCreateResident) ExpectCtxParam1(ctx context.Context) *mResidentsStorageMockCreateResident {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsStorageMockCreateResidentExpectation{}
+	}
+
+	if mmCreateResident.defaultExpectation.params != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Expect")
+	}
+
+	if mmCreateResident.defaultExpectation.paramPtrs == nil {
+		mmCreateResident.defaultExpectation.paramPtrs = &ResidentsStorageMockCreateResidentParamPtrs{}
+	}
+	mmCreateResident.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateResident
+}
+
+// ExpectResidentParam2 sets up expected param resident for ResidentsStorage.CreateResident
+func (mmCreateResident *mResidentsStorageMockCreateResident) ExpectResidentParam2(resident *mm_storage.Resident) *mResidentsStorageMockCreateResident {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsStorageMockCreateResidentExpectation{}
+	}
+
+	if mmCreateResident.defaultExpectation.params != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Expect")
+	}
+
+	if mmCreateResident.defaultExpectation.paramPtrs == nil {
+		mmCreateResident.defaultExpectation.paramPtrs = &ResidentsStorageMockCreateResidentParamPtrs{}
+	}
+	mmCreateResident.defaultExpectation.paramPtrs.resident = &resident
+
+	return mmCreateResident
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsStorage.CreateResident
+func (mmCreateResident *mResidentsStorageMockCreateResident) Inspect(f func(ctx context.Context, resident *mm_storage.Resident)) *mResidentsStorageMockCreateResident {
+	if mmCreateResident.mock.inspectFuncCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("Inspect function is already set for ResidentsStorageMock.CreateResident")
+	}
+
+	mmCreateResident.mock.inspectFuncCreateResident = f
+
+	return mmCreateResident
+}
+
+// Return sets up results that will be returned by ResidentsStorage.CreateResident
+func (mmCreateResident *mResidentsStorageMockCreateResident) Return(err error) *ResidentsStorageMock {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Set")
+	}
+
+	if mmCreateResident.defaultExpectation == nil {
+		mmCreateResident.defaultExpectation = &ResidentsStorageMockCreateResidentExpectation{mock: mmCreateResident.mock}
+	}
+	mmCreateResident.defaultExpectation.results = &ResidentsStorageMockCreateResidentResults{err}
+	return mmCreateResident.mock
+}
+
+// Set uses given function f to mock the ResidentsStorage.CreateResident method
+func (mmCreateResident *mResidentsStorageMockCreateResident) Set(f func(ctx context.Context, resident *mm_storage.Resident) (err error)) *ResidentsStorageMock {
+	if mmCreateResident.defaultExpectation != nil {
+		mmCreateResident.mock.t.Fatalf("Default expectation is already set for the ResidentsStorage.CreateResident method")
+	}
+
+	if len(mmCreateResident.expectations) > 0 {
+		mmCreateResident.mock.t.Fatalf("Some expectations are already set for the ResidentsStorage.CreateResident method")
+	}
+
+	mmCreateResident.mock.funcCreateResident = f
+	return mmCreateResident.mock
+}
+
+// When sets expectation for the ResidentsStorage.CreateResident which will trigger the result defined by the following
+// Then helper
+func (mmCreateResident *mResidentsStorageMockCreateResident) When(ctx context.Context, resident *mm_storage.Resident) *ResidentsStorageMockCreateResidentExpectation {
+	if mmCreateResident.mock.funcCreateResident != nil {
+		mmCreateResident.mock.t.Fatalf("ResidentsStorageMock.CreateResident mock is already set by Set")
+	}
+
+	expectation := &ResidentsStorageMockCreateResidentExpectation{
+		mock:   mmCreateResident.mock,
+		params: &ResidentsStorageMockCreateResidentParams{ctx, resident},
+	}
+	mmCreateResident.expectations = append(mmCreateResident.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsStorage.CreateResident return parameters for the expectation previously defined by the When method
+func (e *ResidentsStorageMockCreateResidentExpectation) Then(err error) *ResidentsStorageMock {
+	e.results = &ResidentsStorageMockCreateResidentResults{err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsStorage.CreateResident should be invoked
+func (mmCreateResident *mResidentsStorageMockCreateResident) Times(n uint64) *mResidentsStorageMockCreateResident {
+	if n == 0 {
+		mmCreateResident.mock.t.Fatalf("Times of ResidentsStorageMock.CreateResident mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateResident.expectedInvocations, n)
+	return mmCreateResident
+}
+
+func (mmCreateResident *mResidentsStorageMockCreateResident) invocationsDone() bool {
+	if len(mmCreateResident.expectations) == 0 && mmCreateResident.defaultExpectation == nil && mmCreateResident.mock.funcCreateResident == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateResident.mock.afterCreateResidentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateResident.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateResident implements storage.ResidentsStorage
+func (mmCreateResident *ResidentsStorageMock) CreateResident(ctx context.Context, resident *mm_storage.Resident) (err error) {
+	mm_atomic.AddUint64(&mmCreateResident.beforeCreateResidentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateResident.afterCreateResidentCounter, 1)
+
+	if mmCreateResident.inspectFuncCreateResident != nil {
+		mmCreateResident.inspectFuncCreateResident(ctx, resident)
+	}
+
+	mm_params := ResidentsStorageMockCreateResidentParams{ctx, resident}
+
+	// Record call args
+	mmCreateResident.CreateResidentMock.mutex.Lock()
+	mmCreateResident.CreateResidentMock.callArgs = append(mmCreateResident.CreateResidentMock.callArgs, &mm_params)
+	mmCreateResident.CreateResidentMock.mutex.Unlock()
+
+	for _, e := range mmCreateResident.CreateResidentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateResident.CreateResidentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateResident.CreateResidentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateResident.CreateResidentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateResident.CreateResidentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsStorageMockCreateResidentParams{ctx, resident}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateResident.t.Errorf("ResidentsStorageMock.CreateResident got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.resident != nil && !minimock.Equal(*mm_want_ptrs.resident, mm_got.resident) {
+				mmCreateResident.t.Errorf("ResidentsStorageMock.CreateResident got unexpected parameter resident, want: %#v, got: %#v%s\n", *mm_want_ptrs.resident, mm_got.resident, minimock.Diff(*mm_want_ptrs.resident, mm_got.resident))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateResident.t.Errorf("ResidentsStorageMock.CreateResident got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateResident.CreateResidentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateResident.t.Fatal("No results are set for the ResidentsStorageMock.CreateResident")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateResident.funcCreateResident != nil {
+		return mmCreateResident.funcCreateResident(ctx, resident)
+	}
+	mmCreateResident.t.Fatalf("Unexpected call to ResidentsStorageMock.CreateResident. %v %v", ctx, resident)
+	return
+}
+
+// CreateResidentAfterCounter returns a count of finished ResidentsStorageMock.CreateResident invocations
+func (mmCreateResident *ResidentsStorageMock) CreateResidentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateResident.afterCreateResidentCounter)
+}
+
+// CreateResidentBeforeCounter returns a count of ResidentsStorageMock.CreateResident invocations
+func (mmCreateResident *ResidentsStorageMock) CreateResidentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateResident.beforeCreateResidentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsStorageMock.CreateResident.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateResident *mResidentsStorageMockCreateResident) Calls() []*ResidentsStorageMockCreateResidentParams {
+	mmCreateResident.mutex.RLock()
+
+	argCopy := make([]*ResidentsStorageMockCreateResidentParams, len(mmCreateResident.callArgs))
+	copy(argCopy, mmCreateResident.callArgs)
+
+	mmCreateResident.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateResidentDone returns true if the count of the CreateResident invocations corresponds
+// the number of defined expectations
+func (m *ResidentsStorageMock) MinimockCreateResidentDone() bool {
+	if m.CreateResidentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateResidentMock.invocationsDone()
+}
+
+// MinimockCreateResidentInspect logs each unmet expectation
+func (m *ResidentsStorageMock) MinimockCreateResidentInspect() {
+	for _, e := range m.CreateResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsStorageMock.CreateResident with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateResidentCounter := mm_atomic.LoadUint64(&m.afterCreateResidentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateResidentMock.defaultExpectation != nil && afterCreateResidentCounter < 1 {
+		if m.CreateResidentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsStorageMock.CreateResident")
+		} else {
+			m.t.Errorf("Expected call to ResidentsStorageMock.CreateResident with params: %#v", *m.CreateResidentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateResident != nil && afterCreateResidentCounter < 1 {
+		m.t.Error("Expected call to ResidentsStorageMock.CreateResident")
+	}
+
+	if !m.CreateResidentMock.invocationsDone() && afterCreateResidentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsStorageMock.CreateResident but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateResidentMock.expectedInvocations), afterCreateResidentCounter)
+	}
+}
+
+type mResidentsStorageMockDeleteResident struct {
+	optional           bool
+	mock               *ResidentsStorageMock
+	defaultExpectation *ResidentsStorageMockDeleteResidentExpectation
+	expectations       []*ResidentsStorageMockDeleteResidentExpectation
+
+	callArgs []*ResidentsStorageMockDeleteResidentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsStorageMockDeleteResidentExpectation specifies expectation struct of the ResidentsStorage.DeleteResident
+type ResidentsStorageMockDeleteResidentExpectation struct {
+	mock      *ResidentsStorageMock
+	params    *ResidentsStorageMockDeleteResidentParams
+	paramPtrs *ResidentsStorageMockDeleteResidentParamPtrs
+	results   *ResidentsStorageMockDeleteResidentResults
+	Counter   uint64
+}
+
+// ResidentsStorageMockDeleteResidentParams contains parameters of the ResidentsStorage.DeleteResident
+type ResidentsStorageMockDeleteResidentParams struct {
+	ctx context.Context
+	id  int
+}
+
+// ResidentsStorageMockDeleteResidentParamPtrs contains pointers to parameters of the ResidentsStorage.DeleteResident
+type ResidentsStorageMockDeleteResidentParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// ResidentsStorageMockDeleteResidentResults contains results of the ResidentsStorage.DeleteResident
+type ResidentsStorageMockDeleteResidentResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Optional() *mResidentsStorageMockDeleteResident {
+	mmDeleteResident.optional = true
+	return mmDeleteResident
+}
+
+// Expect sets up expected params for ResidentsStorage.DeleteResident
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Expect(ctx context.Context, id int) *mResidentsStorageMockDeleteResident {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsStorageMockDeleteResidentExpectation{}
+	}
+
+	if mmDeleteResident.defaultExpectation.paramPtrs != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteResident.defaultExpectation.params = &ResidentsStorageMockDeleteResidentParams{ctx, id}
+	for _, e := range mmDeleteResident.expectations {
+		if minimock.Equal(e.params, mmDeleteResident.defaultExpectation.params) {
+			mmDeleteResident.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteResident.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteResident
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsStorage.DeleteResident
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) ExpectCtxParam1(ctx context.Context) *mResidentsStorageMockDeleteResident {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsStorageMockDeleteResidentExpectation{}
+	}
+
+	if mmDeleteResident.defaultExpectation.params != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Expect")
+	}
+
+	if mmDeleteResident.defaultExpectation.paramPtrs == nil {
+		mmDeleteResident.defaultExpectation.paramPtrs = &ResidentsStorageMockDeleteResidentParamPtrs{}
+	}
+	mmDeleteResident.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteResident
+}
+
+// ExpectIdParam2 sets up expected param id for ResidentsStorage.DeleteResident
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) ExpectIdParam2(id int) *mResidentsStorageMockDeleteResident {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsStorageMockDeleteResidentExpectation{}
+	}
+
+	if mmDeleteResident.defaultExpectation.params != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Expect")
+	}
+
+	if mmDeleteResident.defaultExpectation.paramPtrs == nil {
+		mmDeleteResident.defaultExpectation.paramPtrs = &ResidentsStorageMockDeleteResidentParamPtrs{}
+	}
+	mmDeleteResident.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteResident
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsStorage.DeleteResident
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Inspect(f func(ctx context.Context, id int)) *mResidentsStorageMockDeleteResident {
+	if mmDeleteResident.mock.inspectFuncDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("Inspect function is already set for ResidentsStorageMock.DeleteResident")
+	}
+
+	mmDeleteResident.mock.inspectFuncDeleteResident = f
+
+	return mmDeleteResident
+}
+
+// Return sets up results that will be returned by ResidentsStorage.DeleteResident
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Return(i1 int64, err error) *ResidentsStorageMock {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Set")
+	}
+
+	if mmDeleteResident.defaultExpectation == nil {
+		mmDeleteResident.defaultExpectation = &ResidentsStorageMockDeleteResidentExpectation{mock: mmDeleteResident.mock}
+	}
+	mmDeleteResident.defaultExpectation.results = &ResidentsStorageMockDeleteResidentResults{i1, err}
+	return mmDeleteResident.mock
+}
+
+// Set uses given function f to mock the ResidentsStorage.DeleteResident method
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Set(f func(ctx context.Context, id int) (i1 int64, err error)) *ResidentsStorageMock {
+	if mmDeleteResident.defaultExpectation != nil {
+		mmDeleteResident.mock.t.Fatalf("Default expectation is already set for the ResidentsStorage.DeleteResident method")
+	}
+
+	if len(mmDeleteResident.expectations) > 0 {
+		mmDeleteResident.mock.t.Fatalf("Some expectations are already set for the ResidentsStorage.DeleteResident method")
+	}
+
+	mmDeleteResident.mock.funcDeleteResident = f
+	return mmDeleteResident.mock
+}
+
+// When sets expectation for the ResidentsStorage.DeleteResident which will trigger the result defined by the following
+// Then helper
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) When(ctx context.Context, id int) *ResidentsStorageMockDeleteResidentExpectation {
+	if mmDeleteResident.mock.funcDeleteResident != nil {
+		mmDeleteResident.mock.t.Fatalf("ResidentsStorageMock.DeleteResident mock is already set by Set")
+	}
+
+	expectation := &ResidentsStorageMockDeleteResidentExpectation{
+		mock:   mmDeleteResident.mock,
+		params: &ResidentsStorageMockDeleteResidentParams{ctx, id},
+	}
+	mmDeleteResident.expectations = append(mmDeleteResident.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsStorage.DeleteResident return parameters for the expectation previously defined by the When method
+func (e *ResidentsStorageMockDeleteResidentExpectation) Then(i1 int64, err error) *ResidentsStorageMock {
+	e.results = &ResidentsStorageMockDeleteResidentResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsStorage.DeleteResident should be invoked
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Times(n uint64) *mResidentsStorageMockDeleteResident {
+	if n == 0 {
+		mmDeleteResident.mock.t.Fatalf("Times of ResidentsStorageMock.DeleteResident mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteResident.expectedInvocations, n)
+	return mmDeleteResident
+}
+
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) invocationsDone() bool {
+	if len(mmDeleteResident.expectations) == 0 && mmDeleteResident.defaultExpectation == nil && mmDeleteResident.mock.funcDeleteResident == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteResident.mock.afterDeleteResidentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteResident.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteResident implements storage.ResidentsStorage
+func (mmDeleteResident *ResidentsStorageMock) DeleteResident(ctx context.Context, id int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteResident.beforeDeleteResidentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteResident.afterDeleteResidentCounter, 1)
+
+	if mmDeleteResident.inspectFuncDeleteResident != nil {
+		mmDeleteResident.inspectFuncDeleteResident(ctx, id)
+	}
+
+	mm_params := ResidentsStorageMockDeleteResidentParams{ctx, id}
+
+	// Record call args
+	mmDeleteResident.DeleteResidentMock.mutex.Lock()
+	mmDeleteResident.DeleteResidentMock.callArgs = append(mmDeleteResident.DeleteResidentMock.callArgs, &mm_params)
+	mmDeleteResident.DeleteResidentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteResident.DeleteResidentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteResident.DeleteResidentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteResident.DeleteResidentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteResident.DeleteResidentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteResident.DeleteResidentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsStorageMockDeleteResidentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteResident.t.Errorf("ResidentsStorageMock.DeleteResident got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteResident.t.Errorf("ResidentsStorageMock.DeleteResident got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteResident.t.Errorf("ResidentsStorageMock.DeleteResident got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteResident.DeleteResidentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteResident.t.Fatal("No results are set for the ResidentsStorageMock.DeleteResident")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteResident.funcDeleteResident != nil {
+		return mmDeleteResident.funcDeleteResident(ctx, id)
+	}
+	mmDeleteResident.t.Fatalf("Unexpected call to ResidentsStorageMock.DeleteResident. %v %v", ctx, id)
+	return
+}
+
+// DeleteResidentAfterCounter returns a count of finished ResidentsStorageMock.DeleteResident invocations
+func (mmDeleteResident *ResidentsStorageMock) DeleteResidentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteResident.afterDeleteResidentCounter)
+}
+
+// DeleteResidentBeforeCounter returns a count of ResidentsStorageMock.DeleteResident invocations
+func (mmDeleteResident *ResidentsStorageMock) DeleteResidentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteResident.beforeDeleteResidentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsStorageMock.DeleteResident.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteResident *mResidentsStorageMockDeleteResident) Calls() []*ResidentsStorageMockDeleteResidentParams {
+	mmDeleteResident.mutex.RLock()
+
+	argCopy := make([]*ResidentsStorageMockDeleteResidentParams, len(mmDeleteResident.callArgs))
+	copy(argCopy, mmDeleteResident.callArgs)
+
+	mmDeleteResident.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteResidentDone returns true if the count of the DeleteResident invocations corresponds
+// the number of defined expectations
+func (m *ResidentsStorageMock) MinimockDeleteResidentDone() bool {
+	if m.DeleteResidentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteResidentMock.invocationsDone()
+}
+
+// MinimockDeleteResidentInspect logs each unmet expectation
+func (m *ResidentsStorageMock) MinimockDeleteResidentInspect() {
+	for _, e := range m.DeleteResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsStorageMock.DeleteResident with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteResidentCounter := mm_atomic.LoadUint64(&m.afterDeleteResidentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteResidentMock.defaultExpectation != nil && afterDeleteResidentCounter < 1 {
+		if m.DeleteResidentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsStorageMock.DeleteResident")
+		} else {
+			m.t.Errorf("Expected call to ResidentsStorageMock.DeleteResident with params: %#v", *m.DeleteResidentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteResident != nil && afterDeleteResidentCounter < 1 {
+		m.t.Error("Expected call to ResidentsStorageMock.DeleteResident")
+	}
+
+	if !m.DeleteResidentMock.invocationsDone() && afterDeleteResidentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsStorageMock.DeleteResident but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteResidentMock.expectedInvocations), afterDeleteResidentCounter)
+	}
+}
+
+type mResidentsStorageMockGetResident struct {
+	optional           bool
+	mock               *ResidentsStorageMock
+	defaultExpectation *ResidentsStorageMockGetResidentExpectation
+	expectations       []*ResidentsStorageMockGetResidentExpectation
+
+	callArgs []*ResidentsStorageMockGetResidentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsStorageMockGetResidentExpectation specifies expectation struct of the ResidentsStorage.GetResident
+type ResidentsStorageMockGetResidentExpectation struct {
+	mock      *ResidentsStorageMock
+	params    *ResidentsStorageMockGetResidentParams
+	paramPtrs *ResidentsStorageMockGetResidentParamPtrs
+	results   *ResidentsStorageMockGetResidentResults
+	Counter   uint64
+}
+
+// ResidentsStorageMockGetResidentParams contains parameters of the ResidentsStorage.GetResident
+type ResidentsStorageMockGetResidentParams struct {
+	ctx context.Context
+	id  int
+}
+
+// ResidentsStorageMockGetResidentParamPtrs contains pointers to parameters of the ResidentsStorage.GetResident
+type ResidentsStorageMockGetResidentParamPtrs struct {
+	ctx *context.Context
+	id  *int
+}
+
+// ResidentsStorageMockGetResidentResults contains results of the ResidentsStorage.GetResident
+type ResidentsStorageMockGetResidentResults struct {
+	rp1 *mm_storage.Resident
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetResident *mResidentsStorageMockGetResident) Optional() *mResidentsStorageMockGetResident {
+	mmGetResident.optional = true
+	return mmGetResident
+}
+
+// Expect sets up expected params for ResidentsStorage.GetResident
+func (mmGetResident *mResidentsStorageMockGetResident) Expect(ctx context.Context, id int) *mResidentsStorageMockGetResident {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsStorageMockGetResidentExpectation{}
+	}
+
+	if mmGetResident.defaultExpectation.paramPtrs != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by ExpectParams functions")
+	}
+
+	mmGetResident.defaultExpectation.params = &ResidentsStorageMockGetResidentParams{ctx, id}
+	for _, e := range mmGetResident.expectations {
+		if minimock.Equal(e.params, mmGetResident.defaultExpectation.params) {
+			mmGetResident.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResident.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResident
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsStorage.GetResident
+func (mmGetResident *mResidentsStorageMockGetResident) ExpectCtxParam1(ctx context.Context) *mResidentsStorageMockGetResident {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsStorageMockGetResidentExpectation{}
+	}
+
+	if mmGetResident.defaultExpectation.params != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Expect")
+	}
+
+	if mmGetResident.defaultExpectation.paramPtrs == nil {
+		mmGetResident.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentParamPtrs{}
+	}
+	mmGetResident.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetResident
+}
+
+// ExpectIdParam2 sets up expected param id for ResidentsStorage.GetResident
+func (mmGetResident *mResidentsStorageMockGetResident) ExpectIdParam2(id int) *mResidentsStorageMockGetResident {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsStorageMockGetResidentExpectation{}
+	}
+
+	if mmGetResident.defaultExpectation.params != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Expect")
+	}
+
+	if mmGetResident.defaultExpectation.paramPtrs == nil {
+		mmGetResident.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentParamPtrs{}
+	}
+	mmGetResident.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetResident
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsStorage.GetResident
+func (mmGetResident *mResidentsStorageMockGetResident) Inspect(f func(ctx context.Context, id int)) *mResidentsStorageMockGetResident {
+	if mmGetResident.mock.inspectFuncGetResident != nil {
+		mmGetResident.mock.t.Fatalf("Inspect function is already set for ResidentsStorageMock.GetResident")
+	}
+
+	mmGetResident.mock.inspectFuncGetResident = f
+
+	return mmGetResident
+}
+
+// Return sets up results that will be returned by ResidentsStorage.GetResident
+func (mmGetResident *mResidentsStorageMockGetResident) Return(rp1 *mm_storage.Resident, err error) *ResidentsStorageMock {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Set")
+	}
+
+	if mmGetResident.defaultExpectation == nil {
+		mmGetResident.defaultExpectation = &ResidentsStorageMockGetResidentExpectation{mock: mmGetResident.mock}
+	}
+	mmGetResident.defaultExpectation.results = &ResidentsStorageMockGetResidentResults{rp1, err}
+	return mmGetResident.mock
+}
+
+// Set uses given function f to mock the ResidentsStorage.GetResident method
+func (mmGetResident *mResidentsStorageMockGetResident) Set(f func(ctx context.Context, id int) (rp1 *mm_storage.Resident, err error)) *ResidentsStorageMock {
+	if mmGetResident.defaultExpectation != nil {
+		mmGetResident.mock.t.Fatalf("Default expectation is already set for the ResidentsStorage.GetResident method")
+	}
+
+	if len(mmGetResident.expectations) > 0 {
+		mmGetResident.mock.t.Fatalf("Some expectations are already set for the ResidentsStorage.GetResident method")
+	}
+
+	mmGetResident.mock.funcGetResident = f
+	return mmGetResident.mock
+}
+
+// When sets expectation for the ResidentsStorage.GetResident which will trigger the result defined by the following
+// Then helper
+func (mmGetResident *mResidentsStorageMockGetResident) When(ctx context.Context, id int) *ResidentsStorageMockGetResidentExpectation {
+	if mmGetResident.mock.funcGetResident != nil {
+		mmGetResident.mock.t.Fatalf("ResidentsStorageMock.GetResident mock is already set by Set")
+	}
+
+	expectation := &ResidentsStorageMockGetResidentExpectation{
+		mock:   mmGetResident.mock,
+		params: &ResidentsStorageMockGetResidentParams{ctx, id},
+	}
+	mmGetResident.expectations = append(mmGetResident.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsStorage.GetResident return parameters for the expectation previously defined by the When method
+func (e *ResidentsStorageMockGetResidentExpectation) Then(rp1 *mm_storage.Resident, err error) *ResidentsStorageMock {
+	e.results = &ResidentsStorageMockGetResidentResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsStorage.GetResident should be invoked
+func (mmGetResident *mResidentsStorageMockGetResident) Times(n uint64) *mResidentsStorageMockGetResident {
+	if n == 0 {
+		mmGetResident.mock.t.Fatalf("Times of ResidentsStorageMock.GetResident mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetResident.expectedInvocations, n)
+	return mmGetResident
+}
+
+func (mmGetResident *mResidentsStorageMockGetResident) invocationsDone() bool {
+	if len(mmGetResident.expectations) == 0 && mmGetResident.defaultExpectation == nil && mmGetResident.mock.funcGetResident == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetResident.mock.afterGetResidentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetResident.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetResident implements storage.ResidentsStorage
+func (mmGetResident *ResidentsStorageMock) GetResident(ctx context.Context, id int) (rp1 *mm_storage.Resident, err error) {
+	mm_atomic.AddUint64(&mmGetResident.beforeGetResidentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResident.afterGetResidentCounter, 1)
+
+	if mmGetResident.inspectFuncGetResident != nil {
+		mmGetResident.inspectFuncGetResident(ctx, id)
+	}
+
+	mm_params := ResidentsStorageMockGetResidentParams{ctx, id}
+
+	// Record call args
+	mmGetResident.GetResidentMock.mutex.Lock()
+	mmGetResident.GetResidentMock.callArgs = append(mmGetResident.GetResidentMock.callArgs, &mm_params)
+	mmGetResident.GetResidentMock.mutex.Unlock()
+
+	for _, e := range mmGetResident.GetResidentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetResident.GetResidentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResident.GetResidentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResident.GetResidentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetResident.GetResidentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsStorageMockGetResidentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetResident.t.Errorf("ResidentsStorageMock.GetResident got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetResident.t.Errorf("ResidentsStorageMock.GetResident got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResident.t.Errorf("ResidentsStorageMock.GetResident got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResident.GetResidentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResident.t.Fatal("No results are set for the ResidentsStorageMock.GetResident")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetResident.funcGetResident != nil {
+		return mmGetResident.funcGetResident(ctx, id)
+	}
+	mmGetResident.t.Fatalf("Unexpected call to ResidentsStorageMock.GetResident. %v %v", ctx, id)
+	return
+}
+
+// GetResidentAfterCounter returns a count of finished ResidentsStorageMock.GetResident invocations
+func (mmGetResident *ResidentsStorageMock) GetResidentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResident.afterGetResidentCounter)
+}
+
+// GetResidentBeforeCounter returns a count of ResidentsStorageMock.GetResident invocations
+func (mmGetResident *ResidentsStorageMock) GetResidentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResident.beforeGetResidentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsStorageMock.GetResident.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResident *mResidentsStorageMockGetResident) Calls() []*ResidentsStorageMockGetResidentParams {
+	mmGetResident.mutex.RLock()
+
+	argCopy := make([]*ResidentsStorageMockGetResidentParams, len(mmGetResident.callArgs))
+	copy(argCopy, mmGetResident.callArgs)
+
+	mmGetResident.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResidentDone returns true if the count of the GetResident invocations corresponds
+// the number of defined expectations
+func (m *ResidentsStorageMock) MinimockGetResidentDone() bool {
+	if m.GetResidentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetResidentMock.invocationsDone()
+}
+
+// MinimockGetResidentInspect logs each unmet expectation
+func (m *ResidentsStorageMock) MinimockGetResidentInspect() {
+	for _, e := range m.GetResidentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsStorageMock.GetResident with params: %#v", *e.params)
+		}
+	}
+
+	afterGetResidentCounter := mm_atomic.LoadUint64(&m.afterGetResidentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResidentMock.defaultExpectation != nil && afterGetResidentCounter < 1 {
+		if m.GetResidentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsStorageMock.GetResident")
+		} else {
+			m.t.Errorf("Expected call to ResidentsStorageMock.GetResident with params: %#v", *m.GetResidentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResident != nil && afterGetResidentCounter < 1 {
+		m.t.Error("Expected call to ResidentsStorageMock.GetResident")
+	}
+
+	if !m.GetResidentMock.invocationsDone() && afterGetResidentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsStorageMock.GetResident but found %d calls",
+			mm_atomic.LoadUint64(&m.GetResidentMock.expectedInvocations), afterGetResidentCounter)
+	}
+}
+
+type mResidentsStorageMockGetResidentsOfApartment struct {
+	optional           bool
+	mock               *ResidentsStorageMock
+	defaultExpectation *ResidentsStorageMockGetResidentsOfApartmentExpectation
+	expectations       []*ResidentsStorageMockGetResidentsOfApartmentExpectation
+
+	callArgs []*ResidentsStorageMockGetResidentsOfApartmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsStorageMockGetResidentsOfApartmentExpectation specifies expectation struct of the ResidentsStorage.GetResidentsOfApartment
+type ResidentsStorageMockGetResidentsOfApartmentExpectation struct {
+	mock      *ResidentsStorageMock
+	params    *ResidentsStorageMockGetResidentsOfApartmentParams
+	paramPtrs *ResidentsStorageMockGetResidentsOfApartmentParamPtrs
+	results   *ResidentsStorageMockGetResidentsOfApartmentResults
+	Counter   uint64
+}
+
+// ResidentsStorageMockGetResidentsOfApartmentParams contains parameters of the ResidentsStorage.GetResidentsOfApartment
+type ResidentsStorageMockGetResidentsOfApartmentParams struct {
+	ctx         context.Context
+	apartmentId int
+	current     bool
+}
+
+// ResidentsStorageMockGetResidentsOfApartmentParamPtrs contains pointers to parameters of the ResidentsStorage.GetResidentsOfApartment
+type ResidentsStorageMockGetResidentsOfApartmentParamPtrs struct {
+	ctx         *context.Context
+	apartmentId *int
+	current     *bool
+}
+
+// ResidentsStorageMockGetResidentsOfApartmentResults contains results of the ResidentsStorage.GetResidentsOfApartment
+type ResidentsStorageMockGetResidentsOfApartmentResults struct {
+	rpa1 []*mm_storage.Resident
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Optional() *mResidentsStorageMockGetResidentsOfApartment {
+	mmGetResidentsOfApartment.optional = true
+	return mmGetResidentsOfApartment
+}
+
+// Expect sets up expected params for ResidentsStorage.GetResidentsOfApartment
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Expect(ctx context.Context, apartmentId int, current bool) *mResidentsStorageMockGetResidentsOfApartment {
+	if mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Set")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation == nil {
+		mmGetResidentsOfApartment.defaultExpectation = &ResidentsStorageMockGetResidentsOfApartmentExpectation{}
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.paramPtrs != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by ExpectParams functions")
+	}
+
+	mmGetResidentsOfApartment.defaultExpectation.params = &ResidentsStorageMockGetResidentsOfApartmentParams{ctx, apartmentId, current}
+	for _, e := range mmGetResidentsOfApartment.expectations {
+		if minimock.Equal(e.params, mmGetResidentsOfApartment.defaultExpectation.params) {
+			mmGetResidentsOfApartment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResidentsOfApartment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResidentsOfApartment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsStorage.GetResidentsOfApartment
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) ExpectCtxParam1(ctx context.Context) *mResidentsStorageMockGetResidentsOfApartment {
+	if mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Set")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation == nil {
+		mmGetResidentsOfApartment.defaultExpectation = &ResidentsStorageMockGetResidentsOfApartmentExpectation{}
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.params != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Expect")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.paramPtrs == nil {
+		mmGetResidentsOfApartment.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentsOfApartmentParamPtrs{}
+	}
+	mmGetResidentsOfApartment.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetResidentsOfApartment
+}
+
+// ExpectApartmentIdParam2 sets up expected param apartmentId for ResidentsStorage.GetResidentsOfApartment
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) ExpectApartmentIdParam2(apartmentId int) *mResidentsStorageMockGetResidentsOfApartment {
+	if mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Set")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation == nil {
+		mmGetResidentsOfApartment.defaultExpectation = &ResidentsStorageMockGetResidentsOfApartmentExpectation{}
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.params != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Expect")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.paramPtrs == nil {
+		mmGetResidentsOfApartment.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentsOfApartmentParamPtrs{}
+	}
+	mmGetResidentsOfApartment.defaultExpectation.paramPtrs.apartmentId = &apartmentId
+
+	return mmGetResidentsOfApartment
+}
+
+// ExpectCurrentParam3 sets up expected param current for ResidentsStorage.GetResidentsOfApartment
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) ExpectCurrentParam3(current bool) *mResidentsStorageMockGetResidentsOfApartment {
+	if mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Set")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation == nil {
+		mmGetResidentsOfApartment.defaultExpectation = &ResidentsStorageMockGetResidentsOfApartmentExpectation{}
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.params != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Expect")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation.paramPtrs == nil {
+		mmGetResidentsOfApartment.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentsOfApartmentParamPtrs{}
+	}
+	mmGetResidentsOfApartment.defaultExpectation.paramPtrs.current = &current
+
+	return mmGetResidentsOfApartment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsStorage.GetResidentsOfApartment
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Inspect(f func(ctx context.Context, apartmentId int, current bool)) *mResidentsStorageMockGetResidentsOfApartment {
+	if mmGetResidentsOfApartment.mock.inspectFuncGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("Inspect function is already set for ResidentsStorageMock.GetResidentsOfApartment")
+	}
+
+	mmGetResidentsOfApartment.mock.inspectFuncGetResidentsOfApartment = f
+
+	return mmGetResidentsOfApartment
+}
+
+// Return sets up results that will be returned by ResidentsStorage.GetResidentsOfApartment
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Return(rpa1 []*mm_storage.Resident, err error) *ResidentsStorageMock {
+	if mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Set")
+	}
+
+	if mmGetResidentsOfApartment.defaultExpectation == nil {
+		mmGetResidentsOfApartment.defaultExpectation = &ResidentsStorageMockGetResidentsOfApartmentExpectation{mock: mmGetResidentsOfApartment.mock}
+	}
+	mmGetResidentsOfApartment.defaultExpectation.results = &ResidentsStorageMockGetResidentsOfApartmentResults{rpa1, err}
+	return mmGetResidentsOfApartment.mock
+}
+
+// Set uses given function f to mock the ResidentsStorage.GetResidentsOfApartment method
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Set(f func(ctx context.Context, apartmentId int, current bool) (rpa1 []*mm_storage.Resident, err error)) *ResidentsStorageMock {
+	if mmGetResidentsOfApartment.defaultExpectation != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("Default expectation is already set for the ResidentsStorage.GetResidentsOfApartment method")
+	}
+
+	if len(mmGetResidentsOfApartment.expectations) > 0 {
+		mmGetResidentsOfApartment.mock.t.Fatalf("Some expectations are already set for the ResidentsStorage.GetResidentsOfApartment method")
+	}
+
+	mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment = f
+	return mmGetResidentsOfApartment.mock
+}
+
+// When sets expectation for the ResidentsStorage.GetResidentsOfApartment which will trigger the result defined by the following
+// Then helper
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) When(ctx context.Context, apartmentId int, current bool) *ResidentsStorageMockGetResidentsOfApartmentExpectation {
+	if mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfApartment mock is already set by Set")
+	}
+
+	expectation := &ResidentsStorageMockGetResidentsOfApartmentExpectation{
+		mock:   mmGetResidentsOfApartment.mock,
+		params: &ResidentsStorageMockGetResidentsOfApartmentParams{ctx, apartmentId, current},
+	}
+	mmGetResidentsOfApartment.expectations = append(mmGetResidentsOfApartment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsStorage.GetResidentsOfApartment return parameters for the expectation previously defined by the When method
+func (e *ResidentsStorageMockGetResidentsOfApartmentExpectation) Then(rpa1 []*mm_storage.Resident, err error) *ResidentsStorageMock {
+	e.results = &ResidentsStorageMockGetResidentsOfApartmentResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsStorage.GetResidentsOfApartment should be invoked
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Times(n uint64) *mResidentsStorageMockGetResidentsOfApartment {
+	if n == 0 {
+		mmGetResidentsOfApartment.mock.t.Fatalf("Times of ResidentsStorageMock.GetResidentsOfApartment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetResidentsOfApartment.expectedInvocations, n)
+	return mmGetResidentsOfApartment
+}
+
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) invocationsDone() bool {
+	if len(mmGetResidentsOfApartment.expectations) == 0 && mmGetResidentsOfApartment.defaultExpectation == nil && mmGetResidentsOfApartment.mock.funcGetResidentsOfApartment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetResidentsOfApartment.mock.afterGetResidentsOfApartmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetResidentsOfApartment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetResidentsOfApartment implements storage.ResidentsStorage
+func (mmGetResidentsOfApartment *ResidentsStorageMock) GetResidentsOfApartment(ctx context.Context, apartmentId int, current bool) (rpa1 []*mm_storage.Resident, err error) {
+	mm_atomic.AddUint64(&mmGetResidentsOfApartment.beforeGetResidentsOfApartmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResidentsOfApartment.afterGetResidentsOfApartmentCounter, 1)
+
+	if mmGetResidentsOfApartment.inspectFuncGetResidentsOfApartment != nil {
+		mmGetResidentsOfApartment.inspectFuncGetResidentsOfApartment(ctx, apartmentId, current)
+	}
+
+	mm_params := ResidentsStorageMockGetResidentsOfApartmentParams{ctx, apartmentId, current}
+
+	// Record call args
+	mmGetResidentsOfApartment.GetResidentsOfApartmentMock.mutex.Lock()
+	mmGetResidentsOfApartment.GetResidentsOfApartmentMock.callArgs = append(mmGetResidentsOfApartment.GetResidentsOfApartmentMock.callArgs, &mm_params)
+	mmGetResidentsOfApartment.GetResidentsOfApartmentMock.mutex.Unlock()
+
+	for _, e := range mmGetResidentsOfApartment.GetResidentsOfApartmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetResidentsOfApartment.GetResidentsOfApartmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResidentsOfApartment.GetResidentsOfApartmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResidentsOfApartment.GetResidentsOfApartmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetResidentsOfApartment.GetResidentsOfApartmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsStorageMockGetResidentsOfApartmentParams{ctx, apartmentId, current}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetResidentsOfApartment.t.Errorf("ResidentsStorageMock.GetResidentsOfApartment got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.apartmentId != nil && !minimock.Equal(*mm_want_ptrs.apartmentId, mm_got.apartmentId) {
+				mmGetResidentsOfApartment.t.Errorf("ResidentsStorageMock.GetResidentsOfApartment got unexpected parameter apartmentId, want: %#v, got: %#v%s\n", *mm_want_ptrs.apartmentId, mm_got.apartmentId, minimock.Diff(*mm_want_ptrs.apartmentId, mm_got.apartmentId))
+			}
+
+			if mm_want_ptrs.current != nil && !minimock.Equal(*mm_want_ptrs.current, mm_got.current) {
+				mmGetResidentsOfApartment.t.Errorf("ResidentsStorageMock.GetResidentsOfApartment got unexpected parameter current, want: %#v, got: %#v%s\n", *mm_want_ptrs.current, mm_got.current, minimock.Diff(*mm_want_ptrs.current, mm_got.current))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResidentsOfApartment.t.Errorf("ResidentsStorageMock.GetResidentsOfApartment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResidentsOfApartment.GetResidentsOfApartmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResidentsOfApartment.t.Fatal("No results are set for the ResidentsStorageMock.GetResidentsOfApartment")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetResidentsOfApartment.funcGetResidentsOfApartment != nil {
+		return mmGetResidentsOfApartment.funcGetResidentsOfApartment(ctx, apartmentId, current)
+	}
+	mmGetResidentsOfApartment.t.Fatalf("Unexpected call to ResidentsStorageMock.GetResidentsOfApartment. %v %v %v", ctx, apartmentId, current)
+	return
+}
+
+// GetResidentsOfApartmentAfterCounter returns a count of finished ResidentsStorageMock.GetResidentsOfApartment invocations
+func (mmGetResidentsOfApartment *ResidentsStorageMock) GetResidentsOfApartmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResidentsOfApartment.afterGetResidentsOfApartmentCounter)
+}
+
+// GetResidentsOfApartmentBeforeCounter returns a count of ResidentsStorageMock.GetResidentsOfApartment invocations
+func (mmGetResidentsOfApartment *ResidentsStorageMock) GetResidentsOfApartmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResidentsOfApartment.beforeGetResidentsOfApartmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsStorageMock.GetResidentsOfApartment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResidentsOfApartment *mResidentsStorageMockGetResidentsOfApartment) Calls() []*ResidentsStorageMockGetResidentsOfApartmentParams {
+	mmGetResidentsOfApartment.mutex.RLock()
+
+	argCopy := make([]*ResidentsStorageMockGetResidentsOfApartmentParams, len(mmGetResidentsOfApartment.callArgs))
+	copy(argCopy, mmGetResidentsOfApartment.callArgs)
+
+	mmGetResidentsOfApartment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResidentsOfApartmentDone returns true if the count of the GetResidentsOfApartment invocations corresponds
+// the number of defined expectations
+func (m *ResidentsStorageMock) MinimockGetResidentsOfApartmentDone() bool {
+	if m.GetResidentsOfApartmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetResidentsOfApartmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetResidentsOfApartmentMock.invocationsDone()
+}
+
+// MinimockGetResidentsOfApartmentInspect logs each unmet expectation
+func (m *ResidentsStorageMock) MinimockGetResidentsOfApartmentInspect() {
+	for _, e := range m.GetResidentsOfApartmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsStorageMock.GetResidentsOfApartment with params: %#v", *e.params)
+		}
+	}
+
+	afterGetResidentsOfApartmentCounter := mm_atomic.LoadUint64(&m.afterGetResidentsOfApartmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResidentsOfApartmentMock.defaultExpectation != nil && afterGetResidentsOfApartmentCounter < 1 {
+		if m.GetResidentsOfApartmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsStorageMock.GetResidentsOfApartment")
+		} else {
+			m.t.Errorf("Expected call to ResidentsStorageMock.GetResidentsOfApartment with params: %#v", *m.GetResidentsOfApartmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResidentsOfApartment != nil && afterGetResidentsOfApartmentCounter < 1 {
+		m.t.Error("Expected call to ResidentsStorageMock.GetResidentsOfApartment")
+	}
+
+	if !m.GetResidentsOfApartmentMock.invocationsDone() && afterGetResidentsOfApartmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsStorageMock.GetResidentsOfApartment but found %d calls",
+			mm_atomic.LoadUint64(&m.GetResidentsOfApartmentMock.expectedInvocations), afterGetResidentsOfApartmentCounter)
+	}
+}
+
+type mResidentsStorageMockGetResidentsOfBuilding struct {
+	optional           bool
+	mock               *ResidentsStorageMock
+	defaultExpectation *ResidentsStorageMockGetResidentsOfBuildingExpectation
+	expectations       []*ResidentsStorageMockGetResidentsOfBuildingExpectation
+
+	callArgs []*ResidentsStorageMockGetResidentsOfBuildingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ResidentsStorageMockGetResidentsOfBuildingExpectation specifies expectation struct of the ResidentsStorage.GetResidentsOfBuilding
+type ResidentsStorageMockGetResidentsOfBuildingExpectation struct {
+	mock      *ResidentsStorageMock
+	params    *ResidentsStorageMockGetResidentsOfBuildingParams
+	paramPtrs *ResidentsStorageMockGetResidentsOfBuildingParamPtrs
+	results   *ResidentsStorageMockGetResidentsOfBuildingResults
+	Counter   uint64
+}
+
+// ResidentsStorageMockGetResidentsOfBuildingParams contains parameters of the ResidentsStorage.GetResidentsOfBuilding
+type ResidentsStorageMockGetResidentsOfBuildingParams struct {
+	ctx        context.Context
+	buildingId int
+	current    bool
+}
+
+// ResidentsStorageMockGetResidentsOfBuildingParamPtrs contains pointers to parameters of the ResidentsStorage.GetResidentsOfBuilding
+type ResidentsStorageMockGetResidentsOfBuildingParamPtrs struct {
+	ctx        *context.Context
+	buildingId *int
+	current    *bool
+}
+
+// ResidentsStorageMockGetResidentsOfBuildingResults contains results of the ResidentsStorage.GetResidentsOfBuilding
+type ResidentsStorageMockGetResidentsOfBuildingResults struct {
+	rpa1 []*mm_storage.Resident
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Optional() *mResidentsStorageMockGetResidentsOfBuilding {
+	mmGetResidentsOfBuilding.optional = true
+	return mmGetResidentsOfBuilding
+}
+
+// Expect sets up expected params for ResidentsStorage.GetResidentsOfBuilding
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Expect(ctx context.Context, buildingId int, current bool) *mResidentsStorageMockGetResidentsOfBuilding {
+	if mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Set")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation == nil {
+		mmGetResidentsOfBuilding.defaultExpectation = &ResidentsStorageMockGetResidentsOfBuildingExpectation{}
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.paramPtrs != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by ExpectParams functions")
+	}
+
+	mmGetResidentsOfBuilding.defaultExpectation.params = &ResidentsStorageMockGetResidentsOfBuildingParams{ctx, buildingId, current}
+	for _, e := range mmGetResidentsOfBuilding.expectations {
+		if minimock.Equal(e.params, mmGetResidentsOfBuilding.defaultExpectation.params) {
+			mmGetResidentsOfBuilding.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResidentsOfBuilding.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResidentsOfBuilding
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResidentsStorage.GetResidentsOfBuilding
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) ExpectCtxParam1(ctx context.Context) *mResidentsStorageMockGetResidentsOfBuilding {
+	if mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Set")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation == nil {
+		mmGetResidentsOfBuilding.defaultExpectation = &ResidentsStorageMockGetResidentsOfBuildingExpectation{}
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.params != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Expect")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.paramPtrs == nil {
+		mmGetResidentsOfBuilding.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentsOfBuildingParamPtrs{}
+	}
+	mmGetResidentsOfBuilding.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetResidentsOfBuilding
+}
+
+// ExpectBuildingIdParam2 sets up expected param buildingId for ResidentsStorage.GetResidentsOfBuilding
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) ExpectBuildingIdParam2(buildingId int) *mResidentsStorageMockGetResidentsOfBuilding {
+	if mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Set")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation == nil {
+		mmGetResidentsOfBuilding.defaultExpectation = &ResidentsStorageMockGetResidentsOfBuildingExpectation{}
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.params != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Expect")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.paramPtrs == nil {
+		mmGetResidentsOfBuilding.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentsOfBuildingParamPtrs{}
+	}
+	mmGetResidentsOfBuilding.defaultExpectation.paramPtrs.buildingId = &buildingId
+
+	return mmGetResidentsOfBuilding
+}
+
+// ExpectCurrentParam3 sets up expected param current for ResidentsStorage.GetResidentsOfBuilding
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) ExpectCurrentParam3(current bool) *mResidentsStorageMockGetResidentsOfBuilding {
+	if mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Set")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation == nil {
+		mmGetResidentsOfBuilding.defaultExpectation = &ResidentsStorageMockGetResidentsOfBuildingExpectation{}
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.params != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Expect")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation.paramPtrs == nil {
+		mmGetResidentsOfBuilding.defaultExpectation.paramPtrs = &ResidentsStorageMockGetResidentsOfBuildingParamPtrs{}
+	}
+	mmGetResidentsOfBuilding.defaultExpectation.paramPtrs.current = &current
+
+	return mmGetResidentsOfBuilding
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResidentsStorage.GetResidentsOfBuilding
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Inspect(f func(ctx context.Context, buildingId int, current bool)) *mResidentsStorageMockGetResidentsOfBuilding {
+	if mmGetResidentsOfBuilding.mock.inspectFuncGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("Inspect function is already set for ResidentsStorageMock.GetResidentsOfBuilding")
+	}
+
+	mmGetResidentsOfBuilding.mock.inspectFuncGetResidentsOfBuilding = f
+
+	return mmGetResidentsOfBuilding
+}
+
+// Return sets up results that will be returned by ResidentsStorage.GetResidentsOfBuilding
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Return(rpa1 []*mm_storage.Resident, err error) *ResidentsStorageMock {
+	if mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Set")
+	}
+
+	if mmGetResidentsOfBuilding.defaultExpectation == nil {
+		mmGetResidentsOfBuilding.defaultExpectation = &ResidentsStorageMockGetResidentsOfBuildingExpectation{mock: mmGetResidentsOfBuilding.mock}
+	}
+	mmGetResidentsOfBuilding.defaultExpectation.results = &ResidentsStorageMockGetResidentsOfBuildingResults{rpa1, err}
+	return mmGetResidentsOfBuilding.mock
+}
+
+// Set uses given function f to mock the ResidentsStorage.GetResidentsOfBuilding method
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Set(f func(ctx context.Context, buildingId int, current bool) (rpa1 []*mm_storage.Resident, err error)) *ResidentsStorageMock {
+	if mmGetResidentsOfBuilding.defaultExpectation != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("Default expectation is already set for the ResidentsStorage.GetResidentsOfBuilding method")
+	}
+
+	if len(mmGetResidentsOfBuilding.expectations) > 0 {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("Some expectations are already set for the ResidentsStorage.GetResidentsOfBuilding method")
+	}
+
+	mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding = f
+	return mmGetResidentsOfBuilding.mock
+}
+
+// When sets expectation for the ResidentsStorage.GetResidentsOfBuilding which will trigger the result defined by the following
+// Then helper
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) When(ctx context.Context, buildingId int, current bool) *ResidentsStorageMockGetResidentsOfBuildingExpectation {
+	if mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("ResidentsStorageMock.GetResidentsOfBuilding mock is already set by Set")
+	}
+
+	expectation := &ResidentsStorageMockGetResidentsOfBuildingExpectation{
+		mock:   mmGetResidentsOfBuilding.mock,
+		params: &ResidentsStorageMockGetResidentsOfBuildingParams{ctx, buildingId, current},
+	}
+	mmGetResidentsOfBuilding.expectations = append(mmGetResidentsOfBuilding.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResidentsStorage.GetResidentsOfBuilding return parameters for the expectation previously defined by the When method
+func (e *ResidentsStorageMockGetResidentsOfBuildingExpectation) Then(rpa1 []*mm_storage.Resident, err error) *ResidentsStorageMock {
+	e.results = &ResidentsStorageMockGetResidentsOfBuildingResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ResidentsStorage.GetResidentsOfBuilding should be invoked
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Times(n uint64) *mResidentsStorageMockGetResidentsOfBuilding {
+	if n == 0 {
+		mmGetResidentsOfBuilding.mock.t.Fatalf("Times of ResidentsStorageMock.GetResidentsOfBuilding mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetResidentsOfBuilding.expectedInvocations, n)
+	return mmGetResidentsOfBuilding
+}
+
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) invocationsDone() bool {
+	if len(mmGetResidentsOfBuilding.expectations) == 0 && mmGetResidentsOfBuilding.defaultExpectation == nil && mmGetResidentsOfBuilding.mock.funcGetResidentsOfBuilding == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetResidentsOfBuilding.mock.afterGetResidentsOfBuildingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetResidentsOfBuilding.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetResidentsOfBuilding implements storage.ResidentsStorage
+func (mmGetResidentsOfBuilding *ResidentsStorageMock) GetResidentsOfBuilding(ctx context.Context, buildingId int, current bool) (rpa1 []*mm_storage.Resident, err error) {
+	mm_atomic.AddUint64(&mmGetResidentsOfBuilding.beforeGetResidentsOfBuildingCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResidentsOfBuilding.afterGetResidentsOfBuildingCounter, 1)
+
+	if mmGetResidentsOfBuilding.inspectFuncGetResidentsOfBuilding != nil {
+		mmGetResidentsOfBuilding.inspectFuncGetResidentsOfBuilding(ctx, buildingId, current)
+	}
+
+	mm_params := ResidentsStorageMockGetResidentsOfBuildingParams{ctx, buildingId, current}
+
+	// Record call args
+	mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.mutex.Lock()
+	mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.callArgs = append(mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.callArgs, &mm_params)
+	mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.mutex.Unlock()
+
+	for _, e := range mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.defaultExpectation.params
+		mm_want_ptrs := mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.defaultExpectation.paramPtrs
+
+		mm_got := ResidentsStorageMockGetResidentsOfBuildingParams{ctx, buildingId, current}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetResidentsOfBuilding.t.Errorf("ResidentsStorageMock.GetResidentsOfBuilding got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.buildingId != nil && !minimock.Equal(*mm_want_ptrs.buildingId, mm_got.buildingId) {
+				mmGetResidentsOfBuilding.t.Errorf("ResidentsStorageMock.GetResidentsOfBuilding got unexpected parameter buildingId, want: %#v, got: %#v%s\n", *mm_want_ptrs.buildingId, mm_got.buildingId, minimock.Diff(*mm_want_ptrs.buildingId, mm_got.buildingId))
+			}
+
+			if mm_want_ptrs.current != nil && !minimock.Equal(*mm_want_ptrs.current, mm_got.current) {
+				mmGetResidentsOfBuilding.t.Errorf("ResidentsStorageMock.GetResidentsOfBuilding got unexpected parameter current, want: %#v, got: %#v%s\n", *mm_want_ptrs.current, mm_got.current, minimock.Diff(*mm_want_ptrs.current, mm_got.current))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResidentsOfBuilding.t.Errorf("ResidentsStorageMock.GetResidentsOfBuilding got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResidentsOfBuilding.GetResidentsOfBuildingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResidentsOfBuilding.t.Fatal("No results are set for the ResidentsStorageMock.GetResidentsOfBuilding")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetResidentsOfBuilding.funcGetResidentsOfBuilding != nil {
+		return mmGetResidentsOfBuilding.funcGetResidentsOfBuilding(ctx, buildingId, current)
+	}
+	mmGetResidentsOfBuilding.t.Fatalf("Unexpected call to ResidentsStorageMock.GetResidentsOfBuilding. %v %v %v", ctx, buildingId, current)
+	return
+}
+
+// GetResidentsOfBuildingAfterCounter returns a count of finished ResidentsStorageMock.GetResidentsOfBuilding invocations
+func (mmGetResidentsOfBuilding *ResidentsStorageMock) GetResidentsOfBuildingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResidentsOfBuilding.afterGetResidentsOfBuildingCounter)
+}
+
+// GetResidentsOfBuildingBeforeCounter returns a count of ResidentsStorageMock.GetResidentsOfBuilding invocations
+func (mmGetResidentsOfBuilding *ResidentsStorageMock) GetResidentsOfBuildingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResidentsOfBuilding.beforeGetResidentsOfBuildingCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResidentsStorageMock.GetResidentsOfBuilding.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResidentsOfBuilding *mResidentsStorageMockGetResidentsOfBuilding) Calls() []*ResidentsStorageMockGetResidentsOfBuildingParams {
+	mmGetResidentsOfBuilding.mutex.RLock()
+
+	argCopy := make([]*ResidentsStorageMockGetResidentsOfBuildingParams, len(mmGetResidentsOfBuilding.callArgs))
+	copy(argCopy, mmGetResidentsOfBuilding.callArgs)
+
+	mmGetResidentsOfBuilding.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResidentsOfBuildingDone returns true if the count of the GetResidentsOfBuilding invocations corresponds
+// the number of defined expectations
+func (m *ResidentsStorageMock) MinimockGetResidentsOfBuildingDone() bool {
+	if m.GetResidentsOfBuildingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetResidentsOfBuildingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetResidentsOfBuildingMock.invocationsDone()
+}
+
+// MinimockGetResidentsOfBuildingInspect logs each unmet expectation
+func (m *ResidentsStorageMock) MinimockGetResidentsOfBuildingInspect() {
+	for _, e := range m.GetResidentsOfBuildingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResidentsStorageMock.GetResidentsOfBuilding with params: %#v", *e.params)
+		}
+	}
+
+	afterGetResidentsOfBuildingCounter := mm_atomic.LoadUint64(&m.afterGetResidentsOfBuildingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResidentsOfBuildingMock.defaultExpectation != nil && afterGetResidentsOfBuildingCounter < 1 {
+		if m.GetResidentsOfBuildingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ResidentsStorageMock.GetResidentsOfBuilding")
+		} else {
+			m.t.Errorf("Expected call to ResidentsStorageMock.GetResidentsOfBuilding with params: %#v", *m.GetResidentsOfBuildingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResidentsOfBuilding != nil && afterGetResidentsOfBuildingCounter < 1 {
+		m.t.Error("Expected call to ResidentsStorageMock.GetResidentsOfBuilding")
+	}
+
+	if !m.GetResidentsOfBuildingMock.invocationsDone() && afterGetResidentsOfBuildingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResidentsStorageMock.GetResidentsOfBuilding but found %d calls",
+			mm_atomic.LoadUint64(&m.GetResidentsOfBuildingMock.expectedInvocations), afterGetResidentsOfBuildingCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ResidentsStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateResidentInspect()
+
+			m.MinimockDeleteResidentInspect()
+
+			m.MinimockGetResidentInspect()
+
+			m.MinimockGetResidentsOfApartmentInspect()
+
+			m.MinimockGetResidentsOfBuildingInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ResidentsStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ResidentsStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateResidentDone() &&
+		m.MinimockDeleteResidentDone() &&
+		m.MinimockGetResidentDone() &&
+		m.MinimockGetResidentsOfApartmentDone() &&
+		m.MinimockGetResidentsOfBuildingDone()
+}
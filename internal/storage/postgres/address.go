@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+// byStreet sorts the buildings like address.SortBuildings, the house numbers by their leading
+// digits first.
+const byStreet = `lower(street) NULLS LAST, substring(house_number from '^[0-9]+')::int NULLS LAST, house_number NULLS LAST, id`
+
+// FindBuildings filters the buildings of the tenant by the components of their address, compared
+// in lower case so that the city and the street use the building_tenant_city_idx and
+// building_tenant_street_idx indexes.
+func (pdb *PostgresDatabase) FindBuildings(ctx context.Context, filter address.Filter, order address.Order, buildingIds []int) (b models.BuildingSlice, err error) {
+	ctx, end := pdb.track(ctx, "FindBuildings")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	mods := []qm.QueryMod{models.BuildingWhere.TenantID.EQ(tenantID)}
+	if buildingIds != nil {
+		mods = append(mods, models.BuildingWhere.ID.IN(buildingIds))
+	}
+	for _, c := range [][2]string{
+		{models.BuildingColumns.Street, filter.Street},
+		{models.BuildingColumns.HouseNumber, filter.HouseNumber},
+		{models.BuildingColumns.City, filter.City},
+		{models.BuildingColumns.PostalCode, filter.PostalCode},
+		{models.BuildingColumns.Country, filter.Country},
+	} {
+		if c[1] != "" {
+			mods = append(mods, qm.Where("lower("+c[0]+") = lower(?)", c[1]))
+		}
+	}
+	if order == address.OrderStreet {
+		mods = append(mods, qm.OrderBy(byStreet))
+	} else {
+		mods = append(mods, qm.OrderBy(models.BuildingColumns.ID))
+	}
+
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		b, err = models.Buildings(mods...).All(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_FindBuildings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("filter", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`SELECT "building".* FROM "building" WHERE ("building"."tenant_id" = $1) AND ("building"."id" IN ($2,$3)) AND (lower(street) = lower($4)) AND (lower(city) = lower($5)) ORDER BY `+byStreet+`;`)).
+			WithArgs("acme", 1, 2, "Main Street", "Springfield").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "street", "city", "tenant_id"}).
+				AddRow(2, "Oak", "Main Street", "Springfield", "acme"))
+
+		filter := address.Filter{Street: "Main Street", City: "Springfield"}
+		got, err := pdb.FindBuildings(tenant.WithID(context.Background(), "acme"), filter, address.OrderStreet, []int{1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, models.BuildingSlice{{
+			ID: 2, Name: "Oak", Street: null.StringFrom("Main Street"), City: null.StringFrom("Springfield"), TenantID: "acme",
+		}}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`SELECT "building".* FROM "building" WHERE ("building"."tenant_id" = $1) ORDER BY id;`)).
+			WithArgs(tenant.Default).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}))
+
+		got, err := pdb.FindBuildings(context.Background(), address.Filter{}, address.OrderID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+const apiKeyColumns = `id, "name", subject, prefix, tenant_id, key_hash, created_at, expires_at, revoked_at`
+
+func scanAPIKey(row interface{ Scan(dest ...any) error }) (*storage.APIKey, error) {
+	var k storage.APIKey
+	var tenant sql.NullString
+	var expiresAt, revokedAt sql.NullTime
+	err := row.Scan(&k.ID, &k.Name, &k.Subject, &k.Prefix, &tenant, &k.Hash, &k.CreatedAt, &expiresAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.Valid {
+		k.Tenant = &tenant.String
+	}
+	if expiresAt.Valid {
+		k.ExpiresAt = &expiresAt.Time
+	}
+	if revokedAt.Valid {
+		k.RevokedAt = &revokedAt.Time
+	}
+
+	return &k, nil
+}
+
+func (pdb *PostgresDatabase) GetAPIKeys(ctx context.Context) (_ []*storage.APIKey, err error) {
+	ctx, end := pdb.track(ctx, "GetAPIKeys")
+	defer end(&err)
+
+	rows, err := pdb.executor.QueryContext(ctx, `SELECT `+apiKeyColumns+` FROM public.api_key ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]*storage.APIKey, 0)
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+
+	return keys, rows.Err()
+}
+
+func (pdb *PostgresDatabase) GetAPIKeyByHash(ctx context.Context, hash string) (_ *storage.APIKey, err error) {
+	ctx, end := pdb.track(ctx, "GetAPIKeyByHash")
+	defer end(&err)
+
+	k, err := scanAPIKey(pdb.executor.QueryRowContext(ctx,
+		`SELECT `+apiKeyColumns+` FROM public.api_key WHERE key_hash = $1`, hash))
+	if err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+func (pdb *PostgresDatabase) CreateAPIKey(ctx context.Context, apiKey *storage.APIKey) (err error) {
+	ctx, end := pdb.track(ctx, "CreateAPIKey")
+	defer end(&err)
+
+	err = pdb.executor.QueryRowContext(ctx,
+		`INSERT INTO public.api_key ("name", subject, prefix, tenant_id, key_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at`,
+		apiKey.Name, apiKey.Subject, apiKey.Prefix, apiKey.Tenant, apiKey.Hash, apiKey.ExpiresAt,
+	).Scan(&apiKey.ID, &apiKey.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pdb *PostgresDatabase) RevokeAPIKey(ctx context.Context, id int) (_ int64, err error) {
+	ctx, end := pdb.track(ctx, "RevokeAPIKey")
+	defer end(&err)
+
+	res, err := pdb.executor.ExecContext(ctx,
+		`UPDATE public.api_key SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+// cursorBatch is the number of rows a cursor fetches at a time, it bounds the memory of the streams.
+const cursorBatch = 500
+
+// StreamApartments calls send with the apartments of the tenant by ID, only those of buildingIds
+// unless it is nil. They are read with a server-side cursor, so the memory doesn't grow with the
+// number of apartments; an error of send, e.g. the client went away, stops the stream.
+func (pdb *PostgresDatabase) StreamApartments(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) (err error) {
+	ctx, end := pdb.track(ctx, "StreamApartments")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	mods := []qm.QueryMod{models.ApartmentWhere.TenantID.EQ(tenantID)}
+	if buildingIds != nil {
+		mods = append(mods, models.ApartmentWhere.BuildingID.IN(buildingIds))
+	}
+	mods = append(mods, qm.OrderBy(models.ApartmentColumns.ID))
+
+	return stream(ctx, pdb, tenantID, models.Apartments(mods...).Query, send)
+}
+
+// stream runs the query with a cursor and calls send with its rows. The cursor lives in a
+// read-only transaction, which also sets the tenant for the row-level security policies.
+func stream[T any](ctx context.Context, pdb *PostgresDatabase, tenantID string, q *queries.Query, send func(row *T) error) (err error) {
+	tx, err := pdb.psqlClient.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Nothing is written, the rollback only closes the cursor.
+		rollbackErr := tx.Rollback()
+		if err == nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			err = rollbackErr
+		}
+	}()
+
+	exec := tracedExecutor{db: tx}
+	if pdb.opts.RowLevelSecurity {
+		_, err = exec.ExecContext(ctx, "SELECT set_config('app.tenant_id', $1, true)", tenantID)
+		if err != nil {
+			return err
+		}
+	}
+
+	query, args := queries.BuildQuery(q)
+	_, err = exec.ExecContext(ctx, "DECLARE stream_cursor NO SCROLL CURSOR FOR "+strings.TrimSuffix(query, ";"), args...)
+	if err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM stream_cursor", cursorBatch)
+	for {
+		var batch []*T
+		err = fetchBatch(ctx, exec, fetch, &batch)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range batch {
+			err = send(row)
+			if err != nil {
+				return err
+			}
+		}
+		if len(batch) < cursorBatch {
+			return nil
+		}
+	}
+}
+
+func fetchBatch(ctx context.Context, exec tracedExecutor, fetch string, batch any) error {
+	rows, err := exec.QueryContext(ctx, fetch)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	return queries.Bind(rows, batch)
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_StreamApartments(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "building_id", "number", "floor", "sq_meters", "tenant_id"}
+	fetch := q(`FETCH FORWARD 500 FROM stream_cursor`)
+
+	t.Run("batches", func(t *testing.T) {
+		t.Parallel()
+
+		full := sqlmock.NewRows(columns)
+		for i := 1; i <= cursorBatch; i++ {
+			full.AddRow(i, 1, nil, nil, nil, "acme")
+		}
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectExec(q(`DECLARE stream_cursor NO SCROLL CURSOR FOR SELECT "apartment".* FROM "apartment" WHERE ("apartment"."tenant_id" = $1) AND ("apartment"."building_id" IN ($2,$3)) ORDER BY id`)).
+			WithArgs("acme", 1, 2).
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectQuery(fetch).WillReturnRows(full)
+		mock.ExpectQuery(fetch).WillReturnRows(sqlmock.NewRows(columns).AddRow(cursorBatch+1, 2, "1A", 1, 40, "acme"))
+		mock.ExpectRollback()
+
+		var got []int
+		err := pdb.StreamApartments(tenant.WithID(context.Background(), "acme"), []int{1, 2}, func(a *models.Apartment) error {
+			got = append(got, a.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, got, cursorBatch+1)
+		assert.Equal(t, cursorBatch+1, got[cursorBatch])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("sendError", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectExec(q(`DECLARE stream_cursor NO SCROLL CURSOR FOR SELECT "apartment".* FROM "apartment" WHERE ("apartment"."tenant_id" = $1) ORDER BY id`)).
+			WithArgs(tenant.Default).
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectQuery(fetch).WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, nil, nil, nil, tenant.Default).AddRow(2, 1, nil, nil, nil, tenant.Default))
+		mock.ExpectRollback()
+
+		// The client went away after the first apartment, the cursor is closed.
+		calls := 0
+		err := pdb.StreamApartments(context.Background(), nil, func(*models.Apartment) error {
+			calls++
+			return assert.AnError
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rowLevelSecurity", func(t *testing.T) {
+		t.Parallel()
+
+		opts := DefaultOptions()
+		opts.RowLevelSecurity = true
+		pdb, mock := newMockDatabase(t, opts)
+		mock.ExpectBegin()
+		mock.ExpectExec(q(`SELECT set_config('app.tenant_id', $1, true)`)).
+			WithArgs("acme").
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectExec(`DECLARE stream_cursor`).
+			WithArgs("acme").
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectQuery(fetch).WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectRollback()
+
+		err := pdb.StreamApartments(tenant.WithID(context.Background(), "acme"), nil, func(*models.Apartment) error { return nil })
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
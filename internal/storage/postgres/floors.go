@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const floorColumns = `id, building_id, "number", label, sq_meters, accessible`
+
+// The locks of the row of a building. The floors are written with the building locked for update,
+// the apartments with it locked for key share, so an apartment is checked against the floors that
+// stay until it commits.
+const (
+	lockForUpdate   = "UPDATE"
+	lockForKeyShare = "KEY SHARE"
+)
+
+// lockBuilding locks the row of the building of the tenant, it returns sql.ErrNoRows for an unknown building.
+func lockBuilding(ctx context.Context, exec boil.ContextExecutor, tenantID string, buildingId int, lock string) error {
+	var id int
+	return exec.QueryRowContext(ctx,
+		`SELECT id FROM public.building WHERE tenant_id = $1 AND id = $2 FOR `+lock,
+		tenantID, buildingId).Scan(&id)
+}
+
+func scanFloor(row interface{ Scan(dest ...any) error }) (*storage.Floor, error) {
+	var f storage.Floor
+	var sqMeters sql.NullInt64
+	err := row.Scan(&f.ID, &f.BuildingID, &f.Number, &f.Label, &sqMeters, &f.Accessible)
+	if err != nil {
+		return nil, err
+	}
+	if sqMeters.Valid {
+		m := int(sqMeters.Int64)
+		f.SQMeters = &m
+	}
+	return &f, nil
+}
+
+func (pdb *PostgresDatabase) GetFloors(ctx context.Context, buildingId int) (floors []*storage.Floor, err error) {
+	ctx, end := pdb.track(ctx, "GetFloors")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		rows, err := exec.QueryContext(ctx,
+			`SELECT `+floorColumns+` FROM public.floor WHERE tenant_id = $1 AND building_id = $2 ORDER BY "number"`,
+			tenantID, buildingId)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		floors = make([]*storage.Floor, 0)
+		for rows.Next() {
+			f, err := scanFloor(rows)
+			if err != nil {
+				return err
+			}
+			floors = append(floors, f)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return floors, nil
+}
+
+func (pdb *PostgresDatabase) GetFloor(ctx context.Context, buildingId int, number int) (f *storage.Floor, err error) {
+	ctx, end := pdb.track(ctx, "GetFloor")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		f, err = scanFloor(exec.QueryRowContext(ctx,
+			`SELECT `+floorColumns+` FROM public.floor WHERE tenant_id = $1 AND building_id = $2 AND "number" = $3`,
+			tenantID, buildingId, number))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// CreateFloor upserts the floor of a building of the tenant. The floor is inserted from the row of
+// the building, so nothing is inserted for an unknown building or one of another tenant. The
+// buildings without floors accept the apartments on any floor, so the first floor also declares
+// the floors of the apartments, labelled with their number, which would be rejected otherwise.
+func (pdb *PostgresDatabase) CreateFloor(ctx context.Context, floor *storage.Floor) (err error) {
+	ctx, end := pdb.track(ctx, "CreateFloor")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.transaction(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		err := lockBuilding(ctx, exec, tenantID, floor.BuildingID, lockForUpdate)
+		if err != nil {
+			return err
+		}
+
+		_, err = exec.ExecContext(ctx,
+			`INSERT INTO public.floor (tenant_id, building_id, "number", label)
+			SELECT DISTINCT a.tenant_id, a.building_id, a.floor, a.floor::text FROM public.apartment a
+			WHERE a.tenant_id = $1 AND a.building_id = $2 AND a.floor IS NOT NULL
+				AND NOT EXISTS (SELECT 1 FROM public.floor f WHERE f.tenant_id = $1 AND f.building_id = $2)`,
+			tenantID, floor.BuildingID)
+		if err != nil {
+			return err
+		}
+
+		return exec.QueryRowContext(ctx,
+			`INSERT INTO public.floor (tenant_id, building_id, "number", label, sq_meters, accessible)
+			SELECT b.tenant_id, b.id, $3, $4, $5, $6 FROM public.building b WHERE b.tenant_id = $1 AND b.id = $2
+			ON CONFLICT (tenant_id, building_id, "number") DO UPDATE
+			SET label = EXCLUDED.label, sq_meters = EXCLUDED.sq_meters, accessible = EXCLUDED.accessible
+			RETURNING id`,
+			tenantID, floor.BuildingID, floor.Number, floor.Label, floor.SQMeters, floor.Accessible,
+		).Scan(&floor.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFloor deletes the floor of a building of the tenant unless apartments are on it, with the
+// building locked so none is written on the floor meanwhile.
+func (pdb *PostgresDatabase) DeleteFloor(ctx context.Context, buildingId int, number int) (n int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteFloor")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.transaction(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		err := lockBuilding(ctx, exec, tenantID, buildingId, lockForUpdate)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var used bool
+		err = exec.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM public.apartment WHERE tenant_id = $1 AND building_id = $2 AND floor = $3)`,
+			tenantID, buildingId, number).Scan(&used)
+		if err != nil {
+			return err
+		}
+		if used {
+			return fmt.Errorf("%w: floor [%v] of building [%v] has apartments", storage.ErrReferenced, number, buildingId)
+		}
+
+		res, err := exec.ExecContext(ctx,
+			`DELETE FROM public.floor WHERE tenant_id = $1 AND building_id = $2 AND "number" = $3`,
+			tenantID, buildingId, number)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_Floors(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "building_id", "number", "label", "sq_meters", "accessible"}
+
+	t.Run("getFloors", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`SELECT `+floorColumns+` FROM public.floor WHERE tenant_id = $1 AND building_id = $2 ORDER BY "number"`)).
+			WithArgs(tenant.Default, 1).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(4, 1, -1, "B1", nil, false).
+				AddRow(5, 1, 0, "G", 420, true))
+
+		got, err := pdb.GetFloors(context.Background(), 1)
+		require.NoError(t, err)
+		area := 420
+		assert.Equal(t, []*storage.Floor{
+			{ID: 4, BuildingID: 1, Number: -1, Label: "B1"},
+			{ID: 5, BuildingID: 1, Number: 0, Label: "G", SQMeters: &area, Accessible: true},
+		}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("getFloorsNone", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`FROM public.floor`)).WillReturnRows(sqlmock.NewRows(columns))
+
+		got, err := pdb.GetFloors(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []*storage.Floor{}, got)
+	})
+
+	t.Run("getFloorNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`FROM public.floor`)).WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := pdb.GetFloor(context.Background(), 1, 3)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("createFloor", func(t *testing.T) {
+		t.Parallel()
+
+		area := 420
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectQuery(q(`SELECT id FROM public.building WHERE tenant_id = $1 AND id = $2 FOR UPDATE`)).
+			WithArgs("acme", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		// The first floor declares the floors of the apartments.
+		mock.ExpectExec(q(`INSERT INTO public.floor (tenant_id, building_id, "number", label)
+			SELECT DISTINCT a.tenant_id, a.building_id, a.floor, a.floor::text FROM public.apartment a
+			WHERE a.tenant_id = $1 AND a.building_id = $2 AND a.floor IS NOT NULL
+				AND NOT EXISTS (SELECT 1 FROM public.floor f WHERE f.tenant_id = $1 AND f.building_id = $2)`)).
+			WithArgs("acme", 1).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(q(`ON CONFLICT (tenant_id, building_id, "number") DO UPDATE`)).
+			WithArgs("acme", 1, 0, "G", area, true).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		mock.ExpectCommit()
+
+		floor := &storage.Floor{BuildingID: 1, Number: 0, Label: "G", SQMeters: &area, Accessible: true}
+		require.NoError(t, pdb.CreateFloor(tenant.WithID(context.Background(), "acme"), floor))
+		assert.Equal(t, 5, floor.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("createFloorUnknownBuilding", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectQuery(q(`FROM public.building`)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		err := pdb.CreateFloor(context.Background(), &storage.Floor{BuildingID: 9, Number: 0, Label: "G"})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("deleteFloorWithApartments", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectQuery(q(`FOR UPDATE`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(q(`FROM public.apartment`)).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		_, err := pdb.DeleteFloor(context.Background(), 1, 2)
+		assert.ErrorIs(t, err, storage.ErrReferenced)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("deleteFloorUnknownBuilding", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectQuery(q(`FOR UPDATE`)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		n, err := pdb.DeleteFloor(context.Background(), 9, 2)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("createApartmentOnUndeclaredFloor", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectQuery(q(`FROM "apartment"`)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(q(`FROM public.building b WHERE b.tenant_id = $1 AND b.id = $2 FOR KEY SHARE`)).
+			WithArgs(tenant.Default, 1, 12).
+			WillReturnRows(sqlmock.NewRows([]string{"on_floor"}).AddRow(false))
+		mock.ExpectRollback()
+
+		err := pdb.CreateApartment(context.Background(), &models.Apartment{ID: 7, BuildingID: 1, Floor: null.IntFrom(12)})
+		assert.ErrorIs(t, err, storage.ErrNoFloor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const (
+	// inBox takes the box as $3 (south), $4 (west), $5 (north) and $6 (east), the west above the
+	// east crosses the antimeridian. It uses the building_location_idx index.
+	inBox = `b.latitude BETWEEN $3 AND $5
+		AND ((b.longitude BETWEEN $4 AND $6) OR ($4::float8 > $6::float8 AND (b.longitude >= $4 OR b.longitude <= $6)))`
+
+	// nearbyQuery takes the tenant ($1), the building IDs ($2), NULL for all the buildings, the box
+	// around the circle ($3 to $6), the center ($7, $8), the radius ($9) and the limit ($10). The
+	// distance is the haversine formula of geo.Distance, with geo.EarthRadius, the box narrows the
+	// rows down first.
+	nearbyQuery = `SELECT * FROM (
+			SELECT b.*, 2 * 6371008.8 * asin(least(1, sqrt(
+				power(sin(radians(b.latitude - $7) / 2), 2)
+				+ cos(radians($7)) * cos(radians(b.latitude)) * power(sin(radians(b.longitude - $8) / 2), 2)
+			))) AS distance_m
+			FROM public.building b
+			WHERE b.tenant_id = $1 AND ($2::int[] IS NULL OR b.id = ANY($2)) AND ` + inBox + `
+		) b
+		WHERE b.distance_m <= $9
+		ORDER BY b.distance_m, b.id
+		LIMIT $10`
+
+	// boxQuery takes the tenant ($1), the building IDs ($2), the box ($3 to $6) and the limit ($7).
+	boxQuery = `SELECT b.* FROM public.building b
+		WHERE b.tenant_id = $1 AND ($2::int[] IS NULL OR b.id = ANY($2)) AND ` + inBox + `
+		ORDER BY b.id
+		LIMIT $7`
+)
+
+// GetBuildingsNearby searches the buildings of the tenant by their distance to the center, see
+// nearbyQuery.
+func (pdb *PostgresDatabase) GetBuildingsNearby(ctx context.Context, center geo.Point, radius float64, buildingIds []int, limit int) (nearby []*storage.NearbyBuilding, err error) {
+	ctx, end := pdb.track(ctx, "GetBuildingsNearby")
+	defer end(&err)
+
+	box := geo.BoxAround(center, radius)
+	tenantID := tenant.FromContext(ctx)
+	var rows []*struct {
+		models.Building `boil:",bind"`
+		DistanceM       float64 `boil:"distance_m"`
+	}
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		return queries.Raw(nearbyQuery,
+			tenantID, int64Array(buildingIds), box.MinLat, box.MinLon, box.MaxLat, box.MaxLon,
+			center.Lat, center.Lon, radius, limit,
+		).Bind(ctx, exec, &rows)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nearby = make([]*storage.NearbyBuilding, 0, len(rows))
+	for _, r := range rows {
+		nearby = append(nearby, &storage.NearbyBuilding{Building: &r.Building, DistanceM: r.DistanceM})
+	}
+	return nearby, nil
+}
+
+// GetBuildingsInBox searches the buildings of the tenant in the box, see boxQuery.
+func (pdb *PostgresDatabase) GetBuildingsInBox(ctx context.Context, box geo.Box, buildingIds []int, limit int) (buildings models.BuildingSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetBuildingsInBox")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	buildings = models.BuildingSlice{}
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		return queries.Raw(boxQuery,
+			tenantID, int64Array(buildingIds), box.MinLat, box.MinLon, box.MaxLat, box.MaxLon, limit,
+		).Bind(ctx, exec, &buildings)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildings, nil
+}
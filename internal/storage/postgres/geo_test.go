@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/sotskov-do/oms-assignment/internal/geo"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_GetBuildingsNearby(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "name", "address", "latitude", "longitude", "tenant_id", "distance_m"}
+	center := geo.Point{Lat: 32.8191, Lon: 34.9983}
+	box := geo.BoxAround(center, 2000)
+
+	pdb, mock := newMockDatabase(t, DefaultOptions())
+	mock.ExpectQuery(q(nearbyQuery)).
+		WithArgs("acme", "{1,2}", box.MinLat, box.MinLon, box.MaxLat, box.MaxLon, center.Lat, center.Lon, 2000.0, 10).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "Meridor", "HaMishlatim 4, Haifa, IL", 32.8191, 34.9983, "acme", 0.0).
+			AddRow(2, "Harbor View", nil, 32.82, 34.999, "acme", 119.6))
+
+	got, err := pdb.GetBuildingsNearby(tenant.WithID(context.Background(), "acme"), center, 2000, []int{1, 2}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*storage.NearbyBuilding{
+		{Building: &models.Building{
+			ID: 1, Name: "Meridor", Address: null.StringFrom("HaMishlatim 4, Haifa, IL"),
+			Latitude: null.Float64From(32.8191), Longitude: null.Float64From(34.9983), TenantID: "acme",
+		}, DistanceM: 0},
+		{Building: &models.Building{
+			ID: 2, Name: "Harbor View",
+			Latitude: null.Float64From(32.82), Longitude: null.Float64From(34.999), TenantID: "acme",
+		}, DistanceM: 119.6},
+	}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_GetBuildingsInBox(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "name", "latitude", "longitude", "tenant_id"}
+	box := geo.Box{MinLat: -19, MinLon: 177, MaxLat: -16, MaxLon: -179}
+
+	pdb, mock := newMockDatabase(t, DefaultOptions())
+	mock.ExpectQuery(q(boxQuery)).
+		WithArgs(tenant.Default, nil, -19.0, 177.0, -16.0, -179.0, 100).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "Suva", -18.1, 178.4, tenant.Default))
+
+	got, err := pdb.GetBuildingsInBox(context.Background(), box, nil, 100)
+	require.NoError(t, err)
+	assert.Equal(t, models.BuildingSlice{{
+		ID: 3, Name: "Suva", Latitude: null.Float64From(-18.1), Longitude: null.Float64From(178.4), TenantID: tenant.Default,
+	}}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
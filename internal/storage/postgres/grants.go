@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const grantColumns = `id, subject, "role", building_id, created_at`
+
+func scanGrants(rows *sql.Rows) ([]*storage.Grant, error) {
+	defer rows.Close()
+
+	grants := make([]*storage.Grant, 0)
+	for rows.Next() {
+		var g storage.Grant
+		var buildingID sql.NullInt64
+		err := rows.Scan(&g.ID, &g.Subject, &g.Role, &buildingID, &g.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if buildingID.Valid {
+			id := int(buildingID.Int64)
+			g.BuildingID = &id
+		}
+		grants = append(grants, &g)
+	}
+
+	return grants, rows.Err()
+}
+
+func (pdb *PostgresDatabase) GetGrants(ctx context.Context) (grants []*storage.Grant, err error) {
+	ctx, end := pdb.track(ctx, "GetGrants")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		rows, err := exec.QueryContext(ctx,
+			`SELECT `+grantColumns+` FROM public.building_grant WHERE tenant_id = $1 ORDER BY id`, tenantID)
+		if err != nil {
+			return err
+		}
+		grants, err = scanGrants(rows)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+func (pdb *PostgresDatabase) GetGrantsBySubject(ctx context.Context, subject string) (grants []*storage.Grant, err error) {
+	ctx, end := pdb.track(ctx, "GetGrantsBySubject")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		rows, err := exec.QueryContext(ctx,
+			`SELECT `+grantColumns+` FROM public.building_grant WHERE tenant_id = $1 AND subject = $2 ORDER BY id`,
+			tenantID, subject)
+		if err != nil {
+			return err
+		}
+		grants, err = scanGrants(rows)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// CreateGrant replaces the role of the subject on the building, if it already has one.
+// The composite foreign key rejects the buildings of the other tenants.
+func (pdb *PostgresDatabase) CreateGrant(ctx context.Context, grant *storage.Grant) (err error) {
+	ctx, end := pdb.track(ctx, "CreateGrant")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		return exec.QueryRowContext(ctx,
+			`INSERT INTO public.building_grant (tenant_id, subject, "role", building_id) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (tenant_id, subject, (coalesce(building_id, 0))) DO UPDATE SET "role" = EXCLUDED."role"
+			RETURNING id, created_at`,
+			tenantID, grant.Subject, grant.Role, grant.BuildingID,
+		).Scan(&grant.ID, &grant.CreatedAt)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pdb *PostgresDatabase) DeleteGrant(ctx context.Context, id int) (n int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteGrant")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		res, err := exec.ExecContext(ctx,
+			`DELETE FROM public.building_grant WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// reserveIdempotencyKeyQuery inserts the record in progress, or takes over an expired record
+// of the key. It returns no row when an unexpired record exists, the primary key makes
+// the concurrent duplicates wait for each other and only one of them wins.
+const reserveIdempotencyKeyQuery = `INSERT INTO public.idempotency_key (scope, "key", request_hash, expires_at)
+VALUES ($1, $2, $3, clock_timestamp() + $4 * interval '1 second')
+ON CONFLICT (scope, "key") DO UPDATE SET
+	request_hash = EXCLUDED.request_hash, status = NULL, content_type = NULL, response = NULL,
+	created_at = now(), expires_at = EXCLUDED.expires_at
+WHERE idempotency_key.expires_at <= clock_timestamp()
+RETURNING expires_at`
+
+const selectIdempotencyKeyQuery = `SELECT request_hash, status, content_type, response, expires_at
+FROM public.idempotency_key WHERE scope = $1 AND "key" = $2 AND expires_at > clock_timestamp()`
+
+// reserveIdempotencyKeyAttempts bounds the retries when the existing record expires
+// between the insert and the select.
+const reserveIdempotencyKeyAttempts = 3
+
+func (pdb *PostgresDatabase) ReserveIdempotencyKey(ctx context.Context, record *storage.IdempotencyRecord, lockTimeout time.Duration) (_ *storage.IdempotencyRecord, err error) {
+	ctx, end := pdb.track(ctx, "ReserveIdempotencyKey")
+	defer end(&err)
+
+	for attempt := 0; attempt < reserveIdempotencyKeyAttempts; attempt++ {
+		err = pdb.executor.QueryRowContext(ctx, reserveIdempotencyKeyQuery,
+			record.Scope, record.Key, record.RequestHash, lockTimeout.Seconds(),
+		).Scan(&record.ExpiresAt)
+		if err == nil {
+			return nil, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		existing := storage.IdempotencyRecord{Scope: record.Scope, Key: record.Key}
+		var status sql.NullInt64
+		var contentType sql.NullString
+		err = pdb.executor.QueryRowContext(ctx, selectIdempotencyKeyQuery, record.Scope, record.Key).
+			Scan(&existing.RequestHash, &status, &contentType, &existing.Body, &existing.ExpiresAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		existing.Status = int(status.Int64)
+		existing.ContentType = contentType.String
+
+		return &existing, nil
+	}
+
+	return nil, errors.New("idempotency key keeps expiring")
+}
+
+func (pdb *PostgresDatabase) CompleteIdempotencyKey(ctx context.Context, record *storage.IdempotencyRecord, ttl time.Duration) (err error) {
+	ctx, end := pdb.track(ctx, "CompleteIdempotencyKey")
+	defer end(&err)
+
+	err = pdb.executor.QueryRowContext(ctx,
+		`UPDATE public.idempotency_key
+		SET status = $4, content_type = $5, response = $6, expires_at = clock_timestamp() + $7 * interval '1 second'
+		WHERE scope = $1 AND "key" = $2 AND request_hash = $3 AND status IS NULL
+		RETURNING expires_at`,
+		record.Scope, record.Key, record.RequestHash, record.Status, record.ContentType, record.Body, ttl.Seconds(),
+	).Scan(&record.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.New("idempotency key is no longer reserved")
+	}
+
+	return err
+}
+
+func (pdb *PostgresDatabase) ReleaseIdempotencyKey(ctx context.Context, scope, key string) (err error) {
+	ctx, end := pdb.track(ctx, "ReleaseIdempotencyKey")
+	defer end(&err)
+
+	_, err = pdb.executor.ExecContext(ctx,
+		`DELETE FROM public.idempotency_key WHERE scope = $1 AND "key" = $2 AND status IS NULL`, scope, key)
+
+	return err
+}
+
+func (pdb *PostgresDatabase) DeleteExpiredIdempotencyKeys(ctx context.Context) (_ int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteExpiredIdempotencyKeys")
+	defer end(&err)
+
+	res, err := pdb.executor.ExecContext(ctx,
+		`DELETE FROM public.idempotency_key WHERE expires_at <= clock_timestamp()`)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+func Test_ReserveIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	pdb, mock := newMockDatabase(t, Options{})
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+
+	// Reserved.
+	mock.ExpectQuery(q(reserveIdempotencyKeyQuery)).
+		WithArgs("acme:apikey:bot", "k1", "h", 60.0).
+		WillReturnRows(sqlmock.NewRows([]string{"expires_at"}).AddRow(expiresAt))
+	record := &storage.IdempotencyRecord{Scope: "acme:apikey:bot", Key: "k1", RequestHash: "h"}
+	existing, err := pdb.ReserveIdempotencyKey(ctx, record, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.Equal(t, expiresAt, record.ExpiresAt)
+
+	// Already stored.
+	mock.ExpectQuery(q(reserveIdempotencyKeyQuery)).
+		WithArgs("acme:apikey:bot", "k2", "h", 60.0).
+		WillReturnRows(sqlmock.NewRows([]string{"expires_at"}))
+	mock.ExpectQuery(q(selectIdempotencyKeyQuery)).
+		WithArgs("acme:apikey:bot", "k2").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "status", "content_type", "response", "expires_at"}).
+			AddRow("h", 201, "application/json", []byte(`{}`), expiresAt))
+	existing, err = pdb.ReserveIdempotencyKey(ctx, &storage.IdempotencyRecord{Scope: "acme:apikey:bot", Key: "k2", RequestHash: "h"}, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, 201, existing.Status)
+	assert.Equal(t, "application/json", existing.ContentType)
+	assert.Equal(t, []byte(`{}`), existing.Body)
+
+	// In progress.
+	mock.ExpectQuery(q(reserveIdempotencyKeyQuery)).
+		WithArgs("acme:apikey:bot", "k3", "h", 60.0).
+		WillReturnRows(sqlmock.NewRows([]string{"expires_at"}))
+	mock.ExpectQuery(q(selectIdempotencyKeyQuery)).
+		WithArgs("acme:apikey:bot", "k3").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "status", "content_type", "response", "expires_at"}).
+			AddRow("h", nil, nil, nil, expiresAt))
+	existing, err = pdb.ReserveIdempotencyKey(ctx, &storage.IdempotencyRecord{Scope: "acme:apikey:bot", Key: "k3", RequestHash: "h"}, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Zero(t, existing.Status)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
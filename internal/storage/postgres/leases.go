@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const (
+	leaseColumns = `l.id, l.apartment_id, a.building_id, l.lessee, l.start_date, l.end_date, l.monthly_rent, l.deposit,
+		l.currency, l.indexation, l.indexation_rate::float8, l.status, l.renewal_of`
+	// leasesFrom joins the leases (l) to their apartment (a) for its building.
+	leasesFrom = ` FROM public.lease l JOIN public.apartment a ON a.tenant_id = l.tenant_id AND a.id = l.apartment_id`
+
+	// The field of the encrypted personal data, authenticated with its value.
+	leaseLessee = "lease.lessee"
+)
+
+// scanLease scans the leaseColumns and decrypts the lessee.
+func (pdb *PostgresDatabase) scanLease(row interface{ Scan(dest ...any) error }) (*storage.Lease, error) {
+	var l storage.Lease
+	err := row.Scan(&l.ID, &l.ApartmentID, &l.BuildingID, &l.Lessee, &l.StartDate, &l.EndDate, &l.MonthlyRent, &l.Deposit,
+		&l.Currency, &l.Indexation, &l.IndexationRate, &l.Status, &l.RenewalOf)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Lessee, err = pdb.cipher.Decrypt(leaseLessee, l.Lessee)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+func (pdb *PostgresDatabase) GetLeasesOfApartment(ctx context.Context, apartmentId int) (leases []*storage.Lease, err error) {
+	ctx, end := pdb.track(ctx, "GetLeasesOfApartment")
+	defer end(&err)
+
+	return pdb.queryLeases(ctx,
+		`SELECT `+leaseColumns+leasesFrom+`
+		WHERE l.tenant_id = $1 AND l.apartment_id = $2
+		ORDER BY l.start_date, l.id`,
+		apartmentId)
+}
+
+func (pdb *PostgresDatabase) GetExpiringLeases(ctx context.Context, buildingIds []int, days int) (leases []*storage.Lease, err error) {
+	ctx, end := pdb.track(ctx, "GetExpiringLeases")
+	defer end(&err)
+
+	return pdb.queryLeases(ctx,
+		`SELECT `+leaseColumns+leasesFrom+`
+		WHERE l.tenant_id = $1 AND ($2::int[] IS NULL OR a.building_id = ANY($2)) AND l.status = 'active'
+			AND l.end_date BETWEEN current_date AND current_date + $3::integer
+			AND NOT EXISTS (
+				SELECT 1 FROM public.lease n WHERE n.tenant_id = l.tenant_id AND n.renewal_of = l.id AND n.status = 'active'
+			)
+		ORDER BY l.end_date, l.id`,
+		int64Array(buildingIds), days)
+}
+
+// queryLeases runs the query of the leases of the tenant, the tenant is its first parameter.
+func (pdb *PostgresDatabase) queryLeases(ctx context.Context, query string, args ...any) (leases []*storage.Lease, err error) {
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		rows, err := exec.QueryContext(ctx, query, append([]any{tenantID}, args...)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		leases = make([]*storage.Lease, 0)
+		for rows.Next() {
+			l, err := pdb.scanLease(rows)
+			if err != nil {
+				return err
+			}
+			leases = append(leases, l)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+func (pdb *PostgresDatabase) GetLease(ctx context.Context, id int) (l *storage.Lease, err error) {
+	ctx, end := pdb.track(ctx, "GetLease")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		l, err = pdb.scanLease(exec.QueryRowContext(ctx,
+			`SELECT `+leaseColumns+leasesFrom+` WHERE l.tenant_id = $1 AND l.id = $2`,
+			tenantID, id))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// CreateLease upserts the lease of an apartment of the tenant, with the lessee encrypted. Like
+// CreateResident, the lease is inserted from the row of the apartment and the update skips the
+// leases of another tenant. The exclusion constraint rejects the leases overlapping another one,
+// e.g. written concurrently, with storage.ErrOverlap.
+func (pdb *PostgresDatabase) CreateLease(ctx context.Context, lease *storage.Lease) (err error) {
+	ctx, end := pdb.track(ctx, "CreateLease")
+	defer end(&err)
+
+	lessee, err := pdb.cipher.Encrypt(leaseLessee, lease.Lessee)
+	if err != nil {
+		return err
+	}
+	var id *int
+	if lease.ID != 0 {
+		id = &lease.ID
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		return exec.QueryRowContext(ctx,
+			`INSERT INTO public.lease AS l (id, tenant_id, apartment_id, lessee, start_date, end_date, monthly_rent, deposit,
+				currency, indexation, indexation_rate, status, renewal_of)
+			SELECT coalesce($3::integer, nextval('public.lease_id_seq')::integer), a.tenant_id, a.id, $4, $5, $6, $7, $8,
+				$9, $10, $11, $12, $13
+			FROM public.apartment a WHERE a.tenant_id = $1 AND a.id = $2
+			ON CONFLICT (id) DO UPDATE
+			SET apartment_id = EXCLUDED.apartment_id, lessee = EXCLUDED.lessee, start_date = EXCLUDED.start_date,
+				end_date = EXCLUDED.end_date, monthly_rent = EXCLUDED.monthly_rent, deposit = EXCLUDED.deposit,
+				currency = EXCLUDED.currency, indexation = EXCLUDED.indexation, indexation_rate = EXCLUDED.indexation_rate,
+				status = EXCLUDED.status, renewal_of = EXCLUDED.renewal_of
+			WHERE l.tenant_id = EXCLUDED.tenant_id
+			RETURNING id`,
+			tenantID, lease.ApartmentID, id, lessee, lease.StartDate, lease.EndDate, lease.MonthlyRent, lease.Deposit,
+			lease.Currency, string(lease.Indexation), lease.IndexationRate, string(lease.Status), lease.RenewalOf,
+		).Scan(&lease.ID)
+	})
+	if err != nil {
+		return overlapError(err)
+	}
+
+	return nil
+}
+
+func (pdb *PostgresDatabase) DeleteLease(ctx context.Context, id int) (n int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteLease")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		res, err := exec.ExecContext(ctx, `DELETE FROM public.lease WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
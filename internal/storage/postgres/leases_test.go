@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/pii"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+var leaseRows = []string{"id", "apartment_id", "building_id", "lessee", "start_date", "end_date", "monthly_rent", "deposit",
+	"currency", "indexation", "indexation_rate", "status", "renewal_of"}
+
+func Test_Leases(t *testing.T) {
+	t.Parallel()
+
+	t.Run("encrypted", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		pdb.SetCipher(testCipher(t, "k1", 1))
+
+		var lessee driver.Value
+		rate, renewed := 2.5, 2
+		mock.ExpectQuery(q(`INSERT INTO public.lease AS l`)).
+			WithArgs(tenant.Default, 7, nil, capture{&lessee}, "2026-01-01", "2026-12-31", int64(120000), int64(240000),
+				"EUR", "fixed", rate, "active", renewed).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+		endDate := storage.NewDate(2026, time.December, 31)
+		lease := &storage.Lease{
+			ApartmentID:    7,
+			Lessee:         "Ada Lovelace",
+			StartDate:      storage.NewDate(2026, time.January, 1),
+			EndDate:        &endDate,
+			MonthlyRent:    120000,
+			Deposit:        240000,
+			Currency:       "EUR",
+			Indexation:     storage.IndexationFixed,
+			IndexationRate: &rate,
+			Status:         storage.LeaseActive,
+			RenewalOf:      &renewed,
+		}
+		require.NoError(t, pdb.CreateLease(context.Background(), lease))
+		assert.Equal(t, 3, lease.ID)
+		assert.True(t, pii.Encrypted(lessee.(string)))
+		assert.NotContains(t, lessee, "Ada")
+
+		mock.ExpectQuery(q(`SELECT `+leaseColumns+leasesFrom+` WHERE l.tenant_id = $1 AND l.id = $2`)).
+			WithArgs(tenant.Default, 3).
+			WillReturnRows(sqlmock.NewRows(leaseRows).
+				AddRow(3, 7, 1, lessee, "2026-01-01", "2026-12-31", 120000, 240000, "EUR", "fixed", 2.5, "active", 2))
+
+		got, err := pdb.GetLease(context.Background(), 3)
+		require.NoError(t, err)
+		lease.BuildingID = 1
+		assert.Equal(t, lease, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expiring", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`AND l.end_date BETWEEN current_date AND current_date + $3::integer`)).
+			WithArgs(tenant.Default, "{1,2}", 30).
+			WillReturnRows(sqlmock.NewRows(leaseRows).
+				AddRow(3, 7, 1, "Ada Lovelace", "2026-01-01", "2026-12-31", 120000, 0, "EUR", "none", nil, "active", nil))
+
+		got, err := pdb.GetExpiringLeases(context.Background(), []int{1, 2}, 30)
+		require.NoError(t, err)
+		endDate := storage.NewDate(2026, time.December, 31)
+		assert.Equal(t, []*storage.Lease{{
+			ID:          3,
+			ApartmentID: 7,
+			BuildingID:  1,
+			Lessee:      "Ada Lovelace",
+			StartDate:   storage.NewDate(2026, time.January, 1),
+			EndDate:     &endDate,
+			MonthlyRent: 120000,
+			Currency:    "EUR",
+			Indexation:  storage.IndexationNone,
+			Status:      storage.LeaseActive,
+		}}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("notFound", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`FROM public.lease l`)).WillReturnRows(sqlmock.NewRows(leaseRows))
+
+		_, err := pdb.GetLease(context.Background(), 3)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("unknownApartment", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`INSERT INTO public.lease AS l`)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		err := pdb.CreateLease(context.Background(), &storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: storage.NewDate(2026, time.January, 1)})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`INSERT INTO public.lease AS l`)).
+			WillReturnError(&pq.Error{Code: "23P01", Constraint: "lease_no_overlap"})
+
+		err := pdb.CreateLease(context.Background(), &storage.Lease{ApartmentID: 7, Lessee: "Ada", StartDate: storage.NewDate(2026, time.January, 1)})
+		assert.ErrorIs(t, err, storage.ErrOverlap)
+		assert.EqualError(t, err, "overlap: lease_no_overlap")
+	})
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// migrationsLockID is the key of the advisory lock that serializes migrations between replicas.
+const migrationsLockID = 7_263_001
+
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS public.schema_migrations (
+	version varchar PRIMARY KEY NOT NULL,
+	applied_at timestamptz NOT NULL DEFAULT now()
+)`
+
+type migration struct {
+	version string
+	sql     string
+	// run applies the migrations written in Go instead of sql, e.g. the data migrations that need
+	// the parsers of the application.
+	run func(ctx context.Context, tx *sql.Tx) error
+}
+
+// codeMigrations are the migrations written in Go, applied in the order of their version among
+// the embedded ones.
+var codeMigrations = []migration{
+	{version: "0009_parse_addresses", run: parseAddresses},
+}
+
+func loadMigrations() ([]migration, error) {
+	files, err := fs.Glob(migrationsFS, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	migrations := make([]migration, 0, len(files))
+	for _, f := range files {
+		b, err := migrationsFS.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{
+			version: strings.TrimSuffix(strings.TrimPrefix(f, "migrations/"), ".sql"),
+			sql:     string(b),
+		})
+	}
+	migrations = append(migrations, codeMigrations...)
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	return migrations, nil
+}
+
+// Migrate applies the embedded migrations that are not applied yet, each one in its own transaction.
+func (pdb *PostgresDatabase) Migrate(ctx context.Context) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	conn, err := pdb.psqlClient.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID)
+	if err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", migrationsLockID)
+
+	_, err = conn.ExecContext(ctx, createMigrationsTable)
+	if err != nil {
+		return err
+	}
+
+	applied, err := appliedMigrations(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if m.run != nil {
+			err = m.run(ctx, tx)
+		} else {
+			_, err = tx.ExecContext(ctx, m.sql)
+		}
+		if err == nil {
+			_, err = tx.ExecContext(ctx, "INSERT INTO public.schema_migrations (version) VALUES ($1)", m.version)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration %s: %w", m.version, err)
+		}
+		err = tx.Commit()
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", m.version, err)
+		}
+
+		logger.Component(component).Info("migration applied", "version", m.version)
+	}
+
+	return nil
+}
+
+// PendingMigrations returns the versions of the embedded migrations that are not applied yet.
+func (pdb *PostgresDatabase) PendingMigrations(ctx context.Context) ([]string, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedMigrations(ctx, pdb.psqlClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, m := range migrations {
+		if !applied[m.version] {
+			pending = append(pending, m.version)
+		}
+	}
+
+	return pending, nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func appliedMigrations(ctx context.Context, q queryer) (map[string]bool, error) {
+	applied := make(map[string]bool)
+
+	var exists bool
+	err := q.QueryRowContext(ctx, "SELECT to_regclass('public.schema_migrations') IS NOT NULL").Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT version FROM public.schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version string
+		err = rows.Scan(&version)
+		if err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
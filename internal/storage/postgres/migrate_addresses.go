@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/models"
+)
+
+const (
+	unparsedAddressesQuery = `SELECT id, address FROM public.building
+		WHERE address IS NOT NULL AND street IS NULL AND house_number IS NULL AND city IS NULL
+			AND postal_code IS NULL AND country IS NULL
+		ORDER BY id`
+	parsedAddressUpdate = `UPDATE public.building
+		SET street = $2, house_number = $3, city = $4, postal_code = $5, country = $6
+		WHERE id = $1`
+)
+
+// parseAddresses fills the components of the free-text addresses of the buildings with the
+// address parser, the free text is left as stored.
+func parseAddresses(ctx context.Context, tx *sql.Tx) error {
+	// The policies would hide the rows from the roles that don't own the table.
+	_, err := tx.ExecContext(ctx, "SELECT set_config('app.tenant_id', $1, true)", allTenants)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, unparsedAddressesQuery)
+	if err != nil {
+		return err
+	}
+	var buildings models.BuildingSlice
+	for rows.Next() {
+		b := &models.Building{}
+		err = rows.Scan(&b.ID, &b.Address)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		buildings = append(buildings, b)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, b := range buildings {
+		// The parsed addresses have no coordinates and only the known countries, they are valid.
+		err = address.Resolve(b)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, parsedAddressUpdate, b.ID, b.Street, b.HouseNumber, b.City, b.PostalCode, b.Country)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseAddresses(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	mock.ExpectBegin()
+	mock.ExpectExec(q("SELECT set_config('app.tenant_id', $1, true)")).
+		WithArgs(allTenants).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(q(unparsedAddressesQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "address"}).
+			AddRow(1, "HaMishlatim 4").
+			AddRow(2, "4 Main St, Springfield 62701, USA"))
+	mock.ExpectExec(q(parsedAddressUpdate)).
+		WithArgs(1, "HaMishlatim", "4", nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(q(parsedAddressUpdate)).
+		WithArgs(2, "Main Street", "4", "Springfield", "62701", "US").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	require.NoError(t, parseAddresses(context.Background(), tx))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_LoadMigrations(t *testing.T) {
+	t.Parallel()
+
+	migrations, err := loadMigrations()
+	require.NoError(t, err)
+
+	var versions []string
+	for _, m := range migrations {
+		versions = append(versions, m.version)
+	}
+	assert.IsIncreasing(t, versions)
+	assert.Contains(t, versions, "0008_building_address")
+	i := slices.Index(versions, "0009_parse_addresses")
+	require.NotEqual(t, -1, i)
+	assert.NotNil(t, migrations[i].run)
+	assert.Contains(t, versions, "0010_building_location")
+}
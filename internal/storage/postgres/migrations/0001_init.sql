@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS public.building (
+	id serial PRIMARY KEY NOT NULL,
+	"name" varchar UNIQUE NOT NULL,
+	address text
+);
+
+CREATE TABLE IF NOT EXISTS public.apartment (
+	id serial PRIMARY KEY NOT NULL,
+	building_id integer NOT NULL,
+	"number" varchar,
+	"floor" integer,
+	sq_meters integer,
+	CONSTRAINT building_id FOREIGN KEY (building_id)
+		REFERENCES public.building (id) MATCH SIMPLE
+		ON UPDATE NO ACTION
+		ON DELETE CASCADE
+		NOT VALID
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS public.api_key (
+	id serial PRIMARY KEY NOT NULL,
+	"name" varchar NOT NULL,
+	subject varchar NOT NULL,
+	prefix varchar NOT NULL,
+	key_hash varchar UNIQUE NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	expires_at timestamptz,
+	revoked_at timestamptz
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS public.building_grant (
+	id serial PRIMARY KEY NOT NULL,
+	subject varchar NOT NULL,
+	"role" varchar NOT NULL CHECK ("role" IN ('viewer', 'manager', 'admin')),
+	building_id integer REFERENCES public.building (id) ON DELETE CASCADE,
+	created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- A subject has a single role per building and a single global role (building_id is NULL).
+CREATE UNIQUE INDEX IF NOT EXISTS building_grant_subject_building_idx
+	ON public.building_grant (subject, (coalesce(building_id, 0)));
@@ -0,0 +1,45 @@
+-- Buildings belong to a tenant (management company), the existing ones to the default tenant.
+ALTER TABLE public.building ADD COLUMN IF NOT EXISTS tenant_id varchar NOT NULL DEFAULT 'default';
+ALTER TABLE public.building ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- The building names are unique per tenant.
+ALTER TABLE public.building DROP CONSTRAINT IF EXISTS building_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS building_tenant_name_idx ON public.building (tenant_id, "name");
+ALTER TABLE public.building ADD CONSTRAINT building_id_tenant_key UNIQUE (id, tenant_id);
+
+-- Apartments have the tenant of their building, the composite foreign key keeps it consistent.
+ALTER TABLE public.apartment ADD COLUMN IF NOT EXISTS tenant_id varchar;
+UPDATE public.apartment a SET tenant_id = b.tenant_id FROM public.building b WHERE b.id = a.building_id;
+UPDATE public.apartment SET tenant_id = 'default' WHERE tenant_id IS NULL;
+ALTER TABLE public.apartment ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE public.apartment ADD CONSTRAINT building_tenant FOREIGN KEY (building_id, tenant_id)
+	REFERENCES public.building (id, tenant_id) MATCH SIMPLE
+	ON UPDATE NO ACTION
+	ON DELETE CASCADE
+	NOT VALID;
+CREATE INDEX IF NOT EXISTS apartment_tenant_building_idx ON public.apartment (tenant_id, building_id);
+
+-- Grants are given within a tenant, on its buildings only.
+ALTER TABLE public.building_grant ADD COLUMN IF NOT EXISTS tenant_id varchar NOT NULL DEFAULT 'default';
+ALTER TABLE public.building_grant ALTER COLUMN tenant_id DROP DEFAULT;
+DROP INDEX IF EXISTS public.building_grant_subject_building_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS building_grant_tenant_subject_building_idx
+	ON public.building_grant (tenant_id, subject, (coalesce(building_id, 0)));
+ALTER TABLE public.building_grant ADD CONSTRAINT building_grant_building_tenant FOREIGN KEY (building_id, tenant_id)
+	REFERENCES public.building (id, tenant_id) ON DELETE CASCADE;
+
+-- API keys may be bound to a tenant.
+ALTER TABLE public.api_key ADD COLUMN IF NOT EXISTS tenant_id varchar;
+
+-- Row-level security, enforced for the roles that don't own the tables (see PG_ROW_LEVEL_SECURITY).
+-- The storage sets app.tenant_id in the transaction of every query, '*' is reserved for the
+-- cross-tenant aggregates and can't be requested by a client.
+ALTER TABLE public.building ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON public.building
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+ALTER TABLE public.apartment ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON public.apartment
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
+ALTER TABLE public.building_grant ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON public.building_grant
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
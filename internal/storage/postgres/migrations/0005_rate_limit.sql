@@ -0,0 +1,6 @@
+-- Token buckets of the rate limiter, shared by the replicas.
+CREATE UNLOGGED TABLE IF NOT EXISTS public.rate_limit_bucket (
+	"key" varchar PRIMARY KEY NOT NULL,
+	tokens double precision NOT NULL,
+	updated_at timestamptz NOT NULL
+);
@@ -0,0 +1,15 @@
+-- Responses of the requests made with an Idempotency-Key, replayed on their retries.
+CREATE TABLE IF NOT EXISTS public.idempotency_key (
+	scope varchar NOT NULL,
+	"key" varchar NOT NULL,
+	request_hash varchar NOT NULL,
+	-- NULL while the request is in progress
+	status integer,
+	content_type varchar,
+	response bytea,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	expires_at timestamptz NOT NULL,
+	PRIMARY KEY (scope, "key")
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_expires_at_idx ON public.idempotency_key (expires_at);
@@ -0,0 +1,10 @@
+-- Search of the buildings and the apartments: full-text search of the words of the name, the
+-- address and the number, trigram similarity for the typos.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- The expressions must be the ones of the search query for the indexes to be used.
+CREATE INDEX IF NOT EXISTS building_search_idx ON public.building
+	USING gin (to_tsvector('simple', "name" || ' ' || coalesce(address, '')));
+CREATE INDEX IF NOT EXISTS building_search_trgm_idx ON public.building
+	USING gin (("name" || ' ' || coalesce(address, '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS apartment_number_lower_idx ON public.apartment (building_id, lower("number"));
@@ -0,0 +1,22 @@
+-- Structured addresses of the buildings. The address column is kept, rendered from the components
+-- by the application (see the address package); the existing addresses are parsed into the
+-- components by the 0009_parse_addresses migration.
+ALTER TABLE public.building
+	ADD COLUMN IF NOT EXISTS street varchar,
+	ADD COLUMN IF NOT EXISTS house_number varchar,
+	ADD COLUMN IF NOT EXISTS city varchar,
+	ADD COLUMN IF NOT EXISTS postal_code varchar,
+	-- ISO 3166-1 alpha-2
+	ADD COLUMN IF NOT EXISTS country varchar(2),
+	ADD COLUMN IF NOT EXISTS latitude double precision,
+	ADD COLUMN IF NOT EXISTS longitude double precision;
+
+ALTER TABLE public.building DROP CONSTRAINT IF EXISTS building_coordinates_check;
+ALTER TABLE public.building ADD CONSTRAINT building_coordinates_check CHECK (
+	(latitude IS NULL) = (longitude IS NULL)
+	AND latitude BETWEEN -90 AND 90
+	AND longitude BETWEEN -180 AND 180
+);
+
+CREATE INDEX IF NOT EXISTS building_tenant_city_idx ON public.building (tenant_id, lower(city));
+CREATE INDEX IF NOT EXISTS building_tenant_street_idx ON public.building (tenant_id, lower(street));
@@ -0,0 +1,4 @@
+-- Geospatial searches of the buildings (see geo.go): the bounding boxes around the searched
+-- circles and the searched boxes are ranges of the coordinates.
+CREATE INDEX IF NOT EXISTS building_location_idx ON public.building (tenant_id, latitude, longitude)
+	WHERE latitude IS NOT NULL;
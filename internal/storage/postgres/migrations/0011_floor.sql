@@ -0,0 +1,21 @@
+-- Floors of the buildings. The apartments refer to their floor by its number, 0 is the ground
+-- floor and the basements are below; the label is the name of the floor in the building.
+CREATE TABLE IF NOT EXISTS public.floor (
+	id serial PRIMARY KEY NOT NULL,
+	tenant_id varchar NOT NULL,
+	building_id integer NOT NULL,
+	"number" integer NOT NULL,
+	label varchar NOT NULL,
+	sq_meters integer CHECK (sq_meters > 0),
+	-- Step-free access
+	accessible boolean NOT NULL DEFAULT false,
+	CONSTRAINT floor_building_tenant FOREIGN KEY (building_id, tenant_id)
+		REFERENCES public.building (id, tenant_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS floor_tenant_building_number_idx ON public.floor (tenant_id, building_id, "number");
+CREATE INDEX IF NOT EXISTS apartment_tenant_building_floor_idx ON public.apartment (tenant_id, building_id, floor);
+
+ALTER TABLE public.floor ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON public.floor
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
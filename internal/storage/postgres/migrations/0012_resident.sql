@@ -0,0 +1,30 @@
+-- Residents of the apartments, living there from move_in until the day before move_out. The name,
+-- email and phone are personal data, encrypted by the service when PII_ENCRYPTION_KEYS is set.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE public.apartment ADD CONSTRAINT apartment_id_tenant_key UNIQUE (id, tenant_id);
+
+CREATE TABLE IF NOT EXISTS public.resident (
+	id serial PRIMARY KEY NOT NULL,
+	tenant_id varchar NOT NULL,
+	apartment_id integer NOT NULL,
+	"name" text NOT NULL,
+	email text,
+	phone text,
+	primary_contact boolean NOT NULL DEFAULT false,
+	move_in date NOT NULL,
+	move_out date,
+	CONSTRAINT resident_apartment_tenant FOREIGN KEY (apartment_id, tenant_id)
+		REFERENCES public.apartment (id, tenant_id) ON DELETE CASCADE,
+	CONSTRAINT resident_move_out_check CHECK (move_out IS NULL OR move_out >= move_in),
+	-- An apartment has one primary contact at a time
+	CONSTRAINT resident_one_primary_contact EXCLUDE USING gist (
+		tenant_id WITH =, apartment_id WITH =, daterange(move_in, move_out) WITH &&
+	) WHERE (primary_contact)
+);
+
+CREATE INDEX IF NOT EXISTS resident_tenant_apartment_idx ON public.resident (tenant_id, apartment_id, move_in);
+
+ALTER TABLE public.resident ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON public.resident
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
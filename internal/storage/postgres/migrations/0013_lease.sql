@@ -0,0 +1,37 @@
+-- Leases of the apartments, from start_date to end_date included, open-ended without end_date. The
+-- lessee is personal data, encrypted by the service when PII_ENCRYPTION_KEYS is set. The amounts
+-- are in the minor unit of the currency, e.g. cents.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE IF NOT EXISTS public.lease (
+	id serial PRIMARY KEY NOT NULL,
+	tenant_id varchar NOT NULL,
+	apartment_id integer NOT NULL,
+	lessee text NOT NULL,
+	start_date date NOT NULL,
+	end_date date,
+	monthly_rent bigint NOT NULL CHECK (monthly_rent > 0),
+	deposit bigint NOT NULL DEFAULT 0 CHECK (deposit >= 0),
+	currency char(3) NOT NULL,
+	indexation varchar NOT NULL DEFAULT 'none' CHECK (indexation IN ('none', 'fixed', 'cpi')),
+	-- The yearly increase of a fixed indexation, the cap of a cpi one, in percent
+	indexation_rate numeric(5, 2) CHECK (indexation_rate > 0),
+	status varchar NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'active', 'terminated')),
+	-- The lease this one renews
+	renewal_of integer,
+	CONSTRAINT lease_id_tenant_key UNIQUE (id, tenant_id),
+	CONSTRAINT lease_renewal_of_tenant FOREIGN KEY (renewal_of, tenant_id)
+		REFERENCES public.lease (id, tenant_id) ON DELETE SET NULL (renewal_of),
+	CONSTRAINT lease_apartment_tenant FOREIGN KEY (apartment_id, tenant_id)
+		REFERENCES public.apartment (id, tenant_id) ON DELETE CASCADE,
+	CONSTRAINT lease_end_date_check CHECK (end_date IS NULL OR end_date >= start_date),
+	CONSTRAINT lease_no_overlap EXCLUDE USING gist (
+		tenant_id WITH =, apartment_id WITH =, daterange(start_date, end_date, '[]') WITH &&
+	)
+);
+
+CREATE INDEX IF NOT EXISTS lease_tenant_end_date_idx ON public.lease (tenant_id, end_date) WHERE status = 'active';
+
+ALTER TABLE public.lease ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON public.lease
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.tenant_id', true) = '*');
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+// reencryptBatch is the number of rows read by each transaction of ReencryptPII.
+const reencryptBatch = 500
+
+// piiTable is a table with personal data, its columns and the fields authenticated with their values.
+type piiTable struct {
+	name    string
+	columns []string
+	fields  []string
+}
+
+var (
+	residentPII = piiTable{
+		name:    "public.resident",
+		columns: []string{`"name"`, "email", "phone"},
+		fields:  []string{residentName, residentEmail, residentPhone},
+	}
+	leasePII = piiTable{
+		name:    "public.lease",
+		columns: []string{"lessee"},
+		fields:  []string{leaseLessee},
+	}
+)
+
+// ReencryptPII re-encrypts with the current key the personal data of every tenant that is stored in
+// clear or with a rotated key, e.g. after a key was added. It reads the rows in batches, each in its
+// own transaction with RowLevelSecurity, so it can run again after a failure. A row written since it
+// was read is left as written. Without a cipher nothing is rewritten.
+func (pdb *PostgresDatabase) ReencryptPII(ctx context.Context) (r storage.Reencrypted, err error) {
+	ctx, end := pdb.track(ctx, "ReencryptPII")
+	defer end(&err)
+
+	if pdb.cipher == nil {
+		return r, nil
+	}
+
+	r.Residents, err = pdb.reencrypt(ctx, residentPII)
+	if err != nil {
+		return r, err
+	}
+	r.Leases, err = pdb.reencrypt(ctx, leasePII)
+	if err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// reencrypt rewrites the stale values of the table by batches of rows, it returns the rows rewritten.
+func (pdb *PostgresDatabase) reencrypt(ctx context.Context, t piiTable) (n int64, err error) {
+	selectQuery := fmt.Sprintf(`SELECT id, %s FROM %s WHERE id > $1 ORDER BY id LIMIT %d`,
+		strings.Join(t.columns, ", "), t.name, reencryptBatch)
+	// The row is only updated if its values are still the ones read.
+	set := make([]string, len(t.columns))
+	unchanged := make([]string, len(t.columns))
+	for i, column := range t.columns {
+		set[i] = fmt.Sprintf("%s = $%d", column, i+2)
+		unchanged[i] = fmt.Sprintf("%s IS NOT DISTINCT FROM $%d", column, len(t.columns)+i+2)
+	}
+	updateQuery := fmt.Sprintf(`UPDATE %s SET %s WHERE id = $1 AND %s`,
+		t.name, strings.Join(set, ", "), strings.Join(unchanged, " AND "))
+
+	after := 0
+	for {
+		var rows int
+		err = pdb.scoped(ctx, allTenants, func(exec boil.ContextExecutor) error {
+			var stale [][]any
+			var err error
+			rows, after, stale, err = pdb.readStale(ctx, exec, selectQuery, t, after)
+			if err != nil {
+				return err
+			}
+
+			for _, args := range stale {
+				res, err := exec.ExecContext(ctx, updateQuery, args...)
+				if err != nil {
+					return err
+				}
+				updated, err := res.RowsAffected()
+				if err != nil {
+					return err
+				}
+				n += updated
+			}
+			return nil
+		})
+		if err != nil {
+			return n, err
+		}
+		if rows < reencryptBatch {
+			return n, nil
+		}
+	}
+}
+
+// readStale reads the batch of rows of the table after the ID. It returns the number of rows read,
+// the last ID and, for the rows with stale values, the arguments of their update: the ID, the
+// re-encrypted values and the values read.
+func (pdb *PostgresDatabase) readStale(ctx context.Context, exec boil.ContextExecutor, query string, t piiTable,
+	after int) (n int, last int, stale [][]any, err error) {
+	rows, err := exec.QueryContext(ctx, query, after)
+	if err != nil {
+		return 0, after, nil, err
+	}
+	defer rows.Close()
+
+	last = after
+	for rows.Next() {
+		values := make([]sql.NullString, len(t.columns))
+		dest := []any{&last}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		err = rows.Scan(dest...)
+		if err != nil {
+			return 0, after, nil, err
+		}
+		n++
+
+		args, err := pdb.reencryptRow(t, last, values)
+		if err != nil {
+			return 0, after, nil, err
+		}
+		if args != nil {
+			stale = append(stale, args)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return 0, after, nil, err
+	}
+
+	return n, last, stale, nil
+}
+
+// reencryptRow returns the arguments of the update of the row, nil if none of its values is stale.
+func (pdb *PostgresDatabase) reencryptRow(t piiTable, id int, values []sql.NullString) ([]any, error) {
+	args := make([]any, 1+2*len(values))
+	args[0] = id
+	changed := false
+	for i, v := range values {
+		args[1+i] = v
+		args[1+len(values)+i] = v
+		if !v.Valid || !pdb.cipher.Stale(v.String) {
+			continue
+		}
+
+		plain, err := pdb.cipher.Decrypt(t.fields[i], v.String)
+		if err != nil {
+			return nil, err
+		}
+		encrypted, err := pdb.cipher.Encrypt(t.fields[i], plain)
+		if err != nil {
+			return nil, err
+		}
+		args[1+i] = encrypted
+		changed = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return args, nil
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/pii"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+)
+
+func Test_ReencryptPII(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stale", func(t *testing.T) {
+		t.Parallel()
+
+		rotated, err := testCipher(t, "k1", 1).Encrypt(residentName, "Ada Lovelace")
+		require.NoError(t, err)
+		c, err := pii.ParseKeys("k2:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, pii.KeySize)) +
+			",k1:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, pii.KeySize)))
+		require.NoError(t, err)
+		current, err := c.Encrypt(residentName, "Grace Hopper")
+		require.NoError(t, err)
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		pdb.SetCipher(c)
+
+		mock.ExpectQuery(q(`SELECT id, "name", email, phone FROM public.resident WHERE id > $1 ORDER BY id LIMIT 500`)).
+			WithArgs(0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "phone"}).
+				AddRow(1, rotated, "ada@example.com", nil).
+				AddRow(2, current, nil, nil))
+		var name, email driver.Value
+		mock.ExpectExec(q(`UPDATE public.resident SET "name" = $2, email = $3, phone = $4
+			WHERE id = $1 AND "name" IS NOT DISTINCT FROM $5 AND email IS NOT DISTINCT FROM $6 AND phone IS NOT DISTINCT FROM $7`)).
+			WithArgs(1, capture{&name}, capture{&email}, nil, rotated, "ada@example.com", nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(q(`SELECT id, lessee FROM public.lease WHERE id > $1 ORDER BY id LIMIT 500`)).
+			WithArgs(0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "lessee"}))
+
+		got, err := pdb.ReencryptPII(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, storage.Reencrypted{Residents: 1}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		assert.False(t, c.Stale(name.(string)))
+		assert.False(t, c.Stale(email.(string)))
+		decrypted, err := c.Decrypt(residentName, name.(string))
+		require.NoError(t, err)
+		assert.Equal(t, "Ada Lovelace", decrypted)
+		decrypted, err = c.Decrypt(residentEmail, email.(string))
+		require.NoError(t, err)
+		assert.Equal(t, "ada@example.com", decrypted)
+	})
+
+	t.Run("noCipher", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+
+		got, err := pdb.ReencryptPII(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, storage.Reencrypted{}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
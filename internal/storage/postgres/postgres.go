@@ -3,35 +3,167 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
+	"github.com/sotskov-do/oms-assignment/internal/logger"
 	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/pii"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
 )
 
+const (
+	// component tags the logs of the package, see logger.Component.
+	component = "postgres"
+
+	maxConnectBackoff = 30 * time.Second
+
+	// exclusionViolation is the code of the errors of the exclusion constraints, e.g. lease_no_overlap.
+	exclusionViolation = "23P01"
+)
+
+// Options configures the connection pool and the startup behaviour of the database.
+type Options struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// StatementTimeout aborts any statement that takes longer, 0 disables it.
+	StatementTimeout time.Duration
+	// ConnectRetries is the number of additional pings made by PingWithRetry.
+	ConnectRetries int
+	// ConnectBackoff is the initial delay between pings, doubled after each attempt.
+	ConnectBackoff time.Duration
+	// RowLevelSecurity sets the tenant of every query for the row-level security policies,
+	// which apply when the service doesn't connect as the owner of the tables.
+	RowLevelSecurity bool
+}
+
+func DefaultOptions() Options {
+	return Options{
+		MaxOpenConns:     20,
+		MaxIdleConns:     10,
+		ConnMaxLifetime:  30 * time.Minute,
+		ConnMaxIdleTime:  5 * time.Minute,
+		StatementTimeout: 30 * time.Second,
+		ConnectRetries:   10,
+		ConnectBackoff:   500 * time.Millisecond,
+	}
+}
+
+// QueryObserver receives the duration of every storage method.
+type QueryObserver func(method string, duration time.Duration)
+
 type PostgresDatabase struct {
 	psqlClient *sql.DB
+	executor   tracedExecutor
+	opts       Options
+	observer   QueryObserver
+	cipher     *pii.Cipher
+	// after waits between the pings of PingWithRetry, time.After when nil.
+	after func(d time.Duration) <-chan time.Time
 }
 
-func New(ctx context.Context, pgconn string) (*PostgresDatabase, error) {
-	db, err := sql.Open("postgres", pgconn+"?sslmode=disable")
+func New(ctx context.Context, pgconn string, opts Options) (*PostgresDatabase, error) {
+	dsn, err := buildDSN(pgconn, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		return nil, err
 	}
 
-	return &PostgresDatabase{psqlClient: db}, nil
+	db.SetMaxOpenConns(opts.MaxOpenConns)
+	db.SetMaxIdleConns(opts.MaxIdleConns)
+	db.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(opts.ConnMaxIdleTime)
+
+	return &PostgresDatabase{psqlClient: db, executor: tracedExecutor{db: db}, opts: opts}, nil
+}
+
+func buildDSN(pgconn string, opts Options) (string, error) {
+	u, err := url.Parse(pgconn)
+	if err != nil {
+		return "", fmt.Errorf("invalid postgres url: %w", err)
+	}
+
+	q := u.Query()
+	if !q.Has("sslmode") {
+		q.Set("sslmode", "disable")
+	}
+	if opts.StatementTimeout > 0 {
+		// Unknown parameters are sent by lib/pq as run-time parameters of the session.
+		q.Set("statement_timeout", strconv.FormatInt(opts.StatementTimeout.Milliseconds(), 10))
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
 }
 
 func (pdb *PostgresDatabase) Ping(ctx context.Context) error {
-	err := pdb.psqlClient.Ping()
+	err := pdb.psqlClient.PingContext(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// PingWithRetry pings the database until it answers, the retries are exhausted or ctx is done.
+// It lets the application start together with a database that is still booting.
+func (pdb *PostgresDatabase) PingWithRetry(ctx context.Context) error {
+	after := pdb.after
+	if after == nil {
+		after = time.After
+	}
+
+	backoff := pdb.opts.ConnectBackoff
+	for attempt := 0; ; attempt++ {
+		err := pdb.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempt >= pdb.opts.ConnectRetries {
+			return fmt.Errorf("database is not reachable after %d attempts: %w", attempt+1, err)
+		}
+
+		logger.Component(component).Warn("database is not reachable, retrying", "attempt", attempt+1, "backoff", backoff.String(), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-after(backoff):
+		}
+
+		backoff = min(backoff*2, maxConnectBackoff)
+	}
+}
+
+// Stats returns the connection pool statistics.
+func (pdb *PostgresDatabase) Stats() sql.DBStats {
+	return pdb.psqlClient.Stats()
+}
+
+// SetQueryObserver must be called before the database is used.
+func (pdb *PostgresDatabase) SetQueryObserver(observer QueryObserver) {
+	pdb.observer = observer
+}
+
+// SetCipher encrypts the personal data of the residents with cipher, it must be called before the
+// database is used. They are stored in clear without a cipher.
+func (pdb *PostgresDatabase) SetCipher(cipher *pii.Cipher) {
+	pdb.cipher = cipher
+}
+
 func (pdb *PostgresDatabase) Stop(ctx context.Context) error {
 	err := pdb.psqlClient.Close()
 	if err != nil {
@@ -42,8 +174,15 @@ func (pdb *PostgresDatabase) Stop(ctx context.Context) error {
 
 /* Apartments */
 
-func (pdb *PostgresDatabase) GetApartments(ctx context.Context) (models.ApartmentSlice, error) {
-	a, err := models.Apartments().All(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) GetApartments(ctx context.Context) (a models.ApartmentSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetApartments")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		a, err = models.Apartments(models.ApartmentWhere.TenantID.EQ(tenantID)).All(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +190,18 @@ func (pdb *PostgresDatabase) GetApartments(ctx context.Context) (models.Apartmen
 	return a, nil
 }
 
-func (pdb *PostgresDatabase) GetApartment(ctx context.Context, id int) (*models.Apartment, error) {
-	a, err := models.Apartments(qm.Where("id=?", id)).One(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) GetApartment(ctx context.Context, id int) (a *models.Apartment, err error) {
+	ctx, end := pdb.track(ctx, "GetApartment")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		a, err = models.Apartments(
+			models.ApartmentWhere.ID.EQ(id),
+			models.ApartmentWhere.TenantID.EQ(tenantID),
+		).One(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +209,18 @@ func (pdb *PostgresDatabase) GetApartment(ctx context.Context, id int) (*models.
 	return a, nil
 }
 
-func (pdb *PostgresDatabase) GetApartmentsInBuilding(ctx context.Context, buildingId int) (models.ApartmentSlice, error) {
-	a, err := models.Apartments(qm.Where("building_id=?", buildingId)).All(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) GetApartmentsInBuilding(ctx context.Context, buildingId int) (a models.ApartmentSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetApartmentsInBuilding")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		a, err = models.Apartments(
+			models.ApartmentWhere.BuildingID.EQ(buildingId),
+			models.ApartmentWhere.TenantID.EQ(tenantID),
+		).All(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +228,85 @@ func (pdb *PostgresDatabase) GetApartmentsInBuilding(ctx context.Context, buildi
 	return a, nil
 }
 
-func (pdb *PostgresDatabase) CreateApartment(ctx context.Context, apartment *models.Apartment) error {
-	err := apartment.Upsert(ctx, pdb.psqlClient, true, []string{}, boil.Infer(), boil.Infer())
+func (pdb *PostgresDatabase) GetApartmentsInBuildings(ctx context.Context, buildingIds []int) (a models.ApartmentSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetApartmentsInBuildings")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		a, err = models.Apartments(
+			models.ApartmentWhere.BuildingID.IN(buildingIds),
+			models.ApartmentWhere.TenantID.EQ(tenantID),
+		).All(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (pdb *PostgresDatabase) GetApartmentsOnFloor(ctx context.Context, buildingId int, floor int) (a models.ApartmentSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetApartmentsOnFloor")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		a, err = models.Apartments(
+			models.ApartmentWhere.BuildingID.EQ(buildingId),
+			models.ApartmentWhere.Floor.EQ(null.IntFrom(floor)),
+			models.ApartmentWhere.TenantID.EQ(tenantID),
+		).All(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// CreateApartment upserts the apartment into a building of the tenant, the composite foreign key
+// rejects the buildings of the other tenants.
+func (pdb *PostgresDatabase) CreateApartment(ctx context.Context, apartment *models.Apartment) (err error) {
+	ctx, end := pdb.track(ctx, "CreateApartment")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	apartment.TenantID = tenantID
+	err = pdb.transaction(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		// The upsert would otherwise take over the apartment of another tenant with the same ID.
+		taken, err := models.Apartments(
+			models.ApartmentWhere.ID.EQ(apartment.ID),
+			models.ApartmentWhere.TenantID.NEQ(tenantID),
+		).Exists(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return fmt.Errorf("no apartment with id [%v]", apartment.ID)
+		}
+
+		if apartment.Floor.Valid {
+			// The floors of the building can't change until the apartment commits. An unknown
+			// building has no row, the upsert rejects it.
+			var onFloor bool
+			err = exec.QueryRowContext(ctx,
+				`SELECT NOT EXISTS (SELECT 1 FROM public.floor f WHERE f.tenant_id = b.tenant_id AND f.building_id = b.id)
+					OR EXISTS (SELECT 1 FROM public.floor f WHERE f.tenant_id = b.tenant_id AND f.building_id = b.id AND f."number" = $3)
+				FROM public.building b WHERE b.tenant_id = $1 AND b.id = $2 FOR `+lockForKeyShare,
+				tenantID, apartment.BuildingID, apartment.Floor.Int).Scan(&onFloor)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			if err == nil && !onFloor {
+				return fmt.Errorf("%w: floor [%v] of building [%v]", storage.ErrNoFloor, apartment.Floor.Int, apartment.BuildingID)
+			}
+		}
+
+		return apartment.Upsert(ctx, exec, true, []string{}, boil.Infer(), boil.Infer())
+	})
 	if err != nil {
 		return err
 	}
@@ -78,8 +314,18 @@ func (pdb *PostgresDatabase) CreateApartment(ctx context.Context, apartment *mod
 	return nil
 }
 
-func (pdb *PostgresDatabase) DeleteApartment(ctx context.Context, id int) (int64, error) {
-	n, err := models.Apartments(qm.Where("id=?", id)).DeleteAll(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) DeleteApartment(ctx context.Context, id int) (n int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteApartment")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		n, err = models.Apartments(
+			models.ApartmentWhere.ID.EQ(id),
+			models.ApartmentWhere.TenantID.EQ(tenantID),
+		).DeleteAll(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -89,8 +335,34 @@ func (pdb *PostgresDatabase) DeleteApartment(ctx context.Context, id int) (int64
 
 /* Buildings */
 
-func (pdb *PostgresDatabase) GetBuildings(ctx context.Context) (models.BuildingSlice, error) {
-	b, err := models.Buildings().All(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) GetBuildings(ctx context.Context) (b models.BuildingSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetBuildings")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		b, err = models.Buildings(models.BuildingWhere.TenantID.EQ(tenantID)).All(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (pdb *PostgresDatabase) GetBuildingsByIDs(ctx context.Context, ids []int) (b models.BuildingSlice, err error) {
+	ctx, end := pdb.track(ctx, "GetBuildingsByIDs")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		b, err = models.Buildings(
+			models.BuildingWhere.ID.IN(ids),
+			models.BuildingWhere.TenantID.EQ(tenantID),
+		).All(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +370,18 @@ func (pdb *PostgresDatabase) GetBuildings(ctx context.Context) (models.BuildingS
 	return b, nil
 }
 
-func (pdb *PostgresDatabase) GetBuilding(ctx context.Context, id int) (*models.Building, error) {
-	b, err := models.Buildings(qm.Where("id=?", id)).One(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) GetBuilding(ctx context.Context, id int) (b *models.Building, err error) {
+	ctx, end := pdb.track(ctx, "GetBuilding")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		b, err = models.Buildings(
+			models.BuildingWhere.ID.EQ(id),
+			models.BuildingWhere.TenantID.EQ(tenantID),
+		).One(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -107,8 +389,27 @@ func (pdb *PostgresDatabase) GetBuilding(ctx context.Context, id int) (*models.B
 	return b, nil
 }
 
-func (pdb *PostgresDatabase) CreateBuilding(ctx context.Context, building *models.Building) error {
-	err := building.Upsert(ctx, pdb.psqlClient, true, []string{}, boil.Infer(), boil.Infer())
+func (pdb *PostgresDatabase) CreateBuilding(ctx context.Context, building *models.Building) (err error) {
+	ctx, end := pdb.track(ctx, "CreateBuilding")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	building.TenantID = tenantID
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		// The upsert would otherwise take over the building of another tenant with the same ID.
+		taken, err := models.Buildings(
+			models.BuildingWhere.ID.EQ(building.ID),
+			models.BuildingWhere.TenantID.NEQ(tenantID),
+		).Exists(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return fmt.Errorf("no building with id [%v]", building.ID)
+		}
+
+		return building.Upsert(ctx, exec, true, []string{}, boil.Infer(), boil.Infer())
+	})
 	if err != nil {
 		return err
 	}
@@ -116,11 +417,51 @@ func (pdb *PostgresDatabase) CreateBuilding(ctx context.Context, building *model
 	return nil
 }
 
-func (pdb *PostgresDatabase) DeleteBuilding(ctx context.Context, id int) (int64, error) {
-	n, err := models.Buildings(qm.Where("id=?", id)).DeleteAll(ctx, pdb.psqlClient)
+func (pdb *PostgresDatabase) DeleteBuilding(ctx context.Context, id int) (n int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteBuilding")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		n, err = models.Buildings(
+			models.BuildingWhere.ID.EQ(id),
+			models.BuildingWhere.TenantID.EQ(tenantID),
+		).DeleteAll(ctx, exec)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
 
 	return n, nil
 }
+
+/* Metrics */
+
+// GetTotals counts the rows of all the tenants.
+func (pdb *PostgresDatabase) GetTotals(ctx context.Context) (t storage.Totals, err error) {
+	ctx, end := pdb.track(ctx, "GetTotals")
+	defer end(&err)
+
+	err = pdb.scoped(ctx, allTenants, func(exec boil.ContextExecutor) error {
+		return exec.QueryRowContext(ctx, `SELECT
+			(SELECT count(*) FROM public.building),
+			(SELECT count(*) FROM public.apartment),
+			(SELECT coalesce(sum(sq_meters), 0) FROM public.apartment)`,
+		).Scan(&t.Buildings, &t.Apartments, &t.SQMeters)
+	})
+	if err != nil {
+		return storage.Totals{}, err
+	}
+
+	return t, nil
+}
+
+// overlapError wraps the errors of the exclusion constraints, e.g. lease_no_overlap, with storage.ErrOverlap.
+func overlapError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == exclusionViolation {
+		return fmt.Errorf("%w: %v", storage.ErrOverlap, pqErr.Constraint)
+	}
+	return err
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+// refilledTokens are the tokens of the existing bucket (b) refilled until the time of the new row.
+const refilledTokens = `least($3::double precision,
+	b.tokens + greatest(extract(epoch FROM EXCLUDED.updated_at - b.updated_at), 0) * $2)`
+
+// takeTokenQuery refills and takes a token from the bucket in a single statement. A new bucket is
+// inserted full, minus the token. Otherwise, the update refills and decrements the locked existing
+// row, so the concurrent requests of the same key, the first ones included, are serialized. A
+// request over the limit leaves the bucket as is: the tokens are refilled from the same time later
+// on. The returned tokens are refilled until now, the request is allowed when the bucket was
+// updated now. The clock of the database is used, so the replicas agree on it.
+const takeTokenQuery = `WITH clock AS (SELECT clock_timestamp() AS now)
+INSERT INTO public.rate_limit_bucket AS b ("key", tokens, updated_at)
+SELECT $1, $3::double precision - 1, now FROM clock
+ON CONFLICT ("key") DO UPDATE SET
+	tokens = CASE WHEN ` + refilledTokens + ` >= 1 THEN ` + refilledTokens + ` - 1 ELSE b.tokens END,
+	updated_at = CASE WHEN ` + refilledTokens + ` >= 1 THEN EXCLUDED.updated_at ELSE b.updated_at END
+RETURNING
+	least($3::double precision,
+		b.tokens + greatest(extract(epoch FROM (SELECT now FROM clock) - b.updated_at), 0) * $2),
+	b.updated_at = (SELECT now FROM clock)`
+
+func (pdb *PostgresDatabase) TakeToken(ctx context.Context, key string, rate float64, burst int) (tokens float64, allowed bool, err error) {
+	ctx, end := pdb.track(ctx, "TakeToken")
+	defer end(&err)
+
+	err = pdb.executor.QueryRowContext(ctx, takeTokenQuery, key, rate, burst).Scan(&tokens, &allowed)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return tokens, allowed, nil
+}
+
+func (pdb *PostgresDatabase) DeleteIdleBuckets(ctx context.Context, idle time.Duration) (_ int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteIdleBuckets")
+	defer end(&err)
+
+	res, err := pdb.executor.ExecContext(ctx,
+		`DELETE FROM public.rate_limit_bucket WHERE updated_at < clock_timestamp() - $1 * interval '1 second'`,
+		idle.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RateLimitBuckets(t *testing.T) {
+	t.Parallel()
+
+	pdb, mock := newMockDatabase(t, Options{})
+	ctx := context.Background()
+
+	mock.ExpectQuery(q(takeTokenQuery)).
+		WithArgs("read:ip:10.0.0.1", 2.5, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "allowed"}).AddRow(0.4, false))
+	tokens, allowed, err := pdb.TakeToken(ctx, "read:ip:10.0.0.1", 2.5, 10)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.4, tokens, 1e-9)
+	assert.False(t, allowed)
+
+	mock.ExpectExec(q(`DELETE FROM public.rate_limit_bucket`)).
+		WithArgs(90.0).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	n, err := pdb.DeleteIdleBuckets(ctx, 90*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
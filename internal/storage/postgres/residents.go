@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const (
+	residentColumns = `r.id, r.apartment_id, a.building_id, r."name", r.email, r.phone, r.primary_contact, r.move_in, r.move_out`
+	// residentsFrom joins the residents (r) to their apartment (a) for its building.
+	residentsFrom = ` FROM public.resident r JOIN public.apartment a ON a.tenant_id = r.tenant_id AND a.id = r.apartment_id`
+
+	// currentResident matches the residents (r) living in their apartment today, from move_in until
+	// the day before move_out.
+	currentResident = `r.move_in <= current_date AND (r.move_out IS NULL OR r.move_out > current_date)`
+
+	// The fields of the encrypted personal data, authenticated with their values.
+	residentName  = "resident.name"
+	residentEmail = "resident.email"
+	residentPhone = "resident.phone"
+)
+
+// scanResident scans the residentColumns and decrypts the personal data.
+func (pdb *PostgresDatabase) scanResident(row interface{ Scan(dest ...any) error }) (*storage.Resident, error) {
+	var r storage.Resident
+	var email, phone sql.NullString
+	err := row.Scan(&r.ID, &r.ApartmentID, &r.BuildingID, &r.Name, &email, &phone, &r.PrimaryContact, &r.MoveIn, &r.MoveOut)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Name, err = pdb.cipher.Decrypt(residentName, r.Name)
+	if err != nil {
+		return nil, err
+	}
+	r.Email, err = pdb.decryptNull(residentEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	r.Phone, err = pdb.decryptNull(residentPhone, phone)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (pdb *PostgresDatabase) decryptNull(field string, s sql.NullString) (*string, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	v, err := pdb.cipher.Decrypt(field, s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (pdb *PostgresDatabase) encryptNull(field string, s *string) (*string, error) {
+	if s == nil {
+		return nil, nil
+	}
+	v, err := pdb.cipher.Encrypt(field, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (pdb *PostgresDatabase) GetResidentsOfApartment(ctx context.Context, apartmentId int, current bool) (residents []*storage.Resident, err error) {
+	ctx, end := pdb.track(ctx, "GetResidentsOfApartment")
+	defer end(&err)
+
+	return pdb.queryResidents(ctx,
+		`SELECT `+residentColumns+residentsFrom+`
+		WHERE r.tenant_id = $1 AND r.apartment_id = $2 AND (NOT $3::boolean OR `+currentResident+`)
+		ORDER BY r.move_in, r.id`,
+		apartmentId, current)
+}
+
+func (pdb *PostgresDatabase) GetResidentsOfBuilding(ctx context.Context, buildingId int, current bool) (residents []*storage.Resident, err error) {
+	ctx, end := pdb.track(ctx, "GetResidentsOfBuilding")
+	defer end(&err)
+
+	return pdb.queryResidents(ctx,
+		`SELECT `+residentColumns+residentsFrom+`
+		WHERE r.tenant_id = $1 AND a.building_id = $2 AND (NOT $3::boolean OR `+currentResident+`)
+		ORDER BY r.apartment_id, r.move_in, r.id`,
+		buildingId, current)
+}
+
+// queryResidents runs the query of the residents of the tenant, the tenant is its first parameter.
+func (pdb *PostgresDatabase) queryResidents(ctx context.Context, query string, args ...any) (residents []*storage.Resident, err error) {
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		rows, err := exec.QueryContext(ctx, query, append([]any{tenantID}, args...)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		residents = make([]*storage.Resident, 0)
+		for rows.Next() {
+			r, err := pdb.scanResident(rows)
+			if err != nil {
+				return err
+			}
+			residents = append(residents, r)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return residents, nil
+}
+
+func (pdb *PostgresDatabase) GetResident(ctx context.Context, id int) (r *storage.Resident, err error) {
+	ctx, end := pdb.track(ctx, "GetResident")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		r, err = pdb.scanResident(exec.QueryRowContext(ctx,
+			`SELECT `+residentColumns+residentsFrom+` WHERE r.tenant_id = $1 AND r.id = $2`,
+			tenantID, id))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// CreateResident upserts the resident of an apartment of the tenant, with the personal data
+// encrypted. The resident is inserted from the row of the apartment, so nothing is inserted for an
+// unknown apartment or one of another tenant, and the update skips the residents of another tenant.
+func (pdb *PostgresDatabase) CreateResident(ctx context.Context, resident *storage.Resident) (err error) {
+	ctx, end := pdb.track(ctx, "CreateResident")
+	defer end(&err)
+
+	name, err := pdb.cipher.Encrypt(residentName, resident.Name)
+	if err != nil {
+		return err
+	}
+	email, err := pdb.encryptNull(residentEmail, resident.Email)
+	if err != nil {
+		return err
+	}
+	phone, err := pdb.encryptNull(residentPhone, resident.Phone)
+	if err != nil {
+		return err
+	}
+	var id *int
+	if resident.ID != 0 {
+		id = &resident.ID
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		return exec.QueryRowContext(ctx,
+			`INSERT INTO public.resident AS r (id, tenant_id, apartment_id, "name", email, phone, primary_contact, move_in, move_out)
+			SELECT coalesce($3::integer, nextval('public.resident_id_seq')::integer), a.tenant_id, a.id, $4, $5, $6, $7, $8, $9
+			FROM public.apartment a WHERE a.tenant_id = $1 AND a.id = $2
+			ON CONFLICT (id) DO UPDATE
+			SET apartment_id = EXCLUDED.apartment_id, "name" = EXCLUDED."name", email = EXCLUDED.email,
+				phone = EXCLUDED.phone, primary_contact = EXCLUDED.primary_contact,
+				move_in = EXCLUDED.move_in, move_out = EXCLUDED.move_out
+			WHERE r.tenant_id = EXCLUDED.tenant_id
+			RETURNING id`,
+			tenantID, resident.ApartmentID, id, name, email, phone, resident.PrimaryContact, resident.MoveIn, resident.MoveOut,
+		).Scan(&resident.ID)
+	})
+	if err != nil {
+		return overlapError(err)
+	}
+
+	return nil
+}
+
+func (pdb *PostgresDatabase) DeleteResident(ctx context.Context, id int) (n int64, err error) {
+	ctx, end := pdb.track(ctx, "DeleteResident")
+	defer end(&err)
+
+	tenantID := tenant.FromContext(ctx)
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		res, err := exec.ExecContext(ctx, `DELETE FROM public.resident WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/pii"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+var residentRows = []string{"id", "apartment_id", "building_id", "name", "email", "phone", "primary_contact", "move_in", "move_out"}
+
+// capture matches any argument and keeps it.
+type capture struct {
+	value *driver.Value
+}
+
+func (c capture) Match(v driver.Value) bool {
+	*c.value = v
+	return true
+}
+
+func testCipher(t *testing.T, id string, b byte) *pii.Cipher {
+	t.Helper()
+
+	c, err := pii.ParseKeys(id + ":" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, pii.KeySize)))
+	require.NoError(t, err)
+	return c
+}
+
+func Test_Residents(t *testing.T) {
+	t.Parallel()
+
+	email := "ada@example.com"
+
+	t.Run("encrypted", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		pdb.SetCipher(testCipher(t, "k1", 1))
+
+		var name, encryptedEmail, phone driver.Value
+		mock.ExpectQuery(q(`INSERT INTO public.resident AS r`)).
+			WithArgs(tenant.Default, 7, nil, capture{&name}, capture{&encryptedEmail}, capture{&phone}, true, "2026-01-01", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+		resident := &storage.Resident{
+			ApartmentID:    7,
+			Name:           "Ada Lovelace",
+			Email:          &email,
+			PrimaryContact: true,
+			MoveIn:         storage.NewDate(2026, time.January, 1),
+		}
+		require.NoError(t, pdb.CreateResident(context.Background(), resident))
+		assert.Equal(t, 3, resident.ID)
+		assert.True(t, pii.Encrypted(name.(string)))
+		assert.NotContains(t, name, "Ada")
+		assert.True(t, pii.Encrypted(encryptedEmail.(string)))
+		assert.Nil(t, phone)
+
+		mock.ExpectQuery(q(`SELECT `+residentColumns+residentsFrom+` WHERE r.tenant_id = $1 AND r.id = $2`)).
+			WithArgs(tenant.Default, 3).
+			WillReturnRows(sqlmock.NewRows(residentRows).
+				AddRow(3, 7, 1, name, encryptedEmail, nil, true, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), nil))
+
+		got, err := pdb.GetResident(context.Background(), 3)
+		require.NoError(t, err)
+		resident.BuildingID = 1
+		assert.Equal(t, resident, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`INSERT INTO public.resident AS r`)).
+			WithArgs(tenant.Default, 7, 3, "Ada Lovelace", email, nil, false, "2026-01-01", "2026-06-30").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+		moveOut := storage.NewDate(2026, time.June, 30)
+		err := pdb.CreateResident(context.Background(), &storage.Resident{
+			ID:          3,
+			ApartmentID: 7,
+			Name:        "Ada Lovelace",
+			Email:       &email,
+			MoveIn:      storage.NewDate(2026, time.January, 1),
+			MoveOut:     &moveOut,
+		})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("current", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		pdb.SetCipher(testCipher(t, "k1", 1))
+		mock.ExpectQuery(q(`WHERE r.tenant_id = $1 AND r.apartment_id = $2 AND (NOT $3::boolean OR `+currentResident+`)
+		ORDER BY r.move_in, r.id`)).
+			WithArgs(tenant.Default, 7, true).
+			WillReturnRows(sqlmock.NewRows(residentRows).
+				AddRow(3, 7, 1, "Ada Lovelace", nil, "+972 4 123 4567", false, "2026-01-01", "2026-06-30"))
+
+		got, err := pdb.GetResidentsOfApartment(context.Background(), 7, true)
+		require.NoError(t, err)
+		phone, moveOut := "+972 4 123 4567", storage.NewDate(2026, time.June, 30)
+		assert.Equal(t, []*storage.Resident{{
+			ID:          3,
+			ApartmentID: 7,
+			BuildingID:  1,
+			Name:        "Ada Lovelace",
+			Phone:       &phone,
+			MoveIn:      storage.NewDate(2026, time.January, 1),
+			MoveOut:     &moveOut,
+		}}, got, "the values stored before the encryption are read in clear")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknownKey", func(t *testing.T) {
+		t.Parallel()
+
+		name, err := testCipher(t, "old", 2).Encrypt(residentName, "Ada Lovelace")
+		require.NoError(t, err)
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		pdb.SetCipher(testCipher(t, "k1", 1))
+		mock.ExpectQuery(q(`FROM public.resident r`)).
+			WillReturnRows(sqlmock.NewRows(residentRows).AddRow(3, 7, 1, name, nil, nil, false, "2026-01-01", nil))
+
+		_, err = pdb.GetResidentsOfBuilding(context.Background(), 1, false)
+		assert.ErrorIs(t, err, pii.ErrUnknownKey)
+	})
+
+	t.Run("notFound", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`FROM public.resident r`)).WillReturnRows(sqlmock.NewRows(residentRows))
+
+		_, err := pdb.GetResident(context.Background(), 3)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("unknownApartment", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`INSERT INTO public.resident AS r`)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		err := pdb.CreateResident(context.Background(), &storage.Resident{ApartmentID: 7, Name: "Ada", MoveIn: storage.NewDate(2026, time.January, 1)})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("twoPrimaryContacts", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`INSERT INTO public.resident AS r`)).
+			WillReturnError(&pq.Error{Code: "23P01", Constraint: "resident_one_primary_contact"})
+
+		err := pdb.CreateResident(context.Background(), &storage.Resident{
+			ApartmentID: 7, Name: "Ada", PrimaryContact: true, MoveIn: storage.NewDate(2026, time.January, 1),
+		})
+		assert.ErrorIs(t, err, storage.ErrOverlap)
+		assert.EqualError(t, err, "overlap: resident_one_primary_contact")
+	})
+}
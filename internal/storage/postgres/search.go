@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+const (
+	// buildingText is the searched text of a building, the expression of the search indexes.
+	buildingText = `b."name" || ' ' || coalesce(b.address, '')`
+	// apartmentText is the searched text of an apartment, its number and the text of its building.
+	apartmentText = `a."number" || ' ' || ` + buildingText
+
+	// The headlines mark the words matched by the full-text search in the HTML-escaped text,
+	// without the trailing space of the buildings without an address.
+	headlineOptions   = `'StartSel=<mark>, StopSel=</mark>, HighlightAll=true'`
+	buildingHeadline  = `rtrim(ts_headline('simple', replace(replace(replace(` + buildingText + `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), q.tsq, ` + headlineOptions + `))`
+	apartmentHeadline = `rtrim(ts_headline('simple', replace(replace(replace(` + apartmentText + `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), q.tsq, ` + headlineOptions + `))`
+
+	// searchQuery takes the tenant ($1), the query ($2), the building IDs ($3), NULL for all the
+	// buildings, and the limit ($4). A building matches all the words of the query or, with typos,
+	// is similar enough to it (pg_trgm.word_similarity_threshold). An apartment must also have one
+	// of the words as number, which ranks it above its building.
+	searchQuery = `WITH q AS (
+			SELECT plainto_tsquery('simple', $2) AS tsq, $2::text AS text,
+				regexp_split_to_array(lower(trim($2)), '\s+') AS words
+		)
+		SELECT 'building' AS kind, b.id, b.id AS building_id, b."name" AS title, ` + buildingHeadline + ` AS snippet,
+			ts_rank(to_tsvector('simple', ` + buildingText + `), q.tsq) + word_similarity(q.text, ` + buildingText + `) AS rank
+		FROM public.building b CROSS JOIN q
+		WHERE b.tenant_id = $1 AND ($3::int[] IS NULL OR b.id = ANY($3))
+			AND (to_tsvector('simple', ` + buildingText + `) @@ q.tsq OR q.text <% (` + buildingText + `))
+		UNION ALL
+		SELECT 'apartment', a.id, a.building_id, a."number", ` + apartmentHeadline + `,
+			1 + ts_rank(to_tsvector('simple', ` + apartmentText + `), q.tsq) + word_similarity(q.text, ` + apartmentText + `)
+		FROM public.apartment a JOIN public.building b ON b.id = a.building_id CROSS JOIN q
+		WHERE a.tenant_id = $1 AND ($3::int[] IS NULL OR a.building_id = ANY($3))
+			AND lower(a."number") = ANY(q.words)
+			AND (to_tsvector('simple', ` + apartmentText + `) @@ q.tsq OR q.text <% (` + apartmentText + `))
+		ORDER BY rank DESC, kind, id
+		LIMIT $4`
+)
+
+// Search ranks the buildings and the apartments of the tenant with the full-text search and the
+// trigram similarity of pg_trgm, see searchQuery.
+func (pdb *PostgresDatabase) Search(ctx context.Context, query string, buildingIds []int, limit int) (results []*storage.SearchResult, err error) {
+	ctx, end := pdb.track(ctx, "Search")
+	defer end(&err)
+
+	ids := int64Array(buildingIds)
+	tenantID := tenant.FromContext(ctx)
+	results = []*storage.SearchResult{}
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		rows, err := exec.QueryContext(ctx, searchQuery, tenantID, query, ids, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			r := &storage.SearchResult{}
+			err = rows.Scan(&r.Kind, &r.ID, &r.BuildingID, &r.Title, &r.Snippet, &r.Rank)
+			if err != nil {
+				return err
+			}
+			results = append(results, r)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_Search(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"kind", "id", "building_id", "title", "snippet", "rank"}
+
+	t.Run("buildings", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(searchQuery)).
+			WithArgs("acme", "Meridor 79", "{1,2}", 10).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("apartment", 7, 1, "79", "<mark>79</mark> <mark>Meridor</mark> 1 Main St", 1.5).
+				AddRow("building", 1, 1, "Meridor", "<mark>Meridor</mark> 1 Main St", 0.4))
+
+		got, err := pdb.Search(tenant.WithID(context.Background(), "acme"), "Meridor 79", []int{1, 2}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*storage.SearchResult{
+			{Kind: storage.SearchKindApartment, ID: 7, BuildingID: 1, Title: "79", Snippet: "<mark>79</mark> <mark>Meridor</mark> 1 Main St", Rank: 1.5},
+			{Kind: storage.SearchKindBuilding, ID: 1, BuildingID: 1, Title: "Meridor", Snippet: "<mark>Meridor</mark> 1 Main St", Rank: 0.4},
+		}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(searchQuery)).
+			WithArgs(tenant.Default, "nowhere", nil, 20).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		got, err := pdb.Search(context.Background(), "nowhere", nil, 20)
+		require.NoError(t, err)
+		assert.Equal(t, []*storage.SearchResult{}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+// The statistics queries take the tenant ($1) and the building IDs ($2), NULL for all the buildings.
+const (
+	statsQuery = `SELECT
+			(SELECT count(*) FROM public.building b WHERE b.tenant_id = $1 AND ($2::int[] IS NULL OR b.id = ANY($2))),
+			count(*),
+			coalesce(sum(a.sq_meters), 0),
+			avg(a.sq_meters)::float8,
+			min(a.floor),
+			max(a.floor),
+			count(*) FILTER (WHERE a.number IS NULL),
+			count(*) FILTER (WHERE a.floor IS NULL),
+			count(*) FILTER (WHERE a.sq_meters IS NULL),
+			count(*) FILTER (WHERE EXISTS (
+				SELECT 1 FROM public.resident r WHERE r.tenant_id = a.tenant_id AND r.apartment_id = a.id AND ` + currentResident + `
+			))
+		FROM public.apartment a
+		WHERE a.tenant_id = $1 AND ($2::int[] IS NULL OR a.building_id = ANY($2))`
+	floorsQuery = `SELECT a.floor, count(*)
+		FROM public.apartment a
+		WHERE a.tenant_id = $1 AND ($2::int[] IS NULL OR a.building_id = ANY($2)) AND a.floor IS NOT NULL
+		GROUP BY a.floor
+		ORDER BY a.floor`
+)
+
+// GetStats aggregates the apartments of the tenant in the database, so the rows aren't loaded.
+func (pdb *PostgresDatabase) GetStats(ctx context.Context, buildingIds []int) (s *storage.Stats, err error) {
+	ctx, end := pdb.track(ctx, "GetStats")
+	defer end(&err)
+
+	ids := int64Array(buildingIds)
+	tenantID := tenant.FromContext(ctx)
+	s = &storage.Stats{Floors: []storage.FloorCount{}}
+	err = pdb.scoped(ctx, tenantID, func(exec boil.ContextExecutor) error {
+		var avg sql.NullFloat64
+		var minFloor, maxFloor sql.NullInt64
+		err := exec.QueryRowContext(ctx, statsQuery, tenantID, ids).Scan(
+			&s.Buildings, &s.Apartments, &s.TotalSQMeters, &avg, &minFloor, &maxFloor,
+			&s.Missing.Number, &s.Missing.Floor, &s.Missing.SQMeters, &s.OccupiedApartments,
+		)
+		if err != nil {
+			return err
+		}
+		if s.Apartments > 0 {
+			s.OccupancyRate = ptr(float64(s.OccupiedApartments) / float64(s.Apartments))
+		}
+		if avg.Valid {
+			s.AvgSQMeters = &avg.Float64
+		}
+		if minFloor.Valid {
+			s.MinFloor, s.MaxFloor = ptr(int(minFloor.Int64)), ptr(int(maxFloor.Int64))
+		}
+
+		rows, err := exec.QueryContext(ctx, floorsQuery, tenantID, ids)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var f storage.FloorCount
+			err = rows.Scan(&f.Floor, &f.Apartments)
+			if err != nil {
+				return err
+			}
+			s.Floors = append(s.Floors, f)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// int64Array converts the IDs to an int[] parameter, NULL if they are nil.
+func int64Array(ids []int) pq.Int64Array {
+	if ids == nil {
+		return nil
+	}
+	a := make(pq.Int64Array, len(ids))
+	for i, id := range ids {
+		a[i] = int64(id)
+	}
+	return a
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
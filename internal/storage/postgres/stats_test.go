@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func Test_GetStats(t *testing.T) {
+	t.Parallel()
+
+	statsColumns := []string{"buildings", "apartments", "sum", "avg", "min", "max", "number", "floor", "sq_meters", "occupied"}
+
+	t.Run("building", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(statsQuery)).
+			WithArgs("acme", "{1}").
+			WillReturnRows(sqlmock.NewRows(statsColumns).AddRow(1, 3, 100, 50.0, -1, 2, 0, 1, 1, 2))
+		mock.ExpectQuery(q(floorsQuery)).
+			WithArgs("acme", "{1}").
+			WillReturnRows(sqlmock.NewRows([]string{"floor", "count"}).AddRow(-1, 1).AddRow(2, 1))
+
+		got, err := pdb.GetStats(tenant.WithID(context.Background(), "acme"), []int{1})
+		require.NoError(t, err)
+		avg, minFloor, maxFloor, occupancy := 50.0, -1, 2, 2.0/3
+		assert.Equal(t, &storage.Stats{
+			Buildings:          1,
+			Apartments:         3,
+			TotalSQMeters:      100,
+			AvgSQMeters:        &avg,
+			MinFloor:           &minFloor,
+			MaxFloor:           &maxFloor,
+			Floors:             []storage.FloorCount{{Floor: -1, Apartments: 1}, {Floor: 2, Apartments: 1}},
+			Missing:            storage.MissingCounts{Floor: 1, SQMeters: 1},
+			OccupiedApartments: 2,
+			OccupancyRate:      &occupancy,
+		}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(statsQuery)).
+			WithArgs(tenant.Default, nil).
+			WillReturnRows(sqlmock.NewRows(statsColumns).AddRow(0, 0, 0, nil, nil, nil, 0, 0, 0, 0))
+		mock.ExpectQuery(q(floorsQuery)).
+			WithArgs(tenant.Default, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"floor", "count"}))
+
+		got, err := pdb.GetStats(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, &storage.Stats{Floors: []storage.FloorCount{}}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
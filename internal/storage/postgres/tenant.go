@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// allTenants lets the cross-tenant aggregates through the row-level security policies,
+// it is not a valid tenant ID so the requests can't use it.
+const allTenants = "*"
+
+// scoped runs fn for the tenant. Every query of fn must filter by the tenant itself,
+// with Options.RowLevelSecurity fn also runs in a transaction that sets app.tenant_id,
+// so the row-level security policies reject the rows of the other tenants.
+func (pdb *PostgresDatabase) scoped(ctx context.Context, tenantID string, fn func(exec boil.ContextExecutor) error) (err error) {
+	if !pdb.opts.RowLevelSecurity {
+		return fn(pdb.executor)
+	}
+
+	return pdb.transaction(ctx, tenantID, fn)
+}
+
+// transaction runs fn for the tenant like scoped, but always in a transaction, for the checks
+// that must hold until the write they permit commits, e.g. with the row of the building locked.
+func (pdb *PostgresDatabase) transaction(ctx context.Context, tenantID string, fn func(exec boil.ContextExecutor) error) (err error) {
+	tx, err := pdb.psqlClient.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback())
+		}
+	}()
+
+	exec := tracedExecutor{db: tx}
+	if pdb.opts.RowLevelSecurity {
+		_, err = exec.ExecContext(ctx, "SELECT set_config('app.tenant_id', $1, true)", tenantID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = fn(exec)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,526 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sotskov-do/oms-assignment/internal/models"
+	"github.com/sotskov-do/oms-assignment/internal/storage"
+	"github.com/sotskov-do/oms-assignment/internal/tenant"
+)
+
+func newMockDatabase(t *testing.T, opts Options) (*PostgresDatabase, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return &PostgresDatabase{psqlClient: db, executor: tracedExecutor{db: db}, opts: opts}, mock
+}
+
+func q(sql string) string {
+	return regexp.QuoteMeta(sql)
+}
+
+// Test_TenantScopedQueries checks that every query of the tenant tables filters by the tenant
+// of the request, so one tenant never reads or changes the rows of another.
+func Test_TenantScopedQueries(t *testing.T) {
+	t.Parallel()
+
+	buildingID := 1
+
+	tests := []struct {
+		name   string
+		expect func(mock sqlmock.Sqlmock)
+		run    func(ctx context.Context, pdb *PostgresDatabase) error
+	}{
+		{
+			name: "GetBuildings",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "building".* FROM "building" WHERE ("building"."tenant_id" = $1);`)).
+					WithArgs("acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "address", "tenant_id"}).AddRow(1, "b", nil, "acme"))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				b, err := pdb.GetBuildings(ctx)
+				if err == nil {
+					assert.Equal(t, "acme", b[0].TenantID)
+				}
+				return err
+			},
+		},
+		{
+			name: "GetBuildingsByIDs",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "building".* FROM "building" WHERE ("building"."id" IN ($1,$2)) AND ("building"."tenant_id" = $3);`)).
+					WithArgs(1, 2, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetBuildingsByIDs(ctx, []int{1, 2})
+				return err
+			},
+		},
+		{
+			name: "GetBuilding",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "building".* FROM "building" WHERE ("building"."id" = $1) AND ("building"."tenant_id" = $2) LIMIT 1;`)).
+					WithArgs(1, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetBuilding(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "DeleteBuilding",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(q(`DELETE FROM "building" WHERE ("building"."id" = $1) AND ("building"."tenant_id" = $2);`)).
+					WithArgs(1, "acme").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.DeleteBuilding(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "CreateBuilding",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT COUNT(*) FROM "building" WHERE ("building"."id" = $1) AND ("building"."tenant_id" != $2) LIMIT 1;`)).
+					WithArgs(1, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				// The tenant of the body is replaced with the tenant of the request.
+				mock.ExpectQuery(q(`INSERT INTO "building" ("id", "name", "tenant_id") VALUES ($1,$2,$3) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name","address" = EXCLUDED."address","tenant_id" = EXCLUDED."tenant_id"`)).
+					WithArgs(1, "b", "acme").
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "address", "street", "house_number", "city", "postal_code", "country", "latitude", "longitude",
+					}).AddRow(1, nil, nil, nil, nil, nil, nil, nil, nil))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				return pdb.CreateBuilding(ctx, &models.Building{ID: 1, Name: "b", TenantID: "globex"})
+			},
+		},
+		{
+			name: "GetApartments",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "apartment".* FROM "apartment" WHERE ("apartment"."tenant_id" = $1);`)).
+					WithArgs("acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetApartments(ctx)
+				return err
+			},
+		},
+		{
+			name: "GetApartment",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "apartment".* FROM "apartment" WHERE ("apartment"."id" = $1) AND ("apartment"."tenant_id" = $2) LIMIT 1;`)).
+					WithArgs(1, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetApartment(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetApartmentsInBuilding",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "apartment".* FROM "apartment" WHERE ("apartment"."building_id" = $1) AND ("apartment"."tenant_id" = $2);`)).
+					WithArgs(1, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetApartmentsInBuilding(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetApartmentsInBuildings",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "apartment".* FROM "apartment" WHERE ("apartment"."building_id" IN ($1)) AND ("apartment"."tenant_id" = $2);`)).
+					WithArgs(1, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetApartmentsInBuildings(ctx, []int{1})
+				return err
+			},
+		},
+		{
+			name: "GetApartmentsOnFloor",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`SELECT "apartment".* FROM "apartment" WHERE ("apartment"."building_id" = $1) AND ("apartment"."floor" = $2) AND ("apartment"."tenant_id" = $3);`)).
+					WithArgs(1, 2, "acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetApartmentsOnFloor(ctx, 1, 2)
+				return err
+			},
+		},
+		{
+			name: "DeleteApartment",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(q(`DELETE FROM "apartment" WHERE ("apartment"."id" = $1) AND ("apartment"."tenant_id" = $2);`)).
+					WithArgs(1, "acme").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.DeleteApartment(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetFloors",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`FROM public.floor WHERE tenant_id = $1 AND building_id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "building_id", "number", "label", "sq_meters", "accessible"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetFloors(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetFloor",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`FROM public.floor WHERE tenant_id = $1 AND building_id = $2 AND "number" = $3`)).
+					WithArgs("acme", 1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "building_id", "number", "label", "sq_meters", "accessible"}).AddRow(1, 1, 2, "2", nil, false))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetFloor(ctx, 1, 2)
+				return err
+			},
+		},
+		{
+			name: "CreateFloor",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(q(`SELECT id FROM public.building WHERE tenant_id = $1 AND id = $2 FOR UPDATE`)).
+					WithArgs("acme", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(q(`FROM public.apartment a WHERE a.tenant_id = $1 AND a.building_id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(q(`FROM public.building b WHERE b.tenant_id = $1 AND b.id = $2`)).
+					WithArgs("acme", 1, 2, "2", nil, false).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				return pdb.CreateFloor(ctx, &storage.Floor{BuildingID: 1, Number: 2, Label: "2"})
+			},
+		},
+		{
+			name: "DeleteFloor",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(q(`SELECT id FROM public.building WHERE tenant_id = $1 AND id = $2 FOR UPDATE`)).
+					WithArgs("acme", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(q(`FROM public.apartment WHERE tenant_id = $1 AND building_id = $2 AND floor = $3`)).
+					WithArgs("acme", 1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(q(`DELETE FROM public.floor WHERE tenant_id = $1 AND building_id = $2 AND "number" = $3`)).
+					WithArgs("acme", 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.DeleteFloor(ctx, 1, 2)
+				return err
+			},
+		},
+		{
+			name: "GetResidentsOfApartment",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`WHERE r.tenant_id = $1 AND r.apartment_id = $2`)).
+					WithArgs("acme", 1, true).
+					WillReturnRows(sqlmock.NewRows(residentRows))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetResidentsOfApartment(ctx, 1, true)
+				return err
+			},
+		},
+		{
+			name: "GetResidentsOfBuilding",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`WHERE r.tenant_id = $1 AND a.building_id = $2`)).
+					WithArgs("acme", 1, false).
+					WillReturnRows(sqlmock.NewRows(residentRows))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetResidentsOfBuilding(ctx, 1, false)
+				return err
+			},
+		},
+		{
+			name: "GetResident",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`WHERE r.tenant_id = $1 AND r.id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnRows(sqlmock.NewRows(residentRows).AddRow(1, 1, 1, "Ada", nil, nil, false, "2026-01-01", nil))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetResident(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "CreateResident",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`FROM public.apartment a WHERE a.tenant_id = $1 AND a.id = $2`)).
+					WithArgs("acme", 1, nil, "Ada", nil, nil, false, "2026-01-01", nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				return pdb.CreateResident(ctx, &storage.Resident{ApartmentID: 1, Name: "Ada", MoveIn: storage.NewDate(2026, time.January, 1)})
+			},
+		},
+		{
+			name: "DeleteResident",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(q(`DELETE FROM public.resident WHERE tenant_id = $1 AND id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.DeleteResident(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetLeasesOfApartment",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`WHERE l.tenant_id = $1 AND l.apartment_id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnRows(sqlmock.NewRows(leaseRows))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetLeasesOfApartment(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetExpiringLeases",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`WHERE l.tenant_id = $1 AND ($2::int[] IS NULL OR a.building_id = ANY($2))`)).
+					WithArgs("acme", nil, 30).
+					WillReturnRows(sqlmock.NewRows(leaseRows))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetExpiringLeases(ctx, nil, 30)
+				return err
+			},
+		},
+		{
+			name: "GetLease",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`WHERE l.tenant_id = $1 AND l.id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnRows(sqlmock.NewRows(leaseRows).AddRow(1, 1, 1, "Ada", "2026-01-01", nil, 1000, 0, "EUR", "none", nil, "draft", nil))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetLease(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "CreateLease",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`FROM public.apartment a WHERE a.tenant_id = $1 AND a.id = $2`)).
+					WithArgs("acme", 1, nil, "Ada", "2026-01-01", nil, int64(1000), int64(0), "EUR", "none", nil, "draft", nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				return pdb.CreateLease(ctx, &storage.Lease{
+					ApartmentID: 1, Lessee: "Ada", StartDate: storage.NewDate(2026, time.January, 1), MonthlyRent: 1000,
+					Currency: "EUR", Indexation: storage.IndexationNone, Status: storage.LeaseDraft,
+				})
+			},
+		},
+		{
+			name: "DeleteLease",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(q(`DELETE FROM public.lease WHERE tenant_id = $1 AND id = $2`)).
+					WithArgs("acme", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.DeleteLease(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "GetGrantsBySubject",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`FROM public.building_grant WHERE tenant_id = $1 AND subject = $2`)).
+					WithArgs("acme", "manager").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "subject", "role", "building_id", "created_at"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetGrantsBySubject(ctx, "manager")
+				return err
+			},
+		},
+		{
+			name: "GetGrants",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`FROM public.building_grant WHERE tenant_id = $1`)).
+					WithArgs("acme").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "subject", "role", "building_id", "created_at"}))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.GetGrants(ctx)
+				return err
+			},
+		},
+		{
+			name: "CreateGrant",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(q(`INSERT INTO public.building_grant (tenant_id, subject, "role", building_id)`)).
+					WithArgs("acme", "manager", "viewer", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				return pdb.CreateGrant(ctx, &storage.Grant{Subject: "manager", Role: "viewer", BuildingID: &buildingID})
+			},
+		},
+		{
+			name: "DeleteGrant",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(q(`DELETE FROM public.building_grant WHERE id = $1 AND tenant_id = $2`)).
+					WithArgs(1, "acme").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			run: func(ctx context.Context, pdb *PostgresDatabase) error {
+				_, err := pdb.DeleteGrant(ctx, 1)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pdb, mock := newMockDatabase(t, DefaultOptions())
+			tt.expect(mock)
+
+			err := tt.run(tenant.WithID(context.Background(), "acme"), pdb)
+			require.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_CreateInAnotherTenant(t *testing.T) {
+	t.Parallel()
+
+	ctx := tenant.WithID(context.Background(), "acme")
+
+	t.Run("building", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectQuery(q(`SELECT COUNT(*) FROM "building" WHERE ("building"."id" = $1) AND ("building"."tenant_id" != $2) LIMIT 1;`)).
+			WithArgs(7, "acme").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		err := pdb.CreateBuilding(ctx, &models.Building{ID: 7, Name: "taken"})
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("apartment", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, DefaultOptions())
+		mock.ExpectBegin()
+		mock.ExpectQuery(q(`SELECT COUNT(*) FROM "apartment" WHERE ("apartment"."id" = $1) AND ("apartment"."tenant_id" != $2) LIMIT 1;`)).
+			WithArgs(7, "acme").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		err := pdb.CreateApartment(ctx, &models.Apartment{ID: 7, BuildingID: 1})
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func Test_RowLevelSecurity(t *testing.T) {
+	t.Parallel()
+
+	opts := DefaultOptions()
+	opts.RowLevelSecurity = true
+
+	t.Run("tenant", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, opts)
+		mock.ExpectBegin()
+		mock.ExpectExec(q(`SELECT set_config('app.tenant_id', $1, true)`)).
+			WithArgs("acme").
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectQuery(q(`SELECT "building".* FROM "building" WHERE ("building"."tenant_id" = $1);`)).
+			WithArgs("acme").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		_, err := pdb.GetBuildings(tenant.WithID(context.Background(), "acme"))
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, opts)
+		mock.ExpectBegin()
+		mock.ExpectExec(q(`SELECT set_config('app.tenant_id', $1, true)`)).
+			WithArgs(tenant.Default).
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectExec(q(`DELETE FROM "building"`)).
+			WillReturnError(assert.AnError)
+		mock.ExpectRollback()
+
+		_, err := pdb.DeleteBuilding(context.Background(), 1)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("totals", func(t *testing.T) {
+		t.Parallel()
+
+		pdb, mock := newMockDatabase(t, opts)
+		mock.ExpectBegin()
+		mock.ExpectExec(q(`SELECT set_config('app.tenant_id', $1, true)`)).
+			WithArgs(allTenants).
+			WillReturnResult(driver.ResultNoRows)
+		mock.ExpectQuery(`SELECT`).
+			WillReturnRows(sqlmock.NewRows([]string{"b", "a", "s"}).AddRow(2, 3, 100))
+		mock.ExpectCommit()
+
+		totals, err := pdb.GetTotals(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, storage.Totals{Buildings: 2, Apartments: 3, SQMeters: 100}, totals)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sotskov-do/oms-assignment/internal/logger"
+	"github.com/sotskov-do/oms-assignment/internal/tracing"
+)
+
+// track starts the span of the storage method and returns the function that ends it
+// and reports the duration to the query observer.
+func (pdb *PostgresDatabase) track(ctx context.Context, method string) (context.Context, func(err *error)) {
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "postgres."+method,
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationName(method),
+	)
+
+	return ctx, func(err *error) {
+		if pdb.observer != nil {
+			pdb.observer(method, time.Since(start))
+		}
+		if err != nil && *err != nil {
+			logger.Component(component).DebugContext(ctx, "storage method failed", "method", method, "error", *err)
+		}
+		tracing.End(span, err)
+	}
+}
+
+// tracedExecutor adds the SQL statements to the span of the storage method,
+// db is the pool or the transaction of the method.
+type tracedExecutor struct {
+	db boil.ContextExecutor
+}
+
+var _ boil.ContextExecutor = tracedExecutor{}
+
+func (e tracedExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return e.db.Exec(query, args...)
+}
+
+func (e tracedExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return e.db.Query(query, args...)
+}
+
+func (e tracedExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return e.db.QueryRow(query, args...)
+}
+
+func (e tracedExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	setStatement(ctx, query)
+	return e.db.ExecContext(ctx, query, args...)
+}
+
+func (e tracedExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	setStatement(ctx, query)
+	return e.db.QueryContext(ctx, query, args...)
+}
+
+func (e tracedExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	setStatement(ctx, query)
+	return e.db.QueryRowContext(ctx, query, args...)
+}
+
+func setStatement(ctx context.Context, query string) {
+	// The statements are parameterized, so the values of the arguments are not recorded.
+	trace.SpanFromContext(ctx).SetAttributes(semconv.DBQueryText(query))
+}
@@ -2,15 +2,38 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/sotskov-do/oms-assignment/internal/address"
+	"github.com/sotskov-do/oms-assignment/internal/geo"
 	"github.com/sotskov-do/oms-assignment/internal/models"
 )
 
+// ErrOverlap is returned when the row overlaps another one that an exclusion constraint keeps apart,
+// e.g. two leases of an apartment written concurrently.
+var ErrOverlap = errors.New("overlap")
+
+// ErrNoFloor is returned for an apartment on a floor that its building, which has floors, doesn't have.
+var ErrNoFloor = errors.New("no such floor")
+
+// ErrReferenced is returned when deleting a row that other rows still refer to, e.g. a floor with apartments.
+var ErrReferenced = errors.New("referenced")
+
 //go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.ApartmentsStorage -o ./mocks/
 type ApartmentsStorage interface {
 	GetApartments(ctx context.Context) (models.ApartmentSlice, error)
 	GetApartment(ctx context.Context, id int) (*models.Apartment, error)
 	GetApartmentsInBuilding(ctx context.Context, buildingId int) (models.ApartmentSlice, error)
+	GetApartmentsInBuildings(ctx context.Context, buildingIds []int) (models.ApartmentSlice, error)
+	GetApartmentsOnFloor(ctx context.Context, buildingId int, floor int) (models.ApartmentSlice, error)
+	// StreamApartments calls send with the apartments, only those of buildingIds unless it is nil,
+	// without loading them all in memory. It stops at the first error of send.
+	StreamApartments(ctx context.Context, buildingIds []int, send func(a *models.Apartment) error) error
+	// GetStats aggregates the buildings and the apartments, only those of buildingIds unless it is nil.
+	GetStats(ctx context.Context, buildingIds []int) (*Stats, error)
+	// CreateApartment returns ErrNoFloor for an apartment on a floor that its building doesn't have,
+	// the buildings without floors accept any floor.
 	CreateApartment(ctx context.Context, apartment *models.Apartment) error
 	DeleteApartment(ctx context.Context, id int) (int64, error)
 }
@@ -18,7 +41,317 @@ type ApartmentsStorage interface {
 //go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.BuildingsStorage -o ./mocks/
 type BuildingsStorage interface {
 	GetBuildings(ctx context.Context) (models.BuildingSlice, error)
+	GetBuildingsByIDs(ctx context.Context, ids []int) (models.BuildingSlice, error)
 	GetBuilding(ctx context.Context, id int) (*models.Building, error)
 	CreateBuilding(ctx context.Context, building *models.Building) error
 	DeleteBuilding(ctx context.Context, id int) (int64, error)
 }
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.FloorsStorage -o ./mocks/
+type FloorsStorage interface {
+	// GetFloors returns the floors of the building by number, none for an unknown building.
+	GetFloors(ctx context.Context, buildingId int) ([]*Floor, error)
+	GetFloor(ctx context.Context, buildingId int, number int) (*Floor, error)
+	// CreateFloor replaces the floor of the building with the same number, if it already has one.
+	// The first floor of a building also declares the floors of its apartments. It returns
+	// sql.ErrNoRows for an unknown building.
+	CreateFloor(ctx context.Context, floor *Floor) error
+	// DeleteFloor returns ErrReferenced for a floor with apartments.
+	DeleteFloor(ctx context.Context, buildingId int, number int) (int64, error)
+}
+
+// Floor is a row of the floor table, a storey of a building. The apartments refer to their floor
+// by its number: 0 is the ground floor, the basements are below. The label is the name of the floor
+// in the building, e.g. "G" or "B1".
+type Floor struct {
+	ID         int    `json:"id"`
+	BuildingID int    `json:"building_id"`
+	Number     int    `json:"number"`
+	Label      string `json:"label"`
+	SQMeters   *int   `json:"sq_meters"`
+	// Accessible tells whether the floor has step-free access.
+	Accessible bool `json:"accessible"`
+}
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.ResidentsStorage -o ./mocks/
+type ResidentsStorage interface {
+	// GetResidentsOfApartment returns the residents of the apartment by move-in date, only those
+	// living there today if current is true.
+	GetResidentsOfApartment(ctx context.Context, apartmentId int, current bool) ([]*Resident, error)
+	// GetResidentsOfBuilding returns the residents of the apartments of the building by apartment
+	// and move-in date, only those living there today if current is true.
+	GetResidentsOfBuilding(ctx context.Context, buildingId int, current bool) ([]*Resident, error)
+	GetResident(ctx context.Context, id int) (*Resident, error)
+	// CreateResident inserts the resident, or replaces the one with its ID if it isn't 0. It returns
+	// sql.ErrNoRows for an unknown apartment or the ID of a resident of another tenant, and ErrOverlap
+	// for a primary contact whose stay overlaps another primary contact's.
+	CreateResident(ctx context.Context, resident *Resident) error
+	DeleteResident(ctx context.Context, id int) (int64, error)
+}
+
+// Resident is a row of the resident table, a person living in an apartment from MoveIn until the
+// day before MoveOut. The name and the contact details are personal data, encrypted at rest when
+// a key is configured.
+type Resident struct {
+	ID          int `json:"id"`
+	ApartmentID int `json:"apartment_id"`
+	// BuildingID is the building of the apartment.
+	BuildingID int     `json:"building_id"`
+	Name       string  `json:"name"`
+	Email      *string `json:"email"`
+	Phone      *string `json:"phone"`
+	// PrimaryContact tells whether the resident is the contact of the apartment, there is at most
+	// one at a time.
+	PrimaryContact bool  `json:"primary_contact"`
+	MoveIn         Date  `json:"move_in"`
+	MoveOut        *Date `json:"move_out"`
+}
+
+// Overlaps reports whether the residents lived in their apartments on a same day.
+func (r *Resident) Overlaps(other *Resident) bool {
+	return (r.MoveOut == nil || other.MoveIn.Before(*r.MoveOut)) &&
+		(other.MoveOut == nil || r.MoveIn.Before(*other.MoveOut))
+}
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.LeasesStorage -o ./mocks/
+type LeasesStorage interface {
+	// GetLeasesOfApartment returns the leases of the apartment by start date.
+	GetLeasesOfApartment(ctx context.Context, apartmentId int) ([]*Lease, error)
+	// GetExpiringLeases returns the active leases ending from today to days later, only those of
+	// buildingIds unless it is nil, by end date. The leases already renewed are left out.
+	GetExpiringLeases(ctx context.Context, buildingIds []int, days int) ([]*Lease, error)
+	GetLease(ctx context.Context, id int) (*Lease, error)
+	// CreateLease inserts the lease, or replaces the one with its ID if it isn't 0. It returns
+	// sql.ErrNoRows for an unknown apartment or the ID of a lease of another tenant, and ErrOverlap
+	// for a lease overlapping another lease of the apartment.
+	CreateLease(ctx context.Context, lease *Lease) error
+	DeleteLease(ctx context.Context, id int) (int64, error)
+}
+
+// LeaseStatus is the stage of a lease: a draft is being negotiated, an active lease is signed and
+// a terminated one was ended before its term.
+type LeaseStatus string
+
+const (
+	LeaseDraft      LeaseStatus = "draft"
+	LeaseActive     LeaseStatus = "active"
+	LeaseTerminated LeaseStatus = "terminated"
+)
+
+// Indexation is how the rent of a lease increases every year from its start date: not at all, by
+// a fixed rate or with the consumer price index, possibly capped.
+type Indexation string
+
+const (
+	IndexationNone  Indexation = "none"
+	IndexationFixed Indexation = "fixed"
+	IndexationCPI   Indexation = "cpi"
+)
+
+// Lease is a row of the lease table, the rental of an apartment from StartDate to EndDate
+// included. The lessee is personal data, encrypted at rest when a key is configured.
+type Lease struct {
+	ID          int `json:"id"`
+	ApartmentID int `json:"apartment_id"`
+	// BuildingID is the building of the apartment.
+	BuildingID int `json:"building_id"`
+	// Lessee is the tenant renting the apartment, not to be confused with the tenant of the data.
+	Lessee    string `json:"lessee"`
+	StartDate Date   `json:"start_date"`
+	// EndDate is the last day of the lease, nil for an open-ended lease.
+	EndDate *Date `json:"end_date"`
+	// MonthlyRent and Deposit are in the minor unit of the currency, e.g. cents.
+	MonthlyRent int64 `json:"monthly_rent"`
+	Deposit     int64 `json:"deposit"`
+	// Currency is the ISO 4217 code of the currency, e.g. EUR.
+	Currency   string     `json:"currency"`
+	Indexation Indexation `json:"indexation"`
+	// IndexationRate is the yearly increase of a fixed indexation and the cap of a cpi one, in
+	// percent.
+	IndexationRate *float64    `json:"indexation_rate"`
+	Status         LeaseStatus `json:"status"`
+	// RenewalOf is the ID of the lease this one renews.
+	RenewalOf *int `json:"renewal_of"`
+}
+
+// Overlaps reports whether the leases have a day in common.
+func (l *Lease) Overlaps(other *Lease) bool {
+	return (l.EndDate == nil || !l.EndDate.Before(other.StartDate)) &&
+		(other.EndDate == nil || !other.EndDate.Before(l.StartDate))
+}
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.APIKeysStorage -o ./mocks/
+type APIKeysStorage interface {
+	GetAPIKeys(ctx context.Context) ([]*APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (*APIKey, error)
+	CreateAPIKey(ctx context.Context, apiKey *APIKey) error
+	RevokeAPIKey(ctx context.Context, id int) (int64, error)
+}
+
+// APIKey is a row of the api_key table, only the hash of the key is stored.
+type APIKey struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	Subject   string     `json:"subject"`
+	Prefix    string     `json:"prefix"`
+	Tenant    *string    `json:"tenant,omitempty"`
+	Hash      string     `json:"-"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+//go:generate minimock -i github.com/sotskov-do/oms-assignment/internal/storage.GrantsStorage -o ./mocks/
+type GrantsStorage interface {
+	GetGrants(ctx context.Context) ([]*Grant, error)
+	GetGrantsBySubject(ctx context.Context, subject string) ([]*Grant, error)
+	CreateGrant(ctx context.Context, grant *Grant) error
+	DeleteGrant(ctx context.Context, id int) (int64, error)
+}
+
+// Grant is a row of the building_grant table, a role of the subject on a building
+// or, when BuildingID is nil, on every building.
+type Grant struct {
+	ID         int       `json:"id"`
+	Subject    string    `json:"subject"`
+	Role       string    `json:"role"`
+	BuildingID *int      `json:"building_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// AddressStorage is implemented by the storages that filter and sort the buildings by their address
+// themselves, the buildings service does it in memory for the others.
+type AddressStorage interface {
+	// FindBuildings returns the buildings whose address matches the normalised filter in the order,
+	// only among buildingIds unless it is nil.
+	FindBuildings(ctx context.Context, filter address.Filter, order address.Order, buildingIds []int) (models.BuildingSlice, error)
+}
+
+// SearchStorage is implemented by the storages that search the buildings and the apartments
+// themselves, the search service matches them in memory for the others.
+type SearchStorage interface {
+	// Search returns the limit best matches of the query, only among the buildings of buildingIds
+	// and their apartments unless it is nil.
+	Search(ctx context.Context, query string, buildingIds []int, limit int) ([]*SearchResult, error)
+}
+
+const (
+	SearchKindBuilding  = "building"
+	SearchKindApartment = "apartment"
+)
+
+// SearchResult is a building or an apartment matching a search query.
+type SearchResult struct {
+	// Kind is SearchKindBuilding or SearchKindApartment.
+	Kind string `json:"kind"`
+	// ID is the ID of the building or of the apartment.
+	ID         int `json:"id"`
+	BuildingID int `json:"building_id"`
+	// Title is the name of the building or the number of the apartment.
+	Title string `json:"title"`
+	// Snippet is the searched text, HTML-escaped, with the matched words between <mark> and </mark>.
+	Snippet string `json:"snippet"`
+	// Rank orders the results, the higher the better.
+	Rank float64 `json:"rank"`
+}
+
+// GeoStorage is implemented by the storages that search the buildings by their coordinates
+// themselves, the buildings service filters them in memory for the others. The buildings without
+// coordinates are never found.
+type GeoStorage interface {
+	// GetBuildingsNearby returns the limit buildings closest to the center within radius meters,
+	// the closest first, only among buildingIds unless it is nil.
+	GetBuildingsNearby(ctx context.Context, center geo.Point, radius float64, buildingIds []int, limit int) ([]*NearbyBuilding, error)
+	// GetBuildingsInBox returns the first limit buildings by ID in the box, only among buildingIds
+	// unless it is nil.
+	GetBuildingsInBox(ctx context.Context, box geo.Box, buildingIds []int, limit int) (models.BuildingSlice, error)
+}
+
+// NearbyBuilding is a building found by its distance to the center of a search.
+type NearbyBuilding struct {
+	Building *models.Building `json:"building"`
+	// DistanceM is the distance to the center in meters.
+	DistanceM float64 `json:"distance_m"`
+}
+
+// RateLimitStorage keeps the token buckets of the rate limiter.
+type RateLimitStorage interface {
+	// TakeToken refills the bucket of the key at rate tokens per second up to burst and takes
+	// a token if there is one. It returns the tokens left and whether one was taken.
+	TakeToken(ctx context.Context, key string, rate float64, burst int) (tokens float64, allowed bool, err error)
+	// DeleteIdleBuckets drops the buckets that weren't used for idle, which must be long enough
+	// for them to be full again.
+	DeleteIdleBuckets(ctx context.Context, idle time.Duration) (int64, error)
+}
+
+// IdempotencyStorage keeps the responses of the requests made with an Idempotency-Key.
+type IdempotencyStorage interface {
+	// ReserveIdempotencyKey stores the record as in progress until lockTimeout, so a concurrent
+	// duplicate can't run the request too. If an unexpired record of the same scope and key
+	// already exists nothing is stored and that record is returned instead.
+	ReserveIdempotencyKey(ctx context.Context, record *IdempotencyRecord, lockTimeout time.Duration) (*IdempotencyRecord, error)
+	// CompleteIdempotencyKey stores the response of a reserved record and keeps it for ttl.
+	CompleteIdempotencyKey(ctx context.Context, record *IdempotencyRecord, ttl time.Duration) error
+	// ReleaseIdempotencyKey deletes a record that is still in progress, so the request can be retried.
+	ReleaseIdempotencyKey(ctx context.Context, scope, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+// IdempotencyRecord is a row of the idempotency_key table, the response of a request
+// made with an Idempotency-Key.
+type IdempotencyRecord struct {
+	// Scope is the client that sent the key, the keys of different clients don't collide.
+	Scope string
+	Key   string
+	// RequestHash tells the retries of the request apart from another request with the same key.
+	RequestHash string
+	// Status is 0 while the request is in progress.
+	Status      int
+	ContentType string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// Stats are the aggregates of the apartments of a building or of the portfolio.
+type Stats struct {
+	Buildings     int64 `json:"buildings"`
+	Apartments    int64 `json:"apartments"`
+	TotalSQMeters int64 `json:"total_sq_meters"`
+	// AvgSQMeters is the average of the apartments with an area, nil if none has one.
+	AvgSQMeters *float64 `json:"avg_sq_meters"`
+	// MinFloor and MaxFloor are nil if no apartment has a floor.
+	MinFloor *int `json:"min_floor"`
+	MaxFloor *int `json:"max_floor"`
+	// Floors counts the apartments of every floor, by floor. Those without one are counted in Missing.
+	Floors  []FloorCount  `json:"floors"`
+	Missing MissingCounts `json:"missing"`
+	// OccupiedApartments counts the apartments with a resident today.
+	OccupiedApartments int64 `json:"occupied_apartments"`
+	// OccupancyRate is the share of the occupied apartments, from 0 to 1, nil if there are none.
+	OccupancyRate *float64 `json:"occupancy_rate"`
+}
+
+type FloorCount struct {
+	Floor      int   `json:"floor"`
+	Apartments int64 `json:"apartments"`
+}
+
+// MissingCounts counts the apartments without a value for the field.
+type MissingCounts struct {
+	Number   int64 `json:"number"`
+	Floor    int64 `json:"floor"`
+	SQMeters int64 `json:"sq_meters"`
+}
+
+// Totals are the portfolio-wide counters.
+type Totals struct {
+	Buildings  int64
+	Apartments int64
+	SQMeters   int64
+}
+
+// Reencrypted counts the rows whose personal data was re-encrypted with the current key.
+type Reencrypted struct {
+	Residents int64 `json:"residents"`
+	Leases    int64 `json:"leases"`
+}
@@ -0,0 +1,64 @@
+// Package tenant carries the management company the request acts for.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Default is the tenant of the requests that don't resolve to any, and of the rows
+// that existed before the multi-tenancy.
+const Default = "default"
+
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Validate accepts lowercase slugs of up to 63 characters.
+func Validate(id string) error {
+	if !idPattern.MatchString(id) {
+		return fmt.Errorf("invalid tenant id [%v]", id)
+	}
+	return nil
+}
+
+// ErrForeign is returned when a principal bound to a tenant asks to act for another one.
+var ErrForeign = errors.New("principal can't act for tenant")
+
+// Resolve returns the tenant a request acts for. A principal bound to a tenant can't act for
+// another one, the others choose theirs with requested, Default if it is empty.
+func Resolve(requested, bound string) (string, error) {
+	if requested != "" {
+		err := Validate(requested)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if bound != "" {
+		if requested != "" && requested != bound {
+			return "", fmt.Errorf("%w [%v]", ErrForeign, requested)
+		}
+		return bound, nil
+	}
+	if requested == "" {
+		return Default, nil
+	}
+
+	return requested, nil
+}
+
+type ctxKey struct{}
+
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the tenant of the request, or Default when there is none.
+func FromContext(ctx context.Context) string {
+	id, ok := ctx.Value(ctxKey{}).(string)
+	if !ok || id == "" {
+		return Default
+	}
+	return id
+}
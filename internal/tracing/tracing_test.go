@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return recorder
+}
+
+func attr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, a := range span.Attributes() {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func Test_Middleware(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	app := fiber.New()
+	app.Route("/buildings", func(api fiber.Router) {
+		api.Get("/:id", Middleware, func(c *fiber.Ctx) error {
+			_, span := Start(c.UserContext(), "buildings.GetBuilding")
+			span.End()
+			return c.SendString("ok")
+		}).Name("getByID")
+		api.Delete("/:id", Middleware, func(c *fiber.Ctx) error {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}).Name("delete")
+	}, "buildings.")
+
+	t.Run("propagated", func(t *testing.T) {
+		traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+		req := httptest.NewRequest(fiber.MethodGet, "/buildings/7", nil)
+		req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+
+		_, err := app.Test(req)
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		child, server := spans[0], spans[1]
+
+		assert.Equal(t, "buildings.getByID", server.Name())
+		assert.Equal(t, traceID, server.SpanContext().TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+		assert.Equal(t, "/buildings/:id", attr(server, "http.route").AsString())
+		assert.Equal(t, "7", attr(server, "http.route.param.id").AsString())
+		assert.Equal(t, int64(200), attr(server, "http.response.status_code").AsInt64())
+
+		assert.Equal(t, "buildings.GetBuilding", child.Name())
+		assert.Equal(t, server.SpanContext().SpanID(), child.Parent().SpanID())
+	})
+
+	t.Run("serverError", func(t *testing.T) {
+		_, err := app.Test(httptest.NewRequest(fiber.MethodDelete, "/buildings/7", nil))
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		server := spans[len(spans)-1]
+		assert.Equal(t, "buildings.delete", server.Name())
+		assert.False(t, server.Parent().IsValid())
+		assert.Equal(t, codes.Error, server.Status().Code)
+	})
+}
+
+func Test_End(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	f := func(fail bool) (err error) {
+		_, span := Start(context.Background(), "op")
+		defer End(span, &err)
+
+		if fail {
+			return errors.New("storageError")
+		}
+		return nil
+	}
+
+	_ = f(false)
+	_ = f(true)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "storageError", spans[1].Status().Description)
+}